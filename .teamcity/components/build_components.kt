@@ -92,6 +92,22 @@ fun BuildSteps.RunAcceptanceTestsForPullRequest(packageName: String) {
     }
 }
 
+fun BuildSteps.DownloadPreviousExampleResults(buildTypeId: String) {
+    // the first run won't have any previous results, so this is allowed to fail
+    var resultsUrl = "%%teamcity.serverUrl%%/httpAuth/app/rest/builds/buildType:%s,status:SUCCESS,count:1/artifacts/content/examples-results.csv".format(buildTypeId)
+    step(ScriptBuildStep {
+        name = "Download Results from the Previous Run"
+        scriptContent = "curl -sSf -u \"%%system.teamcity.auth.userId%%:%%system.teamcity.auth.password%%\" -o examples-baseline.csv \"%s\" || echo \"No previous results were found\"".format(resultsUrl)
+    })
+}
+
+fun BuildSteps.RunExamples() {
+    step(ScriptBuildStep {
+        name = "Run Examples"
+        scriptContent = "make examples-test"
+    })
+}
+
 fun ParametrizedWithType.TerraformAcceptanceTestParameters(parallelism : Int, prefix : String, timeout: String) {
     text("PARALLELISM", "%d".format(parallelism))
     text("TEST_PREFIX", prefix)
@@ -0,0 +1,53 @@
+import jetbrains.buildServer.configs.kotlin.v2019_2.*
+
+// runs each of the examples within ./examples against the Azure Stack Hub nightly, recording
+// how long each example took so that regressions can be surfaced
+class examples(displayName: String, environment: String) {
+    val displayName = displayName
+    val environment = environment
+
+    fun buildConfiguration(providerName : String, nightlyTestsEnabled: Boolean, startHour: Int, daysOfWeek: String, daysOfMonth: String) : BuildType {
+        var buildTypeId = uniqueID(providerName)
+        return BuildType {
+            // TC needs a consistent ID for dynamically generated packages
+            id(buildTypeId)
+
+            name = displayName
+
+            vcs {
+                root(providerRepository)
+                cleanCheckout = true
+            }
+
+            steps {
+                ConfigureGoEnv()
+                DownloadTerraformBinary()
+                DownloadPreviousExampleResults(buildTypeId)
+                RunExamples()
+            }
+
+            // the results are published so that the next run can compare against them
+            artifactRules = "examples-results.csv"
+
+            failureConditions {
+                errorMessage = true
+            }
+
+            params {
+                TerraformCoreBinaryTesting()
+                ReadOnlySettings()
+
+                text("env.EXAMPLES_BASELINE_FILE", "examples-baseline.csv", "The results of the previous run, used to detect regressions")
+                text("env.EXAMPLES_SLOWDOWN_THRESHOLD", "1.5", "How many times slower an example can be than the previous run before it's considered a regression")
+            }
+
+            triggers {
+                RunNightly(nightlyTestsEnabled, startHour, daysOfWeek, daysOfMonth)
+            }
+        }
+    }
+
+    fun uniqueID(provider : String) : String {
+        return "%s_EXAMPLES_%s".format(provider.toUpperCase(), environment.toUpperCase())
+    }
+}
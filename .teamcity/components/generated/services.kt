@@ -1,9 +1,12 @@
 // NOTE: this is Generated from the Service Definitions - manual changes will be lost
 //       to re-generate this file, run 'make generate' in the root of the repository
 var services = mapOf(
+        "admin" to "Admin",
         "authorization" to "Authorization",
         "compute" to "Compute",
         "dns" to "DNS",
+        "eventhub" to "EventHub",
+        "iothub" to "IoT Hub",
         "loadbalancer" to "Load Balancer",
         "network" to "Network",
         "resource" to "Resources",
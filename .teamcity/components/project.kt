@@ -10,6 +10,9 @@ fun AzureStack(environment: String, configuration : ClientConfiguration) : Proje
         var pullRequestBuildConfig = pullRequestBuildConfiguration(environment, configuration)
         buildType(pullRequestBuildConfig)
 
+        var examplesBuildConfig = examplesBuildConfiguration(environment, configuration)
+        buildType(examplesBuildConfig)
+
         var buildConfigs = buildConfigurationsForServices(services, providerName, environment, configuration)
         buildConfigs.forEach { buildConfiguration ->
             buildType(buildConfiguration)
@@ -46,6 +49,15 @@ fun pullRequestBuildConfiguration(environment: String, configuration: ClientConf
     return buildConfiguration
 }
 
+fun examplesBuildConfiguration(environment: String, configuration: ClientConfiguration) : BuildType {
+    var locationsForEnv = locations.get(environment)!!
+    var runNightly = runNightly.getOrDefault(environment, false)
+    var examples = examples("! Run Examples", environment)
+    var buildConfiguration = examples.buildConfiguration(providerName, runNightly, examplesStartHour, defaultDaysOfWeek, defaultDaysOfMonth)
+    buildConfiguration.params.ConfigureAzureSpecificTestParameters(configuration, locationsForEnv)
+    return buildConfiguration
+}
+
 class testConfiguration(parallelism: Int = defaultParallelism, startHour: Int = defaultStartHour, daysOfWeek: String = defaultDaysOfWeek, daysOfMonth: String = defaultDaysOfMonth) {
     var parallelism = parallelism
     var startHour = startHour
@@ -1,6 +1,9 @@
 // specifies the default hour (UTC) at which tests should be triggered, if enabled
 var defaultStartHour = 0
 
+// specifies the hour (UTC) at which the examples should be run, after the acceptance tests have been triggered
+var examplesStartHour = 4
+
 // specifies the default level of parallelism per-service-package
 var defaultParallelism = 20
 
@@ -0,0 +1,22 @@
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "example" {
+  name     = "${var.prefix}-resources"
+  location = var.location
+}
+
+resource "azurestack_storage_account" "example" {
+  name                     = "${replace(var.prefix, "-", "")}storage"
+  resource_group_name      = azurestack_resource_group.example.name
+  location                 = azurestack_resource_group.example.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurestack_storage_container" "example" {
+  name                  = "content"
+  storage_account_name  = azurestack_storage_account.example.name
+  container_access_type = "private"
+}
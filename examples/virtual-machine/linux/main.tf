@@ -0,0 +1,76 @@
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "example" {
+  name     = "${var.prefix}-resources"
+  location = var.location
+}
+
+resource "azurestack_virtual_network" "example" {
+  name                = "${var.prefix}-network"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.example.location
+  resource_group_name = azurestack_resource_group.example.name
+}
+
+resource "azurestack_subnet" "example" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.example.name
+  virtual_network_name = azurestack_virtual_network.example.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_network_interface" "example" {
+  name                = "${var.prefix}-nic"
+  location            = azurestack_resource_group.example.location
+  resource_group_name = azurestack_resource_group.example.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurestack_subnet.example.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "random_password" "example" {
+  length      = 24
+  min_lower   = 1
+  min_upper   = 1
+  min_numeric = 1
+  min_special = 1
+}
+
+resource "azurestack_virtual_machine" "example" {
+  name                  = "${var.prefix}-vm"
+  location              = azurestack_resource_group.example.location
+  resource_group_name   = azurestack_resource_group.example.name
+  network_interface_ids = [azurestack_network_interface.example.id]
+  vm_size               = var.vm_size
+
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "${var.prefix}-osdisk"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "${var.prefix}-vm"
+    admin_username = "adminuser"
+    admin_password = random_password.example.result
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
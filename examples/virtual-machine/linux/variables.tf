@@ -0,0 +1,12 @@
+variable "prefix" {
+  description = "The prefix which should be used for all resources in this example"
+}
+
+variable "location" {
+  description = "The Azure Stack Hub Region in which all resources in this example should be created."
+}
+
+variable "vm_size" {
+  description = "The size of the Virtual Machine which should be created."
+  default     = "Standard_DS1_v2"
+}
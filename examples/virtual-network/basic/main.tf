@@ -0,0 +1,22 @@
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "example" {
+  name     = "${var.prefix}-resources"
+  location = var.location
+}
+
+resource "azurestack_virtual_network" "example" {
+  name                = "${var.prefix}-network"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.example.location
+  resource_group_name = azurestack_resource_group.example.name
+}
+
+resource "azurestack_subnet" "example" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.example.name
+  virtual_network_name = azurestack_virtual_network.example.name
+  address_prefix       = "10.0.2.0/24"
+}
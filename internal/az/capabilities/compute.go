@@ -0,0 +1,125 @@
+package capabilities
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// NOTE: Azure Stack Hub doesn't expose the Resource SKUs API, as such the capabilities of a Virtual Machine Size
+// are derived from the data returned by the Virtual Machine Sizes API, combined with the Azure naming convention
+// for Virtual Machine Sizes - where an `s` in the additive features (or an `S` family suffix, such as `DS` or `GS`)
+// denotes support for Premium Storage.
+// see: https://docs.microsoft.com/en-us/azure/virtual-machines/vm-naming-conventions
+
+// ZonesMinimumAPIVersion is the Compute API version which introduced support for Availability Zones
+const ZonesMinimumAPIVersion = "2017-03-30"
+
+// ProximityPlacementGroupsMinimumAPIVersion is the Compute API version which introduced support for Proximity Placement Groups
+const ProximityPlacementGroupsMinimumAPIVersion = "2018-04-01"
+
+// ProximityPlacementGroupsResourceType is the Resource Type for Proximity Placement Groups, which is only available on
+// some builds of Azure Stack Hub
+const ProximityPlacementGroupsResourceType = "Microsoft.Compute/proximityPlacementGroups"
+
+const (
+	storageAccountTypePremium = "Premium_LRS"
+
+	cachingNone     = "None"
+	cachingReadOnly = "ReadOnly"
+)
+
+// VirtualMachineSize describes the capabilities of a Virtual Machine Size available within an Azure Stack Hub Location
+type VirtualMachineSize struct {
+	// Name is the name of the Virtual Machine Size, for example `Standard_DS2_v2`
+	Name string
+
+	// MaxDataDiskCount is the maximum number of Data Disks which can be attached to this Virtual Machine Size
+	MaxDataDiskCount int
+}
+
+// VirtualMachineDisk describes the performance attributes of a Disk attached to a Virtual Machine
+type VirtualMachineDisk struct {
+	// Field is the path to the block defining this Disk, for example `storage_os_disk.0`
+	Field string
+
+	// StorageAccountType is the type of Managed Disk, which is empty for Unmanaged Disks
+	StorageAccountType string
+
+	// Caching is the caching mode of this Disk, which is empty when it's unspecified
+	Caching string
+
+	// WriteAcceleratorEnabled specifies whether Write Accelerator is enabled for this Disk
+	WriteAcceleratorEnabled bool
+}
+
+// SupportsPremiumStorage returns whether Premium Managed Disks can be attached to this Virtual Machine Size
+func (s VirtualMachineSize) SupportsPremiumStorage() bool {
+	family, features := s.parse()
+	return (len(family) > 1 && strings.HasSuffix(family, "S")) || strings.Contains(features, "s")
+}
+
+// SupportsWriteAccelerator returns whether Write Accelerator can be enabled for Disks attached to this Virtual Machine Size
+func (s VirtualMachineSize) SupportsWriteAccelerator() bool {
+	family, _ := s.parse()
+	return family == "M" && s.SupportsPremiumStorage()
+}
+
+// ValidateDataDiskCount returns an error if the number of Data Disks exceeds the maximum supported by this Virtual Machine Size
+func (s VirtualMachineSize) ValidateDataDiskCount(field string, count int) error {
+	if s.MaxDataDiskCount > 0 && count > s.MaxDataDiskCount {
+		return fmt.Errorf("`%s`: %d Data Disks exceeds the maximum of %d supported by the Virtual Machine Size %q", field, count, s.MaxDataDiskCount, s.Name)
+	}
+
+	return nil
+}
+
+// parse splits the name of the Virtual Machine Size into the (upper-case) family and the (lower-case) additive features,
+// for example `Standard_D4s_v3` returns `D` and `s` - whilst `Standard_M64-32ms` returns `M` and `ms`
+func (s VirtualMachineSize) parse() (family string, features string) {
+	segments := strings.Split(s.Name, "_")
+	if len(segments) < 2 {
+		return "", ""
+	}
+
+	// the first segment is the tier (e.g. `Standard` or `Basic`) and any trailing segments are the version (e.g. `v2`)
+	size := segments[1]
+
+	familyEnd := strings.IndexFunc(size, unicode.IsDigit)
+	if familyEnd == -1 {
+		return strings.ToUpper(size), ""
+	}
+
+	featuresStart := strings.LastIndexFunc(size, unicode.IsDigit) + 1
+	return strings.ToUpper(size[:familyEnd]), strings.ToLower(size[featuresStart:])
+}
+
+// ValidateDisk returns an error if the performance attributes of the Disk aren't supported, either at all
+// or by the Virtual Machine Size - which is nil when the Virtual Machine Size isn't known yet
+func ValidateDisk(disk VirtualMachineDisk, size *VirtualMachineSize) error {
+	isPremium := strings.EqualFold(disk.StorageAccountType, storageAccountTypePremium)
+
+	if disk.WriteAcceleratorEnabled {
+		if !isPremium {
+			return fmt.Errorf("`%s.write_accelerator_enabled` can only be enabled when `managed_disk_type` is set to %q", disk.Field, storageAccountTypePremium)
+		}
+
+		if disk.Caching != "" && !strings.EqualFold(disk.Caching, cachingNone) && !strings.EqualFold(disk.Caching, cachingReadOnly) {
+			return fmt.Errorf("`%s.write_accelerator_enabled` can only be enabled when `caching` is set to %q or %q", disk.Field, cachingNone, cachingReadOnly)
+		}
+	}
+
+	if size == nil {
+		return nil
+	}
+
+	if isPremium && !size.SupportsPremiumStorage() {
+		return fmt.Errorf("`%s.managed_disk_type`: the Virtual Machine Size %q doesn't support %q Disks - use a Virtual Machine Size which supports Premium Storage (such as the `DS`, `DSv2` or `Fs` series) or a Standard Disk", disk.Field, size.Name, storageAccountTypePremium)
+	}
+
+	if disk.WriteAcceleratorEnabled && !size.SupportsWriteAccelerator() {
+		return fmt.Errorf("`%s.write_accelerator_enabled`: the Virtual Machine Size %q doesn't support Write Accelerator", disk.Field, size.Name)
+	}
+
+	return nil
+}
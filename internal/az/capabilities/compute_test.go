@@ -0,0 +1,235 @@
+package capabilities
+
+import (
+	"testing"
+)
+
+func TestVirtualMachineSizeSupportsPremiumStorage(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "Standard_A1",
+			Expected: false,
+		},
+		{
+			Name:     "Standard_A2m_v2",
+			Expected: false,
+		},
+		{
+			Name:     "Standard_D2_v2",
+			Expected: false,
+		},
+		{
+			Name:     "Standard_DS2_v2",
+			Expected: true,
+		},
+		{
+			Name:     "standard_ds11-1_v2",
+			Expected: true,
+		},
+		{
+			Name:     "Standard_F4",
+			Expected: false,
+		},
+		{
+			Name:     "Standard_F4s",
+			Expected: true,
+		},
+		{
+			Name:     "Standard_F8s_v2",
+			Expected: true,
+		},
+		{
+			Name:     "Standard_D4s_v3",
+			Expected: true,
+		},
+		{
+			Name:     "Standard_B2ms",
+			Expected: true,
+		},
+		{
+			Name:     "Standard_M64-32ms",
+			Expected: true,
+		},
+		{
+			Name:     "Basic_A0",
+			Expected: false,
+		},
+		{
+			Name:     "invalid",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Name %q", tc.Name)
+		actual := VirtualMachineSize{Name: tc.Name}.SupportsPremiumStorage()
+
+		if tc.Expected != actual {
+			t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineSizeSupportsWriteAccelerator(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "Standard_DS2_v2",
+			Expected: false,
+		},
+		{
+			Name:     "Standard_M64ms",
+			Expected: true,
+		},
+		{
+			Name:     "Standard_M64-32ms",
+			Expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Name %q", tc.Name)
+		actual := VirtualMachineSize{Name: tc.Name}.SupportsWriteAccelerator()
+
+		if tc.Expected != actual {
+			t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineSizeValidateDataDiskCount(t *testing.T) {
+	size := VirtualMachineSize{
+		Name:             "Standard_DS1_v2",
+		MaxDataDiskCount: 4,
+	}
+
+	cases := []struct {
+		Value int
+		Valid bool
+	}{
+		{
+			Value: 0,
+			Valid: true,
+		},
+		{
+			Value: 4,
+			Valid: true,
+		},
+		{
+			Value: 5,
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %d", tc.Value)
+		err := size.ValidateDataDiskCount("storage_data_disk", tc.Value)
+		valid := err == nil
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestValidateDisk(t *testing.T) {
+	standard := &VirtualMachineSize{Name: "Standard_D2_v2"}
+	premium := &VirtualMachineSize{Name: "Standard_DS2_v2"}
+
+	cases := []struct {
+		Disk  VirtualMachineDisk
+		Size  *VirtualMachineSize
+		Valid bool
+	}{
+		{
+			// unknown size, standard disk
+			Disk: VirtualMachineDisk{
+				StorageAccountType: "Standard_LRS",
+				Caching:            "ReadWrite",
+			},
+			Valid: true,
+		},
+		{
+			// unknown size, premium disk
+			Disk: VirtualMachineDisk{
+				StorageAccountType: "Premium_LRS",
+				Caching:            "ReadWrite",
+			},
+			Valid: true,
+		},
+		{
+			// write accelerator requires premium disks regardless of the size
+			Disk: VirtualMachineDisk{
+				StorageAccountType:      "Standard_LRS",
+				WriteAcceleratorEnabled: true,
+			},
+			Valid: false,
+		},
+		{
+			// write accelerator doesn't support read/write caching
+			Disk: VirtualMachineDisk{
+				StorageAccountType:      "Premium_LRS",
+				Caching:                 "ReadWrite",
+				WriteAcceleratorEnabled: true,
+			},
+			Valid: false,
+		},
+		{
+			Disk: VirtualMachineDisk{
+				StorageAccountType: "Premium_LRS",
+				Caching:            "ReadOnly",
+			},
+			Size:  standard,
+			Valid: false,
+		},
+		{
+			Disk: VirtualMachineDisk{
+				StorageAccountType: "premium_lrs",
+				Caching:            "ReadOnly",
+			},
+			Size:  premium,
+			Valid: true,
+		},
+		{
+			// unmanaged disk
+			Disk: VirtualMachineDisk{
+				Caching: "ReadWrite",
+			},
+			Size:  standard,
+			Valid: true,
+		},
+		{
+			Disk: VirtualMachineDisk{
+				StorageAccountType:      "Premium_LRS",
+				Caching:                 "None",
+				WriteAcceleratorEnabled: true,
+			},
+			Size:  premium,
+			Valid: false,
+		},
+		{
+			Disk: VirtualMachineDisk{
+				StorageAccountType:      "Premium_LRS",
+				Caching:                 "None",
+				WriteAcceleratorEnabled: true,
+			},
+			Size:  &VirtualMachineSize{Name: "Standard_M64ms"},
+			Valid: true,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Logf("[DEBUG] Testing Case %d", i)
+		err := ValidateDisk(tc.Disk, tc.Size)
+		valid := err == nil
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t (%+v)", tc.Valid, valid, err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package capabilities
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NOTE: Azure Stack Hub doesn't expose the SKUs available for Container Registries - the Container Registry Resource
+// Provider only offers the `Standard` SKU, however Stamps which support geo-replication (a `Premium` feature) expose
+// the Replications Resource Type, as such the SKUs are derived from the Resource Types supported by the Stamp.
+
+// ContainerRegistryReplicationResourceType is the Resource Type for Container Registry Replications, which is only
+// available on Azure Stack Hubs where the `Premium` SKU is supported
+const ContainerRegistryReplicationResourceType = "Microsoft.ContainerRegistry/registries/replications"
+
+const (
+	containerRegistrySkuBasic    = "Basic"
+	containerRegistrySkuStandard = "Standard"
+	containerRegistrySkuPremium  = "Premium"
+)
+
+// ContainerRegistrySkus returns the Container Registry SKUs supported by the Azure Stack Hub, which is every SKU when
+// the capabilities are unknown
+func (r *ResourceTypes) ContainerRegistrySkus() []string {
+	if !r.Known() {
+		return []string{containerRegistrySkuBasic, containerRegistrySkuStandard, containerRegistrySkuPremium}
+	}
+
+	skus := []string{containerRegistrySkuStandard}
+	if _, ok := r.Get(ContainerRegistryReplicationResourceType); ok {
+		skus = append(skus, containerRegistrySkuPremium)
+	}
+
+	return skus
+}
+
+// ValidateContainerRegistrySku returns an error if the Container Registry SKU specified for the field isn't supported
+// by the Azure Stack Hub
+func (r *ResourceTypes) ValidateContainerRegistrySku(field, sku string) error {
+	skus := r.ContainerRegistrySkus()
+	for _, v := range skus {
+		if strings.EqualFold(v, sku) {
+			return nil
+		}
+	}
+
+	if strings.EqualFold(sku, containerRegistrySkuPremium) {
+		return fmt.Errorf("`%s`: the SKU %q requires geo-replication, which is not supported on this Azure Stack build (%q isn't available) - the supported SKUs are: %s", field, sku, ContainerRegistryReplicationResourceType, strings.Join(skus, ", "))
+	}
+
+	return fmt.Errorf("`%s`: the SKU %q is not supported on this Azure Stack build - the supported SKUs are: %s", field, sku, strings.Join(skus, ", "))
+}
@@ -0,0 +1,97 @@
+package capabilities
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestResourceTypesValidateContainerRegistrySku(t *testing.T) {
+	withoutReplications := NewResourceTypes([]resources.Provider{
+		{
+			Namespace: pointer.FromString("Microsoft.ContainerRegistry"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: pointer.FromString("registries"),
+					APIVersions:  &[]string{"2019-05-01"},
+				},
+			},
+		},
+	})
+	withReplications := NewResourceTypes([]resources.Provider{
+		{
+			Namespace: pointer.FromString("Microsoft.ContainerRegistry"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: pointer.FromString("registries"),
+					APIVersions:  &[]string{"2019-05-01"},
+				},
+				{
+					ResourceType: pointer.FromString("registries/replications"),
+					APIVersions:  &[]string{"2019-05-01"},
+				},
+			},
+		},
+	})
+
+	cases := []struct {
+		Name          string
+		ResourceTypes *ResourceTypes
+		Sku           string
+		Valid         bool
+	}{
+		{
+			Name:          "Standard without replications",
+			ResourceTypes: withoutReplications,
+			Sku:           "Standard",
+			Valid:         true,
+		},
+		{
+			Name:          "Basic without replications",
+			ResourceTypes: withoutReplications,
+			Sku:           "Basic",
+			Valid:         false,
+		},
+		{
+			Name:          "Premium without replications",
+			ResourceTypes: withoutReplications,
+			Sku:           "Premium",
+			Valid:         false,
+		},
+		{
+			Name:          "Premium with replications",
+			ResourceTypes: withReplications,
+			Sku:           "Premium",
+			Valid:         true,
+		},
+		{
+			Name:          "Basic with replications",
+			ResourceTypes: withReplications,
+			Sku:           "Basic",
+			Valid:         false,
+		},
+		{
+			Name:          "Basic when unknown",
+			ResourceTypes: &ResourceTypes{},
+			Sku:           "Basic",
+			Valid:         true,
+		},
+		{
+			Name:          "Premium when unknown",
+			ResourceTypes: &ResourceTypes{},
+			Sku:           "Premium",
+			Valid:         true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+		err := tc.ResourceTypes.ValidateContainerRegistrySku("sku", tc.Sku)
+		valid := err == nil
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package capabilities
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NOTE: Azure Stack Hub doesn't expose the SKUs available for Key Vault - however the `premium` SKU requires keys to be
+// backed by a Hardware Security Module, which is only the case when the Stamp exposes Managed HSMs, as such the SKUs
+// are derived from the Resource Types supported by the Stamp.
+
+// KeyVaultManagedHSMResourceType is the Resource Type for Managed Hardware Security Modules, which is only available on
+// Azure Stack Hubs where keys can be HSM-backed
+const KeyVaultManagedHSMResourceType = "Microsoft.KeyVault/managedHSMs"
+
+const (
+	keyVaultSkuStandard = "standard"
+	keyVaultSkuPremium  = "premium"
+)
+
+// KeyVaultSkus returns the Key Vault SKUs supported by the Azure Stack Hub, which is every SKU when the capabilities
+// are unknown
+func (r *ResourceTypes) KeyVaultSkus() []string {
+	skus := []string{keyVaultSkuStandard}
+
+	if _, ok := r.Get(KeyVaultManagedHSMResourceType); ok || !r.Known() {
+		skus = append(skus, keyVaultSkuPremium)
+	}
+
+	return skus
+}
+
+// ValidateKeyVaultSku returns an error if the Key Vault SKU specified for the field isn't supported by the Azure Stack Hub
+func (r *ResourceTypes) ValidateKeyVaultSku(field, sku string) error {
+	skus := r.KeyVaultSkus()
+	for _, v := range skus {
+		if strings.EqualFold(v, sku) {
+			return nil
+		}
+	}
+
+	if strings.EqualFold(sku, keyVaultSkuPremium) {
+		return fmt.Errorf("`%s`: the SKU %q requires HSM-backed keys, which are not supported on this Azure Stack build (%q isn't available) - the supported SKUs are: %s", field, sku, KeyVaultManagedHSMResourceType, strings.Join(skus, ", "))
+	}
+
+	return fmt.Errorf("`%s`: the SKU %q is not supported on this Azure Stack build - the supported SKUs are: %s", field, sku, strings.Join(skus, ", "))
+}
@@ -0,0 +1,85 @@
+package capabilities
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestResourceTypesValidateKeyVaultSku(t *testing.T) {
+	withoutHSM := NewResourceTypes([]resources.Provider{
+		{
+			Namespace: pointer.FromString("Microsoft.KeyVault"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: pointer.FromString("vaults"),
+					APIVersions:  &[]string{"2019-09-01", "2016-10-01"},
+				},
+			},
+		},
+	})
+	withHSM := NewResourceTypes([]resources.Provider{
+		{
+			Namespace: pointer.FromString("Microsoft.KeyVault"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: pointer.FromString("vaults"),
+					APIVersions:  &[]string{"2019-09-01", "2016-10-01"},
+				},
+				{
+					ResourceType: pointer.FromString("managedHSMs"),
+					APIVersions:  &[]string{"2021-04-01-preview"},
+				},
+			},
+		},
+	})
+
+	cases := []struct {
+		Name          string
+		ResourceTypes *ResourceTypes
+		Sku           string
+		Valid         bool
+	}{
+		{
+			Name:          "standard without HSM",
+			ResourceTypes: withoutHSM,
+			Sku:           "standard",
+			Valid:         true,
+		},
+		{
+			Name:          "premium without HSM",
+			ResourceTypes: withoutHSM,
+			Sku:           "premium",
+			Valid:         false,
+		},
+		{
+			Name:          "standard with HSM",
+			ResourceTypes: withHSM,
+			Sku:           "standard",
+			Valid:         true,
+		},
+		{
+			Name:          "premium with HSM",
+			ResourceTypes: withHSM,
+			Sku:           "premium",
+			Valid:         true,
+		},
+		{
+			Name:          "premium when unknown",
+			ResourceTypes: &ResourceTypes{},
+			Sku:           "premium",
+			Valid:         true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+		err := tc.ResourceTypes.ValidateKeyVaultSku("sku_name", tc.Sku)
+		valid := err == nil
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, err)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package capabilities
+
+import (
+	"fmt"
+)
+
+// Limit is a service limit imposed by Azure Stack Hub, which is frequently lower than the equivalent limit in Azure.
+//
+// Limits are validated at plan time so that configurations which exceed them fail early with a clear message,
+// rather than part-way through an apply.
+type Limit struct {
+	// Description describes what's being limited, for example "security rules per Network Security Group"
+	Description string
+
+	// Maximum is the largest value supported by Azure Stack Hub
+	Maximum int
+}
+
+// Validate returns an error if the value specified for the field exceeds this Limit
+func (l Limit) Validate(field string, value int) error {
+	if value > l.Maximum {
+		return fmt.Errorf("`%s`: %d exceeds the Azure Stack Hub limit of %d %s", field, value, l.Maximum, l.Description)
+	}
+
+	return nil
+}
+
+// ValidateStringLength returns a SchemaValidateFunc which ensures the length of a string doesn't exceed this Limit
+func (l Limit) ValidateStringLength() func(interface{}, string) ([]string, []error) {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		if err := l.Validate(k, len(v)); err != nil {
+			errors = append(errors, err)
+		}
+
+		return
+	}
+}
@@ -0,0 +1,97 @@
+package capabilities
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLimitValidate(t *testing.T) {
+	limit := Limit{
+		Description: "widgets per gadget",
+		Maximum:     2,
+	}
+
+	cases := []struct {
+		Value int
+		Valid bool
+	}{
+		{
+			Value: 0,
+			Valid: true,
+		},
+		{
+			Value: 2,
+			Valid: true,
+		},
+		{
+			Value: 3,
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %d", tc.Value)
+		err := limit.Validate("widget", tc.Value)
+		valid := err == nil
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestLimitValidateMessage(t *testing.T) {
+	limit := Limit{
+		Description: "widgets per gadget",
+		Maximum:     2,
+	}
+
+	err := limit.Validate("widget", 5)
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	expected := "`widget`: 5 exceeds the Azure Stack Hub limit of 2 widgets per gadget"
+	if !strings.EqualFold(err.Error(), expected) {
+		t.Fatalf("expected %q but got %q", expected, err.Error())
+	}
+}
+
+func TestLimitValidateStringLength(t *testing.T) {
+	limit := Limit{
+		Description: "characters in a name",
+		Maximum:     5,
+	}
+
+	cases := []struct {
+		Value interface{}
+		Valid bool
+	}{
+		{
+			Value: "",
+			Valid: true,
+		},
+		{
+			Value: "hello",
+			Valid: true,
+		},
+		{
+			Value: "hello!",
+			Valid: false,
+		},
+		{
+			Value: 1,
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %v", tc.Value)
+		_, errors := limit.ValidateStringLength()(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package capabilities
+
+// NOTE: these limits are those documented for Azure Stack Hub, which differ from Azure
+// see: https://docs.microsoft.com/en-us/azure-stack/user/azure-stack-network-differences
+
+var (
+	// NetworkSecurityGroupRules is the maximum number of Security Rules within a Network Security Group
+	NetworkSecurityGroupRules = Limit{
+		Description: "security rules per Network Security Group",
+		Maximum:     200,
+	}
+
+	// NetworkSecurityRuleDescriptionLength is the maximum length of the description of a Security Rule
+	NetworkSecurityRuleDescriptionLength = Limit{
+		Description: "characters in the description of a security rule",
+		Maximum:     140,
+	}
+
+	// NetworkSecurityRulePortRanges is the maximum number of source or destination port ranges within a Security Rule
+	NetworkSecurityRulePortRanges = Limit{
+		Description: "port ranges per security rule",
+		Maximum:     15,
+	}
+)
+
+// LoadBalancerResourceType is the Resource Type for Load Balancers
+const LoadBalancerResourceType = "Microsoft.Network/loadBalancers"
+
+// LoadBalancerStandardSkuMinimumAPIVersion is the Network API version which introduced support for the Standard Load
+// Balancer SKU and disabling Outbound SNAT on Load Balancing Rules
+const LoadBalancerStandardSkuMinimumAPIVersion = "2017-08-01"
+
+// LoadBalancerZonesMinimumAPIVersion is the Network API version which introduced support for zonal Frontend IP Configurations
+const LoadBalancerZonesMinimumAPIVersion = "2017-06-01"
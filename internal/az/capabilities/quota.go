@@ -0,0 +1,116 @@
+package capabilities
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// QuotaUsage is the usage of a quota within a Location, as returned by the Usage API of a Resource Provider
+type QuotaUsage struct {
+	// Name is the name of the quota, for example `cores`
+	Name string
+
+	// Current is the amount of the quota which is currently in use
+	Current int64
+
+	// Limit is the maximum amount of the quota available, where a negative value means it's unlimited
+	Limit int64
+}
+
+// QuotaRequirement is the amount of a quota which is required to provision a Resource
+type QuotaRequirement struct {
+	// ResourceProvider is the Resource Provider whose Usage API reports the quota, for example `Microsoft.Compute`
+	ResourceProvider string
+
+	// Name is the name of the quota, for example `cores`
+	Name string
+
+	// Amount is the amount of the quota required by the Resource
+	Amount int64
+}
+
+// QuotaUsageFunc retrieves the usage of the quotas for a Resource Provider within a Location
+type QuotaUsageFunc func(ctx context.Context, resourceProvider, location string) ([]QuotaUsage, error)
+
+// QuotaTracker tracks the quota required by each Resource within a plan, so that the requirements of all of the Resources
+// being provisioned are compared against the remaining quota - rather than the requirements of each Resource in isolation.
+//
+// Since Azure Stack Hub quotas are frequently small, this allows a plan which would exceed them to be surfaced up-front,
+// rather than the apply failing part-way through with a `QuotaExceeded` error.
+type QuotaTracker struct {
+	lock sync.Mutex
+
+	// usages is keyed by the Resource Provider and Location, and is retrieved once per plan
+	usages map[string][]QuotaUsage
+
+	// planned is keyed by the Resource Provider, Location and quota - and then by the Resource requiring it, since
+	// the same Resource can be diffed more than once
+	planned map[string]map[string]int64
+}
+
+func NewQuotaTracker() *QuotaTracker {
+	return &QuotaTracker{
+		usages:  make(map[string][]QuotaUsage),
+		planned: make(map[string]map[string]int64),
+	}
+}
+
+// Check records the requirements of the Resource identified by `resource` within `location`, returning a description of
+// each quota which would be exceeded once the requirements of every Resource checked so far are accounted for.
+//
+// Quotas which aren't returned by the Usage API of the Resource Provider are skipped, since these vary between versions
+// of Azure Stack Hub.
+func (t *QuotaTracker) Check(ctx context.Context, location, resource string, requirements []QuotaRequirement, usageFunc QuotaUsageFunc) ([]string, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	exceeded := make([]string, 0)
+	for _, requirement := range requirements {
+		usagesKey := strings.ToLower(fmt.Sprintf("%s/%s", requirement.ResourceProvider, location))
+		usages, ok := t.usages[usagesKey]
+		if !ok {
+			var err error
+			usages, err = usageFunc(ctx, requirement.ResourceProvider, location)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving the usage of %q within %q: %+v", requirement.ResourceProvider, location, err)
+			}
+			t.usages[usagesKey] = usages
+		}
+
+		var usage *QuotaUsage
+		for i := range usages {
+			if strings.EqualFold(usages[i].Name, requirement.Name) {
+				usage = &usages[i]
+				break
+			}
+		}
+		if usage == nil {
+			continue
+		}
+
+		plannedKey := strings.ToLower(fmt.Sprintf("%s/%s", usagesKey, requirement.Name))
+		if _, ok := t.planned[plannedKey]; !ok {
+			t.planned[plannedKey] = make(map[string]int64)
+		}
+		t.planned[plannedKey][resource] = requirement.Amount
+
+		if usage.Limit < 0 {
+			continue
+		}
+
+		planned := int64(0)
+		for _, amount := range t.planned[plannedKey] {
+			planned += amount
+		}
+
+		if usage.Current+planned > usage.Limit {
+			exceeded = append(exceeded, fmt.Sprintf("the %q quota for %q within %q would be exceeded: %d in use, %d required by this plan and a limit of %d", usage.Name, requirement.ResourceProvider, location, usage.Current, planned, usage.Limit))
+		}
+	}
+
+	sort.Strings(exceeded)
+	return exceeded, nil
+}
@@ -0,0 +1,104 @@
+package capabilities
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestQuotaTrackerCheck(t *testing.T) {
+	calls := 0
+	usageFunc := func(ctx context.Context, resourceProvider, location string) ([]QuotaUsage, error) {
+		calls++
+		if resourceProvider != "Microsoft.Compute" {
+			return nil, fmt.Errorf("unexpected Resource Provider %q", resourceProvider)
+		}
+
+		return []QuotaUsage{
+			{
+				Name:    "cores",
+				Current: 6,
+				Limit:   10,
+			},
+			{
+				Name:    "virtualMachines",
+				Current: 3,
+				Limit:   -1,
+			},
+		}, nil
+	}
+
+	cores := func(amount int64) []QuotaRequirement {
+		return []QuotaRequirement{
+			{
+				ResourceProvider: "Microsoft.Compute",
+				Name:             "cores",
+				Amount:           amount,
+			},
+			{
+				ResourceProvider: "Microsoft.Compute",
+				Name:             "virtualMachines",
+				Amount:           1,
+			},
+			{
+				ResourceProvider: "Microsoft.Compute",
+				Name:             "availabilitySets",
+				Amount:           1,
+			},
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Resource string
+		Amount   int64
+		Expected int
+	}{
+		{
+			Name:     "within the remaining quota",
+			Resource: "first",
+			Amount:   2,
+			Expected: 0,
+		},
+		{
+			Name:     "diffed again",
+			Resource: "first",
+			Amount:   2,
+			Expected: 0,
+		},
+		{
+			Name:     "exactly the remaining quota",
+			Resource: "second",
+			Amount:   2,
+			Expected: 0,
+		},
+		{
+			Name:     "exceeds the remaining quota",
+			Resource: "third",
+			Amount:   1,
+			Expected: 1,
+		},
+	}
+
+	tracker := NewQuotaTracker()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		exceeded, err := tracker.Check(context.TODO(), "local", v.Resource, cores(v.Amount), usageFunc)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if len(exceeded) != v.Expected {
+			t.Fatalf("Expected %d exceeded quotas but got %d: %+v", v.Expected, len(exceeded), exceeded)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("Expected the usage to be retrieved once but it was retrieved %d times", calls)
+	}
+
+	if _, err := tracker.Check(context.TODO(), "local", "fourth", []QuotaRequirement{{ResourceProvider: "Microsoft.Network", Name: "PublicIPAddresses", Amount: 1}}, usageFunc); err == nil {
+		t.Fatalf("Expected an error when the usage can't be retrieved")
+	}
+}
@@ -0,0 +1,194 @@
+package capabilities
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+// ResourceType describes a Resource Type supported by the Azure Stack Hub the provider is connected to
+type ResourceType struct {
+	// Name is the name of the Resource Type including the namespace, for example `Microsoft.Compute/disks`
+	Name string
+
+	// APIVersions are the API versions supported for this Resource Type, newest first
+	APIVersions []string
+
+	// Locations are the (normalized) Locations where this Resource Type is available, which is empty when
+	// the Resource Type isn't tied to a Location
+	Locations []string
+}
+
+// ResourceTypes contains the Resource Types supported by the Azure Stack Hub the provider is connected to - which
+// differ between builds of Azure Stack Hub (and the Resource Providers installed on the Stamp), rather than being
+// fixed as in Azure.
+//
+// These are retrieved from the Providers API once when the provider is configured. Where this isn't possible (for
+// example when the credentials don't have permission to list the Resource Providers) the capabilities are unknown
+// and every check passes - leaving the API to reject anything which isn't supported, as it would otherwise.
+type ResourceTypes struct {
+	// types is keyed by the lower-cased name of the Resource Type, and is nil when the capabilities are unknown
+	types map[string]ResourceType
+}
+
+// LoadResourceTypes retrieves the Resource Types supported by the Azure Stack Hub from the Providers API
+func LoadResourceTypes(ctx context.Context, client *resources.ProvidersClient) *ResourceTypes {
+	providers := make([]resources.Provider, 0)
+
+	iterator, err := client.ListComplete(ctx, nil, "")
+	if err != nil {
+		log.Printf("[DEBUG] retrieving the Resource Providers: %+v. Capability checks will be unavailable", err)
+		return &ResourceTypes{}
+	}
+	for iterator.NotDone() {
+		providers = append(providers, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Printf("[DEBUG] retrieving the Resource Providers: %+v. Capability checks will be unavailable", err)
+			return &ResourceTypes{}
+		}
+	}
+
+	return NewResourceTypes(providers)
+}
+
+// NewResourceTypes returns the Resource Types supported by the specified Resource Providers
+func NewResourceTypes(providers []resources.Provider) *ResourceTypes {
+	types := make(map[string]ResourceType)
+
+	for _, provider := range providers {
+		if provider.Namespace == nil || provider.ResourceTypes == nil {
+			continue
+		}
+
+		for _, rt := range *provider.ResourceTypes {
+			if rt.ResourceType == nil {
+				continue
+			}
+
+			resourceType := ResourceType{
+				Name:        fmt.Sprintf("%s/%s", *provider.Namespace, *rt.ResourceType),
+				APIVersions: make([]string, 0),
+				Locations:   make([]string, 0),
+			}
+			if rt.APIVersions != nil {
+				resourceType.APIVersions = *rt.APIVersions
+			}
+			if rt.Locations != nil {
+				for _, v := range *rt.Locations {
+					resourceType.Locations = append(resourceType.Locations, location.Normalize(v))
+				}
+			}
+
+			types[strings.ToLower(resourceType.Name)] = resourceType
+		}
+	}
+
+	return &ResourceTypes{
+		types: types,
+	}
+}
+
+// Known returns whether the supported Resource Types could be retrieved, where the checks are skipped otherwise
+func (r *ResourceTypes) Known() bool {
+	return r != nil && r.types != nil
+}
+
+// Get returns the specified Resource Type (for example `Microsoft.Compute/disks`) and whether it's supported,
+// which is always false when the capabilities are unknown
+func (r *ResourceTypes) Get(name string) (*ResourceType, bool) {
+	if !r.Known() {
+		return nil, false
+	}
+
+	resourceType, ok := r.types[strings.ToLower(name)]
+	if !ok || len(resourceType.APIVersions) == 0 {
+		return nil, false
+	}
+
+	return &resourceType, true
+}
+
+// ValidateResourceType returns an error if the Resource Type isn't supported by this build of Azure Stack Hub,
+// or (when loc is specified) isn't available in the specified Location
+func (r *ResourceTypes) ValidateResourceType(name, loc string) error {
+	if !r.Known() {
+		return nil
+	}
+
+	resourceType, ok := r.Get(name)
+	if !ok {
+		return fmt.Errorf("the Resource Type %q is not supported on this Azure Stack build - check that the Resource Provider %q is installed on the Azure Stack Hub", name, strings.Split(name, "/")[0])
+	}
+
+	loc = location.Normalize(loc)
+	if loc == "" || len(resourceType.Locations) == 0 {
+		return nil
+	}
+
+	for _, v := range resourceType.Locations {
+		if v == loc {
+			return nil
+		}
+	}
+
+	locations := append([]string{}, resourceType.Locations...)
+	sort.Strings(locations)
+	return fmt.Errorf("the Resource Type %q is not supported in the location %q on this Azure Stack build - the supported locations are: %s", resourceType.Name, loc, strings.Join(locations, ", "))
+}
+
+// ValidateAPIVersion returns an error if the Resource Type doesn't support the specified (or a newer) API version
+// on this build of Azure Stack Hub, which is used for functionality (described by feature) introduced in that API version
+func (r *ResourceTypes) ValidateAPIVersion(name, minimumAPIVersion, feature string) error {
+	if !r.Known() {
+		return nil
+	}
+
+	resourceType, ok := r.Get(name)
+	if !ok {
+		return fmt.Errorf("%s requires the Resource Type %q, which is not supported on this Azure Stack build", feature, name)
+	}
+
+	for _, v := range resourceType.APIVersions {
+		if compareAPIVersions(v, minimumAPIVersion) >= 0 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s requires API version %q (or newer) of %q, which is not supported on this Azure Stack build - the newest supported API version is %q", feature, minimumAPIVersion, resourceType.Name, LatestAPIVersion(resourceType.APIVersions))
+}
+
+// LatestAPIVersion returns the most recent stable API version from the specified API versions (which are returned
+// by the API newest first), falling back to the most recent preview version when there's no stable API version
+func LatestAPIVersion(apiVersions []string) string {
+	for _, version := range apiVersions {
+		if !strings.Contains(strings.ToLower(version), "preview") {
+			return version
+		}
+	}
+
+	if len(apiVersions) > 0 {
+		return apiVersions[0]
+	}
+
+	return ""
+}
+
+// compareAPIVersions compares the dates of two API versions (in the format `2020-06-01` with an optional
+// suffix such as `-preview`), returning a negative number when a is older than b, zero when they're from the
+// same date and a positive number when a is newer than b
+func compareAPIVersions(a, b string) int {
+	return strings.Compare(apiVersionDate(a), apiVersionDate(b))
+}
+
+func apiVersionDate(apiVersion string) string {
+	if len(apiVersion) > len("2006-01-02") {
+		return apiVersion[:len("2006-01-02")]
+	}
+
+	return apiVersion
+}
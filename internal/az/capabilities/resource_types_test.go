@@ -0,0 +1,167 @@
+package capabilities
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func testResourceTypes() *ResourceTypes {
+	return NewResourceTypes([]resources.Provider{
+		{
+			Namespace: pointer.FromString("Microsoft.Compute"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: pointer.FromString("disks"),
+					APIVersions:  &[]string{"2019-07-01", "2018-04-01", "2017-03-30"},
+					Locations:    &[]string{"Local"},
+				},
+				{
+					ResourceType: pointer.FromString("virtualMachines"),
+					APIVersions:  &[]string{"2020-06-01-preview", "2016-03-30"},
+					Locations:    &[]string{"Local"},
+				},
+			},
+		},
+		{
+			Namespace: pointer.FromString("Microsoft.Authorization"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: pointer.FromString("roleAssignments"),
+					APIVersions:  &[]string{"2015-07-01"},
+				},
+			},
+		},
+	})
+}
+
+func TestResourceTypesValidateResourceType(t *testing.T) {
+	cases := []struct {
+		ResourceType string
+		Location     string
+		Valid        bool
+	}{
+		{
+			ResourceType: "Microsoft.Compute/disks",
+			Valid:        true,
+		},
+		{
+			ResourceType: "microsoft.compute/DISKS",
+			Location:     "local",
+			Valid:        true,
+		},
+		{
+			ResourceType: "Microsoft.Compute/disks",
+			Location:     "West Europe",
+			Valid:        false,
+		},
+		{
+			ResourceType: "Microsoft.Authorization/roleAssignments",
+			Location:     "westeurope",
+			Valid:        true,
+		},
+		{
+			ResourceType: "Microsoft.EventHub/namespaces",
+			Valid:        false,
+		},
+	}
+
+	resourceTypes := testResourceTypes()
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q in %q", tc.ResourceType, tc.Location)
+		err := resourceTypes.ValidateResourceType(tc.ResourceType, tc.Location)
+		valid := err == nil
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, err)
+		}
+	}
+}
+
+func TestResourceTypesValidateAPIVersion(t *testing.T) {
+	cases := []struct {
+		ResourceType string
+		APIVersion   string
+		Valid        bool
+	}{
+		{
+			ResourceType: "Microsoft.Compute/disks",
+			APIVersion:   "2017-03-30",
+			Valid:        true,
+		},
+		{
+			ResourceType: "Microsoft.Compute/disks",
+			APIVersion:   "2019-07-01",
+			Valid:        true,
+		},
+		{
+			ResourceType: "Microsoft.Compute/disks",
+			APIVersion:   "2020-12-01",
+			Valid:        false,
+		},
+		{
+			ResourceType: "Microsoft.Compute/virtualMachines",
+			APIVersion:   "2020-06-01",
+			Valid:        true,
+		},
+		{
+			ResourceType: "Microsoft.EventHub/namespaces",
+			APIVersion:   "2017-04-01",
+			Valid:        false,
+		},
+	}
+
+	resourceTypes := testResourceTypes()
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q with %q", tc.ResourceType, tc.APIVersion)
+		err := resourceTypes.ValidateAPIVersion(tc.ResourceType, tc.APIVersion, "`zones`")
+		valid := err == nil
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", tc.Valid, valid, err)
+		}
+	}
+}
+
+func TestResourceTypesUnknown(t *testing.T) {
+	for _, resourceTypes := range []*ResourceTypes{nil, {}} {
+		if resourceTypes.Known() {
+			t.Fatalf("Expected the capabilities to be unknown")
+		}
+
+		if err := resourceTypes.ValidateResourceType("Microsoft.EventHub/namespaces", "local"); err != nil {
+			t.Fatalf("Expected no error when the capabilities are unknown but got: %+v", err)
+		}
+
+		if err := resourceTypes.ValidateAPIVersion("Microsoft.Compute/disks", "2099-01-01", "`zones`"); err != nil {
+			t.Fatalf("Expected no error when the capabilities are unknown but got: %+v", err)
+		}
+	}
+}
+
+func TestLatestAPIVersion(t *testing.T) {
+	cases := []struct {
+		Input    []string
+		Expected string
+	}{
+		{
+			Input:    []string{},
+			Expected: "",
+		},
+		{
+			Input:    []string{"2020-06-01-preview", "2019-07-01"},
+			Expected: "2019-07-01",
+		},
+		{
+			Input:    []string{"2020-06-01-preview"},
+			Expected: "2020-06-01-preview",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := LatestAPIVersion(tc.Input); actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
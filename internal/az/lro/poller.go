@@ -0,0 +1,287 @@
+package lro
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// defaultWorkers matches the default parallelism of Terraform, such that a full batch of operations
+	// can be polled at once without exceeding the number of requests Terraform would otherwise make
+	defaultWorkers = 10
+
+	// defaultMinimumDelay is the shortest time between two polls of the same operation
+	defaultMinimumDelay = 5 * time.Second
+)
+
+// defaultPoller is the instance of Poller shared by all Resources
+var defaultPoller = NewPoller(defaultWorkers, defaultMinimumDelay)
+
+// Future is the subset of azure.FutureAPI which is used to poll a Long Running Operation
+type Future interface {
+	DoneWithContext(ctx context.Context, sender autorest.Sender) (bool, error)
+	GetPollingDelay() (time.Duration, bool)
+}
+
+// statusFuture is implemented by azure.Future, returning the status of the operation from the last response received
+// without making a request
+type statusFuture interface {
+	Status() string
+}
+
+// hasTerminated returns whether the status of the operation is already terminal - for example, when the response which
+// started the operation was returned once it had completed
+func hasTerminated(future Future) bool {
+	f, ok := future.(statusFuture)
+	if !ok {
+		return false
+	}
+
+	switch strings.ToLower(f.Status()) {
+	case "succeeded", "failed", "canceled":
+		return true
+	}
+
+	return false
+}
+
+// WaitForCompletion waits for the Long Running Operation to complete using the shared Poller - and is
+// intended as a drop-in replacement for `future.WaitForCompletionRef(ctx, client.Client)`
+func WaitForCompletion(ctx context.Context, future Future, client autorest.Client) error {
+	return defaultPoller.WaitForCompletion(ctx, future, client)
+}
+
+// Poller multiplexes the polling of Long Running Operations.
+//
+// Rather than each operation polling on its own schedule (as `WaitForCompletionRef` does), the operations
+// are queued by when they're next due to be polled and polled by a fixed pool of workers. This bounds the
+// number of concurrent polling requests when many resources are provisioned at once (which otherwise leads
+// to throttling by ARM and slower rollouts) and reduces the number of requests by skipping the immediate
+// first poll and ensuring a minimum delay between polls, whilst honouring the `Retry-After` header.
+type Poller struct {
+	minimumDelay time.Duration
+	workers      int
+
+	lock    sync.Mutex
+	queue   operationQueue
+	wake    chan struct{}
+	work    chan *operation
+	started sync.Once
+}
+
+// NewPoller returns a Poller which polls using the specified number of workers, waiting at least minimumDelay
+// between polls of an operation
+func NewPoller(workers int, minimumDelay time.Duration) *Poller {
+	return &Poller{
+		minimumDelay: minimumDelay,
+		workers:      workers,
+		wake:         make(chan struct{}, 1),
+		work:         make(chan *operation),
+	}
+}
+
+// WaitForCompletion blocks until the Long Running Operation has completed (returning any error from it),
+// the context has been cancelled or the retries for polling have been exhausted
+func (p *Poller) WaitForCompletion(ctx context.Context, future Future, client autorest.Client) error {
+	// operations which have already completed are returned immediately, rather than being queued - as with
+	// `WaitForCompletionRef`, DoneWithContext doesn't make a request (and returns any error) once the status is terminal
+	if hasTerminated(future) {
+		_, err := future.DoneWithContext(ctx, client)
+		return err
+	}
+
+	p.started.Do(p.start)
+
+	// if the provided context already has a deadline don't override it
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && client.PollingDuration != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, client.PollingDuration)
+		defer cancel()
+	}
+
+	op := &operation{
+		ctx:           ctx,
+		future:        future,
+		sender:        client,
+		pollingDelay:  client.PollingDelay,
+		retryAttempts: client.RetryAttempts,
+		retryDuration: client.RetryDuration,
+		result:        make(chan error, 1),
+	}
+	if interval, ok := PollingIntervalFromContext(ctx); ok {
+		op.pollingInterval = &interval
+	}
+
+	// the status is returned in the response which started the operation (and it's not yet terminal), so there's no
+	// need to poll immediately
+	p.schedule(op, p.delay(op))
+
+	select {
+	case err := <-op.result:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for the Long Running Operation to complete: %+v", ctx.Err())
+	}
+}
+
+func (p *Poller) start() {
+	for i := 0; i < p.workers; i++ {
+		go func() {
+			for op := range p.work {
+				p.poll(op)
+			}
+		}()
+	}
+
+	go p.run()
+}
+
+// run dispatches operations to the workers as they become due
+func (p *Poller) run() {
+	timer := time.NewTimer(0)
+	<-timer.C
+
+	for {
+		p.lock.Lock()
+		due := make([]*operation, 0)
+		wait := time.Duration(-1)
+		now := time.Now()
+		for p.queue.Len() > 0 {
+			next := p.queue[0]
+			if next.due.After(now) {
+				wait = next.due.Sub(now)
+				break
+			}
+			due = append(due, heap.Pop(&p.queue).(*operation))
+		}
+		p.lock.Unlock()
+
+		for _, op := range due {
+			p.work <- op
+		}
+
+		if wait < 0 {
+			<-p.wake
+			continue
+		}
+
+		timer.Reset(wait)
+		select {
+		case <-timer.C:
+		case <-p.wake:
+			if !timer.Stop() {
+				<-timer.C
+			}
+		}
+	}
+}
+
+func (p *Poller) poll(op *operation) {
+	// the caller has stopped waiting, so there's nothing to report back to
+	if op.ctx.Err() != nil {
+		return
+	}
+
+	done, err := op.future.DoneWithContext(op.ctx, op.sender)
+	if done {
+		op.result <- err
+		return
+	}
+
+	if err == nil {
+		p.schedule(op, p.delay(op))
+		return
+	}
+
+	// there was an error polling for the status, so back off exponentially using the client's retry duration
+	if op.attempts >= op.retryAttempts {
+		op.result <- fmt.Errorf("polling for the status of the Long Running Operation: the number of retries has been exceeded: %+v", err)
+		return
+	}
+	delay := op.retryDuration * time.Duration(1<<op.attempts)
+	op.attempts++
+	p.schedule(op, delay)
+}
+
+// delay returns the time to wait before next polling the operation, using the `Retry-After` header when
+// present and otherwise the client's polling delay - but no less than the minimum delay. A polling interval
+// configured for the operation takes precedence over all of these.
+func (p *Poller) delay(op *operation) time.Duration {
+	if op.pollingInterval != nil {
+		return *op.pollingInterval
+	}
+
+	delay, ok := op.future.GetPollingDelay()
+	if !ok {
+		delay = op.pollingDelay
+	}
+
+	if delay < p.minimumDelay {
+		delay = p.minimumDelay
+	}
+
+	return delay
+}
+
+func (p *Poller) schedule(op *operation, delay time.Duration) {
+	op.due = time.Now().Add(delay)
+
+	p.lock.Lock()
+	heap.Push(&p.queue, op)
+	p.lock.Unlock()
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+type operation struct {
+	ctx    context.Context
+	future Future
+	sender autorest.Sender
+
+	pollingDelay  time.Duration
+	retryAttempts int
+	// pollingInterval is the interval configured using WithPollingInterval, which is nil when not specified
+	pollingInterval *time.Duration
+	retryDuration   time.Duration
+
+	attempts int
+	due      time.Time
+	result   chan error
+}
+
+// operationQueue implements heap.Interface, ordering the operations by when they're next due to be polled
+type operationQueue []*operation
+
+func (q operationQueue) Len() int {
+	return len(q)
+}
+
+func (q operationQueue) Less(i, j int) bool {
+	return q[i].due.Before(q[j].due)
+}
+
+func (q operationQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *operationQueue) Push(x interface{}) {
+	*q = append(*q, x.(*operation))
+}
+
+func (q *operationQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return item
+}
@@ -0,0 +1,228 @@
+package lro
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type testFuture struct {
+	// pollsUntilDone is the number of polls after which the operation completes
+	pollsUntilDone int32
+	// errorsBeforeSuccess is the number of polls which fail before the status is returned
+	errorsBeforeSuccess int32
+	// err is returned once the operation completes
+	err error
+
+	polls int32
+}
+
+func (f *testFuture) DoneWithContext(_ context.Context, _ autorest.Sender) (bool, error) {
+	polls := atomic.AddInt32(&f.polls, 1)
+	if polls <= f.errorsBeforeSuccess {
+		return false, fmt.Errorf("transient error")
+	}
+
+	if polls-f.errorsBeforeSuccess >= f.pollsUntilDone {
+		return true, f.err
+	}
+
+	return false, nil
+}
+
+func (f *testFuture) GetPollingDelay() (time.Duration, bool) {
+	return 0, false
+}
+
+// terminalTestFuture is a testFuture whose status is known from the response which started the operation
+type terminalTestFuture struct {
+	testFuture
+
+	status string
+}
+
+func (f *terminalTestFuture) Status() string {
+	return f.status
+}
+
+func testClient() autorest.Client {
+	return autorest.Client{
+		PollingDelay:  time.Millisecond,
+		RetryAttempts: 3,
+		RetryDuration: time.Millisecond,
+	}
+}
+
+func TestPollerWaitForCompletion(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Future        *testFuture
+		ExpectedPolls int32
+		Error         bool
+	}{
+		{
+			Name:          "completes on the first poll",
+			Future:        &testFuture{pollsUntilDone: 1},
+			ExpectedPolls: 1,
+		},
+		{
+			Name:          "completes after several polls",
+			Future:        &testFuture{pollsUntilDone: 5},
+			ExpectedPolls: 5,
+		},
+		{
+			Name:          "operation failed",
+			Future:        &testFuture{pollsUntilDone: 2, err: fmt.Errorf("provisioning failed")},
+			ExpectedPolls: 2,
+			Error:         true,
+		},
+		{
+			Name:          "recovers from transient errors",
+			Future:        &testFuture{pollsUntilDone: 1, errorsBeforeSuccess: 2},
+			ExpectedPolls: 3,
+		},
+		{
+			Name:          "retries exhausted",
+			Future:        &testFuture{pollsUntilDone: 1, errorsBeforeSuccess: 10},
+			ExpectedPolls: 4,
+			Error:         true,
+		},
+	}
+
+	poller := NewPoller(2, time.Millisecond)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := poller.WaitForCompletion(context.TODO(), v.Future, testClient())
+		if v.Error && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual := atomic.LoadInt32(&v.Future.polls); actual != v.ExpectedPolls {
+			t.Fatalf("Expected %d polls but got %d", v.ExpectedPolls, actual)
+		}
+	}
+}
+
+func TestPollerWaitForCompletionConcurrent(t *testing.T) {
+	poller := NewPoller(3, time.Millisecond)
+
+	futures := make([]*testFuture, 0)
+	for i := 0; i < 50; i++ {
+		futures = append(futures, &testFuture{pollsUntilDone: int32(i%5) + 1})
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(futures))
+	for _, future := range futures {
+		wg.Add(1)
+		go func(future *testFuture) {
+			defer wg.Done()
+			errs <- poller.WaitForCompletion(context.TODO(), future, testClient())
+		}(future)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+
+	for i, future := range futures {
+		if expected := int32(i%5) + 1; future.polls != expected {
+			t.Fatalf("Expected %d polls for operation %d but got %d", expected, i, future.polls)
+		}
+	}
+}
+
+func TestPollerWaitForCompletionCancelled(t *testing.T) {
+	poller := NewPoller(1, time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+
+	future := &testFuture{pollsUntilDone: 1}
+	if err := poller.WaitForCompletion(ctx, future, testClient()); err == nil {
+		t.Fatalf("Expected an error when the context is cancelled but didn't get one")
+	}
+
+	if future.polls != 0 {
+		t.Fatalf("Expected no polls but got %d", future.polls)
+	}
+}
+
+func TestPollerWaitForCompletionPollingInterval(t *testing.T) {
+	poller := NewPoller(1, time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+
+	future := &testFuture{pollsUntilDone: 3}
+	if err := poller.WaitForCompletion(WithPollingInterval(ctx, time.Millisecond), future, testClient()); err != nil {
+		t.Fatalf("Expected the polling interval to take precedence over the minimum delay but got: %+v", err)
+	}
+
+	if future.polls != 3 {
+		t.Fatalf("Expected 3 polls but got %d", future.polls)
+	}
+}
+
+func TestPollerWaitForCompletionAlreadyTerminal(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Future        *terminalTestFuture
+		ExpectedPolls int32
+		Error         bool
+	}{
+		{
+			Name:          "succeeded",
+			Future:        &terminalTestFuture{testFuture: testFuture{pollsUntilDone: 1}, status: "Succeeded"},
+			ExpectedPolls: 1,
+		},
+		{
+			Name:          "failed",
+			Future:        &terminalTestFuture{testFuture: testFuture{pollsUntilDone: 1, err: fmt.Errorf("provisioning failed")}, status: "Failed"},
+			ExpectedPolls: 1,
+			Error:         true,
+		},
+		{
+			Name:          "in progress",
+			Future:        &terminalTestFuture{testFuture: testFuture{pollsUntilDone: 2}, status: "InProgress"},
+			ExpectedPolls: 2,
+		},
+	}
+
+	// a minimum delay longer than the timeout ensures operations which have already completed aren't queued
+	poller := NewPoller(1, time.Hour)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+		if v.Future.status == "InProgress" {
+			ctx = WithPollingInterval(ctx, time.Millisecond)
+		}
+
+		err := poller.WaitForCompletion(ctx, v.Future, testClient())
+		cancel()
+		if v.Error && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual := atomic.LoadInt32(&v.Future.polls); actual != v.ExpectedPolls {
+			t.Fatalf("Expected %d calls to DoneWithContext but got %d", v.ExpectedPolls, actual)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package lro
+
+import (
+	"context"
+	"time"
+)
+
+type pollingIntervalKey struct{}
+
+// WithPollingInterval returns a copy of the context which specifies the interval between polls of the Long
+// Running Operations started using it - taking precedence over both the `Retry-After` header returned by the
+// API and the default polling delay of the client
+func WithPollingInterval(ctx context.Context, interval time.Duration) context.Context {
+	return context.WithValue(ctx, pollingIntervalKey{}, interval)
+}
+
+// PollingIntervalFromContext returns the polling interval specified using WithPollingInterval, if any
+func PollingIntervalFromContext(ctx context.Context) (time.Duration, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+
+	interval, ok := ctx.Value(pollingIntervalKey{}).(time.Duration)
+	if !ok || interval <= 0 {
+		return 0, false
+	}
+
+	return interval, true
+}
@@ -0,0 +1,9 @@
+package tags
+
+import "github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+
+// OnlyTagsChanged returns whether `tags` is the only field of an existing Resource which has changed,
+// in which case the (faster) tags-only update API can be used rather than a full CreateOrUpdate
+func OnlyTagsChanged(d *pluginsdk.ResourceData) bool {
+	return !d.IsNewResource() && d.HasChange("tags") && !d.HasChangeExcept("tags")
+}
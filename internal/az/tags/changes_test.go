@@ -0,0 +1,97 @@
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+func TestOnlyTagsChanged(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Expected bool
+	}{
+		{
+			Name: "No Changes",
+			Config: map[string]interface{}{
+				"name": "example",
+				"tags": map[string]interface{}{
+					"environment": "production",
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Only Tags Changed",
+			Config: map[string]interface{}{
+				"name": "example",
+				"tags": map[string]interface{}{
+					"environment": "staging",
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Tags and Other Fields Changed",
+			Config: map[string]interface{}{
+				"name": "updated",
+				"tags": map[string]interface{}{
+					"environment": "staging",
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Only Other Fields Changed",
+			Config: map[string]interface{}{
+				"name": "updated",
+				"tags": map[string]interface{}{
+					"environment": "production",
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+			"tags": Schema(),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		state := &terraform.InstanceState{
+			ID: "example",
+			Attributes: map[string]string{
+				"name":             "example",
+				"tags.%":           "1",
+				"tags.environment": "production",
+			},
+		}
+		config := terraform.NewResourceConfigRaw(v.Config)
+
+		diff, err := resource.Diff(context.TODO(), state, config, nil)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual := OnlyTagsChanged(d); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
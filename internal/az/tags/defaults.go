@@ -0,0 +1,50 @@
+package tags
+
+import "strings"
+
+// MergeDefaults adds the default tags defined at the Provider level to the user-specified tags - where
+// a tag is specified on both the resource's value takes precedence
+func MergeDefaults(tagsMap map[string]interface{}, defaults map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap)+len(defaults))
+	for k, v := range defaults {
+		if !hasKey(tagsMap, k) {
+			output[k] = v
+		}
+	}
+	for k, v := range tagsMap {
+		output[k] = v
+	}
+	return output
+}
+
+// StripDefaults removes any default tags which aren't defined in the configuration from the tags returned
+// by the API, so that the tags added by the provider don't show up as a diff. Default tags whose value has
+// drifted are retained, so that the next apply resets them to the default value
+func StripDefaults(tagsMap map[string]interface{}, configured map[string]interface{}, defaults map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+	for k, v := range tagsMap {
+		if !hasKey(configured, k) && isDefaultValue(defaults, k, v) {
+			continue
+		}
+		output[k] = v
+	}
+	return output
+}
+
+func isDefaultValue(defaults map[string]interface{}, key string, value interface{}) bool {
+	actual, err := TagValueToString(value)
+	if err != nil {
+		return false
+	}
+
+	for k, v := range defaults {
+		if !strings.EqualFold(k, key) {
+			continue
+		}
+
+		expected, err := TagValueToString(v)
+		return err == nil && expected == actual
+	}
+
+	return false
+}
@@ -0,0 +1,118 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeDefaults(t *testing.T) {
+	defaults := map[string]interface{}{
+		"environment": "production",
+		"owner":       "platform",
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:  "No Tags",
+			Input: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"environment": "production",
+				"owner":       "platform",
+			},
+		},
+		{
+			Name: "Additional Tags",
+			Input: map[string]interface{}{
+				"cost-center": "1234",
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+				"owner":       "platform",
+			},
+		},
+		{
+			Name: "Resource Overrides Default Tag",
+			Input: map[string]interface{}{
+				"Environment": "staging",
+			},
+			Expected: map[string]interface{}{
+				"Environment": "staging",
+				"owner":       "platform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+		actual := MergeDefaults(v.Input, defaults)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestStripDefaults(t *testing.T) {
+	defaults := map[string]interface{}{
+		"environment": "production",
+		"owner":       "platform",
+	}
+
+	testData := []struct {
+		Name       string
+		Input      map[string]interface{}
+		Configured map[string]interface{}
+		Expected   map[string]interface{}
+	}{
+		{
+			Name: "Default Tags Removed",
+			Input: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+				"owner":       "platform",
+			},
+			Configured: map[string]interface{}{
+				"cost-center": "1234",
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+			},
+		},
+		{
+			Name: "Overridden Default Tag Retained",
+			Input: map[string]interface{}{
+				"Environment": "staging",
+				"owner":       "platform",
+			},
+			Configured: map[string]interface{}{
+				"Environment": "staging",
+			},
+			Expected: map[string]interface{}{
+				"Environment": "staging",
+			},
+		},
+		{
+			Name: "Drifted Default Tag Retained",
+			Input: map[string]interface{}{
+				"environment": "changed",
+				"owner":       "platform",
+			},
+			Configured: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"environment": "changed",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+		actual := StripDefaults(v.Input, v.Configured, defaults)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
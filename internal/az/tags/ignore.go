@@ -0,0 +1,89 @@
+package tags
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+// IgnoreConfig defines the tags which are managed outside of Terraform (for example by compliance tooling)
+// and as such changes to these tags should be ignored
+type IgnoreConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+var (
+	ignoreConfigLock sync.RWMutex
+	ignoreConfig     IgnoreConfig
+)
+
+// ConfigureIgnored sets the tags which should be ignored by the Tags Schema - this is configured once when
+// the Provider is configured, since the DiffSuppressFunc doesn't have access to the Provider's meta
+func ConfigureIgnored(config IgnoreConfig) {
+	ignoreConfigLock.Lock()
+	defer ignoreConfigLock.Unlock()
+
+	ignoreConfig = config
+}
+
+// IsIgnored returns whether changes to the specified tag key should be ignored - tag keys are
+// case-insensitive, as such so is this comparison
+func (c IgnoreConfig) IsIgnored(key string) bool {
+	for _, v := range c.Keys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range c.KeyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withoutIgnored returns the tags which aren't ignored by the specified configuration
+func (c IgnoreConfig) withoutIgnored(tagsMap map[string]interface{}) map[string]string {
+	output := make(map[string]string, len(tagsMap))
+	for k, v := range tagsMap {
+		if c.IsIgnored(k) {
+			continue
+		}
+
+		// Validate should have ignored this error already
+		value, _ := TagValueToString(v)
+		output[k] = value
+	}
+	return output
+}
+
+// suppressIgnored is a DiffSuppressFunc which ignores changes to the tags defined in the `ignore_tags`
+// block of the Provider - including changes to the number of tags when this is caused by an ignored tag
+func suppressIgnored(k, _, _ string, d *pluginsdk.ResourceData) bool {
+	ignoreConfigLock.RLock()
+	config := ignoreConfig
+	ignoreConfigLock.RUnlock()
+
+	if len(config.Keys) == 0 && len(config.KeyPrefixes) == 0 {
+		return false
+	}
+
+	segments := strings.SplitN(k, ".", 2)
+	if len(segments) != 2 {
+		return false
+	}
+
+	if segments[1] != "%" {
+		return config.IsIgnored(segments[1])
+	}
+
+	oldRaw, newRaw := d.GetChange(segments[0])
+	oldTags, _ := oldRaw.(map[string]interface{})
+	newTags, _ := newRaw.(map[string]interface{})
+	return reflect.DeepEqual(config.withoutIgnored(oldTags), config.withoutIgnored(newTags))
+}
@@ -0,0 +1,143 @@
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+func TestIgnoreConfigIsIgnored(t *testing.T) {
+	config := IgnoreConfig{
+		Keys:        []string{"costcenter"},
+		KeyPrefixes: []string{"compliance-"},
+	}
+
+	testData := []struct {
+		Key      string
+		Expected bool
+	}{
+		{
+			Key:      "costcenter",
+			Expected: true,
+		},
+		{
+			Key:      "CostCenter",
+			Expected: true,
+		},
+		{
+			Key:      "compliance-scanned",
+			Expected: true,
+		},
+		{
+			Key:      "Compliance-Owner",
+			Expected: true,
+		},
+		{
+			Key:      "environment",
+			Expected: false,
+		},
+		{
+			Key:      "costcenter-id",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Key)
+
+		if actual := config.IsIgnored(v.Key); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestSchemaSuppressesIgnoredTags(t *testing.T) {
+	ConfigureIgnored(IgnoreConfig{
+		Keys:        []string{"costcenter"},
+		KeyPrefixes: []string{"compliance-"},
+	})
+	defer ConfigureIgnored(IgnoreConfig{})
+
+	testData := []struct {
+		Name         string
+		State        map[string]string
+		Config       map[string]interface{}
+		ExpectChange bool
+	}{
+		{
+			Name: "Ignored Tags Added Outside of Terraform",
+			State: map[string]string{
+				"tags.%":                  "3",
+				"tags.environment":        "production",
+				"tags.costcenter":         "1234",
+				"tags.compliance-scanned": "true",
+			},
+			Config: map[string]interface{}{
+				"environment": "production",
+			},
+			ExpectChange: false,
+		},
+		{
+			Name: "Ignored Tag Changed",
+			State: map[string]string{
+				"tags.%":           "2",
+				"tags.environment": "production",
+				"tags.costcenter":  "1234",
+			},
+			Config: map[string]interface{}{
+				"environment": "production",
+				"costcenter":  "5678",
+			},
+			ExpectChange: false,
+		},
+		{
+			Name: "Other Tag Changed",
+			State: map[string]string{
+				"tags.%":           "2",
+				"tags.environment": "production",
+				"tags.costcenter":  "1234",
+			},
+			Config: map[string]interface{}{
+				"environment": "staging",
+			},
+			ExpectChange: true,
+		},
+	}
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": Schema(),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		state := &terraform.InstanceState{
+			ID:         "example",
+			Attributes: v.State,
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"tags": v.Config,
+		})
+
+		diff, err := resource.Diff(context.TODO(), state, config, nil)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		hasChange := false
+		if diff != nil {
+			for _, attr := range diff.Attributes {
+				if attr.Old != attr.New || attr.NewRemoved {
+					hasChange = true
+				}
+			}
+		}
+		if hasChange != v.ExpectChange {
+			t.Fatalf("Expected a change to be %t but got %t: %+v", v.ExpectChange, hasChange, diff)
+		}
+	}
+}
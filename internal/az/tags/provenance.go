@@ -0,0 +1,80 @@
+package tags
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const (
+	ProvenanceWorkspaceKey  = "terraform-workspace"
+	ProvenanceModuleHashKey = "terraform-module-hash"
+	ProvenanceLastApplyKey  = "terraform-last-apply"
+)
+
+// Provenance returns the set of provenance tags identifying the Terraform configuration
+// which last applied a resource - the module path is hashed so that local paths aren't exposed
+func Provenance(workspace, modulePath string, appliedAt time.Time) map[string]interface{} {
+	hash := sha256.Sum256([]byte(modulePath))
+
+	return map[string]interface{}{
+		ProvenanceWorkspaceKey:  workspace,
+		ProvenanceModuleHashKey: hex.EncodeToString(hash[:])[:16],
+		ProvenanceLastApplyKey:  appliedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// MergeProvenance adds the provenance tags to the user-specified tags - where a user has
+// specified a provenance tag explicitly the user-specified value is retained
+func MergeProvenance(tagsMap map[string]interface{}, provenance map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap)+len(provenance))
+	for k, v := range provenance {
+		output[k] = v
+	}
+
+	for k, v := range tagsMap {
+		for pk := range provenance {
+			if strings.EqualFold(k, pk) {
+				delete(output, pk)
+			}
+		}
+		output[k] = v
+	}
+
+	return output
+}
+
+// StripProvenance removes any provenance tags which aren't defined in the configuration, so
+// that the tags added by the provider don't show up as a diff
+func StripProvenance(tagsMap map[string]interface{}, configured map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+	for k, v := range tagsMap {
+		if isProvenanceKey(k) && !hasKey(configured, k) {
+			continue
+		}
+		output[k] = v
+	}
+
+	return output
+}
+
+func isProvenanceKey(key string) bool {
+	for _, k := range []string{ProvenanceWorkspaceKey, ProvenanceModuleHashKey, ProvenanceLastApplyKey} {
+		if strings.EqualFold(key, k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasKey(tagsMap map[string]interface{}, key string) bool {
+	for k := range tagsMap {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,127 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProvenance(t *testing.T) {
+	appliedAt := time.Date(2021, 12, 1, 10, 30, 0, 0, time.FixedZone("PST", -8*60*60))
+
+	result := Provenance("production", "/src/infra/network", appliedAt)
+
+	if v := result[ProvenanceWorkspaceKey]; v != "production" {
+		t.Fatalf("Expected the workspace to be %q but got %q", "production", v)
+	}
+
+	if v := result[ProvenanceLastApplyKey]; v != "2021-12-01T18:30:00Z" {
+		t.Fatalf("Expected the last apply timestamp to be %q but got %q", "2021-12-01T18:30:00Z", v)
+	}
+
+	hash := result[ProvenanceModuleHashKey].(string)
+	if len(hash) != 16 {
+		t.Fatalf("Expected the module hash to be 16 characters but got %d", len(hash))
+	}
+
+	if other := Provenance("production", "/src/infra/compute", appliedAt); other[ProvenanceModuleHashKey] == hash {
+		t.Fatalf("Expected different module paths to produce different hashes")
+	}
+}
+
+func TestMergeProvenance(t *testing.T) {
+	provenance := map[string]interface{}{
+		ProvenanceWorkspaceKey:  "default",
+		ProvenanceModuleHashKey: "abc123",
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:  "No Tags",
+			Input: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				ProvenanceWorkspaceKey:  "default",
+				ProvenanceModuleHashKey: "abc123",
+			},
+		},
+		{
+			Name: "User Tags",
+			Input: map[string]interface{}{
+				"environment": "production",
+			},
+			Expected: map[string]interface{}{
+				"environment":           "production",
+				ProvenanceWorkspaceKey:  "default",
+				ProvenanceModuleHashKey: "abc123",
+			},
+		},
+		{
+			Name: "User Overrides Provenance Tag",
+			Input: map[string]interface{}{
+				"Terraform-Workspace": "custom",
+			},
+			Expected: map[string]interface{}{
+				"Terraform-Workspace":   "custom",
+				ProvenanceModuleHashKey: "abc123",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+		actual := MergeProvenance(v.Input, provenance)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestStripProvenance(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Input      map[string]interface{}
+		Configured map[string]interface{}
+		Expected   map[string]interface{}
+	}{
+		{
+			Name: "Provenance Tags Removed",
+			Input: map[string]interface{}{
+				"environment":           "production",
+				ProvenanceWorkspaceKey:  "default",
+				ProvenanceModuleHashKey: "abc123",
+				ProvenanceLastApplyKey:  "2021-12-01T18:30:00Z",
+			},
+			Configured: map[string]interface{}{
+				"environment": "production",
+			},
+			Expected: map[string]interface{}{
+				"environment": "production",
+			},
+		},
+		{
+			Name: "Configured Provenance Tag Retained",
+			Input: map[string]interface{}{
+				ProvenanceWorkspaceKey: "custom",
+				ProvenanceLastApplyKey: "2021-12-01T18:30:00Z",
+			},
+			Configured: map[string]interface{}{
+				ProvenanceWorkspaceKey: "custom",
+			},
+			Expected: map[string]interface{}{
+				ProvenanceWorkspaceKey: "custom",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+		actual := StripProvenance(v.Input, v.Configured)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -17,10 +17,11 @@ func SchemaDataSource() *pluginsdk.Schema {
 // require recreation of the resource
 func ForceNewSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
-		Type:         pluginsdk.TypeMap,
-		Optional:     true,
-		ForceNew:     true,
-		ValidateFunc: Validate,
+		Type:             pluginsdk.TypeMap,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateFunc:     Validate,
+		DiffSuppressFunc: suppressIgnored,
 		Elem: &pluginsdk.Schema{
 			Type: pluginsdk.TypeString,
 		},
@@ -30,9 +31,10 @@ func ForceNewSchema() *pluginsdk.Schema {
 // Schema returns the Schema used for Tags
 func Schema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
-		Type:         pluginsdk.TypeMap,
-		Optional:     true,
-		ValidateFunc: Validate,
+		Type:             pluginsdk.TypeMap,
+		Optional:         true,
+		ValidateFunc:     Validate,
+		DiffSuppressFunc: suppressIgnored,
 		Elem: &pluginsdk.Schema{
 			Type: pluginsdk.TypeString,
 		},
@@ -42,9 +44,10 @@ func Schema() *pluginsdk.Schema {
 // Schema returns the Schema used for Tags
 func SchemaEnforceLowerCaseKeys() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
-		Type:         pluginsdk.TypeMap,
-		Optional:     true,
-		ValidateFunc: EnforceLowerCaseKeys,
+		Type:             pluginsdk.TypeMap,
+		Optional:         true,
+		ValidateFunc:     EnforceLowerCaseKeys,
+		DiffSuppressFunc: suppressIgnored,
 		Elem: &pluginsdk.Schema{
 			Type: pluginsdk.TypeString,
 		},
@@ -0,0 +1,39 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// normalizeEnvironment tidies up the Environment returned from the Azure Stack Hub metadata endpoint.
+//
+// Stamps using Active Directory Federation Services (AD FS) return a Login Endpoint with a trailing
+// slash (e.g. `https://adfs.local.azurestack.external/adfs/`) which prevents the AD FS environment from
+// being detected when building the OAuth Config - and as such the wrong Tenant being used for tokens.
+func normalizeEnvironment(env *azure.Environment) {
+	env.ActiveDirectoryEndpoint = strings.TrimSuffix(env.ActiveDirectoryEndpoint, "/")
+}
+
+// isADFSEnvironment returns whether the Azure Stack Hub Stamp uses Active Directory Federation Services
+// (rather than Azure Active Directory) as its identity provider
+func isADFSEnvironment(env azure.Environment) bool {
+	return strings.HasSuffix(strings.ToLower(strings.TrimSuffix(env.ActiveDirectoryEndpoint, "/")), "/adfs")
+}
+
+// unavailableAuthorizer is used in place of an Authorizer which couldn't be built, such that the error
+// is only surfaced when a client which needs it is used
+type unavailableAuthorizer struct {
+	err error
+}
+
+func (a unavailableAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			return r, fmt.Errorf("an authorization token isn't available for %q: %+v", r.URL.Host, a.err)
+		})
+	}
+}
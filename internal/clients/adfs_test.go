@@ -0,0 +1,57 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestIsADFSEnvironment(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "https://login.microsoftonline.com/",
+			Expected: false,
+		},
+		{
+			Input:    "https://adfs.local.azurestack.external/adfs",
+			Expected: true,
+		},
+		{
+			Input:    "https://adfs.local.azurestack.external/adfs/",
+			Expected: true,
+		},
+		{
+			Input:    "https://adfs.local.azurestack.external/ADFS/",
+			Expected: true,
+		},
+		{
+			Input:    "https://adfs.local.azurestack.external/",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := isADFSEnvironment(azure.Environment{ActiveDirectoryEndpoint: v.Input})
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestNormalizeEnvironment(t *testing.T) {
+	env := azure.Environment{
+		ActiveDirectoryEndpoint: "https://adfs.local.azurestack.external/adfs/",
+	}
+	normalizeEnvironment(&env)
+
+	// the OAuth Config only detects AD FS when the endpoint ends in `/adfs`
+	expected := "https://adfs.local.azurestack.external/adfs"
+	if env.ActiveDirectoryEndpoint != expected {
+		t.Fatalf("Expected %q but got %q", expected, env.ActiveDirectoryEndpoint)
+	}
+}
@@ -2,7 +2,14 @@ package clients
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
 
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 )
@@ -17,18 +24,14 @@ type ResourceManagerAccount struct {
 	TenantId                         string
 }
 
-func NewResourceManagerAccount(ctx context.Context, config authentication.Config, env azure.Environment, skipResourceProviderRegistration bool) (*ResourceManagerAccount, error) {
-	objectId := ""
-
-	/*// TODO remove this when we confirm that MSI no longer returns nil with getAuthenticatedObjectID
-	// todo comment out for now as it is not stack env aware, add in a env param for it to use so it doens't look it up?
-	if getAuthenticatedObjectID := config.GetAuthenticatedObjectID; getAuthenticatedObjectID != nil {
-		v, err := getAuthenticatedObjectID(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("getting authenticated object ID: %v", err)
-		}
-		objectId = *v
-	}*/
+func NewResourceManagerAccount(ctx context.Context, config authentication.Config, env azure.Environment, authorizer autorest.Authorizer, skipResourceProviderRegistration bool) (*ResourceManagerAccount, error) {
+	// the Object ID is taken from the claims within the access token, rather than from the Graph API
+	// (as `config.GetAuthenticatedObjectID` does) since that isn't Azure Stack aware and isn't available
+	// when the Hub uses AD FS as its identity provider
+	objectId, err := authenticatedObjectId(ctx, authorizer)
+	if err != nil {
+		return nil, fmt.Errorf("getting authenticated object ID: %v", err)
+	}
 
 	account := ResourceManagerAccount{
 		AuthenticatedAsAServicePrincipal: config.AuthenticatedAsAServicePrincipal,
@@ -41,3 +44,61 @@ func NewResourceManagerAccount(ctx context.Context, config authentication.Config
 	}
 	return &account, nil
 }
+
+// authenticatedObjectId returns the `oid` claim from the access token used by the authorizer, or an
+// empty string when the token doesn't contain one. When authenticating using Auxiliary Tenants the
+// claim is taken from the token for the primary Tenant.
+func authenticatedObjectId(ctx context.Context, authorizer autorest.Authorizer) (string, error) {
+	var provider interface{}
+	switch v := authorizer.(type) {
+	case *autorest.BearerAuthorizer:
+		provider = v.TokenProvider()
+	case *autorest.MultiTenantBearerAuthorizer:
+		provider = v.TokenProvider()
+	default:
+		return "", nil
+	}
+
+	if refresher, ok := provider.(adal.RefresherWithContext); ok {
+		if err := refresher.EnsureFreshWithContext(ctx); err != nil {
+			return "", fmt.Errorf("refreshing access token: %+v", err)
+		}
+	}
+
+	token := ""
+	switch v := provider.(type) {
+	case adal.OAuthTokenProvider:
+		token = v.OAuthToken()
+	case adal.MultitenantOAuthTokenProvider:
+		token = v.PrimaryOAuthToken()
+	}
+
+	objectId, err := objectIdFromAccessToken(token)
+	if err != nil {
+		log.Printf("[DEBUG] unable to determine the Object ID from the access token: %+v", err)
+		return "", nil
+	}
+
+	return objectId, nil
+}
+
+func objectIdFromAccessToken(token string) (string, error) {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return "", fmt.Errorf("expected the access token to contain 3 segments but got %d", len(segments))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return "", fmt.Errorf("decoding the access token claims: %+v", err)
+	}
+
+	var claims struct {
+		ObjectId string `json:"oid"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("parsing the access token claims: %+v", err)
+	}
+
+	return claims.ObjectId, nil
+}
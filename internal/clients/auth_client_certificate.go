@@ -0,0 +1,63 @@
+package clients
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// ClientCertificateConfig configures authenticating as a Service Principal using a Client Certificate which
+// has been provided as a value (for example from a CI secret), rather than read from a file on disk
+type ClientCertificateConfig struct {
+	certificate *x509.Certificate
+	privateKey  *rsa.PrivateKey
+}
+
+// NewClientCertificateConfig decodes the base64-encoded PKCS#12 (PFX) Client Certificate
+func NewClientCertificateConfig(encoded string, password string) (*ClientCertificateConfig, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding `client_certificate`: expected a base64-encoded PKCS#12 certificate: %+v", err)
+	}
+
+	privateKey, certificate, err := pkcs12.Decode(data, password)
+	if err != nil {
+		return nil, fmt.Errorf("decoding PKCS#12 certificate from `client_certificate`: %+v", err)
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the PKCS#12 certificate specified in `client_certificate` must contain an RSA private key")
+	}
+
+	return &ClientCertificateConfig{
+		certificate: certificate,
+		privateKey:  rsaPrivateKey,
+	}, nil
+}
+
+// getADALToken returns an Authorizer for the specified endpoint using the Client Certificate
+func (c ClientCertificateConfig) getADALToken(_ context.Context, sender autorest.Sender, clientId string, oauthConfig *authentication.OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	if oauthConfig == nil || oauthConfig.OAuth == nil {
+		return nil, fmt.Errorf("getting Authorization Token for client cert: an OAuth token wasn't configured correctly; please file a bug with more details")
+	}
+
+	spt, err := adal.NewServicePrincipalTokenFromCertificate(*oauthConfig.OAuth, clientId, c.certificate, c.privateKey, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	spt.SetSender(sender)
+
+	if err := spt.Refresh(); err != nil {
+		return nil, err
+	}
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
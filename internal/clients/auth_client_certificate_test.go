@@ -0,0 +1,30 @@
+package clients
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestNewClientCertificateConfigInvalid(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input string
+	}{
+		{
+			Name:  "not base64",
+			Input: "not-base64!",
+		},
+		{
+			Name:  "not a PKCS#12 certificate",
+			Input: base64.StdEncoding.EncodeToString([]byte("hello world")),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if _, err := NewClientCertificateConfig(v.Input, ""); err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// oidcAudience is the audience which Azure Active Directory expects the ID Token to be issued for
+const oidcAudience = "api://AzureADTokenExchange"
+
+// OIDCConfig configures authenticating as a Service Principal using an OpenID Connect ID Token issued
+// by a trusted identity provider (such as GitHub Actions), using Workload Identity Federation.
+//
+// The ID Token is exchanged for an access token each time one is needed, since the ID Tokens issued by
+// CI systems are typically short-lived - as such the ID Token is obtained from the first of IDToken,
+// IDTokenFilePath, or the IDTokenRequestURL which is specified.
+type OIDCConfig struct {
+	// IDToken is the ID Token to exchange for an access token
+	IDToken string
+
+	// IDTokenFilePath is the path to a file containing the ID Token, which is re-read each time the
+	// token is exchanged, allowing it to be rotated (for example by Kubernetes Workload Identity)
+	IDTokenFilePath string
+
+	// IDTokenRequestURL and IDTokenRequestToken are used to request an ID Token from the identity
+	// provider, as exposed to GitHub Actions as `ACTIONS_ID_TOKEN_REQUEST_URL` and `ACTIONS_ID_TOKEN_REQUEST_TOKEN`
+	IDTokenRequestURL   string
+	IDTokenRequestToken string
+}
+
+// Validate returns an error when no source for the ID Token has been configured
+func (c OIDCConfig) Validate() error {
+	if c.IDToken != "" || c.IDTokenFilePath != "" {
+		return nil
+	}
+
+	if c.IDTokenRequestURL != "" {
+		if c.IDTokenRequestToken == "" {
+			return fmt.Errorf("`oidc_request_token` must be specified when using `oidc_request_url`")
+		}
+		return nil
+	}
+
+	return fmt.Errorf("one of `oidc_token`, `oidc_token_file_path` or `oidc_request_url` must be specified when `use_oidc` is enabled")
+}
+
+// getADALToken returns an Authorizer for the specified endpoint by exchanging the ID Token for an access token
+func (c OIDCConfig) getADALToken(ctx context.Context, sender autorest.Sender, clientId string, oauthConfig *authentication.OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+	if oauthConfig == nil || oauthConfig.OAuth == nil {
+		return nil, fmt.Errorf("getting Authorization Token for OIDC auth: an OAuth token wasn't configured correctly; please file a bug with more details")
+	}
+
+	secret := &oidcClientAssertion{
+		config: c,
+		ctx:    ctx,
+		sender: sender,
+	}
+	spt, err := adal.NewServicePrincipalTokenWithSecret(*oauthConfig.OAuth, clientId, endpoint, secret)
+	if err != nil {
+		return nil, err
+	}
+	spt.SetSender(sender)
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+// idToken returns the ID Token from the configured source
+func (c OIDCConfig) idToken(ctx context.Context, sender autorest.Sender) (string, error) {
+	if c.IDToken != "" {
+		return c.IDToken, nil
+	}
+
+	if c.IDTokenFilePath != "" {
+		contents, err := ioutil.ReadFile(c.IDTokenFilePath)
+		if err != nil {
+			return "", fmt.Errorf("reading ID Token from %q: %+v", c.IDTokenFilePath, err)
+		}
+		return strings.TrimSpace(string(contents)), nil
+	}
+
+	requestUrl, err := url.Parse(c.IDTokenRequestURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing `oidc_request_url`: %+v", err)
+	}
+	query := requestUrl.Query()
+	query.Set("audience", oidcAudience)
+	requestUrl.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("building ID Token request: %+v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.IDTokenRequestToken))
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting ID Token: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading ID Token response: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("requesting ID Token: expected a 200 but got a %d: %s", resp.StatusCode, string(body))
+	}
+
+	var token struct {
+		Value *string `json:"value"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("parsing ID Token response: %+v", err)
+	}
+
+	if token.Value == nil || *token.Value == "" {
+		return "", fmt.Errorf("the ID Token response didn't contain a token")
+	}
+
+	return *token.Value, nil
+}
+
+// oidcClientAssertion authenticates the Service Principal using the ID Token as a Client Assertion, rather
+// than a Client Secret or Certificate
+type oidcClientAssertion struct {
+	config OIDCConfig
+	ctx    context.Context
+	sender autorest.Sender
+}
+
+// SetAuthenticationValues implements adal.ServicePrincipalSecret and is called each time the access token is refreshed
+func (s *oidcClientAssertion) SetAuthenticationValues(_ *adal.ServicePrincipalToken, values *url.Values) error {
+	token, err := s.config.idToken(s.ctx, s.sender)
+	if err != nil {
+		return fmt.Errorf("obtaining ID Token for OIDC auth: %+v", err)
+	}
+
+	values.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	values.Set("client_assertion", token)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler - the ID Token isn't persisted
+func (s *oidcClientAssertion) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type string `json:"type"`
+	}{
+		Type: "OIDCClientAssertion",
+	})
+}
@@ -0,0 +1,121 @@
+package clients
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOIDCConfigValidate(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    OIDCConfig
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			Input:    OIDCConfig{},
+			Expected: false,
+		},
+		{
+			Name: "token",
+			Input: OIDCConfig{
+				IDToken: "abc123",
+			},
+			Expected: true,
+		},
+		{
+			Name: "token file path",
+			Input: OIDCConfig{
+				IDTokenFilePath: "/var/run/secrets/token",
+			},
+			Expected: true,
+		},
+		{
+			Name: "request url without a token",
+			Input: OIDCConfig{
+				IDTokenRequestURL: "https://example.com/token",
+			},
+			Expected: false,
+		},
+		{
+			Name: "request url with a token",
+			Input: OIDCConfig{
+				IDTokenRequestURL:   "https://example.com/token",
+				IDTokenRequestToken: "abc123",
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := v.Input.Validate()
+		if v.Expected && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Expected && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestOIDCConfigIDTokenFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(path, []byte("abc123\n"), os.ModePerm); err != nil {
+		t.Fatalf("writing token file: %+v", err)
+	}
+
+	config := OIDCConfig{
+		IDTokenFilePath: path,
+	}
+	actual, err := config.idToken(context.TODO(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if actual != "abc123" {
+		t.Fatalf("Expected %q but got %q", "abc123", actual)
+	}
+}
+
+func TestOIDCConfigIDTokenFromRequestURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer request-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Query().Get("audience") != oidcAudience {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"value": "abc123"}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	config := OIDCConfig{
+		IDTokenRequestURL:   server.URL + "?api-version=2.0",
+		IDTokenRequestToken: "request-token",
+	}
+	actual, err := config.idToken(context.TODO(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if actual != "abc123" {
+		t.Fatalf("Expected %q but got %q", "abc123", actual)
+	}
+
+	config.IDTokenRequestToken = "invalid"
+	if _, err := config.idToken(context.TODO(), http.DefaultClient); err == nil {
+		t.Fatalf("Expected an error when the request token is invalid but didn't get one")
+	}
+}
@@ -0,0 +1,94 @@
+package clients
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestObjectIdFromAccessToken(t *testing.T) {
+	encode := func(claims string) string {
+		return "eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCJ9." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+	}
+
+	testData := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// not a jwt
+			Input: "abc123",
+			Error: true,
+		},
+		{
+			// invalid claims
+			Input: encode("not-json"),
+			Error: true,
+		},
+		{
+			// no object id claim, e.g. AD FS
+			Input:    encode(`{"appid":"11111111-1111-1111-1111-111111111111"}`),
+			Expected: "",
+		},
+		{
+			Input:    encode(`{"appid":"11111111-1111-1111-1111-111111111111","oid":"22222222-2222-2222-2222-222222222222"}`),
+			Expected: "22222222-2222-2222-2222-222222222222",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := objectIdFromAccessToken(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+type testMultiTenantTokenProvider struct {
+	primary string
+}
+
+func (p testMultiTenantTokenProvider) PrimaryOAuthToken() string {
+	return p.primary
+}
+
+func (p testMultiTenantTokenProvider) AuxiliaryOAuthTokens() []string {
+	return []string{"auxiliary"}
+}
+
+func TestAuthenticatedObjectIdMultiTenant(t *testing.T) {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"oid":"22222222-2222-2222-2222-222222222222"}`))
+	authorizer := autorest.NewMultiTenantBearerAuthorizer(testMultiTenantTokenProvider{
+		primary: "eyJhbGciOiJSUzI1NiIsInR5cCI6IkpXVCJ9." + claims + ".signature",
+	})
+
+	actual, err := authenticatedObjectId(context.TODO(), authorizer)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if expected := "22222222-2222-2222-2222-222222222222"; actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
@@ -3,37 +3,78 @@ package clients
 import (
 	"context"
 	"fmt"
+	"log"
+	"net/http"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
-	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/features"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/stateencryption"
 )
 
 type ClientBuilder struct {
 	AuthConfig                  *authentication.Config
+	AdminAuthConfig             *authentication.Config
 	DisableCorrelationRequestID bool
 	CustomCorrelationRequestID  string
+	CorrelationRequestIDPrefix  string
 	SkipProviderRegistration    bool
 	TerraformVersion            string
+	RetryMaxAttempts            int
+	RetryBackoff                time.Duration
 	Features                    features.UserFeatures
+	StateEncryption             *stateencryption.Encrypter
+	DefaultTags                 map[string]interface{}
+	PollingInterval             time.Duration
+	PollingIntervalOverrides    map[string]time.Duration
+
+	// HTTPClient is used for all requests (including those to obtain tokens), allowing a proxy and custom
+	// CA Certificates to be configured
+	HTTPClient *http.Client
+
+	// OIDC is specified when authenticating using an OIDC ID Token, in which case the AuthConfig
+	// is only used to describe the account, rather than to obtain tokens
+	OIDC *OIDCConfig
+
+	// ClientCertificate is specified when authenticating using a Client Certificate provided as a value,
+	// in which case the AuthConfig is only used to describe the account, rather than to obtain tokens
+	ClientCertificate *ClientCertificateConfig
+
+	// OfflineEnvironment is specified when the endpoints of the Stamp are defined in the Provider block,
+	// in which case they're not retrieved from the metadata endpoint
+	OfflineEnvironment *OfflineEnvironment
 }
 
-func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
-	env, err := authentication.LoadEnvironmentFromUrl(builder.AuthConfig.CustomResourceManagerEndpoint)
-	if err != nil {
-		return nil, fmt.Errorf("unable to load stack encironment from endpoint %q: %+v", builder.AuthConfig.CustomResourceManagerEndpoint, err)
+// adalTokenFunc returns an Authorizer for the specified endpoint
+type adalTokenFunc func(ctx context.Context, sender autorest.Sender, oauthConfig *authentication.OAuthConfig, endpoint string) (autorest.Authorizer, error)
+
+// tokenFunc returns the function used to obtain tokens for the specified authentication configuration
+func (b ClientBuilder) tokenFunc(config authentication.Config) adalTokenFunc {
+	if b.OIDC != nil {
+		oidc := *b.OIDC
+		return func(ctx context.Context, sender autorest.Sender, oauthConfig *authentication.OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+			return oidc.getADALToken(ctx, sender, config.ClientID, oauthConfig, endpoint)
+		}
 	}
 
-	// client declarations:
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
-	if err != nil {
-		return nil, fmt.Errorf("building account: %+v", err)
+	if b.ClientCertificate != nil {
+		certificate := *b.ClientCertificate
+		return func(ctx context.Context, sender autorest.Sender, oauthConfig *authentication.OAuthConfig, endpoint string) (autorest.Authorizer, error) {
+			return certificate.getADALToken(ctx, sender, config.ClientID, oauthConfig, endpoint)
+		}
 	}
 
-	client := Client{
-		Account: account,
+	return config.GetADALToken
+}
+
+func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
+	env, err := builder.loadEnvironment(builder.AuthConfig.CustomResourceManagerEndpoint, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load stack encironment from endpoint %q: %+v", builder.AuthConfig.CustomResourceManagerEndpoint, err)
 	}
 
 	oauthConfig, err := builder.AuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
@@ -46,47 +87,82 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("unable to configure OAuthConfig for tenant %s", builder.AuthConfig.TenantID)
 	}
 
-	sender := sender.BuildSender("Azurestack")
+	sender := common.BuildSender(builder.HTTPClient)
+	getADALToken := builder.tokenFunc(*builder.AuthConfig)
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, env.TokenAudience)
+	auth, err := getADALToken(ctx, sender, oauthConfig, env.TokenAudience)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get authorization token for resource manager: %+v", err)
 	}
 
+	// client declarations:
+	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, auth, builder.SkipProviderRegistration)
+	if err != nil {
+		return nil, fmt.Errorf("building account: %+v", err)
+	}
+
+	client := Client{
+		Account:                  account,
+		Features:                 builder.Features,
+		StateEncryption:          builder.StateEncryption,
+		DefaultTags:              builder.DefaultTags,
+		PollingInterval:          builder.PollingInterval,
+		PollingIntervalOverrides: builder.PollingIntervalOverrides,
+	}
+
 	// Graph Endpoints
 	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, graphEndpoint)
+	graphAuth, err := getADALToken(ctx, sender, oauthConfig, graphEndpoint)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get authorization token for graph endpoints: %+v", err)
+		if !isADFSEnvironment(*env) {
+			return nil, fmt.Errorf("unable to get authorization token for graph endpoints: %+v", err)
+		}
+
+		// Stamps using AD FS expose the Graph API on the Stamp itself, which users authenticating via the
+		// Azure CLI can't necessarily obtain a token for - since it's only used by a handful of resources
+		// the error is deferred until the Graph API is used
+		log.Printf("[WARN] Unable to obtain an authorization token for the AD FS Graph endpoint %q - resources using the Graph API will be unavailable: %+v", graphEndpoint, err)
+		graphAuth = unavailableAuthorizer{err: err}
 	}
 
 	// Storage Endpoints
-	storageAuth, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, endpoint)
+	storageAuth, err := getADALToken(ctx, sender, oauthConfig, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get authorization token for storage endpoints: %+v", err)
 	}
 
 	// Key Vault Endpoints
-	// keyVaultAuth := builder.AuthConfig.BearerAuthorizerCallback(ctx, sender, oauthConfig)
+	keyVaultAuth, err := getADALToken(ctx, sender, oauthConfig, env.KeyVaultEndpoint)
+	if err != nil {
+		// the Key Vault data plane is only used by a handful of data sources, as such the error is deferred
+		// until it's used rather than preventing the provider from being configured
+		log.Printf("[WARN] Unable to obtain an authorization token for the Key Vault endpoint %q - data sources using the Key Vault data plane will be unavailable: %+v", env.KeyVaultEndpoint, err)
+		keyVaultAuth = unavailableAuthorizer{err: err}
+	}
 
 	o := &common.ClientOptions{
-		SubscriptionId:   builder.AuthConfig.SubscriptionID,
-		TenantID:         builder.AuthConfig.TenantID,
-		TerraformVersion: builder.TerraformVersion,
-		GraphAuthorizer:  graphAuth,
-		GraphEndpoint:    graphEndpoint,
-		// KeyVaultAuthorizer:          keyVaultAuth,
+		SubscriptionId:              builder.AuthConfig.SubscriptionID,
+		TenantID:                    builder.AuthConfig.TenantID,
+		AuxiliaryTenantIDs:          builder.AuthConfig.AuxiliaryTenantIDs,
+		TerraformVersion:            builder.TerraformVersion,
+		HTTPClient:                  builder.HTTPClient,
+		GraphAuthorizer:             graphAuth,
+		GraphEndpoint:               graphEndpoint,
+		KeyVaultAuthorizer:          keyVaultAuth,
 		ResourceManagerAuthorizer:   auth,
 		ResourceManagerEndpoint:     endpoint,
 		StorageAuthorizer:           storageAuth,
 		SkipProviderReg:             builder.SkipProviderRegistration,
+		RetryMaxAttempts:            builder.RetryMaxAttempts,
+		RetryBackoff:                builder.RetryBackoff,
 		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
 		CustomCorrelationRequestID:  builder.CustomCorrelationRequestID,
+		CorrelationRequestIDPrefix:  builder.CorrelationRequestIDPrefix,
 		Environment:                 *env,
 		TokenFunc: func(endpoint string) (autorest.Authorizer, error) {
-			authorizer, err := builder.AuthConfig.GetADALToken(ctx, sender, oauthConfig, endpoint)
+			authorizer, err := getADALToken(ctx, sender, oauthConfig, endpoint)
 			if err != nil {
 				return nil, fmt.Errorf("getting authorization token for endpoint %s: %+v", endpoint, err)
 			}
@@ -94,10 +170,27 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		},
 	}
 
+	if builder.AdminAuthConfig != nil {
+		adminEnv, err := builder.loadEnvironment(builder.AdminAuthConfig.CustomResourceManagerEndpoint, true)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load stack environment from admin endpoint %q: %+v", builder.AdminAuthConfig.CustomResourceManagerEndpoint, err)
+		}
+
+		if err := configureAdminEndpoint(ctx, o, *builder.AdminAuthConfig, *adminEnv, builder.tokenFunc(*builder.AdminAuthConfig)); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
 
+	// the capabilities of the Azure Stack Hub are best-effort - when these can't be retrieved the checks are skipped
+	client.Capabilities = &capabilities.ResourceTypes{}
+	if features.EnhancedValidationEnabled() {
+		client.Capabilities = capabilities.LoadResourceTypes(ctx, client.Resource.ProvidersClient)
+	}
+
 	/*if features.EnhancedValidationEnabled() {
 		location.CacheSupportedLocations(ctx, env.ResourceManagerEndpoint)
 		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient)
@@ -105,3 +198,26 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	return &client, nil
 }
+
+// configureAdminEndpoint authenticates against the Azure Stack administrative management endpoint, which
+// exposes the Admin APIs (such as Offers, Plans and Quotas) used to operate the Stamp
+func configureAdminEndpoint(ctx context.Context, o *common.ClientOptions, config authentication.Config, env azure.Environment, getADALToken adalTokenFunc) error {
+	oauthConfig, err := config.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
+	if err != nil {
+		return fmt.Errorf("building OAuth Config for the admin endpoint: %+v", err)
+	}
+	if oauthConfig == nil {
+		return fmt.Errorf("unable to configure OAuthConfig for the admin endpoint for tenant %s", config.TenantID)
+	}
+
+	auth, err := getADALToken(ctx, common.BuildSender(o.HTTPClient), oauthConfig, env.TokenAudience)
+	if err != nil {
+		return fmt.Errorf("unable to get authorization token for the admin endpoint: %+v", err)
+	}
+
+	o.AdminResourceManagerAuthorizer = auth
+	o.AdminResourceManagerEndpoint = env.ResourceManagerEndpoint
+	o.AdminSubscriptionId = config.SubscriptionID
+
+	return nil
+}
@@ -2,34 +2,82 @@ package clients
 
 import (
 	"context"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/features"
+	admin "github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/client"
 	authorization "github.com/hashicorp/terraform-provider-azurestack/internal/services/authorization/client"
+	batch "github.com/hashicorp/terraform-provider-azurestack/internal/services/batch/client"
 	compute "github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/client"
+	containers "github.com/hashicorp/terraform-provider-azurestack/internal/services/containers/client"
 	dns "github.com/hashicorp/terraform-provider-azurestack/internal/services/dns/client"
+	eventhub "github.com/hashicorp/terraform-provider-azurestack/internal/services/eventhub/client"
+	iothub "github.com/hashicorp/terraform-provider-azurestack/internal/services/iothub/client"
+	keyvault "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/client"
 	loadbalancer "github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/client"
+	managedidentity "github.com/hashicorp/terraform-provider-azurestack/internal/services/managedidentity/client"
+	marketplace "github.com/hashicorp/terraform-provider-azurestack/internal/services/marketplace/client"
+	monitor "github.com/hashicorp/terraform-provider-azurestack/internal/services/monitor/client"
 	network "github.com/hashicorp/terraform-provider-azurestack/internal/services/network/client"
+	policy "github.com/hashicorp/terraform-provider-azurestack/internal/services/policy/client"
+	recoveryservices "github.com/hashicorp/terraform-provider-azurestack/internal/services/recoveryservices/client"
 	resource "github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/client"
 	storage "github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/stateencryption"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
 )
 
 type Client struct {
 	// StopContext is used for propagating control from Terraform Core (e.g. Ctrl/Cmd+C)
 	StopContext context.Context
 
-	Account       *ResourceManagerAccount
-	Authorization *authorization.Client
-	Compute       *compute.Client
-	Dns           *dns.Client
-	LoadBalancer  *loadbalancer.Client
-	Network       *network.Client
-	Resource      *resource.Client
-	Storage       *storage.Client
+	Account          *ResourceManagerAccount
+	Admin            *admin.Client
+	Authorization    *authorization.Client
+	Batch            *batch.Client
+	Compute          *compute.Client
+	Containers       *containers.Client
+	Dns              *dns.Client
+	EventHub         *eventhub.Client
+	IoTHub           *iothub.Client
+	KeyVault         *keyvault.Client
+	LoadBalancer     *loadbalancer.Client
+	ManagedIdentity  *managedidentity.Client
+	Marketplace      *marketplace.Client
+	Monitor          *monitor.Client
+	Network          *network.Client
+	Policy           *policy.Client
+	RecoveryServices *recoveryservices.Client
+	Resource         *resource.Client
+	Storage          *storage.Client
 
 	Features features.UserFeatures
+
+	// StateEncryption encrypts sensitive attributes prior to them being written into the state, and is nil when disabled
+	StateEncryption *stateencryption.Encrypter
+
+	// CorrelationRequestID is the Correlation ID sent with each request, which is empty when this is disabled
+	CorrelationRequestID string
+
+	// Operations records the duration of each Create, Update and Delete operation performed during the run
+	Operations *timeouts.OperationTracker
+
+	// Capabilities are the Resource Types supported by the Azure Stack Hub, used to validate functionality at plan time
+	Capabilities *capabilities.ResourceTypes
+
+	// DefaultTags are merged into the tags of every Resource supporting tags, with the tags specified on the Resource taking precedence
+	DefaultTags map[string]interface{}
+
+	// PollingInterval is the interval between polls of Long Running Operations, which is zero when the delay
+	// requested by the API should be used
+	PollingInterval time.Duration
+
+	// PollingIntervalOverrides are the polling intervals configured for specific Resource Types, keyed by the Resource Type
+	PollingIntervalOverrides map[string]time.Duration
 }
 
 // NOTE: it should be possible for this method to become Private once the top level Client's removed
@@ -40,12 +88,25 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	validation.Disabled = true
 
 	client.StopContext = ctx
+	client.CorrelationRequestID = o.CorrelationRequestID()
+	client.Operations = timeouts.NewOperationTracker()
 
+	client.Admin = admin.NewClient(o)
 	client.Authorization = authorization.NewClient(o)
+	client.Batch = batch.NewClient(o)
 	client.Compute = compute.NewClient(o)
+	client.Containers = containers.NewClient(o)
 	client.Dns = dns.NewClient(o)
+	client.EventHub = eventhub.NewClient(o)
+	client.IoTHub = iothub.NewClient(o)
+	client.KeyVault = keyvault.NewClient(o)
 	client.LoadBalancer = loadbalancer.NewClient(o)
+	client.ManagedIdentity = managedidentity.NewClient(o)
+	client.Marketplace = marketplace.NewClient(o)
+	client.Monitor = monitor.NewClient(o)
 	client.Network = network.NewClient(o)
+	client.Policy = policy.NewClient(o)
+	client.RecoveryServices = recoveryservices.NewClient(o)
 	client.Resource = resource.NewClient(o)
 	client.Storage = storage.NewClient(o)
 
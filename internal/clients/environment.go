@@ -0,0 +1,132 @@
+package clients
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+// OfflineEnvironment describes the endpoints of an Azure Stack Hub Stamp, allowing the Provider to be used
+// from a runner which can't reach the metadata endpoint of the Stamp (for example on a disconnected Stamp)
+type OfflineEnvironment struct {
+	// ActiveDirectoryEndpoint is the Login Endpoint for either Azure Active Directory or AD FS
+	ActiveDirectoryEndpoint string
+
+	// TokenAudience is the Audience of tokens for the Resource Manager endpoint
+	TokenAudience string
+
+	// AdminTokenAudience is the Audience of tokens for the administrative Resource Manager endpoint
+	AdminTokenAudience string
+
+	GraphEndpoint         string
+	StorageEndpointSuffix string
+
+	// KeyVaultDNSSuffix defaults to `vault.{StorageEndpointSuffix}` when unset
+	KeyVaultDNSSuffix string
+}
+
+// environment returns the Environment for the specified Resource Manager endpoint, matching the Environment
+// which would otherwise be returned from the metadata endpoint
+func (e OfflineEnvironment) environment(resourceManagerEndpoint string, tokenAudience string) *azure.Environment {
+	keyVaultDNSSuffix := e.KeyVaultDNSSuffix
+	if keyVaultDNSSuffix == "" {
+		keyVaultDNSSuffix = fmt.Sprintf("vault.%s", e.StorageEndpointSuffix)
+	}
+
+	return &azure.Environment{
+		Name:                    "HybridEnvironment",
+		ActiveDirectoryEndpoint: e.ActiveDirectoryEndpoint,
+		GraphEndpoint:           e.GraphEndpoint,
+		KeyVaultDNSSuffix:       keyVaultDNSSuffix,
+		KeyVaultEndpoint:        fmt.Sprintf("https://%s", keyVaultDNSSuffix),
+		ResourceManagerEndpoint: resourceManagerEndpoint,
+		StorageEndpointSuffix:   strings.TrimPrefix(e.StorageEndpointSuffix, "."),
+		TokenAudience:           tokenAudience,
+	}
+}
+
+// loadEnvironment returns the Environment for the specified Resource Manager endpoint - using the offline
+// definition when one's been specified and otherwise retrieving it from the metadata endpoint of the Stamp
+func (b ClientBuilder) loadEnvironment(resourceManagerEndpoint string, admin bool) (*azure.Environment, error) {
+	var env *azure.Environment
+	if b.OfflineEnvironment != nil {
+		tokenAudience := b.OfflineEnvironment.TokenAudience
+		if admin {
+			tokenAudience = b.OfflineEnvironment.AdminTokenAudience
+		}
+		env = b.OfflineEnvironment.environment(resourceManagerEndpoint, tokenAudience)
+	} else {
+		loaded, err := environmentFromMetadata(common.BuildSender(b.HTTPClient), resourceManagerEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("%+v\n\nIf the metadata endpoint of the Azure Stack Hub Stamp can't be reached from this machine, the endpoints can instead be specified using the `offline_environment` block in the Provider block", err)
+		}
+		env = loaded
+	}
+
+	normalizeEnvironment(env)
+	return env, nil
+}
+
+type environmentMetadata struct {
+	GalleryEndpoint string `json:"galleryEndpoint"`
+	GraphEndpoint   string `json:"graphEndpoint"`
+	Authentication  struct {
+		LoginEndpoint string   `json:"loginEndpoint"`
+		Audiences     []string `json:"audiences"`
+	} `json:"authentication"`
+}
+
+// environmentFromMetadata retrieves the Environment from the metadata endpoint of the Stamp using the specified
+// Sender (so that the proxy and any custom CA Certificates are used) - returning the same Environment as
+// `azure.EnvironmentFromURL`, which always uses the default HTTP Client
+func environmentFromMetadata(sender autorest.Sender, resourceManagerEndpoint string) (*azure.Environment, error) {
+	if resourceManagerEndpoint == "" {
+		return nil, fmt.Errorf("Endpoint was not set!")
+	}
+
+	metadataUri := fmt.Sprintf("%s/metadata/endpoints?api-version=1.0", strings.TrimSuffix(resourceManagerEndpoint, "/"))
+	req, err := http.NewRequest(http.MethodGet, metadataUri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request for %q: %+v", metadataUri, err)
+	}
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Environment from Endpoint %q: %+v", resourceManagerEndpoint, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error retrieving Environment from Endpoint %q: unexpected status %d", resourceManagerEndpoint, resp.StatusCode)
+	}
+
+	var metadata environmentMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return nil, fmt.Errorf("Error parsing Environment from Endpoint %q: %+v", resourceManagerEndpoint, err)
+	}
+	if len(metadata.Authentication.Audiences) == 0 {
+		return nil, fmt.Errorf("Error parsing Environment from Endpoint %q: no token audiences were returned", resourceManagerEndpoint)
+	}
+
+	// the Stamp DNS Suffix is the Resource Manager endpoint without the first label, for example
+	// `https://management.region.example.com/` becomes `region.example.com`
+	stampDNSSuffix := strings.TrimSuffix(strings.TrimPrefix(strings.Replace(resourceManagerEndpoint, strings.Split(resourceManagerEndpoint, ".")[0], "", 1), "."), "/")
+	keyVaultDNSSuffix := fmt.Sprintf("vault.%s", stampDNSSuffix)
+
+	return &azure.Environment{
+		Name:                    "HybridEnvironment",
+		ActiveDirectoryEndpoint: metadata.Authentication.LoginEndpoint,
+		GalleryEndpoint:         metadata.GalleryEndpoint,
+		GraphEndpoint:           metadata.GraphEndpoint,
+		KeyVaultDNSSuffix:       keyVaultDNSSuffix,
+		KeyVaultEndpoint:        fmt.Sprintf("https://%s", keyVaultDNSSuffix),
+		ResourceManagerEndpoint: resourceManagerEndpoint,
+		StorageEndpointSuffix:   stampDNSSuffix,
+		TokenAudience:           metadata.Authentication.Audiences[0],
+	}, nil
+}
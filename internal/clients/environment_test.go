@@ -0,0 +1,144 @@
+package clients
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestLoadOfflineEnvironment(t *testing.T) {
+	offline := &OfflineEnvironment{
+		ActiveDirectoryEndpoint: "https://adfs.local.azurestack.external/adfs/",
+		TokenAudience:           "https://management.adfs.azurestack.local/00000000-0000-0000-0000-000000000000",
+		AdminTokenAudience:      "https://adminmanagement.adfs.azurestack.local/00000000-0000-0000-0000-000000000000",
+		GraphEndpoint:           "https://graph.local.azurestack.external/",
+		StorageEndpointSuffix:   "local.azurestack.external",
+	}
+
+	testData := []struct {
+		Name     string
+		Input    *OfflineEnvironment
+		Endpoint string
+		Admin    bool
+		Expected azure.Environment
+	}{
+		{
+			Name:     "tenant",
+			Input:    offline,
+			Endpoint: "https://management.local.azurestack.external",
+			Expected: azure.Environment{
+				Name:                    "HybridEnvironment",
+				ActiveDirectoryEndpoint: "https://adfs.local.azurestack.external/adfs",
+				GraphEndpoint:           "https://graph.local.azurestack.external/",
+				KeyVaultDNSSuffix:       "vault.local.azurestack.external",
+				KeyVaultEndpoint:        "https://vault.local.azurestack.external",
+				ResourceManagerEndpoint: "https://management.local.azurestack.external",
+				StorageEndpointSuffix:   "local.azurestack.external",
+				TokenAudience:           "https://management.adfs.azurestack.local/00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Name:     "admin",
+			Input:    offline,
+			Endpoint: "https://adminmanagement.local.azurestack.external",
+			Admin:    true,
+			Expected: azure.Environment{
+				Name:                    "HybridEnvironment",
+				ActiveDirectoryEndpoint: "https://adfs.local.azurestack.external/adfs",
+				GraphEndpoint:           "https://graph.local.azurestack.external/",
+				KeyVaultDNSSuffix:       "vault.local.azurestack.external",
+				KeyVaultEndpoint:        "https://vault.local.azurestack.external",
+				ResourceManagerEndpoint: "https://adminmanagement.local.azurestack.external",
+				StorageEndpointSuffix:   "local.azurestack.external",
+				TokenAudience:           "https://adminmanagement.adfs.azurestack.local/00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Name: "custom key vault suffix",
+			Input: &OfflineEnvironment{
+				ActiveDirectoryEndpoint: "https://login.microsoftonline.com/",
+				TokenAudience:           "https://management.contoso.onmicrosoft.com/00000000-0000-0000-0000-000000000000",
+				GraphEndpoint:           "https://graph.windows.net/",
+				StorageEndpointSuffix:   "region.contoso.com",
+				KeyVaultDNSSuffix:       "kv.region.contoso.com",
+			},
+			Endpoint: "https://management.region.contoso.com",
+			Expected: azure.Environment{
+				Name:                    "HybridEnvironment",
+				ActiveDirectoryEndpoint: "https://login.microsoftonline.com",
+				GraphEndpoint:           "https://graph.windows.net/",
+				KeyVaultDNSSuffix:       "kv.region.contoso.com",
+				KeyVaultEndpoint:        "https://kv.region.contoso.com",
+				ResourceManagerEndpoint: "https://management.region.contoso.com",
+				StorageEndpointSuffix:   "region.contoso.com",
+				TokenAudience:           "https://management.contoso.onmicrosoft.com/00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		builder := ClientBuilder{
+			OfflineEnvironment: v.Input,
+		}
+		actual, err := builder.loadEnvironment(v.Endpoint, v.Admin)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if !reflect.DeepEqual(*actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, *actual)
+		}
+	}
+}
+
+func TestEnvironmentFromMetadata(t *testing.T) {
+	metadata := `{
+  "galleryEndpoint": "https://providers.local.azurestack.external:30016/",
+  "graphEndpoint": "https://graph.local.azurestack.external/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://adfs.local.azurestack.external/adfs",
+    "audiences": ["https://management.adfs.azurestack.local/00000000-0000-0000-0000-000000000000"]
+  }
+}`
+
+	var requestedUri string
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		requestedUri = r.URL.String()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(metadata)),
+		}, nil
+	})
+
+	actual, err := environmentFromMetadata(sender, "https://management.local.azurestack.external/")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if expected := "https://management.local.azurestack.external/metadata/endpoints?api-version=1.0"; requestedUri != expected {
+		t.Fatalf("Expected the metadata to be retrieved from %q but got %q", expected, requestedUri)
+	}
+
+	expected := azure.Environment{
+		Name:                    "HybridEnvironment",
+		ActiveDirectoryEndpoint: "https://adfs.local.azurestack.external/adfs",
+		GalleryEndpoint:         "https://providers.local.azurestack.external:30016/",
+		GraphEndpoint:           "https://graph.local.azurestack.external/",
+		KeyVaultDNSSuffix:       "vault.local.azurestack.external",
+		KeyVaultEndpoint:        "https://vault.local.azurestack.external",
+		ResourceManagerEndpoint: "https://management.local.azurestack.external/",
+		StorageEndpointSuffix:   "local.azurestack.external",
+		TokenAudience:           "https://management.adfs.azurestack.local/00000000-0000-0000-0000-000000000000",
+	}
+	if !reflect.DeepEqual(*actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, *actual)
+	}
+}
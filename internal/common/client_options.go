@@ -2,12 +2,13 @@ package common
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/features"
 	"github.com/hashicorp/terraform-provider-azurestack/version"
@@ -19,6 +20,10 @@ type ClientOptions struct {
 	PartnerId        string
 	TerraformVersion string
 
+	// AuxiliaryTenantIDs are the Tenants (in addition to TenantID) which the ResourceManagerAuthorizer
+	// obtains tokens for, allowing resources to be managed across Tenants
+	AuxiliaryTenantIDs []string
+
 	GraphAuthorizer           autorest.Authorizer
 	GraphEndpoint             string
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -28,8 +33,21 @@ type ClientOptions struct {
 	SynapseAuthorizer         autorest.Authorizer
 	BatchManagementAuthorizer autorest.Authorizer
 
+	// the Admin API is opt-in and these fields are only populated when it's been configured
+	AdminResourceManagerAuthorizer autorest.Authorizer
+	AdminResourceManagerEndpoint   string
+	AdminSubscriptionId            string
+
+	// HTTPClient is used to send all requests, and is configured with the proxy and any custom CA Certificates
+	HTTPClient *http.Client
+
+	// RetryMaxAttempts and RetryBackoff configure how requests which have been throttled are retried
+	RetryMaxAttempts int
+	RetryBackoff     time.Duration
+
 	SkipProviderReg             bool
 	CustomCorrelationRequestID  string
+	CorrelationRequestIDPrefix  string
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
 	Environment                 azure.Environment
@@ -44,15 +62,35 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("Azurestack")
+	c.Sender = autorest.DecorateSender(BuildSender(o.HTTPClient), withStructuredRequestLogging(), withThrottlingRetries(o.RetryMaxAttempts, o.RetryBackoff), withPollingInterval())
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
-		id := o.CustomCorrelationRequestID
-		if id == "" {
-			id = correlationRequestID()
-		}
-		c.RequestInspector = withCorrelationRequestID(id)
+		c.RequestInspector = withCorrelationRequestID(o.correlationRequestID())
+	}
+}
+
+// CorrelationRequestID returns the Correlation ID sent with each request, or an empty string when sending
+// the Correlation ID has been disabled
+func (o ClientOptions) CorrelationRequestID() string {
+	if o.DisableCorrelationRequestID {
+		return ""
+	}
+
+	return o.correlationRequestID()
+}
+
+// correlationRequestID returns the Correlation ID sent with each request - which is either specified by the
+// platform, or generated once for each run of the Provider (and optionally prefixed by the user)
+func (o ClientOptions) correlationRequestID() string {
+	if o.CustomCorrelationRequestID != "" {
+		return o.CustomCorrelationRequestID
+	}
+
+	id := correlationRequestID()
+	if o.CorrelationRequestIDPrefix != "" {
+		id = fmt.Sprintf("%s-%s", o.CorrelationRequestIDPrefix, id)
 	}
+	return id
 }
 
 func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableTerraformPartnerID bool) {
@@ -29,3 +29,42 @@ func TestWithCorrelationRequestID(t *testing.T) {
 			HeaderCorrelationRequestID, uuid, req.Header.Get(HeaderCorrelationRequestID))
 	}
 }
+
+func TestClientOptionsCorrelationRequestID(t *testing.T) {
+	generated := correlationRequestID()
+
+	testData := []struct {
+		Name     string
+		Input    ClientOptions
+		Expected string
+	}{
+		{
+			Name:     "generated",
+			Input:    ClientOptions{},
+			Expected: generated,
+		},
+		{
+			Name: "prefixed",
+			Input: ClientOptions{
+				CorrelationRequestIDPrefix: "pipeline-1234",
+			},
+			Expected: "pipeline-1234-" + generated,
+		},
+		{
+			Name: "custom",
+			Input: ClientOptions{
+				CustomCorrelationRequestID: "00000000-0000-0000-0000-000000000000",
+				CorrelationRequestIDPrefix: "pipeline-1234",
+			},
+			Expected: "00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := v.Input.correlationRequestID(); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// HTTPClientOptions configure the HTTP Client used for all requests made by the Provider - which is needed
+// for Azure Stack Hub Stamps which are only reachable through a proxy and/or use certificates issued by an
+// internal Certificate Authority
+type HTTPClientOptions struct {
+	// ProxyURL is the URL of the proxy which requests are sent through. When this isn't specified the
+	// proxy is determined from the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables.
+	ProxyURL string
+
+	// CustomCACertificatePath is the path to a PEM encoded bundle of CA Certificates which should be
+	// trusted in addition to the CA Certificates of the system
+	CustomCACertificatePath string
+}
+
+// BuildHTTPClient returns the HTTP Client used for all requests made by the Provider
+func BuildHTTPClient(input HTTPClientOptions) (*http.Client, error) {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+	}
+
+	if input.ProxyURL != "" {
+		proxyUrl, err := url.Parse(input.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the Proxy URL %q: %+v", input.ProxyURL, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	if input.CustomCACertificatePath != "" {
+		pool, err := loadCACertificates(input.CustomCACertificatePath)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    pool,
+		}
+	}
+
+	return &http.Client{
+		Transport: transport,
+	}, nil
+}
+
+// loadCACertificates returns the CA Certificates of the system with the CA Certificates from the PEM encoded
+// bundle at the specified path appended
+func loadCACertificates(path string) (*x509.CertPool, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading the CA Certificate bundle %q: %+v", path, err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		log.Printf("[DEBUG] Unable to load the CA Certificates of the system, only the CA Certificates from %q will be trusted: %+v", path, err)
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(contents) {
+		return nil, fmt.Errorf("the CA Certificate bundle %q doesn't contain any PEM encoded certificates", path)
+	}
+
+	return pool, nil
+}
+
+// BuildSender returns a Sender which sends requests using the specified HTTP Client (or a default HTTP Client
+// when this is nil), logging each request and response
+func BuildSender(client *http.Client) autorest.Sender {
+	if client == nil {
+		client = &http.Client{
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+			},
+		}
+	}
+
+	return autorest.DecorateSender(client, withRequestLogging("Azurestack"))
+}
+
+// withRequestLogging logs the wire format of each request and response (with the Authorization header
+// removed) at the DEBUG level
+func withRequestLogging(providerName string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// strip the authorization header prior to printing
+			authHeaderName := "Authorization"
+			auth := r.Header.Get(authHeaderName)
+			if auth != "" {
+				r.Header.Del(authHeaderName)
+			}
+
+			// dump request to wire format
+			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
+				log.Printf("[DEBUG] %s Request: \n%s\n", providerName, dump)
+			} else {
+				// fallback to basic message
+				log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, r.Method, r.URL)
+			}
+
+			// add the auth header back
+			if auth != "" {
+				r.Header.Add(authHeaderName, auth)
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				// dump response to wire format
+				if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
+					log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, r.URL, dump)
+				} else {
+					// fallback to basic message
+					log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, resp.Status, r.URL)
+				}
+			} else if err != nil {
+				log.Printf("[DEBUG] %s Response Error: %s for %s\n", providerName, err, r.URL)
+			} else {
+				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
+			}
+			return resp, err
+		})
+	}
+}
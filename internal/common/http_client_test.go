@@ -0,0 +1,118 @@
+package common
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBuildHTTPClientCustomCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	bundlePath := filepath.Join(dir, "bundle.pem")
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(bundlePath, bundle, 0600); err != nil {
+		t.Fatalf("writing bundle: %+v", err)
+	}
+
+	invalidPath := filepath.Join(dir, "invalid.pem")
+	if err := os.WriteFile(invalidPath, []byte("not a certificate"), 0600); err != nil {
+		t.Fatalf("writing bundle: %+v", err)
+	}
+
+	testData := []struct {
+		Name          string
+		Input         HTTPClientOptions
+		ExpectError   bool
+		ExpectTrusted bool
+	}{
+		{
+			Name:          "default",
+			Input:         HTTPClientOptions{},
+			ExpectTrusted: false,
+		},
+		{
+			Name: "custom ca certificate",
+			Input: HTTPClientOptions{
+				CustomCACertificatePath: bundlePath,
+			},
+			ExpectTrusted: true,
+		},
+		{
+			Name: "missing ca certificate bundle",
+			Input: HTTPClientOptions{
+				CustomCACertificatePath: filepath.Join(dir, "missing.pem"),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid ca certificate bundle",
+			Input: HTTPClientOptions{
+				CustomCACertificatePath: invalidPath,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid proxy url",
+			Input: HTTPClientOptions{
+				ProxyURL: "http://[::1",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		client, err := BuildHTTPClient(v.Input)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		resp, err := client.Get(server.URL)
+		if v.ExpectTrusted && err != nil {
+			t.Fatalf("Expected the certificate to be trusted but got: %+v", err)
+		}
+		if !v.ExpectTrusted && err == nil {
+			t.Fatalf("Expected the certificate not to be trusted")
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+}
+
+func TestBuildHTTPClientProxyURL(t *testing.T) {
+	client, err := BuildHTTPClient(HTTPClientOptions{
+		ProxyURL: "http://proxy.example.com:3128",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://management.local.azurestack.external", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	proxy, err := client.Transport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if proxy == nil || proxy.String() != "http://proxy.example.com:3128" {
+		t.Fatalf("Expected the proxy %q but got %v", "http://proxy.example.com:3128", proxy)
+	}
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+)
+
+// withPollingInterval returns a SendDecorator which replaces the `Retry-After` header of successful responses
+// to requests whose context specifies a polling interval (see `lro.WithPollingInterval`), so that the interval
+// is used when polling a Long Running Operation using `WaitForCompletionRef` - since this uses the delay from
+// the `Retry-After` header of the latest response in preference to the polling delay of the client.
+//
+// Throttled (and failed) responses are left as-is, so that the delay requested by the API is still honoured.
+func withPollingInterval() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			resp, err := s.Do(r)
+			if err != nil || resp == nil || resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+				return resp, err
+			}
+
+			interval, ok := lro.PollingIntervalFromContext(r.Context())
+			if !ok {
+				return resp, err
+			}
+
+			// the `Retry-After` header is parsed as a whole number of seconds
+			seconds := int64(math.Ceil(interval.Seconds()))
+			if resp.Header == nil {
+				resp.Header = http.Header{}
+			}
+			resp.Header.Set(autorest.HeaderRetryAfter, strconv.FormatInt(seconds, 10))
+
+			return resp, err
+		})
+	}
+}
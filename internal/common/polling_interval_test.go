@@ -0,0 +1,87 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+)
+
+func TestWithPollingInterval(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Interval   time.Duration
+		Status     int
+		RetryAfter string
+		Expected   string
+	}{
+		{
+			Name:       "no polling interval",
+			Status:     http.StatusAccepted,
+			RetryAfter: "10",
+			Expected:   "10",
+		},
+		{
+			Name:       "polling interval replaces the header",
+			Interval:   2 * time.Second,
+			Status:     http.StatusAccepted,
+			RetryAfter: "10",
+			Expected:   "2",
+		},
+		{
+			Name:     "polling interval without a header",
+			Interval: 30 * time.Second,
+			Status:   http.StatusOK,
+			Expected: "30",
+		},
+		{
+			Name:     "polling interval is rounded up to whole seconds",
+			Interval: 1500 * time.Millisecond,
+			Status:   http.StatusCreated,
+			Expected: "2",
+		},
+		{
+			Name:       "throttled responses are left as-is",
+			Interval:   2 * time.Second,
+			Status:     http.StatusTooManyRequests,
+			RetryAfter: "10",
+			Expected:   "10",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if v.RetryAfter != "" {
+				w.Header().Set("Retry-After", v.RetryAfter)
+			}
+			w.WriteHeader(v.Status)
+		}))
+
+		ctx := context.TODO()
+		if v.Interval > 0 {
+			ctx = lro.WithPollingInterval(ctx, v.Interval)
+		}
+
+		sender := autorest.DecorateSender(server.Client(), withPollingInterval())
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		resp, err := sender.Do(req)
+		server.Close()
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual := resp.Header.Get("Retry-After"); actual != v.Expected {
+			t.Fatalf("Expected the `Retry-After` header to be %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package common
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// headerRequestID is the header containing the ID which ARM assigned to the request
+const headerRequestID = "x-ms-request-id"
+
+// withStructuredRequestLogging returns a SendDecorator which logs a single line for each request (including
+// the Correlation ID and the Request ID assigned by ARM) allowing Terraform operations to be matched to the
+// Activity and Audit Logs of the Stamp. Since this is logged at the DEBUG level it's only output when TF_LOG
+// is set to DEBUG or TRACE.
+func withStructuredRequestLogging() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := s.Do(r)
+			duration := time.Since(start).Round(time.Millisecond)
+
+			correlationId := r.Header.Get(HeaderCorrelationRequestID)
+			if resp == nil {
+				log.Printf("[DEBUG] ARM Request: method=%s url=%q correlation_id=%q duration=%s error=%q", r.Method, r.URL.String(), correlationId, duration, errorString(err))
+				return resp, err
+			}
+
+			log.Printf("[DEBUG] ARM Request: method=%s url=%q correlation_id=%q request_id=%q status=%d duration=%s", r.Method, r.URL.String(), correlationId, resp.Header.Get(headerRequestID), resp.StatusCode, duration)
+			return resp, err
+		})
+	}
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,48 @@
+package common
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithStructuredRequestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "11111111-1111-1111-1111-111111111111")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Header.Set(HeaderCorrelationRequestID, "pipeline-1234")
+
+	sender := autorest.DecorateSender(server.Client(), withStructuredRequestLogging())
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	for _, expected := range []string{
+		"method=PUT",
+		`correlation_id="pipeline-1234"`,
+		`request_id="11111111-1111-1111-1111-111111111111"`,
+		"status=202",
+		"/subscriptions/00000000-0000-0000-0000-000000000000",
+	} {
+		if !strings.Contains(output.String(), expected) {
+			t.Fatalf("Expected the log to contain %q but got %q", expected, output.String())
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the number of times a throttled request is retried by default
+	DefaultRetryMaxAttempts = 3
+
+	// DefaultRetryBackoff is the delay before the first retry of a throttled request which doesn't
+	// include a `Retry-After` header
+	DefaultRetryBackoff = 5 * time.Second
+
+	// maxRetryBackoff caps the exponential back-off between retries
+	maxRetryBackoff = 5 * time.Minute
+)
+
+// withThrottlingRetries returns a SendDecorator which retries requests throttled by ARM (a 429 response)
+// up to the specified number of times - waiting for the duration in the `Retry-After` header when present,
+// and otherwise backing off exponentially from the specified backoff.
+//
+// Azure Stack Hub throttles far more aggressively than Azure, and the Azure SDK for Go doesn't retry
+// throttled requests - meaning that without this they'd be surfaced as errors.
+func withThrottlingRetries(maxAttempts int, backoff time.Duration) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+
+			var resp *http.Response
+			var err error
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+				autorest.DrainResponseBody(resp) //nolint:errcheck
+
+				resp, err = s.Do(rr.Request())
+				if err != nil || resp == nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= maxAttempts {
+					return resp, err
+				}
+
+				log.Printf("[DEBUG] Request to %s was throttled - retrying (attempt %d of %d)", r.URL, attempt+1, maxAttempts)
+				if autorest.DelayWithRetryAfter(resp, r.Context().Done()) {
+					continue
+				}
+				if !autorest.DelayForBackoffWithCap(backoff, maxRetryBackoff, attempt, r.Context().Done()) {
+					return resp, r.Context().Err()
+				}
+			}
+		})
+	}
+}
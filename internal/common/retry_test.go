@@ -0,0 +1,94 @@
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithThrottlingRetries(t *testing.T) {
+	testData := []struct {
+		Name             string
+		ThrottledFor     int32
+		RetryAfter       string
+		MaxAttempts      int
+		ExpectedStatus   int
+		ExpectedRequests int32
+	}{
+		{
+			Name:             "not throttled",
+			ThrottledFor:     0,
+			MaxAttempts:      3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "throttled then succeeds",
+			ThrottledFor:     2,
+			MaxAttempts:      3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "throttled with a retry-after header",
+			ThrottledFor:     1,
+			RetryAfter:       "1",
+			MaxAttempts:      3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 2,
+		},
+		{
+			Name:             "retries exhausted",
+			ThrottledFor:     10,
+			MaxAttempts:      2,
+			ExpectedStatus:   http.StatusTooManyRequests,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "retries disabled",
+			ThrottledFor:     10,
+			MaxAttempts:      0,
+			ExpectedStatus:   http.StatusTooManyRequests,
+			ExpectedRequests: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) <= v.ThrottledFor {
+				if v.RetryAfter != "" {
+					w.Header().Set("Retry-After", v.RetryAfter)
+				}
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		sender := autorest.DecorateSender(server.Client(), withThrottlingRetries(v.MaxAttempts, time.Millisecond))
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		resp, err := sender.Do(req)
+		server.Close()
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("Expected the status %d but got %d", v.ExpectedStatus, resp.StatusCode)
+		}
+		if requests != v.ExpectedRequests {
+			t.Fatalf("Expected %d requests but got %d", v.ExpectedRequests, requests)
+		}
+	}
+}
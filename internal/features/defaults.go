@@ -1,10 +1,47 @@
 package features
 
+import "time"
+
 func Default() UserFeatures {
 	return UserFeatures{
 		// NOTE: ensure all nested objects are fully populated
+		DisallowedValues: []DisallowedValue{},
+		KeyVault: KeyVaultFeatures{
+			PurgeSoftDeleteOnDestroy:    true,
+			RecoverSoftDeletedKeyVaults: true,
+		},
+		NetworkConflictRetry: NetworkConflictRetryFeatures{
+			MaxAttempts: 5,
+			BaseDelay:   10 * time.Second,
+		},
+		NetworkInterface: NetworkInterfaceFeatures{
+			RemoveLoadBalancerAssociationsDuringDeletion: false,
+		},
+		ProtectedResources: ProtectedResourcesFeatures{
+			Enabled:  false,
+			TagName:  "terraform-protected",
+			TagValue: "true",
+		},
+		ProvenanceTags: ProvenanceTagsFeatures{
+			Enabled:    false,
+			Workspace:  "",
+			ModulePath: "",
+		},
+		QuotaCheck: QuotaCheckFeatures{
+			Enabled:        false,
+			FailOnExceeded: false,
+		},
 		ResourceGroup: ResourceGroupFeatures{
+			DeleteComputeResourcesFirst:        false,
+			DeleteNestedItemsDuringDeletion:    false,
 			PreventDeletionIfContainsResources: false,
 		},
+		TemplateDeployment: TemplateDeploymentFeatures{
+			DeleteNestedItemsDuringDeletion: false,
+		},
+		VirtualMachine: VirtualMachineFeatures{
+			DeleteOSDiskOnDeletion: false,
+			GracefulShutdown:       false,
+		},
 	}
 }
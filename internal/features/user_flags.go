@@ -1,9 +1,75 @@
 package features
 
+import "time"
+
 type UserFeatures struct {
-	ResourceGroup ResourceGroupFeatures
+	DisallowedValues     []DisallowedValue
+	KeyVault             KeyVaultFeatures
+	NetworkConflictRetry NetworkConflictRetryFeatures
+	NetworkInterface     NetworkInterfaceFeatures
+	ProtectedResources   ProtectedResourcesFeatures
+	ProvenanceTags       ProvenanceTagsFeatures
+	QuotaCheck           QuotaCheckFeatures
+	ResourceGroup        ResourceGroupFeatures
+	TemplateDeployment   TemplateDeploymentFeatures
+	VirtualMachine       VirtualMachineFeatures
+}
+
+// DisallowedValue prevents an attribute of a Resource from being set to any of the specified Values
+type DisallowedValue struct {
+	ResourceType string
+	Attribute    string
+	Values       []string
+}
+
+type KeyVaultFeatures struct {
+	PurgeSoftDeleteOnDestroy    bool
+	RecoverSoftDeletedKeyVaults bool
+}
+
+// NetworkConflictRetryFeatures configures how Network operations which fail due to a conflicting operation
+// (such as `AnotherOperationInProgress`) are retried, where each retry waits BaseDelay doubled per attempt
+type NetworkConflictRetryFeatures struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+type NetworkInterfaceFeatures struct {
+	RemoveLoadBalancerAssociationsDuringDeletion bool
+}
+
+// ProtectedResourcesFeatures prevents Resources which have the Tag TagName set to TagValue from being destroyed
+type ProtectedResourcesFeatures struct {
+	Enabled  bool
+	TagName  string
+	TagValue string
+}
+
+type ProvenanceTagsFeatures struct {
+	Enabled    bool
+	Workspace  string
+	ModulePath string
+}
+
+// QuotaCheckFeatures compares the quota required by the Resources being provisioned against the remaining quota during
+// the plan, failing the plan when it would be exceeded and FailOnExceeded is set - otherwise this is only logged, since
+// warnings can't be returned from a plan
+type QuotaCheckFeatures struct {
+	Enabled        bool
+	FailOnExceeded bool
 }
 
 type ResourceGroupFeatures struct {
+	DeleteComputeResourcesFirst        bool
+	DeleteNestedItemsDuringDeletion    bool
 	PreventDeletionIfContainsResources bool
 }
+
+type TemplateDeploymentFeatures struct {
+	DeleteNestedItemsDuringDeletion bool
+}
+
+type VirtualMachineFeatures struct {
+	DeleteOSDiskOnDeletion bool
+	GracefulShutdown       bool
+}
@@ -1,5 +1,10 @@
 package locks
 
+import (
+	"sort"
+	"strings"
+)
+
 // Remove duplicates from the input array and return unify array (without duplicated elements)
 func removeDuplicatesFromStringArray(elements []string) []string {
 	visited := map[string]bool{}
@@ -14,3 +19,25 @@ func removeDuplicatesFromStringArray(elements []string) []string {
 
 	return result
 }
+
+// sortedUniqueIDs returns the specified Resource IDs without duplicates (compared case-insensitively,
+// as Resource IDs are) sorted in a consistent order, so that multiple locks are always acquired in
+// the same order
+func sortedUniqueIDs(ids []string) []string {
+	visited := map[string]bool{}
+	result := make([]string, 0)
+
+	for _, id := range ids {
+		key := strings.ToLower(id)
+		if !visited[key] {
+			visited[key] = true
+			result = append(result, id)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return strings.ToLower(result[i]) < strings.ToLower(result[j])
+	})
+
+	return result
+}
@@ -36,3 +36,40 @@ func TestRemoveDuplicatesInStringArray(t *testing.T) {
 		})
 	}
 }
+
+func TestSortedUniqueIDs(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Input  []string
+		Result []string
+	}{
+		{
+			Name:   "unsorted",
+			Input:  []string{"/subscriptions/123/resourceGroups/rg2", "/subscriptions/123/resourceGroups/rg1"},
+			Result: []string{"/subscriptions/123/resourceGroups/rg1", "/subscriptions/123/resourceGroups/rg2"},
+		},
+		{
+			Name:   "duplicates with different casing",
+			Input:  []string{"/subscriptions/123/resourceGroups/rg1", "/subscriptions/123/resourcegroups/RG1"},
+			Result: []string{"/subscriptions/123/resourceGroups/rg1"},
+		},
+		{
+			Name:   "sorted case-insensitively",
+			Input:  []string{"/subscriptions/123/resourceGroups/b", "/subscriptions/123/resourceGroups/A"},
+			Result: []string{"/subscriptions/123/resourceGroups/A", "/subscriptions/123/resourceGroups/b"},
+		},
+		{
+			Name:   "empty array",
+			Input:  []string{},
+			Result: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if actual := sortedUniqueIDs(tc.Input); !reflect.DeepEqual(actual, tc.Result) {
+				t.Fatalf("Expected sortedUniqueIDs to return %v but got %v", tc.Result, actual)
+			}
+		})
+	}
+}
@@ -3,11 +3,39 @@ package locks
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = NewMutexKV()
 
+// ByID acquires an exclusive lock on the Resource with the specified ID, which should be used
+// when the Resource itself is being modified
 func ByID(id string) {
 	armMutexKV.Lock(id)
 }
 
+// ReadByID acquires a shared lock on the Resource with the specified ID, which should be used when
+// the Resource is referenced (but not modified) by another Resource - allowing these operations to
+// run in parallel, whilst blocking changes to the Resource itself
+func ReadByID(id string) {
+	armMutexKV.RLock(id)
+}
+
+// MultipleByID acquires an exclusive lock on each of the specified Resource IDs - these are locked
+// in a consistent order to avoid deadlocks between callers locking the same Resources
+func MultipleByID(ids *[]string) {
+	for _, id := range sortedUniqueIDs(*ids) {
+		ByID(id)
+	}
+}
+
+// ReadMultipleByID acquires a shared lock on each of the specified Resource IDs - these are locked
+// in a consistent order to avoid deadlocks between callers locking the same Resources
+func ReadMultipleByID(ids *[]string) {
+	for _, id := range sortedUniqueIDs(*ids) {
+		ReadByID(id)
+	}
+}
+
 // handle the case of using the same name for different kinds of resources
+//
+// NOTE: locking by name serializes operations on Resources with the same name in different Resource
+// Groups, as such new usages should lock using the Resource ID instead
 func ByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
 	armMutexKV.Lock(updatedName)
@@ -25,6 +53,22 @@ func UnlockByID(id string) {
 	armMutexKV.Unlock(id)
 }
 
+func ReadUnlockByID(id string) {
+	armMutexKV.RUnlock(id)
+}
+
+func UnlockMultipleByID(ids *[]string) {
+	for _, id := range sortedUniqueIDs(*ids) {
+		UnlockByID(id)
+	}
+}
+
+func ReadUnlockMultipleByID(ids *[]string) {
+	for _, id := range sortedUniqueIDs(*ids) {
+		ReadUnlockByID(id)
+	}
+}
+
 func UnlockByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
 	armMutexKV.Unlock(updatedName)
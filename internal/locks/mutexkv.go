@@ -2,18 +2,19 @@ package locks
 
 import (
 	"log"
+	"strings"
 	"sync"
 )
 
-// mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
+// mutexKV is a simple key/value store for arbitrary read/write mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
-// keys they must serialize on.
+// keys they must serialize on. Keys are case-insensitive, since Resource IDs are.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*sync.RWMutex
 }
 
-// Locks the mutex for the given key. Caller is responsible for calling Unlock
+// Locks the mutex for the given key for writing. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
 	log.Printf("[DEBUG] Locking %q", key)
@@ -28,13 +29,31 @@ func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
+// RLock locks the mutex for the given key for reading, which can be held by multiple callers at
+// once but not at the same time as a write lock. Caller is responsible for calling RUnlock
+// for the same key
+func (m *mutexKV) RLock(key string) {
+	log.Printf("[DEBUG] Read Locking %q", key)
+	m.get(key).RLock()
+	log.Printf("[DEBUG] Read Locked %q", key)
+}
+
+// RUnlock the mutex for the given key. Caller must have called RLock for the same key first
+func (m *mutexKV) RUnlock(key string) {
+	log.Printf("[DEBUG] Read Unlocking %q", key)
+	m.get(key).RUnlock()
+	log.Printf("[DEBUG] Read Unlocked %q", key)
+}
+
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *sync.RWMutex {
+	key = strings.ToLower(key)
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &sync.RWMutex{}
 		m.store[key] = mutex
 	}
 	return mutex
@@ -43,6 +62,6 @@ func (m *mutexKV) get(key string) *sync.Mutex {
 // Returns a properly initialized mutexKV
 func NewMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*sync.RWMutex),
 	}
 }
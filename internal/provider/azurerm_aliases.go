@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	loadBalancerParse "github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+// azureRMAliasSchemaVersion is the Schema Version of the aliases for the Resources within the AzureRM Provider.
+//
+// Terraform refuses to load the State of a Resource written using a newer Schema Version than the Provider supports,
+// as such this needs to be greater than the Schema Version of each of these Resources within the AzureRM Provider -
+// the State Upgraders for the earlier versions are no-ops, so the shim for each Resource runs exactly once.
+const azureRMAliasSchemaVersion = 10
+
+type azureRMAlias struct {
+	// resourceType is the equivalent Resource Type within this Provider
+	resourceType string
+
+	// upgradeState (optionally) converts the State written by the AzureRM Provider into the Schema used by this
+	// Provider - attributes which aren't supported by this Provider are removed from the State automatically
+	upgradeState pluginsdk.StateUpgraderFunc
+}
+
+// azureRMAliases are the Resources within the AzureRM Provider which are supported by this Provider, which allows
+// an existing configuration (and State) to be moved across using `terraform state replace-provider` - after which
+// each Resource can be imported as the equivalent Resource within this Provider.
+var azureRMAliases = map[string]azureRMAlias{
+	"azurerm_availability_set":            {resourceType: "azurestack_availability_set"},
+	"azurerm_dns_a_record":                {resourceType: "azurestack_dns_a_record"},
+	"azurerm_dns_aaaa_record":             {resourceType: "azurestack_dns_aaaa_record"},
+	"azurerm_dns_cname_record":            {resourceType: "azurestack_dns_cname_record"},
+	"azurerm_dns_mx_record":               {resourceType: "azurestack_dns_mx_record"},
+	"azurerm_dns_ns_record":               {resourceType: "azurestack_dns_ns_record"},
+	"azurerm_dns_ptr_record":              {resourceType: "azurestack_dns_ptr_record"},
+	"azurerm_dns_srv_record":              {resourceType: "azurestack_dns_srv_record"},
+	"azurerm_dns_txt_record":              {resourceType: "azurestack_dns_txt_record"},
+	"azurerm_dns_zone":                    {resourceType: "azurestack_dns_zone"},
+	"azurerm_eventhub":                    {resourceType: "azurestack_eventhub"},
+	"azurerm_eventhub_authorization_rule": {resourceType: "azurestack_eventhub_authorization_rule"},
+	"azurerm_eventhub_consumer_group":     {resourceType: "azurestack_eventhub_consumer_group"},
+	"azurerm_eventhub_namespace":          {resourceType: "azurestack_eventhub_namespace"},
+	"azurerm_key_vault":                   {resourceType: "azurestack_key_vault"},
+	"azurerm_lb":                          {resourceType: "azurestack_lb"},
+	"azurerm_lb_backend_address_pool":     {resourceType: "azurestack_lb_backend_address_pool", upgradeState: upgradeAzureRMLoadBalancerBackendAddressPool},
+	"azurerm_lb_nat_pool":                 {resourceType: "azurestack_lb_nat_pool"},
+	"azurerm_lb_nat_rule":                 {resourceType: "azurestack_lb_nat_rule"},
+	"azurerm_lb_probe":                    {resourceType: "azurestack_lb_probe", upgradeState: upgradeAzureRMLoadBalancerProbe},
+	"azurerm_lb_rule":                     {resourceType: "azurestack_lb_rule", upgradeState: upgradeAzureRMLoadBalancerRule},
+	"azurerm_local_network_gateway":       {resourceType: "azurestack_local_network_gateway"},
+	"azurerm_managed_disk":                {resourceType: "azurestack_managed_disk", upgradeState: upgradeAzureRMManagedDisk},
+	"azurerm_network_interface":           {resourceType: "azurestack_network_interface"},
+	"azurerm_network_interface_backend_address_pool_association": {resourceType: "azurestack_network_interface_backend_address_pool_association"},
+	"azurerm_network_security_group":                             {resourceType: "azurestack_network_security_group"},
+	"azurerm_network_security_rule":                              {resourceType: "azurestack_network_security_rule"},
+	"azurerm_public_ip":                                          {resourceType: "azurestack_public_ip"},
+	"azurerm_resource_group":                                     {resourceType: "azurestack_resource_group"},
+	"azurerm_role_assignment":                                    {resourceType: "azurestack_role_assignment"},
+	"azurerm_role_definition":                                    {resourceType: "azurestack_role_definition"},
+	"azurerm_route":                                              {resourceType: "azurestack_route"},
+	"azurerm_route_table":                                        {resourceType: "azurestack_route_table"},
+	"azurerm_storage_account":                                    {resourceType: "azurestack_storage_account"},
+	"azurerm_storage_blob":                                       {resourceType: "azurestack_storage_blob"},
+	"azurerm_storage_container":                                  {resourceType: "azurestack_storage_container"},
+	"azurerm_storage_queue":                                      {resourceType: "azurestack_storage_queue"},
+	"azurerm_storage_table":                                      {resourceType: "azurestack_storage_table"},
+	"azurerm_subnet":                                             {resourceType: "azurestack_subnet", upgradeState: upgradeAzureRMSubnet},
+	"azurerm_template_deployment":                                {resourceType: "azurestack_template_deployment"},
+	"azurerm_virtual_machine":                                    {resourceType: "azurestack_virtual_machine"},
+	"azurerm_virtual_machine_data_disk_attachment":               {resourceType: "azurestack_virtual_machine_data_disk_attachment"},
+	"azurerm_virtual_machine_extension":                          {resourceType: "azurestack_virtual_machine_extension"},
+	"azurerm_virtual_network":                                    {resourceType: "azurestack_virtual_network"},
+	"azurerm_virtual_network_gateway":                            {resourceType: "azurestack_virtual_network_gateway"},
+	"azurerm_virtual_network_gateway_connection":                 {resourceType: "azurestack_virtual_network_gateway_connection"},
+	"azurerm_virtual_network_peering":                            {resourceType: "azurestack_virtual_network_peering"},
+}
+
+// addAzureRMAliases adds the aliases for the Resources within the AzureRM Provider to the Resources
+func addAzureRMAliases(resources map[string]*schema.Resource) {
+	for azureRMResourceType, alias := range azureRMAliases {
+		resource, ok := resources[alias.resourceType]
+		if !ok {
+			panic(fmt.Sprintf("the alias %q references the Resource %q which doesn't exist", azureRMResourceType, alias.resourceType))
+		}
+
+		// the alias shares the Schema and functions of the Resource, but since the State has been written by the
+		// AzureRM Provider it uses its own Schema Version and State Upgraders
+		aliased := *resource
+		aliased.SchemaVersion = azureRMAliasSchemaVersion
+		aliased.StateUpgraders = azureRMAliasStateUpgraders(&aliased, alias.upgradeState)
+		resources[azureRMResourceType] = &aliased
+	}
+}
+
+func azureRMAliasStateUpgraders(resource *schema.Resource, upgradeState pluginsdk.StateUpgraderFunc) []schema.StateUpgrader {
+	noOp := func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		return rawState, nil
+	}
+
+	// the State is upgraded field-by-field, so the Schema of the Resource within this Provider is sufficient
+	impliedType := resource.CoreConfigSchema().ImpliedType()
+
+	upgraders := make([]schema.StateUpgrader, 0)
+	for version := 0; version < azureRMAliasSchemaVersion; version++ {
+		upgrade := noOp
+		if version == azureRMAliasSchemaVersion-1 && upgradeState != nil {
+			upgrade = upgradeState
+		}
+
+		upgraders = append(upgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    impliedType,
+			Upgrade: upgrade,
+		})
+	}
+
+	return upgraders
+}
+
+func upgradeAzureRMLoadBalancerBackendAddressPool(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	// `resource_group_name` was removed in v3.0 of the AzureRM Provider
+	if v, ok := rawState["resource_group_name"].(string); !ok || v == "" {
+		id, err := loadBalancerParse.LoadBalancerBackendAddressPoolID(rawState["id"].(string))
+		if err != nil {
+			return nil, err
+		}
+		rawState["resource_group_name"] = id.ResourceGroup
+	}
+
+	return rawState, nil
+}
+
+func upgradeAzureRMLoadBalancerProbe(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	// `resource_group_name` was removed in v3.0 of the AzureRM Provider
+	if v, ok := rawState["resource_group_name"].(string); !ok || v == "" {
+		id, err := loadBalancerParse.LoadBalancerProbeID(rawState["id"].(string))
+		if err != nil {
+			return nil, err
+		}
+		rawState["resource_group_name"] = id.ResourceGroup
+	}
+
+	return rawState, nil
+}
+
+func upgradeAzureRMLoadBalancerRule(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	// `resource_group_name` was removed in v3.0 of the AzureRM Provider
+	if v, ok := rawState["resource_group_name"].(string); !ok || v == "" {
+		id, err := loadBalancerParse.LoadBalancingRuleID(rawState["id"].(string))
+		if err != nil {
+			return nil, err
+		}
+		rawState["resource_group_name"] = id.ResourceGroup
+	}
+
+	// `backend_address_pool_id` was replaced by `backend_address_pool_ids` in v3.0 of the AzureRM Provider
+	if v, ok := rawState["backend_address_pool_id"].(string); !ok || v == "" {
+		if ids, ok := rawState["backend_address_pool_ids"].([]interface{}); ok && len(ids) > 0 {
+			rawState["backend_address_pool_id"] = ids[0]
+		}
+	}
+
+	return rawState, nil
+}
+
+func upgradeAzureRMManagedDisk(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	// `zones` was replaced by `zone` in v3.0 of the AzureRM Provider
+	if v, ok := rawState["zone"].(string); ok && v != "" {
+		rawState["zones"] = []interface{}{v}
+	}
+
+	return rawState, nil
+}
+
+func upgradeAzureRMSubnet(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	// `address_prefix` was replaced by `address_prefixes` in v3.0 of the AzureRM Provider
+	if v, ok := rawState["address_prefix"].(string); !ok || v == "" {
+		if prefixes, ok := rawState["address_prefixes"].([]interface{}); ok && len(prefixes) > 0 {
+			rawState["address_prefix"] = prefixes[0]
+		}
+	}
+
+	return rawState, nil
+}
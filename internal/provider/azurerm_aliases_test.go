@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAzureRMAliases(t *testing.T) {
+	resources := TestAzureProvider().ResourcesMap
+
+	for azureRMResourceType, alias := range azureRMAliases {
+		aliased, ok := resources[azureRMResourceType]
+		if !ok {
+			t.Fatalf("Expected the alias %q to be registered", azureRMResourceType)
+		}
+
+		if aliased.SchemaVersion != azureRMAliasSchemaVersion {
+			t.Fatalf("Expected the alias %q to use Schema Version %d but got %d", azureRMResourceType, azureRMAliasSchemaVersion, aliased.SchemaVersion)
+		}
+
+		// the Resource itself shouldn't be changed
+		if resources[alias.resourceType].SchemaVersion == azureRMAliasSchemaVersion {
+			t.Fatalf("Expected the Schema Version of %q to be unchanged", alias.resourceType)
+		}
+	}
+}
+
+func TestAzureRMAliasesUpgradeState(t *testing.T) {
+	testData := []struct {
+		Name         string
+		ResourceType string
+		Version      int64
+		Input        map[string]interface{}
+		Expected     map[string]string
+	}{
+		{
+			Name:         "Network Interface with an unsupported attribute",
+			ResourceType: "azurerm_network_interface",
+			Version:      0,
+			Input: map[string]interface{}{
+				"id":                            "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1",
+				"name":                          "nic1",
+				"resource_group_name":           "group1",
+				"location":                      "local",
+				"network_security_group_id":     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+				"enable_accelerated_networking": false,
+			},
+			Expected: map[string]string{
+				"name":                "nic1",
+				"resource_group_name": "group1",
+			},
+		},
+		{
+			Name:         "Subnet with Address Prefixes",
+			ResourceType: "azurerm_subnet",
+			Version:      0,
+			Input: map[string]interface{}{
+				"id":                   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+				"name":                 "subnet1",
+				"resource_group_name":  "group1",
+				"virtual_network_name": "network1",
+				"address_prefixes":     []interface{}{"10.0.2.0/24"},
+			},
+			Expected: map[string]string{
+				"name":           "subnet1",
+				"address_prefix": "10.0.2.0/24",
+			},
+		},
+		{
+			Name:         "Load Balancer Rule from a later Schema Version",
+			ResourceType: "azurerm_lb_rule",
+			Version:      1,
+			Input: map[string]interface{}{
+				"id":                       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/loadBalancingRules/rule1",
+				"name":                     "rule1",
+				"loadbalancer_id":          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1",
+				"backend_address_pool_ids": []interface{}{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1"},
+			},
+			Expected: map[string]string{
+				"resource_group_name":     "group1",
+				"backend_address_pool_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1",
+			},
+		},
+	}
+
+	ctx := context.TODO()
+	server := TestAzureProvider().GRPCProvider()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("retrieving the Provider Schema: %+v", err)
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		raw, err := json.Marshal(v.Input)
+		if err != nil {
+			t.Fatalf("marshalling the State: %+v", err)
+		}
+
+		resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+			TypeName: v.ResourceType,
+			Version:  v.Version,
+			RawState: &tfprotov5.RawState{
+				JSON: raw,
+			},
+		})
+		if err != nil {
+			t.Fatalf("upgrading the State: %+v", err)
+		}
+		for _, diag := range resp.Diagnostics {
+			t.Fatalf("upgrading the State: %s: %s", diag.Summary, diag.Detail)
+		}
+
+		value, err := resp.UpgradedState.Unmarshal(schemas.ResourceSchemas[v.ResourceType].ValueType())
+		if err != nil {
+			t.Fatalf("unmarshalling the upgraded State: %+v", err)
+		}
+
+		attributes := make(map[string]tftypes.Value)
+		if err := value.As(&attributes); err != nil {
+			t.Fatalf("converting the upgraded State: %+v", err)
+		}
+
+		for key, expected := range v.Expected {
+			var actual string
+			if err := attributes[key].As(&actual); err != nil {
+				t.Fatalf("converting %q: %+v", key, err)
+			}
+
+			if actual != expected {
+				t.Fatalf("Expected %q to be %q but got %q", key, expected, actual)
+			}
+		}
+	}
+}
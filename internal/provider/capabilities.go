@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/sdk"
+)
+
+// resourceProviderTypes returns the Resource Types provisioned (keyed by the Terraform Resource Type) by the
+// Resources within the specified Service Registration
+func resourceProviderTypes(service interface{}) map[string]string {
+	if v, ok := service.(sdk.UntypedServiceRegistrationWithCapabilities); ok {
+		return v.ResourceProviderTypes()
+	}
+	return map[string]string{}
+}
+
+// withCapabilities wraps the CustomizeDiff function of a Resource, so that when a new Resource is planned the
+// Resource Type it provisions is checked against those supported by the Azure Stack Hub (and the location) -
+// rather than the API returning an unhelpful error part-way through the apply.
+func withCapabilities(resource *schema.Resource, armResourceType string) *schema.Resource {
+	if armResourceType == "" {
+		return resource
+	}
+
+	_, hasLocation := resource.Schema["location"]
+
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if client, ok := meta.(*clients.Client); ok && client != nil && d.Id() == "" {
+			loc := ""
+			if hasLocation && d.NewValueKnown("location") {
+				loc = d.Get("location").(string)
+			}
+
+			if err := client.Capabilities.ValidateResourceType(armResourceType, loc); err != nil {
+				return err
+			}
+		}
+
+		if customizeDiff != nil {
+			return customizeDiff(ctx, d, meta)
+		}
+
+		return nil
+	}
+
+	return resource
+}
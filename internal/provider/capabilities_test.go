@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestResourceProviderTypesExist(t *testing.T) {
+	resources := TestAzureProvider().ResourcesMap
+	armResourceTypeRegex := regexp.MustCompile(`^Microsoft\.[A-Za-z]+/[A-Za-z]+(/[A-Za-z]+)*$`)
+
+	for _, service := range SupportedUntypedServices() {
+		for resourceType, armResourceType := range resourceProviderTypes(service) {
+			t.Logf("[DEBUG] Testing %q", resourceType)
+
+			if _, ok := resources[resourceType]; !ok {
+				t.Fatalf("Expected the Resource %q to exist", resourceType)
+			}
+
+			if !armResourceTypeRegex.MatchString(armResourceType) {
+				t.Fatalf("Expected the Resource Type %q for the Resource %q to be in the format `{namespace}/{type}`", armResourceType, resourceType)
+			}
+		}
+	}
+}
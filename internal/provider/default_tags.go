@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+)
+
+// withDefaultTags wraps the Create, Read and Update functions of a Resource supporting tags, so that the
+// `default_tags` defined on the Provider are merged into the tags sent to the API - and then removed from
+// the state, since they're not present in the users configuration
+func withDefaultTags(resource *schema.Resource) *schema.Resource {
+	return withTags(resource, injectDefaultTags, stripDefaultTags)
+}
+
+func injectDefaultTags(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	configured := d.Get("tags").(map[string]interface{})
+
+	defaultTags := meta.(*clients.Client).DefaultTags
+	if len(defaultTags) == 0 {
+		return configured, nil
+	}
+
+	if err := d.Set("tags", tags.MergeDefaults(configured, defaultTags)); err != nil {
+		return nil, err
+	}
+
+	return configured, nil
+}
+
+func stripDefaultTags(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+	defaultTags := meta.(*clients.Client).DefaultTags
+	if len(defaultTags) == 0 || d.Id() == "" {
+		return nil
+	}
+
+	return d.Set("tags", tags.StripDefaults(d.Get("tags").(map[string]interface{}), configured, defaultTags))
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/features"
+)
+
+// withDisallowedValues wraps the CustomizeDiff function of a Resource, so that when the `disallowed_values`
+// feature is configured the plan fails if an attribute of the Resource is set to a disallowed value
+func withDisallowedValues(resourceType string, resource *schema.Resource) *schema.Resource {
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if client, ok := meta.(*clients.Client); ok && client != nil {
+			if err := checkDisallowedValues(resourceType, d, client.Features.DisallowedValues); err != nil {
+				return err
+			}
+		}
+
+		if customizeDiff != nil {
+			return customizeDiff(ctx, d, meta)
+		}
+
+		return nil
+	}
+
+	return resource
+}
+
+func checkDisallowedValues(resourceType string, d *schema.ResourceDiff, disallowedValues []features.DisallowedValue) error {
+	for _, disallowed := range disallowedValues {
+		if disallowed.ResourceType != resourceType || !d.NewValueKnown(disallowed.Attribute) {
+			continue
+		}
+
+		// existing Resources are only checked when the attribute changes, so that the feature can be
+		// enabled without blocking unrelated changes to Resources provisioned beforehand
+		if d.Id() != "" && !d.HasChange(disallowed.Attribute) {
+			continue
+		}
+
+		for _, value := range flattenDisallowedValueAttribute(d.Get(disallowed.Attribute)) {
+			for _, v := range disallowed.Values {
+				if strings.EqualFold(value, v) {
+					return fmt.Errorf("`%s` is set to %q which isn't allowed by the `disallowed_values` feature configured in the Provider block", disallowed.Attribute, value)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// flattenDisallowedValueAttribute returns the value(s) of a primitive attribute, or a List/Set of primitives, as strings
+func flattenDisallowedValueAttribute(input interface{}) []string {
+	values := make([]string, 0)
+
+	switch v := input.(type) {
+	case nil:
+		return values
+	case *schema.Set:
+		return flattenDisallowedValueAttribute(v.List())
+	case []interface{}:
+		for _, item := range v {
+			values = append(values, flattenDisallowedValueAttribute(item)...)
+		}
+	case map[string]interface{}:
+		// nested blocks aren't compared - their attributes can be targeted using `block.0.attribute`
+	default:
+		values = append(values, fmt.Sprintf("%v", v))
+	}
+
+	return values
+}
+
+// validateDisallowedValues checks that the `disallowed_values` feature targets Resources (and top-level
+// attributes of them) which are supported by the Provider, since a typo would otherwise silently disable it
+func validateDisallowedValues(resources map[string]*schema.Resource, disallowedValues []features.DisallowedValue) error {
+	for _, disallowed := range disallowedValues {
+		resource, ok := resources[disallowed.ResourceType]
+		if !ok {
+			return fmt.Errorf("the `disallowed_values` feature references the Resource %q which isn't supported by this Provider", disallowed.ResourceType)
+		}
+
+		attribute := strings.Split(disallowed.Attribute, ".")[0]
+		if _, ok := resource.Schema[attribute]; !ok {
+			return fmt.Errorf("the `disallowed_values` feature references the attribute %q which isn't supported by the Resource %q", disallowed.Attribute, disallowed.ResourceType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/features"
+)
+
+func TestValidateDisallowedValues(t *testing.T) {
+	resources := TestAzureProvider().ResourcesMap
+
+	testData := []struct {
+		Name     string
+		Input    []features.DisallowedValue
+		Expected bool
+	}{
+		{
+			Name:     "None",
+			Input:    []features.DisallowedValue{},
+			Expected: true,
+		},
+		{
+			Name: "Top Level Attribute",
+			Input: []features.DisallowedValue{
+				{
+					ResourceType: "azurestack_public_ip",
+					Attribute:    "allocation_method",
+					Values:       []string{"Dynamic"},
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Nested Attribute",
+			Input: []features.DisallowedValue{
+				{
+					ResourceType: "azurestack_network_interface",
+					Attribute:    "ip_configuration.0.private_ip_address_allocation",
+					Values:       []string{"Dynamic"},
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Unsupported Resource",
+			Input: []features.DisallowedValue{
+				{
+					ResourceType: "azurestack_public_ip_address",
+					Attribute:    "allocation_method",
+					Values:       []string{"Dynamic"},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Unsupported Attribute",
+			Input: []features.DisallowedValue{
+				{
+					ResourceType: "azurestack_public_ip",
+					Attribute:    "allocation",
+					Values:       []string{"Dynamic"},
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+
+		err := validateDisallowedValues(resources, testCase.Input)
+		if testCase.Expected && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !testCase.Expected && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestFlattenDisallowedValueAttribute(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    interface{}
+		Expected []string
+	}{
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: []string{},
+		},
+		{
+			Name:     "String",
+			Input:    "Dynamic",
+			Expected: []string{"Dynamic"},
+		},
+		{
+			Name:     "Bool",
+			Input:    true,
+			Expected: []string{"true"},
+		},
+		{
+			Name:     "Int",
+			Input:    4,
+			Expected: []string{"4"},
+		},
+		{
+			Name:     "List",
+			Input:    []interface{}{"10.0.0.0/16", "10.1.0.0/16"},
+			Expected: []string{"10.0.0.0/16", "10.1.0.0/16"},
+		},
+		{
+			Name:     "Set",
+			Input:    schema.NewSet(schema.HashString, []interface{}{"1"}),
+			Expected: []string{"1"},
+		},
+		{
+			Name: "Nested Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name": "example",
+				},
+			},
+			Expected: []string{},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+
+		actual := flattenDisallowedValueAttribute(testCase.Input)
+		if !reflect.DeepEqual(actual, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, actual)
+		}
+	}
+}
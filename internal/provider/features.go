@@ -1,7 +1,10 @@
 package provider
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/features"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
 )
@@ -10,12 +13,180 @@ func schemaFeatures() *pluginsdk.Schema {
 	// NOTE: if there's only one nested field these want to be Required (since there's no point
 	//       specifying the block otherwise) - however for 2+ they should be optional
 	featuresMap := map[string]*pluginsdk.Schema{
+		"disallowed_values": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*schema.Schema{
+					"resource_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"attribute": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"values": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"key_vault": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*schema.Schema{
+					"purge_soft_delete_on_destroy": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"recover_soft_deleted_key_vaults": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"network_conflict_retry": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*schema.Schema{
+					"max_attempts": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      5,
+						ValidateFunc: validation.IntBetween(1, 20),
+					},
+
+					"base_delay_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 300),
+					},
+				},
+			},
+		},
+
+		"network_interface": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*schema.Schema{
+					"remove_load_balancer_associations_during_deletion": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"protect_critical_resources": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
+					"tag_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "terraform-protected",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"tag_value": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "true",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"provenance_tags": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
+					"workspace": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"module_path": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"quota_check": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
+					"fail_on_exceeded": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
 		"resource_group": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*schema.Schema{
+					"delete_compute_resources_first": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
+					"delete_nested_items_during_deletion": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
 					"prevent_deletion_if_contains_resources": {
 						Type:     pluginsdk.TypeBool,
 						Optional: true,
@@ -23,6 +194,39 @@ func schemaFeatures() *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"template_deployment": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*schema.Schema{
+					"delete_nested_items_during_deletion": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"virtual_machine": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*schema.Schema{
+					"delete_os_disk_on_deletion": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
+					"graceful_shutdown": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
 	}
 
 	return &pluginsdk.Schema{
@@ -46,15 +250,147 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 
 	val := input[0].(map[string]interface{})
 
+	if raw, ok := val["disallowed_values"]; ok {
+		for _, item := range raw.([]interface{}) {
+			if item == nil {
+				continue
+			}
+			disallowedValueRaw := item.(map[string]interface{})
+
+			values := make([]string, 0)
+			for _, v := range disallowedValueRaw["values"].([]interface{}) {
+				if v != nil {
+					values = append(values, v.(string))
+				}
+			}
+
+			featuresMap.DisallowedValues = append(featuresMap.DisallowedValues, features.DisallowedValue{
+				ResourceType: disallowedValueRaw["resource_type"].(string),
+				Attribute:    disallowedValueRaw["attribute"].(string),
+				Values:       values,
+			})
+		}
+	}
+
+	if raw, ok := val["key_vault"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			keyVaultRaw := items[0].(map[string]interface{})
+			if v, ok := keyVaultRaw["purge_soft_delete_on_destroy"]; ok {
+				featuresMap.KeyVault.PurgeSoftDeleteOnDestroy = v.(bool)
+			}
+			if v, ok := keyVaultRaw["recover_soft_deleted_key_vaults"]; ok {
+				featuresMap.KeyVault.RecoverSoftDeletedKeyVaults = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["network_conflict_retry"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			conflictRetryRaw := items[0].(map[string]interface{})
+			if v, ok := conflictRetryRaw["max_attempts"]; ok && v.(int) > 0 {
+				featuresMap.NetworkConflictRetry.MaxAttempts = v.(int)
+			}
+			if v, ok := conflictRetryRaw["base_delay_seconds"]; ok && v.(int) > 0 {
+				featuresMap.NetworkConflictRetry.BaseDelay = time.Duration(v.(int)) * time.Second
+			}
+		}
+	}
+
+	if raw, ok := val["network_interface"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			networkInterfaceRaw := items[0].(map[string]interface{})
+			if v, ok := networkInterfaceRaw["remove_load_balancer_associations_during_deletion"]; ok {
+				featuresMap.NetworkInterface.RemoveLoadBalancerAssociationsDuringDeletion = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["protect_critical_resources"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			protectedResourcesRaw := items[0].(map[string]interface{})
+			if v, ok := protectedResourcesRaw["enabled"]; ok {
+				featuresMap.ProtectedResources.Enabled = v.(bool)
+			}
+			if v, ok := protectedResourcesRaw["tag_name"]; ok && v.(string) != "" {
+				featuresMap.ProtectedResources.TagName = v.(string)
+			}
+			if v, ok := protectedResourcesRaw["tag_value"]; ok && v.(string) != "" {
+				featuresMap.ProtectedResources.TagValue = v.(string)
+			}
+		}
+	}
+
+	if raw, ok := val["provenance_tags"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			provenanceTagsRaw := items[0].(map[string]interface{})
+			if v, ok := provenanceTagsRaw["enabled"]; ok {
+				featuresMap.ProvenanceTags.Enabled = v.(bool)
+			}
+			if v, ok := provenanceTagsRaw["workspace"]; ok {
+				featuresMap.ProvenanceTags.Workspace = v.(string)
+			}
+			if v, ok := provenanceTagsRaw["module_path"]; ok {
+				featuresMap.ProvenanceTags.ModulePath = v.(string)
+			}
+		}
+	}
+
+	if raw, ok := val["quota_check"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			quotaCheckRaw := items[0].(map[string]interface{})
+			if v, ok := quotaCheckRaw["enabled"]; ok {
+				featuresMap.QuotaCheck.Enabled = v.(bool)
+			}
+			if v, ok := quotaCheckRaw["fail_on_exceeded"]; ok {
+				featuresMap.QuotaCheck.FailOnExceeded = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["resource_group"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
 			resourceGroupRaw := items[0].(map[string]interface{})
+			if v, ok := resourceGroupRaw["delete_compute_resources_first"]; ok {
+				featuresMap.ResourceGroup.DeleteComputeResourcesFirst = v.(bool)
+			}
+			if v, ok := resourceGroupRaw["delete_nested_items_during_deletion"]; ok {
+				featuresMap.ResourceGroup.DeleteNestedItemsDuringDeletion = v.(bool)
+			}
 			if v, ok := resourceGroupRaw["prevent_deletion_if_contains_resources"]; ok {
 				featuresMap.ResourceGroup.PreventDeletionIfContainsResources = v.(bool)
 			}
 		}
 	}
 
+	if raw, ok := val["template_deployment"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			templateDeploymentRaw := items[0].(map[string]interface{})
+			if v, ok := templateDeploymentRaw["delete_nested_items_during_deletion"]; ok {
+				featuresMap.TemplateDeployment.DeleteNestedItemsDuringDeletion = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["virtual_machine"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			virtualMachinesRaw := items[0].(map[string]interface{})
+			if v, ok := virtualMachinesRaw["delete_os_disk_on_deletion"]; ok {
+				featuresMap.VirtualMachine.DeleteOSDiskOnDeletion = v.(bool)
+			}
+			if v, ok := virtualMachinesRaw["graceful_shutdown"]; ok {
+				featuresMap.VirtualMachine.GracefulShutdown = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
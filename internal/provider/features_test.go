@@ -3,7 +3,9 @@ package provider
 import (
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/features"
 )
 
@@ -18,43 +20,261 @@ func TestExpandFeatures(t *testing.T) {
 			Name:  "Empty Block",
 			Input: []interface{}{},
 			Expected: features.UserFeatures{
+				DisallowedValues: []features.DisallowedValue{},
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    true,
+					RecoverSoftDeletedKeyVaults: true,
+				},
+				NetworkConflictRetry: features.NetworkConflictRetryFeatures{
+					MaxAttempts: 5,
+					BaseDelay:   10 * time.Second,
+				},
+				NetworkInterface: features.NetworkInterfaceFeatures{
+					RemoveLoadBalancerAssociationsDuringDeletion: false,
+				},
+				ProtectedResources: features.ProtectedResourcesFeatures{
+					Enabled:  false,
+					TagName:  "terraform-protected",
+					TagValue: "true",
+				},
+				ProvenanceTags: features.ProvenanceTagsFeatures{
+					Enabled:    false,
+					Workspace:  "",
+					ModulePath: "",
+				},
+				QuotaCheck: features.QuotaCheckFeatures{
+					Enabled:        false,
+					FailOnExceeded: false,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
+					DeleteComputeResourcesFirst:        false,
+					DeleteNestedItemsDuringDeletion:    false,
 					PreventDeletionIfContainsResources: false,
 				},
+				TemplateDeployment: features.TemplateDeploymentFeatures{
+					DeleteNestedItemsDuringDeletion: false,
+				},
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+					GracefulShutdown:       false,
+				},
 			},
 		},
 		{
 			Name: "Complete Enabled",
 			Input: []interface{}{
 				map[string]interface{}{
+					"disallowed_values": []interface{}{
+						map[string]interface{}{
+							"resource_type": "azurestack_public_ip",
+							"attribute":     "allocation_method",
+							"values":        []interface{}{"Dynamic"},
+						},
+					},
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":    true,
+							"recover_soft_deleted_key_vaults": true,
+						},
+					},
+					"network_conflict_retry": []interface{}{
+						map[string]interface{}{
+							"max_attempts":       3,
+							"base_delay_seconds": 30,
+						},
+					},
+					"network_interface": []interface{}{
+						map[string]interface{}{
+							"remove_load_balancer_associations_during_deletion": true,
+						},
+					},
+					"protect_critical_resources": []interface{}{
+						map[string]interface{}{
+							"enabled":   true,
+							"tag_name":  "critical",
+							"tag_value": "yes",
+						},
+					},
+					"provenance_tags": []interface{}{
+						map[string]interface{}{
+							"enabled":     true,
+							"workspace":   "production",
+							"module_path": "/src/infra",
+						},
+					},
+					"quota_check": []interface{}{
+						map[string]interface{}{
+							"enabled":          true,
+							"fail_on_exceeded": true,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
+							"delete_compute_resources_first":         true,
+							"delete_nested_items_during_deletion":    true,
 							"prevent_deletion_if_contains_resources": true,
 						},
 					},
+					"template_deployment": []interface{}{
+						map[string]interface{}{
+							"delete_nested_items_during_deletion": true,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": true,
+							"graceful_shutdown":          true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
+				DisallowedValues: []features.DisallowedValue{
+					{
+						ResourceType: "azurestack_public_ip",
+						Attribute:    "allocation_method",
+						Values:       []string{"Dynamic"},
+					},
+				},
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    true,
+					RecoverSoftDeletedKeyVaults: true,
+				},
+				NetworkConflictRetry: features.NetworkConflictRetryFeatures{
+					MaxAttempts: 3,
+					BaseDelay:   30 * time.Second,
+				},
+				NetworkInterface: features.NetworkInterfaceFeatures{
+					RemoveLoadBalancerAssociationsDuringDeletion: true,
+				},
+				ProtectedResources: features.ProtectedResourcesFeatures{
+					Enabled:  true,
+					TagName:  "critical",
+					TagValue: "yes",
+				},
+				ProvenanceTags: features.ProvenanceTagsFeatures{
+					Enabled:    true,
+					Workspace:  "production",
+					ModulePath: "/src/infra",
+				},
+				QuotaCheck: features.QuotaCheckFeatures{
+					Enabled:        true,
+					FailOnExceeded: true,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
+					DeleteComputeResourcesFirst:        true,
+					DeleteNestedItemsDuringDeletion:    true,
 					PreventDeletionIfContainsResources: true,
 				},
+				TemplateDeployment: features.TemplateDeploymentFeatures{
+					DeleteNestedItemsDuringDeletion: true,
+				},
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: true,
+					GracefulShutdown:       true,
+				},
 			},
 		},
 		{
 			Name: "Complete Disabled",
 			Input: []interface{}{
 				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":    false,
+							"recover_soft_deleted_key_vaults": false,
+						},
+					},
+					"network_conflict_retry": []interface{}{
+						map[string]interface{}{
+							"max_attempts":       1,
+							"base_delay_seconds": 1,
+						},
+					},
+					"network_interface": []interface{}{
+						map[string]interface{}{
+							"remove_load_balancer_associations_during_deletion": false,
+						},
+					},
+					"protect_critical_resources": []interface{}{
+						map[string]interface{}{
+							"enabled":   false,
+							"tag_name":  "terraform-protected",
+							"tag_value": "true",
+						},
+					},
+					"provenance_tags": []interface{}{
+						map[string]interface{}{
+							"enabled":     false,
+							"workspace":   "",
+							"module_path": "",
+						},
+					},
+					"quota_check": []interface{}{
+						map[string]interface{}{
+							"enabled":          false,
+							"fail_on_exceeded": false,
+						},
+					},
 					"resource_group": []interface{}{
 						map[string]interface{}{
+							"delete_compute_resources_first":         false,
+							"delete_nested_items_during_deletion":    false,
 							"prevent_deletion_if_contains_resources": false,
 						},
 					},
+					"template_deployment": []interface{}{
+						map[string]interface{}{
+							"delete_nested_items_during_deletion": false,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": false,
+							"graceful_shutdown":          false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
+				DisallowedValues: []features.DisallowedValue{},
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    false,
+					RecoverSoftDeletedKeyVaults: false,
+				},
+				NetworkConflictRetry: features.NetworkConflictRetryFeatures{
+					MaxAttempts: 1,
+					BaseDelay:   1 * time.Second,
+				},
+				NetworkInterface: features.NetworkInterfaceFeatures{
+					RemoveLoadBalancerAssociationsDuringDeletion: false,
+				},
+				ProtectedResources: features.ProtectedResourcesFeatures{
+					Enabled:  false,
+					TagName:  "terraform-protected",
+					TagValue: "true",
+				},
+				ProvenanceTags: features.ProvenanceTagsFeatures{
+					Enabled:    false,
+					Workspace:  "",
+					ModulePath: "",
+				},
+				QuotaCheck: features.QuotaCheckFeatures{
+					Enabled:        false,
+					FailOnExceeded: false,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
+					DeleteComputeResourcesFirst:        false,
+					DeleteNestedItemsDuringDeletion:    false,
 					PreventDeletionIfContainsResources: false,
 				},
+				TemplateDeployment: features.TemplateDeploymentFeatures{
+					DeleteNestedItemsDuringDeletion: false,
+				},
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+					GracefulShutdown:       false,
+				},
 			},
 		},
 	}
@@ -84,6 +304,8 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 			},
 			Expected: features.UserFeatures{
 				ResourceGroup: features.ResourceGroupFeatures{
+					DeleteComputeResourcesFirst:        false,
+					DeleteNestedItemsDuringDeletion:    false,
 					PreventDeletionIfContainsResources: false,
 				},
 			},
@@ -94,6 +316,8 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 				map[string]interface{}{
 					"resource_group": []interface{}{
 						map[string]interface{}{
+							"delete_compute_resources_first":         false,
+							"delete_nested_items_during_deletion":    false,
 							"prevent_deletion_if_contains_resources": true,
 						},
 					},
@@ -101,16 +325,62 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 			},
 			Expected: features.UserFeatures{
 				ResourceGroup: features.ResourceGroupFeatures{
+					DeleteComputeResourcesFirst:        false,
+					DeleteNestedItemsDuringDeletion:    false,
 					PreventDeletionIfContainsResources: true,
 				},
 			},
 		},
+		{
+			Name: "Delete Nested Items During Deletion Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"delete_compute_resources_first":         true,
+							"delete_nested_items_during_deletion":    true,
+							"prevent_deletion_if_contains_resources": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					DeleteComputeResourcesFirst:        true,
+					DeleteNestedItemsDuringDeletion:    true,
+					PreventDeletionIfContainsResources: false,
+				},
+			},
+		},
+		{
+			Name: "Delete Compute Resources First Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"delete_compute_resources_first":         true,
+							"delete_nested_items_during_deletion":    false,
+							"prevent_deletion_if_contains_resources": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					DeleteComputeResourcesFirst:        true,
+					DeleteNestedItemsDuringDeletion:    false,
+					PreventDeletionIfContainsResources: false,
+				},
+			},
+		},
 		{
 			Name: "Prevent Deletion If Contains Resources Disabled",
 			Input: []interface{}{
 				map[string]interface{}{
 					"resource_group": []interface{}{
 						map[string]interface{}{
+							"delete_compute_resources_first":         false,
+							"delete_nested_items_during_deletion":    false,
 							"prevent_deletion_if_contains_resources": false,
 						},
 					},
@@ -118,6 +388,8 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 			},
 			Expected: features.UserFeatures{
 				ResourceGroup: features.ResourceGroupFeatures{
+					DeleteComputeResourcesFirst:        false,
+					DeleteNestedItemsDuringDeletion:    false,
 					PreventDeletionIfContainsResources: false,
 				},
 			},
@@ -132,3 +404,634 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesProtectedResources(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"protect_critical_resources": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ProtectedResources: features.ProtectedResourcesFeatures{
+					Enabled:  false,
+					TagName:  "terraform-protected",
+					TagValue: "true",
+				},
+			},
+		},
+		{
+			Name: "Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"protect_critical_resources": []interface{}{
+						map[string]interface{}{
+							"enabled":   true,
+							"tag_name":  "",
+							"tag_value": "",
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ProtectedResources: features.ProtectedResourcesFeatures{
+					Enabled:  true,
+					TagName:  "terraform-protected",
+					TagValue: "true",
+				},
+			},
+		},
+		{
+			Name: "Enabled With Custom Tag",
+			Input: []interface{}{
+				map[string]interface{}{
+					"protect_critical_resources": []interface{}{
+						map[string]interface{}{
+							"enabled":   true,
+							"tag_name":  "stamp-critical",
+							"tag_value": "yes",
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ProtectedResources: features.ProtectedResourcesFeatures{
+					Enabled:  true,
+					TagName:  "stamp-critical",
+					TagValue: "yes",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ProtectedResources, testCase.Expected.ProtectedResources) {
+			t.Fatalf("Expected %+v but got %+v", result.ProtectedResources, testCase.Expected.ProtectedResources)
+		}
+	}
+}
+
+func TestExpandFeaturesProvenanceTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"provenance_tags": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ProvenanceTags: features.ProvenanceTagsFeatures{
+					Enabled: false,
+				},
+			},
+		},
+		{
+			Name: "Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"provenance_tags": []interface{}{
+						map[string]interface{}{
+							"enabled":     true,
+							"workspace":   "",
+							"module_path": "",
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ProvenanceTags: features.ProvenanceTagsFeatures{
+					Enabled: true,
+				},
+			},
+		},
+		{
+			Name: "Enabled With Workspace And Module Path",
+			Input: []interface{}{
+				map[string]interface{}{
+					"provenance_tags": []interface{}{
+						map[string]interface{}{
+							"enabled":     true,
+							"workspace":   "staging",
+							"module_path": "/src/infra/network",
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ProvenanceTags: features.ProvenanceTagsFeatures{
+					Enabled:    true,
+					Workspace:  "staging",
+					ModulePath: "/src/infra/network",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ProvenanceTags, testCase.Expected.ProvenanceTags) {
+			t.Fatalf("Expected %+v but got %+v", result.ProvenanceTags, testCase.Expected.ProvenanceTags)
+		}
+	}
+}
+
+func TestExpandFeaturesTemplateDeployment(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"template_deployment": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				TemplateDeployment: features.TemplateDeploymentFeatures{
+					DeleteNestedItemsDuringDeletion: false,
+				},
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+					GracefulShutdown:       false,
+				},
+			},
+		},
+		{
+			Name: "Delete Nested Items During Deletion Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"template_deployment": []interface{}{
+						map[string]interface{}{
+							"delete_nested_items_during_deletion": true,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": true,
+							"graceful_shutdown":          true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				TemplateDeployment: features.TemplateDeploymentFeatures{
+					DeleteNestedItemsDuringDeletion: true,
+				},
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: true,
+					GracefulShutdown:       true,
+				},
+			},
+		},
+		{
+			Name: "Delete Nested Items During Deletion Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"template_deployment": []interface{}{
+						map[string]interface{}{
+							"delete_nested_items_during_deletion": false,
+						},
+					},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": false,
+							"graceful_shutdown":          false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				TemplateDeployment: features.TemplateDeploymentFeatures{
+					DeleteNestedItemsDuringDeletion: false,
+				},
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+					GracefulShutdown:       false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.TemplateDeployment, testCase.Expected.TemplateDeployment) {
+			t.Fatalf("Expected %+v but got %+v", result.TemplateDeployment, testCase.Expected.TemplateDeployment)
+		}
+	}
+}
+
+func TestExpandFeaturesNetworkInterface(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network_interface": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				NetworkInterface: features.NetworkInterfaceFeatures{
+					RemoveLoadBalancerAssociationsDuringDeletion: false,
+				},
+			},
+		},
+		{
+			Name: "Remove Load Balancer Associations During Deletion Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network_interface": []interface{}{
+						map[string]interface{}{
+							"remove_load_balancer_associations_during_deletion": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NetworkInterface: features.NetworkInterfaceFeatures{
+					RemoveLoadBalancerAssociationsDuringDeletion: true,
+				},
+			},
+		},
+		{
+			Name: "Remove Load Balancer Associations During Deletion Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network_interface": []interface{}{
+						map[string]interface{}{
+							"remove_load_balancer_associations_during_deletion": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NetworkInterface: features.NetworkInterfaceFeatures{
+					RemoveLoadBalancerAssociationsDuringDeletion: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.NetworkInterface, testCase.Expected.NetworkInterface) {
+			t.Fatalf("Expected %+v but got %+v", result.NetworkInterface, testCase.Expected.NetworkInterface)
+		}
+	}
+}
+
+func TestExpandFeaturesDisallowedValues(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"disallowed_values": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				DisallowedValues: []features.DisallowedValue{},
+			},
+		},
+		{
+			Name: "Multiple Values",
+			Input: []interface{}{
+				map[string]interface{}{
+					"disallowed_values": []interface{}{
+						map[string]interface{}{
+							"resource_type": "azurestack_public_ip",
+							"attribute":     "allocation_method",
+							"values":        []interface{}{"Dynamic"},
+						},
+						map[string]interface{}{
+							"resource_type": "azurestack_storage_account",
+							"attribute":     "account_replication_type",
+							"values":        []interface{}{"LRS", "ZRS"},
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				DisallowedValues: []features.DisallowedValue{
+					{
+						ResourceType: "azurestack_public_ip",
+						Attribute:    "allocation_method",
+						Values:       []string{"Dynamic"},
+					},
+					{
+						ResourceType: "azurestack_storage_account",
+						Attribute:    "account_replication_type",
+						Values:       []string{"LRS", "ZRS"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.DisallowedValues, testCase.Expected.DisallowedValues) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected.DisallowedValues, result.DisallowedValues)
+		}
+	}
+}
+
+func TestExpandFeaturesVirtualMachine(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+					GracefulShutdown:       false,
+				},
+			},
+		},
+		{
+			Name: "Delete OS Disk Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": true,
+							"graceful_shutdown":          false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: true,
+					GracefulShutdown:       false,
+				},
+			},
+		},
+		{
+			Name: "Graceful Shutdown Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion": false,
+							"graceful_shutdown":          true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: false,
+					GracefulShutdown:       true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.VirtualMachine, testCase.Expected.VirtualMachine) {
+			t.Fatalf("Expected %+v but got %+v", result.VirtualMachine, testCase.Expected.VirtualMachine)
+		}
+	}
+}
+
+func TestExpandFeaturesKeyVault(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name:  "Empty Block",
+			Input: map[string]interface{}{},
+			Expected: features.UserFeatures{
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    true,
+					RecoverSoftDeletedKeyVaults: true,
+				},
+			},
+		},
+		{
+			Name: "Purge Soft Delete On Destroy Disabled",
+			Input: map[string]interface{}{
+				"purge_soft_delete_on_destroy": false,
+			},
+			Expected: features.UserFeatures{
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    false,
+					RecoverSoftDeletedKeyVaults: true,
+				},
+			},
+		},
+		{
+			Name: "Recover Soft Deleted Key Vaults Disabled",
+			Input: map[string]interface{}{
+				"recover_soft_deleted_key_vaults": false,
+			},
+			Expected: features.UserFeatures{
+				KeyVault: features.KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy:    true,
+					RecoverSoftDeletedKeyVaults: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+
+		// the input is read through the schema, so that the fields omitted from the block are defaulted as they
+		// would be when configured in the Provider block
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"features": schemaFeatures()}, map[string]interface{}{
+			"features": []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{testCase.Input},
+				},
+			},
+		})
+		result := expandFeatures(d.Get("features").([]interface{}))
+		if !reflect.DeepEqual(result.KeyVault, testCase.Expected.KeyVault) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected.KeyVault, result.KeyVault)
+		}
+	}
+}
+
+func TestExpandFeaturesQuotaCheck(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"quota_check": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				QuotaCheck: features.QuotaCheckFeatures{
+					Enabled:        false,
+					FailOnExceeded: false,
+				},
+			},
+		},
+		{
+			Name: "Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"quota_check": []interface{}{
+						map[string]interface{}{
+							"enabled":          true,
+							"fail_on_exceeded": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				QuotaCheck: features.QuotaCheckFeatures{
+					Enabled:        true,
+					FailOnExceeded: false,
+				},
+			},
+		},
+		{
+			Name: "Enabled And Failing When Exceeded",
+			Input: []interface{}{
+				map[string]interface{}{
+					"quota_check": []interface{}{
+						map[string]interface{}{
+							"enabled":          true,
+							"fail_on_exceeded": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				QuotaCheck: features.QuotaCheckFeatures{
+					Enabled:        true,
+					FailOnExceeded: true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.QuotaCheck, testCase.Expected.QuotaCheck) {
+			t.Fatalf("Expected %+v but got %+v", result.QuotaCheck, testCase.Expected.QuotaCheck)
+		}
+	}
+}
+
+func TestExpandFeaturesNetworkConflictRetry(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network_conflict_retry": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				NetworkConflictRetry: features.NetworkConflictRetryFeatures{
+					MaxAttempts: 5,
+					BaseDelay:   10 * time.Second,
+				},
+			},
+		},
+		{
+			Name: "Retries Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network_conflict_retry": []interface{}{
+						map[string]interface{}{
+							"max_attempts":       1,
+							"base_delay_seconds": 10,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NetworkConflictRetry: features.NetworkConflictRetryFeatures{
+					MaxAttempts: 1,
+					BaseDelay:   10 * time.Second,
+				},
+			},
+		},
+		{
+			Name: "Custom Backoff",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network_conflict_retry": []interface{}{
+						map[string]interface{}{
+							"max_attempts":       10,
+							"base_delay_seconds": 2,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NetworkConflictRetry: features.NetworkConflictRetryFeatures{
+					MaxAttempts: 10,
+					BaseDelay:   2 * time.Second,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.NetworkConflictRetry, testCase.Expected.NetworkConflictRetry) {
+			t.Fatalf("Expected %+v but got %+v", result.NetworkConflictRetry, testCase.Expected.NetworkConflictRetry)
+		}
+	}
+}
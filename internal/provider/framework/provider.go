@@ -0,0 +1,94 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/sdk"
+)
+
+var _ tfsdk.Provider = &Provider{}
+
+// Provider is a Plugin Framework provider which is muxed with the Plugin SDK provider, allowing new Resources
+// and Data Sources to be written using the Plugin Framework whilst the existing ones continue to use the Plugin SDK.
+//
+// Since the Provider Schema must be identical across both providers the schema is built from the Plugin SDK
+// provider, which is also responsible for configuring the clients - which are then shared with this provider.
+type Provider struct {
+	schema   tfsdk.Schema
+	services []sdk.FrameworkServiceRegistration
+
+	// meta returns the meta of the Plugin SDK provider, which is populated once it's been configured
+	meta func() interface{}
+
+	client *clients.Client
+}
+
+// NewProvider returns a Plugin Framework provider exposing the Resources and Data Sources from the specified
+// services using the specified Provider Schema, which retrieves the configured clients from the Plugin SDK provider using meta
+func NewProvider(schema tfsdk.Schema, services []sdk.FrameworkServiceRegistration, meta func() interface{}) *Provider {
+	return &Provider{
+		schema:   schema,
+		services: services,
+		meta:     meta,
+	}
+}
+
+func (p *Provider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return p.schema, nil
+}
+
+// Configure retrieves the clients from the Plugin SDK provider - which the mux server configures first, since
+// it's the first server registered
+func (p *Provider) Configure(_ context.Context, _ tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+	client, ok := p.meta().(*clients.Client)
+	if !ok || client == nil {
+		resp.Diagnostics.AddError("Configuring the Provider", "the Plugin SDK provider must be configured prior to the Plugin Framework provider")
+		return
+	}
+
+	p.client = client
+}
+
+func (p *Provider) GetResources(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
+	resources := make(map[string]tfsdk.ResourceType)
+	for _, service := range p.services {
+		for k, v := range service.FrameworkResources() {
+			resources[k] = v
+		}
+	}
+
+	return resources, nil
+}
+
+func (p *Provider) GetDataSources(_ context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
+	dataSources := make(map[string]tfsdk.DataSourceType)
+	for _, service := range p.services {
+		for k, v := range service.FrameworkDataSources() {
+			dataSources[k] = v
+		}
+	}
+
+	return dataSources, nil
+}
+
+// ClientFromProvider returns the clients configured for the specified provider, for use by Resources and Data
+// Sources when they're instantiated (within NewResource/NewDataSource)
+func ClientFromProvider(in tfsdk.Provider) (*clients.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	p, ok := in.(*Provider)
+	if !ok {
+		diags.AddError("Retrieving the Client", "the provider was not an AzureStack Plugin Framework provider")
+		return nil, diags
+	}
+
+	if p.client == nil {
+		diags.AddError("Retrieving the Client", "the provider has not been configured")
+		return nil, diags
+	}
+
+	return p.client, diags
+}
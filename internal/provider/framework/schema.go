@@ -0,0 +1,167 @@
+package framework
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SchemaFromProtocol converts the Provider Schema exposed by the Plugin SDK provider into a Plugin Framework
+// Schema - since the mux server requires that the Provider Schema is identical across both providers, this
+// avoids maintaining a second copy of the Provider Schema which could drift.
+func SchemaFromProtocol(input *tfprotov5.Schema) (*tfsdk.Schema, error) {
+	if input == nil || input.Block == nil {
+		return nil, fmt.Errorf("the Provider Schema was nil")
+	}
+
+	attributes, err := attributesFromProtocol(input.Block.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := blocksFromProtocol(input.Block.BlockTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := tfsdk.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+		Version:    input.Version,
+	}
+	if input.Block.Deprecated {
+		schema.DeprecationMessage = "Deprecated"
+	}
+	if input.Block.DescriptionKind == tfprotov5.StringKindMarkdown {
+		schema.MarkdownDescription = input.Block.Description
+	} else {
+		schema.Description = input.Block.Description
+	}
+
+	return &schema, nil
+}
+
+func attributesFromProtocol(input []*tfprotov5.SchemaAttribute) (map[string]tfsdk.Attribute, error) {
+	attributes := make(map[string]tfsdk.Attribute)
+
+	for _, v := range input {
+		attrType, err := attributeTypeFromProtocol(v.Type)
+		if err != nil {
+			return nil, fmt.Errorf("converting the type of the attribute %q: %+v", v.Name, err)
+		}
+
+		attribute := tfsdk.Attribute{
+			Type:      attrType,
+			Required:  v.Required,
+			Optional:  v.Optional,
+			Computed:  v.Computed,
+			Sensitive: v.Sensitive,
+		}
+		if v.Deprecated {
+			attribute.DeprecationMessage = "Deprecated"
+		}
+		if v.DescriptionKind == tfprotov5.StringKindMarkdown {
+			attribute.MarkdownDescription = v.Description
+		} else {
+			attribute.Description = v.Description
+		}
+
+		attributes[v.Name] = attribute
+	}
+
+	return attributes, nil
+}
+
+func blocksFromProtocol(input []*tfprotov5.SchemaNestedBlock) (map[string]tfsdk.Block, error) {
+	blocks := make(map[string]tfsdk.Block)
+
+	for _, v := range input {
+		if v.Block == nil {
+			return nil, fmt.Errorf("the block %q had no schema", v.TypeName)
+		}
+
+		attributes, err := attributesFromProtocol(v.Block.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("converting the block %q: %+v", v.TypeName, err)
+		}
+
+		nested, err := blocksFromProtocol(v.Block.BlockTypes)
+		if err != nil {
+			return nil, fmt.Errorf("converting the block %q: %+v", v.TypeName, err)
+		}
+
+		block := tfsdk.Block{
+			Attributes: attributes,
+			Blocks:     nested,
+			MinItems:   v.MinItems,
+			MaxItems:   v.MaxItems,
+		}
+
+		switch v.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			block.NestingMode = tfsdk.BlockNestingModeList
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			block.NestingMode = tfsdk.BlockNestingModeSet
+		default:
+			return nil, fmt.Errorf("the block %q uses the unsupported nesting mode %q", v.TypeName, v.Nesting)
+		}
+
+		if v.Block.Deprecated {
+			block.DeprecationMessage = "Deprecated"
+		}
+		if v.Block.DescriptionKind == tfprotov5.StringKindMarkdown {
+			block.MarkdownDescription = v.Block.Description
+		} else {
+			block.Description = v.Block.Description
+		}
+
+		blocks[v.TypeName] = block
+	}
+
+	return blocks, nil
+}
+
+func attributeTypeFromProtocol(input tftypes.Type) (attr.Type, error) {
+	switch {
+	case input.Is(tftypes.String):
+		return types.StringType, nil
+	case input.Is(tftypes.Number):
+		return types.NumberType, nil
+	case input.Is(tftypes.Bool):
+		return types.BoolType, nil
+	case input.Is(tftypes.List{}):
+		elem, err := attributeTypeFromProtocol(input.(tftypes.List).ElementType)
+		if err != nil {
+			return nil, err
+		}
+		return types.ListType{ElemType: elem}, nil
+	case input.Is(tftypes.Set{}):
+		elem, err := attributeTypeFromProtocol(input.(tftypes.Set).ElementType)
+		if err != nil {
+			return nil, err
+		}
+		return types.SetType{ElemType: elem}, nil
+	case input.Is(tftypes.Map{}):
+		elem, err := attributeTypeFromProtocol(input.(tftypes.Map).ElementType)
+		if err != nil {
+			return nil, err
+		}
+		return types.MapType{ElemType: elem}, nil
+	case input.Is(tftypes.Object{}):
+		attrTypes := make(map[string]attr.Type)
+		for k, v := range input.(tftypes.Object).AttributeTypes {
+			attrType, err := attributeTypeFromProtocol(v)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[k] = attrType
+		}
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", input.String())
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// resourcesWithoutImport are the Resources which intentionally don't support import, since they represent
+// something which can't be looked up from the API once it's been created
+var resourcesWithoutImport = map[string]string{
+	"azurestack_ssh_key_pair":          "the Private Key is only available when the Key Pair is generated",
+	"azurestack_storage_queue_message": "Queue Messages are consumed rather than retrieved by ID",
+}
+
+// ValidateImportedState confirms that every Required and Optional attribute of the Resource (including those
+// within any blocks present in the state) has been populated by the Read function when the Resource is imported,
+// which is needed for `terraform plan -generate-config-out` to generate valid configuration for the Resource -
+// and for the plan following the import not to show a diff.
+//
+// Attributes which are documented as not being returned by the API (or which are explicitly ignored by the
+// caller) are skipped, since these can't be populated during import.
+func ValidateImportedState(resourceType string, attributes map[string]string, ignore ...string) error {
+	resource, ok := TestAzureProvider().ResourcesMap[resourceType]
+	if !ok {
+		return fmt.Errorf("the Resource %q was not found in the Provider", resourceType)
+	}
+
+	// the attributes ignored by the caller can contain the index of the block, e.g. `block.0.attribute`
+	ignoredPaths := make([]string, 0)
+	for _, attribute := range ignore {
+		segments := make([]string, 0)
+		for _, segment := range strings.Split(attribute, ".") {
+			if _, err := strconv.Atoi(segment); err != nil {
+				segments = append(segments, segment)
+			}
+		}
+		ignoredPaths = append(ignoredPaths, strings.Join(segments, "."))
+	}
+	for _, service := range SupportedUntypedServices() {
+		ignoredPaths = append(ignoredPaths, attributesNotReturnedByAPI(service)[resourceType]...)
+	}
+
+	missing := missingAttributes(resource.Schema, attributes, "", "", ignoredPaths)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	return fmt.Errorf("the following attributes of %q weren't populated when importing the Resource - either the Read function should set them, or they should be listed in `AttributesNotReturnedByAPI`:\n\n  - %s", resourceType, strings.Join(missing, "\n  - "))
+}
+
+// missingAttributes returns the state keys of the Required and Optional attributes within the schema which aren't
+// present in the flatmapped attributes, where `prefix` is the state key of the block and `path` is the same key
+// without indexes. Computed-only attributes are skipped, since these aren't part of the configuration.
+//
+// An Optional List, Set or Map which is empty is treated as being populated, since the Read function has set it - and
+// an Optional attribute which conflicts with an attribute that's populated is skipped, since only one can be set.
+func missingAttributes(s map[string]*schema.Schema, attributes map[string]string, prefix, path string, ignore []string) []string {
+	missing := make([]string, 0)
+
+	for name, v := range s {
+		key := prefix + name
+		attributePath := path + name
+		if name == "timeouts" || !(v.Required || v.Optional) || utils.SliceContainsValue(ignore, attributePath) {
+			continue
+		}
+		if v.Optional && anyAttributePresent(attributes, v.ConflictsWith) {
+			continue
+		}
+
+		switch v.Type {
+		case schema.TypeList, schema.TypeSet:
+			count, ok := attributes[key+".#"]
+			if !ok || (v.Required && count == "0") {
+				missing = append(missing, key)
+				continue
+			}
+
+			if nested, ok := v.Elem.(*schema.Resource); ok {
+				for _, index := range blockIndexes(attributes, key) {
+					missing = append(missing, missingAttributes(nested.Schema, attributes, fmt.Sprintf("%s.%s.", key, index), attributePath+".", ignore)...)
+				}
+			}
+		case schema.TypeMap:
+			if _, ok := attributes[key+".%"]; !ok {
+				missing = append(missing, key)
+			}
+		default:
+			if _, ok := attributes[key]; !ok {
+				missing = append(missing, key)
+			}
+		}
+	}
+
+	return missing
+}
+
+// anyAttributePresent returns whether any of the attributes (in the format used by `ConflictsWith`) are present within
+// the flatmapped attributes
+func anyAttributePresent(attributes map[string]string, keys []string) bool {
+	for _, key := range keys {
+		for _, k := range []string{key, key + ".#", key + ".%"} {
+			if _, ok := attributes[k]; ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// blockIndexes returns the indexes of a block within the flatmapped attributes - which are sequential for
+// Lists, but are the hash of the block for Sets
+func blockIndexes(attributes map[string]string, key string) []string {
+	indexes := make([]string, 0)
+	for k := range attributes {
+		if !strings.HasPrefix(k, key+".") {
+			continue
+		}
+
+		segments := strings.SplitN(strings.TrimPrefix(k, key+"."), ".", 2)
+		if len(segments) != 2 || segments[0] == "#" {
+			continue
+		}
+
+		if !utils.SliceContainsValue(indexes, segments[0]) {
+			indexes = append(indexes, segments[0])
+		}
+	}
+	sort.Strings(indexes)
+	return indexes
+}
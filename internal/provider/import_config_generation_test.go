@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourcesSupportImport(t *testing.T) {
+	resources := TestAzureProvider().ResourcesMap
+
+	for resourceType, resource := range resources {
+		if _, ok := resourcesWithoutImport[resourceType]; ok {
+			if resource.Importer != nil {
+				t.Fatalf("%q supports import, so should be removed from `resourcesWithoutImport`", resourceType)
+			}
+			continue
+		}
+
+		if resource.Importer == nil {
+			t.Fatalf("%q doesn't support import", resourceType)
+		}
+	}
+
+	for resourceType := range resourcesWithoutImport {
+		if _, ok := resources[resourceType]; !ok {
+			t.Fatalf("%q is listed in `resourcesWithoutImport` but doesn't exist", resourceType)
+		}
+	}
+}
+
+func TestMissingAttributes(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"sku": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"fqdn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"secret": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"zones": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"settings": {
+			Type:     schema.TypeMap,
+			Required: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"parameters": {
+			Type:          schema.TypeMap,
+			Optional:      true,
+			ConflictsWith: []string{"parameters_body"},
+			Elem:          &schema.Schema{Type: schema.TypeString},
+		},
+		"parameters_body": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"parameters"},
+		},
+		"rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"priority": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"timeouts": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"create": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		Name       string
+		Attributes map[string]string
+		Ignore     []string
+		Expected   []string
+	}{
+		{
+			Name: "fully populated",
+			Attributes: map[string]string{
+				"name":            "example",
+				"description":     "",
+				"sku":             "Standard",
+				"secret":          "s3cr3t",
+				"zones.#":         "1",
+				"zones.0":         "1",
+				"settings.%":      "0",
+				"tags.%":          "0",
+				"parameters.%":    "0",
+				"rule.#":          "1",
+				"rule.0.name":     "first",
+				"rule.0.priority": "100",
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "missing top-level attributes",
+			Attributes: map[string]string{
+				"name":   "example",
+				"rule.#": "0",
+			},
+			Expected: []string{"description", "parameters", "parameters_body", "secret", "settings", "sku", "tags", "zones"},
+		},
+		{
+			Name: "missing attributes within a block",
+			Attributes: map[string]string{
+				"name":            "example",
+				"description":     "",
+				"sku":             "Standard",
+				"secret":          "s3cr3t",
+				"zones.#":         "1",
+				"zones.0":         "1",
+				"settings.%":      "0",
+				"tags.%":          "0",
+				"parameters.%":    "0",
+				"rule.#":          "2",
+				"rule.0.name":     "first",
+				"rule.1.priority": "100",
+			},
+			Expected: []string{"rule.0.priority", "rule.1.name"},
+		},
+		{
+			Name: "missing optional block",
+			Attributes: map[string]string{
+				"name":         "example",
+				"description":  "",
+				"sku":          "Standard",
+				"secret":       "s3cr3t",
+				"zones.#":      "1",
+				"zones.0":      "1",
+				"settings.%":   "0",
+				"tags.%":       "0",
+				"parameters.%": "0",
+			},
+			Expected: []string{"rule"},
+		},
+		{
+			Name: "ignored attributes",
+			Attributes: map[string]string{
+				"name":            "example",
+				"description":     "",
+				"sku":             "Standard",
+				"zones.#":         "1",
+				"zones.0":         "1",
+				"settings.%":      "0",
+				"tags.%":          "0",
+				"parameters.%":    "0",
+				"rule.#":          "1",
+				"rule.0.priority": "100",
+			},
+			Ignore:   []string{"secret", "rule.name"},
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := missingAttributes(s, v.Attributes, "", "", v.Ignore)
+		sort.Strings(actual)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestValidateImportedState(t *testing.T) {
+	attributes := map[string]string{
+		"name":                "example",
+		"resource_group_name": "example-resources",
+		"location":            "local",
+		"sku":                 "Standard",
+		"tags.%":              "0",
+	}
+
+	if err := ValidateImportedState("azurestack_recovery_services_vault", attributes); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	delete(attributes, "sku")
+	if err := ValidateImportedState("azurestack_recovery_services_vault", attributes); err == nil {
+		t.Fatalf("Expected an error when `sku` isn't populated")
+	}
+	if err := ValidateImportedState("azurestack_recovery_services_vault", attributes, "sku"); err != nil {
+		t.Fatalf("Expected no error when `sku` is ignored but got: %+v", err)
+	}
+
+	attributes["sku"] = "Standard"
+	delete(attributes, "tags.%")
+	if err := ValidateImportedState("azurestack_recovery_services_vault", attributes); err == nil {
+		t.Fatalf("Expected an error when the Optional `tags` aren't populated")
+	}
+}
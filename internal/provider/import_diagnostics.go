@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/sdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// attributesNotReturnedByAPI returns the attributes (keyed by Resource Type) which aren't returned by the API
+// for the Resources within the specified Service Registration
+func attributesNotReturnedByAPI(service interface{}) map[string][]string {
+	if v, ok := service.(sdk.UntypedServiceRegistrationWithImportMetadata); ok {
+		return v.AttributesNotReturnedByAPI()
+	}
+	return map[string][]string{}
+}
+
+// withImportDiagnostics wraps the Read function of a Resource, so that when the Resource is imported a warning
+// is returned listing the attributes which aren't returned by the API - which would otherwise show up as a diff
+// (and potentially force the replacement of the Resource) during the next plan.
+func withImportDiagnostics(resourceType string, resource *schema.Resource, attributes []string) *schema.Resource {
+	if len(attributes) == 0 {
+		return resource
+	}
+
+	if read := resource.Read; read != nil {
+		resource.Read = nil
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			imported := isBeingImported(resource, d)
+			if err := read(d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			if imported && d.Id() != "" {
+				return importDiagnostics(resourceType, attributes)
+			}
+			return nil
+		}
+	} else if read := resource.ReadContext; read != nil {
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			imported := isBeingImported(resource, d)
+			diags := read(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			if imported && d.Id() != "" {
+				diags = append(diags, importDiagnostics(resourceType, attributes)...)
+			}
+			return diags
+		}
+	}
+
+	return resource
+}
+
+// isBeingImported returns whether the Resource is being read for the first time following an import - which is
+// the only time that a Resource is read without any of its Required attributes being present in the state
+func isBeingImported(resource *schema.Resource, d *schema.ResourceData) bool {
+	hasRequired := false
+	for name, s := range resource.Schema {
+		if !s.Required {
+			continue
+		}
+
+		hasRequired = true
+		if _, ok := d.GetOk(name); ok {
+			return false
+		}
+	}
+
+	return hasRequired
+}
+
+func importDiagnostics(resourceType string, attributes []string) diag.Diagnostics {
+	ignoreChanges := make([]string, 0)
+	for _, attribute := range attributes {
+		topLevel := strings.Split(attribute, ".")[0]
+		if !utils.SliceContainsValue(ignoreChanges, topLevel) {
+			ignoreChanges = append(ignoreChanges, topLevel)
+		}
+	}
+	sort.Strings(ignoreChanges)
+
+	lines := make([]string, 0)
+	for _, attribute := range attributes {
+		lines = append(lines, fmt.Sprintf("  - %s", attribute))
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Some attributes of %q couldn't be imported", resourceType),
+			Detail: fmt.Sprintf(`The following attributes aren't returned by the API, so they haven't been imported into the state:
+
+%s
+
+Where these are defined in the configuration the next plan will show a change to them, which may force the replacement of the Resource. This can be avoided by adding these attributes to 'ignore_changes':
+
+  lifecycle {
+    ignore_changes = [%s]
+  }`, strings.Join(lines, "\n"), strings.Join(ignoreChanges, ", ")),
+		},
+	}
+}
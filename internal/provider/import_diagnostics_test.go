@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAttributesNotReturnedByAPIExist(t *testing.T) {
+	resources := TestAzureProvider().ResourcesMap
+
+	for _, service := range SupportedUntypedServices() {
+		for resourceType, attributes := range attributesNotReturnedByAPI(service) {
+			t.Logf("[DEBUG] Testing %q", resourceType)
+
+			resource, ok := resources[resourceType]
+			if !ok {
+				t.Fatalf("Expected the Resource %q to exist", resourceType)
+			}
+
+			for _, attribute := range attributes {
+				s := resource.Schema
+				segments := strings.Split(attribute, ".")
+				for i, segment := range segments {
+					v, ok := s[segment]
+					if !ok {
+						t.Fatalf("Expected the attribute %q to exist in the Resource %q", attribute, resourceType)
+					}
+					if i == len(segments)-1 {
+						break
+					}
+
+					nested, ok := v.Elem.(*schema.Resource)
+					if !ok {
+						t.Fatalf("Expected %q to be a block in the Resource %q", segment, resourceType)
+					}
+					s = nested.Schema
+				}
+			}
+		}
+	}
+}
+
+func TestWithImportDiagnostics(t *testing.T) {
+	testData := []struct {
+		Name           string
+		State          map[string]interface{}
+		ExpectWarnings bool
+	}{
+		{
+			Name:           "imported",
+			State:          map[string]interface{}{},
+			ExpectWarnings: true,
+		},
+		{
+			Name: "refreshed",
+			State: map[string]interface{}{
+				"name": "example",
+			},
+			ExpectWarnings: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resource := withImportDiagnostics("azurestack_example", &schema.Resource{
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				d.Set("name", "example")
+				return nil
+			},
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"secret": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		}, []string{"secret"})
+
+		d := schema.TestResourceDataRaw(t, resource.Schema, v.State)
+		d.SetId("example")
+
+		diags := resource.ReadContext(context.TODO(), d, nil)
+		if diags.HasError() {
+			t.Fatalf("Expected no errors but got: %+v", diags)
+		}
+
+		if !v.ExpectWarnings {
+			if len(diags) != 0 {
+				t.Fatalf("Expected no warnings but got: %+v", diags)
+			}
+			continue
+		}
+
+		if len(diags) != 1 || diags[0].Severity != diag.Warning {
+			t.Fatalf("Expected a single warning but got: %+v", diags)
+		}
+		if !strings.Contains(diags[0].Detail, "ignore_changes = [secret]") {
+			t.Fatalf("Expected the warning to suggest `ignore_changes` but got: %s", diags[0].Detail)
+		}
+	}
+}
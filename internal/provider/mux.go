@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/provider/framework"
+)
+
+// ProtoV5ProviderServerFactory returns a Provider Server which muxes the Plugin SDK provider together with the
+// Plugin Framework provider, allowing Resources and Data Sources to be built using either
+func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	return protoV5ProviderServerFactory(ctx, AzureProvider())
+}
+
+func protoV5ProviderServerFactory(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	resp, err := sdkProvider.GRPCProvider().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Provider Schema from the Plugin SDK provider: %+v", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("retrieving the Provider Schema from the Plugin SDK provider: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	providerSchema, err := framework.SchemaFromProtocol(resp.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("building the Provider Schema for the Plugin Framework provider: %+v", err)
+	}
+
+	frameworkProvider := framework.NewProvider(*providerSchema, SupportedFrameworkServices(), sdkProvider.Meta)
+
+	// the Plugin SDK provider must be the first server, since it's configured first and builds the clients
+	// which are then used by the Plugin Framework provider
+	servers := []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(frameworkProvider),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, fmt.Errorf("building the mux server: %+v", err)
+	}
+
+	return muxServer.ProviderServer, nil
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestProtoV5ProviderServerFactory(t *testing.T) {
+	ctx := context.TODO()
+
+	// the mux server validates that the Provider Schema is identical across both providers
+	factory, err := protoV5ProviderServerFactory(ctx, TestAzureProvider())
+	if err != nil {
+		t.Fatalf("building the mux server: %+v", err)
+	}
+
+	resp, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("retrieving the Provider Schema: %+v", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("retrieving the Provider Schema: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	if _, ok := resp.ResourceSchemas["azurestack_resource_group"]; !ok {
+		t.Fatalf("expected the Plugin SDK Resources to be exposed by the mux server")
+	}
+}
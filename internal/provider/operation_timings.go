@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+// slowestOperationsCount is the number of operations which are listed when an operation is approaching its timeout
+const slowestOperationsCount = 10
+
+// withOperationTimings wraps the Create, Update and Delete functions of a Resource, so that the duration of
+// each operation is recorded - and a warning is returned listing the slowest operations performed during
+// the run when an operation times out (or comes close to doing so), to help tune the timeouts.
+func withOperationTimings(resourceType string, resource *schema.Resource) *schema.Resource {
+	type operationFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+
+	wrap := func(action string, timeoutKey string, operation operationFunc) operationFunc {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			// the Resource ID isn't known until the Resource has been created
+			resourceId := d.Id()
+
+			start := time.Now()
+			diags := operation(ctx, d, meta)
+			duration := time.Since(start).Round(time.Second)
+
+			if resourceId == "" {
+				resourceId = d.Id()
+			}
+
+			return append(diags, recordOperation(meta, timeouts.Operation{
+				ResourceType: resourceType,
+				ResourceId:   resourceId,
+				Action:       action,
+				Duration:     duration,
+				Timeout:      d.Timeout(timeoutKey),
+				TimedOut:     diagsContainTimeout(diags),
+			})...)
+		}
+	}
+
+	if create := resource.Create; create != nil {
+		resource.Create = nil
+		resource.CreateContext = wrap("create", schema.TimeoutCreate, fromLegacyFunc(create))
+	} else if create := resource.CreateContext; create != nil {
+		resource.CreateContext = wrap("create", schema.TimeoutCreate, create)
+	}
+
+	if update := resource.Update; update != nil {
+		resource.Update = nil
+		resource.UpdateContext = wrap("update", schema.TimeoutUpdate, fromLegacyFunc(update))
+	} else if update := resource.UpdateContext; update != nil {
+		resource.UpdateContext = wrap("update", schema.TimeoutUpdate, update)
+	}
+
+	if del := resource.Delete; del != nil {
+		resource.Delete = nil
+		resource.DeleteContext = wrap("delete", schema.TimeoutDelete, fromLegacyFunc(del))
+	} else if del := resource.DeleteContext; del != nil {
+		resource.DeleteContext = wrap("delete", schema.TimeoutDelete, del)
+	}
+
+	return resource
+}
+
+func fromLegacyFunc(f func(d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.FromErr(f(d, meta))
+	}
+}
+
+func diagsContainTimeout(diags diag.Diagnostics) bool {
+	for _, v := range diags {
+		if v.Severity == diag.Error && strings.Contains(v.Summary, context.DeadlineExceeded.Error()) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordOperation records the operation, returning a warning listing the slowest operations when the
+// operation is approaching its timeout
+func recordOperation(meta interface{}, operation timeouts.Operation) diag.Diagnostics {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.Operations == nil {
+		return nil
+	}
+
+	log.Printf("[INFO] Operation Timing: resource_type=%s action=%s id=%q duration=%s timeout=%s correlation_id=%q", operation.ResourceType, operation.Action, operation.ResourceId, operation.Duration, operation.Timeout, client.CorrelationRequestID)
+	client.Operations.Record(operation)
+
+	if !operation.ApproachingTimeout() {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		slowestOperationsDiagnostic(operation, client.Operations.Slowest(slowestOperationsCount), client.CorrelationRequestID),
+	}
+}
+
+func slowestOperationsDiagnostic(operation timeouts.Operation, slowest []timeouts.Operation, correlationId string) diag.Diagnostic {
+	summary := fmt.Sprintf("The %s of %q took %s, which is close to the %s timeout", operation.Action, operation.ResourceType, operation.Duration, operation.Timeout)
+	if operation.TimedOut {
+		summary = fmt.Sprintf("The %s of %q timed out after %s", operation.Action, operation.ResourceType, operation.Duration)
+	}
+
+	lines := make([]string, 0)
+	for _, v := range slowest {
+		lines = append(lines, fmt.Sprintf("  - %s %s %q: %s (timeout %s)", v.Action, v.ResourceType, v.ResourceId, v.Duration, v.Timeout))
+	}
+
+	correlation := "Sending the Correlation ID has been disabled, so these operations can only be identified in the Activity and Audit Logs of the Stamp using the IDs above."
+	if correlationId != "" {
+		correlation = fmt.Sprintf("The requests made during this run used the Correlation ID %q, which the operator of the Stamp can use to locate these operations in the Activity and Audit Logs.", correlationId)
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail: fmt.Sprintf(`The slowest operations performed during this run were:
+
+%s
+
+%s
+
+The timeouts used for each operation can be increased using the 'timeouts' block within the Resource.`, strings.Join(lines, "\n"), correlation),
+	}
+}
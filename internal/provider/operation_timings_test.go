@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func TestWithOperationTimings(t *testing.T) {
+	testData := []struct {
+		Name           string
+		Error          error
+		ExpectWarnings bool
+	}{
+		{
+			Name:           "succeeded",
+			Error:          nil,
+			ExpectWarnings: false,
+		},
+		{
+			Name:           "timed out",
+			Error:          fmt.Errorf("waiting for creation of Example %q: %+v", "example", context.DeadlineExceeded),
+			ExpectWarnings: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		createErr := v.Error
+		resource := withOperationTimings("azurestack_example", &schema.Resource{
+			Create: func(d *schema.ResourceData, meta interface{}) error {
+				d.SetId("example")
+				return createErr
+			},
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		})
+		if resource.Create != nil || resource.CreateContext == nil {
+			t.Fatalf("Expected the Create function to be replaced by a CreateContext function")
+		}
+
+		client := &clients.Client{
+			CorrelationRequestID: "abc123",
+			Operations:           timeouts.NewOperationTracker(),
+		}
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"name": "example",
+		})
+
+		diags := resource.CreateContext(context.TODO(), d, client)
+		if recorded := client.Operations.Slowest(10); len(recorded) != 1 || recorded[0].ResourceId != "example" || recorded[0].Action != "create" {
+			t.Fatalf("Expected a single create operation to be recorded but got %+v", recorded)
+		}
+
+		warnings := make(diag.Diagnostics, 0)
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Warning {
+				warnings = append(warnings, diagnostic)
+			}
+		}
+
+		if !v.ExpectWarnings {
+			if len(warnings) != 0 {
+				t.Fatalf("Expected no warnings but got: %+v", warnings)
+			}
+			continue
+		}
+
+		if !diags.HasError() {
+			t.Fatalf("Expected the error to be returned but got: %+v", diags)
+		}
+		if len(warnings) != 1 {
+			t.Fatalf("Expected a single warning but got: %+v", warnings)
+		}
+		if !strings.Contains(warnings[0].Detail, `"abc123"`) {
+			t.Fatalf("Expected the warning to contain the Correlation ID but got: %s", warnings[0].Detail)
+		}
+	}
+}
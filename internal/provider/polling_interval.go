@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+// withPollingInterval wraps the Create, Update and Delete functions of a Resource, so that the Long Running
+// Operations performed by them are polled using the interval configured for the Resource Type within the
+// `timeouts` block of the Provider block - or otherwise `polling_interval_seconds` - when either is specified.
+//
+// Since legacy Resources build their context from the StopContext of the client (rather than the context
+// passed to the Create, Update and Delete functions) the interval is specified on both.
+func withPollingInterval(resourceType string, resource *schema.Resource) *schema.Resource {
+	type operationFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+	type legacyOperationFunc = func(d *schema.ResourceData, meta interface{}) error
+
+	wrap := func(operation operationFunc) operationFunc {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			ctx, meta = withResourcePollingInterval(ctx, resourceType, meta)
+			return operation(ctx, d, meta)
+		}
+	}
+	wrapLegacy := func(operation legacyOperationFunc) legacyOperationFunc {
+		return func(d *schema.ResourceData, meta interface{}) error {
+			_, meta = withResourcePollingInterval(context.Background(), resourceType, meta)
+			return operation(d, meta)
+		}
+	}
+
+	if create := resource.Create; create != nil {
+		resource.Create = wrapLegacy(create)
+	} else if create := resource.CreateContext; create != nil {
+		resource.CreateContext = wrap(create)
+	}
+
+	if update := resource.Update; update != nil {
+		resource.Update = wrapLegacy(update)
+	} else if update := resource.UpdateContext; update != nil {
+		resource.UpdateContext = wrap(update)
+	}
+
+	if del := resource.Delete; del != nil {
+		resource.Delete = wrapLegacy(del)
+	} else if del := resource.DeleteContext; del != nil {
+		resource.DeleteContext = wrap(del)
+	}
+
+	return resource
+}
+
+// withResourcePollingInterval returns the context and client which should be used for an operation on the
+// Resource Type, which specify the polling interval when one is configured
+func withResourcePollingInterval(ctx context.Context, resourceType string, meta interface{}) (context.Context, interface{}) {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil {
+		return ctx, meta
+	}
+
+	interval := client.PollingInterval
+	if v, ok := client.PollingIntervalOverrides[resourceType]; ok {
+		interval = v
+	}
+	if interval <= 0 {
+		return ctx, meta
+	}
+
+	// the client is shared between Resources, so the StopContext is replaced on a copy of it
+	resourceClient := *client
+	if resourceClient.StopContext != nil {
+		resourceClient.StopContext = lro.WithPollingInterval(resourceClient.StopContext, interval)
+	}
+
+	return lro.WithPollingInterval(ctx, interval), &resourceClient
+}
+
+// expandPollingIntervalOverrides returns the polling intervals specified within the `timeouts` blocks of the
+// Provider block, keyed by the Resource Type
+func expandPollingIntervalOverrides(overrides []timeouts.Override) map[string]time.Duration {
+	output := make(map[string]time.Duration)
+	for _, override := range overrides {
+		if override.PollingInterval != nil {
+			output[override.ResourceType] = *override.PollingInterval
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+)
+
+func TestWithPollingInterval(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Interval  time.Duration
+		Overrides map[string]time.Duration
+		Expected  time.Duration
+	}{
+		{
+			Name:     "not configured",
+			Expected: 0,
+		},
+		{
+			Name:     "provider default",
+			Interval: 10 * time.Second,
+			Expected: 10 * time.Second,
+		},
+		{
+			Name:     "override",
+			Interval: 10 * time.Second,
+			Overrides: map[string]time.Duration{
+				"azurestack_example": 2 * time.Second,
+			},
+			Expected: 2 * time.Second,
+		},
+		{
+			Name:     "override for another resource type",
+			Interval: 10 * time.Second,
+			Overrides: map[string]time.Duration{
+				"azurestack_other": 2 * time.Second,
+			},
+			Expected: 10 * time.Second,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		var actual time.Duration
+		resource := withPollingInterval("azurestack_example", &schema.Resource{
+			Create: func(d *schema.ResourceData, meta interface{}) error {
+				// legacy Resources build their context from the StopContext of the client
+				actual, _ = lro.PollingIntervalFromContext(meta.(*clients.Client).StopContext)
+				return nil
+			},
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		})
+
+		client := &clients.Client{
+			StopContext:              context.TODO(),
+			PollingInterval:          v.Interval,
+			PollingIntervalOverrides: v.Overrides,
+		}
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"name": "example",
+		})
+
+		if err := resource.Create(d, client); err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected a polling interval of %s but got %s", v.Expected, actual)
+		}
+		if _, ok := lro.PollingIntervalFromContext(client.StopContext); ok {
+			t.Fatalf("Expected the StopContext of the shared client to be left as-is")
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+)
+
+// withProtectedResources wraps the Delete function of a Resource supporting tags, so that when the
+// `protect_critical_resources` feature is enabled a Resource carrying the protection tag is refused
+// deletion - including when it's being replaced - until the tag has been removed.
+func withProtectedResources(resourceType string, resource *schema.Resource) *schema.Resource {
+	if s, ok := resource.Schema["tags"]; !ok || s.Type != schema.TypeMap {
+		return resource
+	}
+
+	if del := resource.Delete; del != nil {
+		resource.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			if err := checkResourceIsNotProtected(resourceType, d, meta); err != nil {
+				return err
+			}
+			return del(d, meta)
+		}
+	}
+
+	if del := resource.DeleteContext; del != nil {
+		resource.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := checkResourceIsNotProtected(resourceType, d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return del(ctx, d, meta)
+		}
+	}
+
+	return resource
+}
+
+func checkResourceIsNotProtected(resourceType string, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client)
+	protection := client.Features.ProtectedResources
+	if !protection.Enabled {
+		return nil
+	}
+
+	// the `default_tags` are removed from the state by withDefaultTags, so they're merged back in to
+	// include a protection tag which is set through them
+	resourceTags := tags.MergeDefaults(d.Get("tags").(map[string]interface{}), client.DefaultTags)
+
+	// tag names are case-insensitive in Azure, so both the name and the value are compared case-insensitively
+	for k, v := range resourceTags {
+		if !strings.EqualFold(k, protection.TagName) {
+			continue
+		}
+
+		if value, ok := v.(string); ok && strings.EqualFold(value, protection.TagValue) {
+			return fmt.Errorf("the %s %q is protected from deletion since the tag %q is set to %q - remove the tag (or disable the `protect_critical_resources` feature) and apply before deleting it", resourceType, d.Id(), k, value)
+		}
+	}
+
+	return nil
+}
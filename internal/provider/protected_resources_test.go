@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/features"
+)
+
+func TestWithProtectedResources(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Enabled       bool
+		Tags          map[string]interface{}
+		DefaultTags   map[string]interface{}
+		ExpectDeleted bool
+	}{
+		{
+			Name:          "disabled",
+			Enabled:       false,
+			Tags:          map[string]interface{}{"terraform-protected": "true"},
+			ExpectDeleted: true,
+		},
+		{
+			Name:          "no tags",
+			Enabled:       true,
+			Tags:          map[string]interface{}{},
+			ExpectDeleted: true,
+		},
+		{
+			Name:          "tag with a different value",
+			Enabled:       true,
+			Tags:          map[string]interface{}{"terraform-protected": "false"},
+			ExpectDeleted: true,
+		},
+		{
+			Name:          "protected",
+			Enabled:       true,
+			Tags:          map[string]interface{}{"terraform-protected": "true"},
+			ExpectDeleted: false,
+		},
+		{
+			Name:          "protected with a different casing",
+			Enabled:       true,
+			Tags:          map[string]interface{}{"Terraform-Protected": "True"},
+			ExpectDeleted: false,
+		},
+		{
+			Name:          "protected through the default tags",
+			Enabled:       true,
+			Tags:          map[string]interface{}{},
+			DefaultTags:   map[string]interface{}{"terraform-protected": "true"},
+			ExpectDeleted: false,
+		},
+		{
+			Name:          "default tag overridden on the resource",
+			Enabled:       true,
+			Tags:          map[string]interface{}{"terraform-protected": "false"},
+			DefaultTags:   map[string]interface{}{"terraform-protected": "true"},
+			ExpectDeleted: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		deleted := false
+		resource := withProtectedResources("azurestack_example", &schema.Resource{
+			Delete: func(d *schema.ResourceData, meta interface{}) error {
+				deleted = true
+				return nil
+			},
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		})
+
+		userFeatures := features.Default()
+		userFeatures.ProtectedResources.Enabled = v.Enabled
+		client := &clients.Client{
+			Features:    userFeatures,
+			DefaultTags: v.DefaultTags,
+		}
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"tags": v.Tags,
+		})
+		d.SetId("example")
+
+		err := resource.Delete(d, client)
+		if v.ExpectDeleted && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.ExpectDeleted && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if deleted != v.ExpectDeleted {
+			t.Fatalf("Expected the Delete function to be called to be %t but got %t", v.ExpectDeleted, deleted)
+		}
+	}
+}
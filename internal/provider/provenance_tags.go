@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+)
+
+// withProvenanceTags wraps the Create, Read and Update functions of a Resource supporting tags, so that
+// when the `provenance_tags` feature is enabled the provenance tags are written on every apply - and
+// then removed from the state, since they're not present in the users configuration
+func withProvenanceTags(resource *schema.Resource) *schema.Resource {
+	return withTags(resource, injectProvenanceTags, stripProvenanceTags)
+}
+
+func injectProvenanceTags(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	configured := d.Get("tags").(map[string]interface{})
+
+	provenance := meta.(*clients.Client).Features.ProvenanceTags
+	if !provenance.Enabled {
+		return configured, nil
+	}
+
+	provenanceTags := tags.Provenance(provenance.Workspace, provenance.ModulePath, time.Now())
+	if err := d.Set("tags", tags.MergeProvenance(configured, provenanceTags)); err != nil {
+		return nil, err
+	}
+
+	return configured, nil
+}
+
+func stripProvenanceTags(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+	if !meta.(*clients.Client).Features.ProvenanceTags.Enabled || d.Id() == "" {
+		return nil
+	}
+
+	return d.Set("tags", tags.StripProvenance(d.Get("tags").(map[string]interface{}), configured))
+}
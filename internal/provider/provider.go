@@ -5,14 +5,21 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/sdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/stateencryption"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
 )
 
@@ -41,6 +48,9 @@ func azureProvider() *schema.Provider {
 	dataSources := make(map[string]*schema.Resource)
 	resources := make(map[string]*schema.Resource)
 
+	// the quota required by each Resource is tracked for the lifetime of the Provider, which is a single plan/apply
+	quotaTracker := capabilities.NewQuotaTracker()
+
 	// first handle the typed services
 	for _, service := range SupportedTypedServices() {
 		debugLog("[DEBUG] Registering Data Sources for %q..", service.Name())
@@ -60,6 +70,7 @@ func azureProvider() *schema.Provider {
 		}
 
 		debugLog("[DEBUG] Registering Resources for %q..", service.Name())
+		wrappers := resourceWrappers(quotaTracker, resourceProviderTypes(service), attributesNotReturnedByAPI(service))
 		for _, r := range service.Resources() {
 			key := r.ResourceType()
 			if existing := resources[key]; existing != nil {
@@ -71,7 +82,7 @@ func azureProvider() *schema.Provider {
 			if err != nil {
 				panic(fmt.Errorf("creating Wrapper for Resource %q: %+v", key, err))
 			}
-			resources[key] = resource
+			resources[key] = wrapResource(key, resource, wrappers)
 		}
 	}
 
@@ -87,15 +98,19 @@ func azureProvider() *schema.Provider {
 		}
 
 		debugLog("[DEBUG] Registering Resources for %q..", service.Name())
+		wrappers := resourceWrappers(quotaTracker, resourceProviderTypes(service), attributesNotReturnedByAPI(service))
 		for k, v := range service.SupportedResources() {
 			if existing := resources[k]; existing != nil {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
-			resources[k] = v
+			resources[k] = wrapResource(k, v, wrappers)
 		}
 	}
 
+	// finally add the aliases for the equivalent Resources within the AzureRM Provider
+	addAzureRMAliases(resources)
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -120,18 +135,72 @@ func azureProvider() *schema.Provider {
 			},
 
 			"arm_endpoint": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_ENDPOINT", ""),
-				Deprecated:  "use `endpoint` instead",
-				Description: "The Azure Stack management endpoint which should be used.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_ENDPOINT", ""),
+				Deprecated:   "use `endpoint` instead",
+				ValidateFunc: validation.IsURLWithHTTPS,
+				Description:  "The Azure Stack management endpoint which should be used.",
 			},
 
 			"endpoint": {
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_ENDPOINT", ""),
+				ValidateFunc: validation.IsURLWithHTTPS,
+				Description:  "The Azure Stack management endpoint which should be used.",
+			},
+
+			"offline_environment": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_ENDPOINT", ""),
-				Description: "The Azure Stack management endpoint which should be used.",
+				MaxItems:    1,
+				Description: "The endpoints of the Azure Stack Hub Stamp, which are otherwise retrieved from its metadata endpoint.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active_directory_endpoint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+							Description:  "The Login Endpoint of the Azure Active Directory or AD FS instance used by the Stamp.",
+						},
+
+						"token_audience": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "The Audience of tokens for the management endpoint.",
+						},
+
+						"admin_token_audience": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "The Audience of tokens for the administrative management endpoint.",
+						},
+
+						"graph_endpoint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+							Description:  "The Graph endpoint of the Stamp.",
+						},
+
+						"storage_endpoint_suffix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "The DNS suffix used for Storage Accounts.",
+						},
+
+						"key_vault_dns_suffix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "The DNS suffix used for Key Vaults. Defaults to `vault.{storage_endpoint_suffix}`.",
+						},
+					},
+				},
 			},
 
 			"auxiliary_tenant_ids": {
@@ -141,6 +210,7 @@ func azureProvider() *schema.Provider {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
+				Description: "List of auxiliary Tenant IDs required for multi-tenancy and cross-tenant scenarios. This is only supported when authenticating using a Client Secret or the Azure CLI.",
 			},
 
 			// Client Certificate specific fields
@@ -151,6 +221,14 @@ func azureProvider() *schema.Provider {
 				Description: "The path to the Client Certificate associated with the Service Principal for use when authenticating as a Service Principal using a Client Certificate.",
 			},
 
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE", ""),
+				Description: "Base64 encoded PKCS#12 certificate bundle to use when authenticating as a Service Principal using a Client Certificate",
+			},
+
 			"client_certificate_password": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -166,19 +244,65 @@ func azureProvider() *schema.Provider {
 				Description: "The Client Secret which should be used. For use When authenticating as a Service Principal using a Client Secret.",
 			},
 
+			// Azure CLI specific fields
+			"use_cli": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_CLI", true),
+				Description: "Allow Azure CLI to be used for Authentication.",
+			},
+
 			// Managed Service Identity specific fields
 			"use_msi": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_MSI", false),
-				Description: "Allowed Managed Service Identity be used for Authentication.",
+				Description: "Allow Managed Service Identity to be used for Authentication.",
 			},
 
 			"msi_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
-				Description: "The path to a custom endpoint for Managed Service Identity - in most circumstances this should be detected automatically. ",
+				Description: "The path to a custom endpoint for Managed Service Identity - in most circumstances this should be detected automatically.",
+			},
+
+			// OIDC specific fields
+			"use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_OIDC", false),
+				Description: "Allow OpenID Connect to be used for authentication",
+			},
+
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", ""),
+				Description: "The OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
+			"oidc_token_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN_FILE_PATH", ""),
+				Description: "The path to a file containing an OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
+			"oidc_request_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_TOKEN", "ACTIONS_ID_TOKEN_REQUEST_TOKEN"}, ""),
+				Description: "The bearer token for the request to the OIDC provider. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
+			"oidc_request_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_URL"}, ""),
+				Description: "The URL for the OIDC provider from which to request an ID token. For use when authenticating as a Service Principal using OpenID Connect.",
 			},
 
 			"disable_correlation_request_id": {
@@ -188,6 +312,14 @@ func azureProvider() *schema.Provider {
 				Description: "This will disable the x-ms-correlation-request-id header.",
 			},
 
+			"correlation_request_id_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_CORRELATION_REQUEST_ID_PREFIX", ""),
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]{1,32}$`), "`correlation_request_id_prefix` must be between 1 and 32 characters and can only contain letters, numbers and hyphens"),
+				Description:  "A prefix for the x-ms-correlation-request-id header sent with each request, allowing the requests made by a Terraform run to be identified in the Activity and Audit Logs of the Stamp.",
+			},
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -196,6 +328,134 @@ func azureProvider() *schema.Provider {
 				Description: "Should the AzureStack Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"retry_max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_MAX_ATTEMPTS", common.DefaultRetryMaxAttempts),
+				ValidateFunc: validation.IntBetween(0, 20),
+				Description:  "The number of times a request which has been throttled by Azure Stack should be retried.",
+			},
+
+			"retry_backoff_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_BACKOFF_SECONDS", int(common.DefaultRetryBackoff.Seconds())),
+				ValidateFunc: validation.IntBetween(1, 300),
+				Description:  "The number of seconds to wait before retrying a request which has been throttled by Azure Stack, when this isn't specified by the API. This doubles with each retry.",
+			},
+
+			"polling_interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_POLLING_INTERVAL_SECONDS", 0),
+				ValidateFunc: validation.IntBetween(0, 300),
+				Description:  "The number of seconds to wait between polls of a Long Running Operation. Defaults to `0`, which uses the delay requested by Azure Stack.",
+			},
+
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_PROXY_URL", ""),
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+				Description:  "The URL of the proxy which requests should be sent through. When this isn't specified the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables are used.",
+			},
+
+			"custom_ca_certificate_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_CUSTOM_CA_CERTIFICATE_PATH", ""),
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The path to a PEM encoded bundle of CA Certificates which should be trusted in addition to the CA Certificates of the system.",
+			},
+
+			// Admin API specific fields
+			"admin_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_ADMIN_ENDPOINT", ""),
+				ValidateFunc: validation.IsURLWithHTTPS,
+				Description:  "The Azure Stack administrative management endpoint which should be used. Setting this enables the Admin resources.",
+			},
+
+			"admin_subscription_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ADMIN_SUBSCRIPTION_ID", ""),
+				Description: "The ID of the Default Provider Subscription which should be used for the Admin resources.",
+			},
+
+			"admin_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ADMIN_CLIENT_ID", ""),
+				Description: "The Client ID which should be used for the Admin resources. Defaults to `client_id`.",
+			},
+
+			"admin_client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ADMIN_CLIENT_SECRET", ""),
+				Description: "The Client Secret which should be used for the Admin resources. Defaults to `client_secret`.",
+			},
+
+			"admin_tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ADMIN_TENANT_ID", ""),
+				Description: "The Tenant ID which should be used for the Admin resources. Defaults to `tenant_id`.",
+			},
+
+			"state_encryption_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STATE_ENCRYPTION_KEY", ""),
+				Description: "A base64-encoded 256-bit key used to encrypt sensitive attributes (such as Storage Account Keys and Shared Keys) before they're written into the state.",
+			},
+
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: tags.Validate,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "A mapping of tags which should be assigned to all Resources supporting tags, where tags specified on a Resource take precedence.",
+			},
+
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The tags which are managed outside of Terraform (for example by compliance tooling), changes to which should be ignored on all Resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Description: "The tag keys which should be ignored.",
+						},
+
+						"key_prefixes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Description: "The prefixes of the tag keys which should be ignored.",
+						},
+					},
+				},
+			},
+
+			"timeouts": schemaTimeouts(),
+
 			"features": schemaFeatures(),
 		},
 
@@ -221,6 +481,17 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			return nil, diag.FromErr(fmt.Errorf("The provider only supports 3 auxiliary tenant IDs"))
 		}
 
+		// the Client Certificate and Managed Service Identity authentication methods only obtain tokens for the
+		// primary Tenant, so would otherwise silently ignore the Auxiliary Tenants
+		if len(auxTenants) > 0 {
+			if d.Get("client_certificate_path").(string) != "" {
+				return nil, diag.FromErr(fmt.Errorf("`auxiliary_tenant_ids` isn't supported when `client_certificate_path` is specified"))
+			}
+			if d.Get("use_msi").(bool) {
+				return nil, diag.FromErr(fmt.Errorf("`auxiliary_tenant_ids` isn't supported when `use_msi` is enabled"))
+			}
+		}
+
 		builder := &authentication.Builder{
 			SubscriptionID:                d.Get("subscription_id").(string),
 			ClientID:                      d.Get("client_id").(string),
@@ -234,21 +505,66 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			ClientCertPath:                d.Get("client_certificate_path").(string),
 
 			// Feature Toggles
-			SupportsClientCertAuth:   true,
-			SupportsClientSecretAuth: true,
-			// SupportsManagedServiceIdentity: d.Get("use_msi").(bool), todo supported in stack?
-			SupportsAzureCliToken:    true,
-			SupportsAuxiliaryTenants: len(auxTenants) > 0,
+			SupportsClientCertAuth:         true,
+			SupportsClientSecretAuth:       true,
+			SupportsManagedServiceIdentity: d.Get("use_msi").(bool),
+			SupportsAzureCliToken:          d.Get("use_cli").(bool),
+			SupportsAuxiliaryTenants:       len(auxTenants) > 0,
 
 			// Doc Links
 			ClientSecretDocsLink: "https://registry.terraform.io/providers/hashicorp/azurestack/latest/docs/guides/service_principal_client_secret",
 		}
 
-		config, err := builder.Build()
+		var oidc *clients.OIDCConfig
+		if d.Get("use_oidc").(bool) {
+			oidc = &clients.OIDCConfig{
+				IDToken:             d.Get("oidc_token").(string),
+				IDTokenFilePath:     d.Get("oidc_token_file_path").(string),
+				IDTokenRequestURL:   d.Get("oidc_request_url").(string),
+				IDTokenRequestToken: d.Get("oidc_request_token").(string),
+			}
+			if err := oidc.Validate(); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+
+		var clientCertificate *clients.ClientCertificateConfig
+		if v := d.Get("client_certificate").(string); v != "" {
+			if builder.ClientCertPath != "" {
+				return nil, diag.FromErr(fmt.Errorf("only one of `client_certificate` and `client_certificate_path` can be specified"))
+			}
+
+			certificate, err := clients.NewClientCertificateConfig(v, builder.ClientCertPassword)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			clientCertificate = certificate
+		}
+
+		// the authentication methods supported by the builder don't include OIDC or a Client Certificate
+		// provided as a value - in which case the tokens are obtained by the client instead
+		externalAuth := ""
+		if oidc != nil {
+			externalAuth = "`use_oidc` is enabled"
+		} else if clientCertificate != nil {
+			externalAuth = "`client_certificate` is specified"
+		}
+
+		config, err := buildAuthConfig(*builder, externalAuth)
 		if err != nil {
 			return nil, diag.FromErr(fmt.Errorf("building Azurestack Client: %s", err))
 		}
 
+		adminConfig, err := buildAdminAuthConfig(d, builder, externalAuth)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		offlineEnvironment := expandOfflineEnvironment(d.Get("offline_environment").([]interface{}))
+		if offlineEnvironment != nil && adminConfig != nil && offlineEnvironment.AdminTokenAudience == "" {
+			return nil, diag.FromErr(fmt.Errorf("`admin_token_audience` must be specified within the `offline_environment` block when `admin_endpoint` is set"))
+		}
+
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
 			// Terraform 0.12 introduced this field to the protocol
@@ -256,12 +572,68 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
+		tags.ConfigureIgnored(expandIgnoreTags(d.Get("ignore_tags").([]interface{})))
+
+		features := expandFeatures(d.Get("features").([]interface{}))
+		if err := validateDisallowedValues(p.ResourcesMap, features.DisallowedValues); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		timeoutOverrides := expandTimeoutOverrides(d.Get("timeouts").([]interface{}))
+		if err := applyTimeoutOverrides(p.ResourcesMap, timeoutOverrides); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if features.ProvenanceTags.Enabled {
+			if features.ProvenanceTags.Workspace == "" {
+				features.ProvenanceTags.Workspace = "default"
+				if v := os.Getenv("TF_WORKSPACE"); v != "" {
+					features.ProvenanceTags.Workspace = v
+				}
+			}
+
+			if features.ProvenanceTags.ModulePath == "" {
+				wd, err := os.Getwd()
+				if err != nil {
+					return nil, diag.FromErr(fmt.Errorf("determining the working directory for the `provenance_tags` feature: %+v", err))
+				}
+				features.ProvenanceTags.ModulePath = wd
+			}
+		}
+
+		stateEncryption, err := stateencryption.New(d.Get("state_encryption_key").(string))
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("configuring `state_encryption_key`: %+v", err))
+		}
+		stateencryption.Register(stateEncryption)
+
+		httpClient, err := common.BuildHTTPClient(common.HTTPClientOptions{
+			ProxyURL:                d.Get("proxy_url").(string),
+			CustomCACertificatePath: d.Get("custom_ca_certificate_path").(string),
+		})
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("configuring the HTTP Client: %+v", err))
+		}
+
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
+			AdminAuthConfig:             adminConfig,
 			SkipProviderRegistration:    skipProviderRegistration,
 			TerraformVersion:            terraformVersion,
+			RetryMaxAttempts:            d.Get("retry_max_attempts").(int),
+			RetryBackoff:                time.Duration(d.Get("retry_backoff_seconds").(int)) * time.Second,
+			PollingInterval:             time.Duration(d.Get("polling_interval_seconds").(int)) * time.Second,
+			PollingIntervalOverrides:    expandPollingIntervalOverrides(timeoutOverrides),
 			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
+			CorrelationRequestIDPrefix:  d.Get("correlation_request_id_prefix").(string),
+			Features:                    features,
+			StateEncryption:             stateEncryption,
+			DefaultTags:                 d.Get("default_tags").(map[string]interface{}),
+			HTTPClient:                  httpClient,
+			OIDC:                        oidc,
+			ClientCertificate:           clientCertificate,
+			OfflineEnvironment:          offlineEnvironment,
 
 			// this field is intentionally not exposed in the provider block, since it's only used for
 			// platform level tracing
@@ -303,6 +675,105 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	}
 }
 
+// buildAdminAuthConfig returns the authentication configuration for the Admin management endpoint, or nil
+// when the Admin API hasn't been configured - the credentials default to those used for the Tenant endpoint
+func buildAdminAuthConfig(d *schema.ResourceData, tenant *authentication.Builder, externalAuth string) (*authentication.Config, error) {
+	endpoint := d.Get("admin_endpoint").(string)
+	if endpoint == "" {
+		return nil, nil
+	}
+
+	subscriptionId := d.Get("admin_subscription_id").(string)
+	if subscriptionId == "" {
+		return nil, fmt.Errorf("`admin_subscription_id` must be specified when `admin_endpoint` is set")
+	}
+
+	builder := *tenant
+	builder.CustomResourceManagerEndpoint = endpoint
+	builder.SubscriptionID = subscriptionId
+	builder.AuxiliaryTenantIDs = nil
+	builder.SupportsAuxiliaryTenants = false
+
+	if v := d.Get("admin_client_id").(string); v != "" {
+		builder.ClientID = v
+	}
+	if v := d.Get("admin_client_secret").(string); v != "" {
+		builder.ClientSecret = v
+	}
+	if v := d.Get("admin_tenant_id").(string); v != "" {
+		builder.TenantID = v
+	}
+
+	config, err := buildAuthConfig(builder, externalAuth)
+	if err != nil {
+		return nil, fmt.Errorf("building Azurestack Admin Client: %s", err)
+	}
+
+	return config, nil
+}
+
+func expandOfflineEnvironment(input []interface{}) *clients.OfflineEnvironment {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &clients.OfflineEnvironment{
+		ActiveDirectoryEndpoint: raw["active_directory_endpoint"].(string),
+		TokenAudience:           raw["token_audience"].(string),
+		AdminTokenAudience:      raw["admin_token_audience"].(string),
+		GraphEndpoint:           raw["graph_endpoint"].(string),
+		StorageEndpointSuffix:   raw["storage_endpoint_suffix"].(string),
+		KeyVaultDNSSuffix:       raw["key_vault_dns_suffix"].(string),
+	}
+}
+
+func expandIgnoreTags(input []interface{}) tags.IgnoreConfig {
+	config := tags.IgnoreConfig{
+		Keys:        make([]string, 0),
+		KeyPrefixes: make([]string, 0),
+	}
+	if len(input) == 0 || input[0] == nil {
+		return config
+	}
+
+	raw := input[0].(map[string]interface{})
+	config.Keys = *utils.ExpandStringSlice(raw["keys"].(*schema.Set).List())
+	config.KeyPrefixes = *utils.ExpandStringSlice(raw["key_prefixes"].(*schema.Set).List())
+	return config
+}
+
+// buildAuthConfig returns the authentication configuration for the builder. When an authentication method
+// which isn't supported by the builder is used (as described by externalAuth) the configuration only
+// describes the Service Principal, and the tokens are obtained by the client instead
+func buildAuthConfig(builder authentication.Builder, externalAuth string) (*authentication.Config, error) {
+	if externalAuth == "" {
+		return builder.Build()
+	}
+
+	if builder.ClientID == "" {
+		return nil, fmt.Errorf("`client_id` must be specified when %s", externalAuth)
+	}
+	if builder.TenantID == "" {
+		return nil, fmt.Errorf("`tenant_id` must be specified when %s", externalAuth)
+	}
+	if builder.SubscriptionID == "" {
+		return nil, fmt.Errorf("`subscription_id` must be specified when %s", externalAuth)
+	}
+	if len(builder.AuxiliaryTenantIDs) > 0 {
+		return nil, fmt.Errorf("`auxiliary_tenant_ids` isn't supported when %s", externalAuth)
+	}
+
+	return &authentication.Config{
+		AuthenticatedAsAServicePrincipal: true,
+		ClientID:                         builder.ClientID,
+		CustomResourceManagerEndpoint:    builder.CustomResourceManagerEndpoint,
+		Environment:                      builder.Environment,
+		SubscriptionID:                   builder.SubscriptionID,
+		TenantID:                         builder.TenantID,
+	}, nil
+}
+
 const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers are registered.
 
 Terraform automatically attempts to register the Resource Providers it supports to
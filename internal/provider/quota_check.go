@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+)
+
+const (
+	quotaResourceProviderCompute = "Microsoft.Compute"
+	quotaResourceProviderNetwork = "Microsoft.Network"
+	quotaResourceProviderStorage = "Microsoft.Storage"
+)
+
+// quotaRequirementsFunc returns the quota required by the Resource being planned, which is empty when nothing
+// further is required (for example, when an existing Resource is being updated in-place)
+type quotaRequirementsFunc func(ctx context.Context, d *schema.ResourceDiff, client *clients.Client) ([]capabilities.QuotaRequirement, error)
+
+// quotaCheckedResources are the Resources whose quota requirements are compared against the remaining quota when
+// the `quota_check` feature is enabled
+var quotaCheckedResources = map[string]quotaRequirementsFunc{
+	"azurestack_managed_disk":    managedDiskQuotaRequirements,
+	"azurestack_public_ip":       publicIPQuotaRequirements,
+	"azurestack_storage_account": storageAccountQuotaRequirements,
+	"azurestack_virtual_machine": virtualMachineQuotaRequirements,
+}
+
+// withQuotaCheck wraps the CustomizeDiff function of a Resource which consumes quota, so that when the `quota_check`
+// feature is enabled the quota required by the Resources within the plan is compared against the remaining quota -
+// failing the plan when `fail_on_exceeded` is set and the apply would exceed it.
+func withQuotaCheck(resourceType string, tracker *capabilities.QuotaTracker, resource *schema.Resource) *schema.Resource {
+	requirementsFunc, ok := quotaCheckedResources[resourceType]
+	if !ok {
+		return resource
+	}
+
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if client, ok := meta.(*clients.Client); ok && client != nil && client.Features.QuotaCheck.Enabled {
+			if err := checkQuota(ctx, resourceType, d, client, tracker, requirementsFunc); err != nil {
+				return err
+			}
+		}
+
+		if customizeDiff != nil {
+			return customizeDiff(ctx, d, meta)
+		}
+
+		return nil
+	}
+
+	return resource
+}
+
+func checkQuota(ctx context.Context, resourceType string, d *schema.ResourceDiff, client *clients.Client, tracker *capabilities.QuotaTracker, requirementsFunc quotaRequirementsFunc) error {
+	if !d.NewValueKnown("location") || !d.NewValueKnown("name") || !d.NewValueKnown("resource_group_name") {
+		return nil
+	}
+	loc := location.Normalize(d.Get("location").(string))
+
+	// the Usage APIs (and the Virtual Machine Sizes API) aren't available on every Azure Stack Hub, so failing to
+	// retrieve them is logged rather than blocking the plan
+	requirements, err := requirementsFunc(ctx, d, client)
+	if err != nil {
+		log.Printf("[WARN] Skipping the quota check for the %s %q: %+v", resourceType, d.Get("name").(string), err)
+		return nil
+	}
+	if len(requirements) == 0 {
+		return nil
+	}
+
+	// the same Resource can be diffed more than once, as such its requirements are tracked using its name
+	resource := strings.ToLower(fmt.Sprintf("%s/%s/%s", resourceType, d.Get("resource_group_name").(string), d.Get("name").(string)))
+	exceeded, err := tracker.Check(ctx, loc, resource, requirements, func(ctx context.Context, resourceProvider, location string) ([]capabilities.QuotaUsage, error) {
+		return quotaUsages(ctx, client, resourceProvider, location)
+	})
+	if err != nil {
+		log.Printf("[WARN] Skipping the quota check for the %s %q: %+v", resourceType, d.Get("name").(string), err)
+		return nil
+	}
+	if len(exceeded) == 0 {
+		return nil
+	}
+
+	if client.Features.QuotaCheck.FailOnExceeded {
+		return fmt.Errorf("provisioning the %s %q would exceed the remaining quota:\n\n  - %s", resourceType, d.Get("name").(string), strings.Join(exceeded, "\n  - "))
+	}
+
+	// a CustomizeDiff can't return warnings in Plugin SDK v2, as such this is only available in the Terraform logs
+	for _, v := range exceeded {
+		log.Printf("[WARN] Provisioning the %s %q: %s", resourceType, d.Get("name").(string), v)
+	}
+
+	return nil
+}
+
+// quotaUsages retrieves the usage of the quotas for the Resource Provider within the location, from its Usage API
+func quotaUsages(ctx context.Context, client *clients.Client, resourceProvider, loc string) ([]capabilities.QuotaUsage, error) {
+	usages := make([]capabilities.QuotaUsage, 0)
+
+	switch resourceProvider {
+	case quotaResourceProviderCompute:
+		iterator, err := client.Compute.UsageClient.ListComplete(ctx, loc)
+		if err != nil {
+			return nil, err
+		}
+		for iterator.NotDone() {
+			v := iterator.Value()
+			if v.Name != nil && v.Name.Value != nil && v.CurrentValue != nil && v.Limit != nil {
+				usages = append(usages, capabilities.QuotaUsage{
+					Name:    *v.Name.Value,
+					Current: int64(*v.CurrentValue),
+					Limit:   *v.Limit,
+				})
+			}
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+	case quotaResourceProviderNetwork:
+		iterator, err := client.Network.UsagesClient.ListComplete(ctx, loc)
+		if err != nil {
+			return nil, err
+		}
+		for iterator.NotDone() {
+			v := iterator.Value()
+			if v.Name != nil && v.Name.Value != nil && v.CurrentValue != nil && v.Limit != nil {
+				usages = append(usages, capabilities.QuotaUsage{
+					Name:    *v.Name.Value,
+					Current: *v.CurrentValue,
+					Limit:   *v.Limit,
+				})
+			}
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+	case quotaResourceProviderStorage:
+		// the Storage Usage API available on Azure Stack Hub is scoped to the Subscription rather than a location
+		resp, err := client.Storage.UsageClient.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Value != nil {
+			for _, v := range *resp.Value {
+				if v.Name != nil && v.Name.Value != nil && v.CurrentValue != nil && v.Limit != nil {
+					usages = append(usages, capabilities.QuotaUsage{
+						Name:    *v.Name.Value,
+						Current: int64(*v.CurrentValue),
+						Limit:   int64(*v.Limit),
+					})
+				}
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("the Resource Provider %q isn't supported", resourceProvider)
+	}
+
+	return usages, nil
+}
+
+func managedDiskQuotaRequirements(_ context.Context, d *schema.ResourceDiff, _ *clients.Client) ([]capabilities.QuotaRequirement, error) {
+	if d.Id() != "" || !d.NewValueKnown("storage_account_type") {
+		return nil, nil
+	}
+
+	name := "StandardDiskCount"
+	if strings.HasPrefix(d.Get("storage_account_type").(string), "Premium") {
+		name = "PremiumDiskCount"
+	}
+
+	return []capabilities.QuotaRequirement{
+		{
+			ResourceProvider: quotaResourceProviderCompute,
+			Name:             name,
+			Amount:           1,
+		},
+	}, nil
+}
+
+func publicIPQuotaRequirements(_ context.Context, d *schema.ResourceDiff, _ *clients.Client) ([]capabilities.QuotaRequirement, error) {
+	requirements := make([]capabilities.QuotaRequirement, 0)
+	if d.Id() == "" {
+		requirements = append(requirements, capabilities.QuotaRequirement{
+			ResourceProvider: quotaResourceProviderNetwork,
+			Name:             "PublicIPAddresses",
+			Amount:           1,
+		})
+	}
+
+	// Static Public IP Addresses are tracked using a separate quota, which is consumed when one's created or updated to Static
+	allocationMethod := d.Get("allocation_method").(string)
+	if allocationMethod == "" {
+		allocationMethod = d.Get("public_ip_address_allocation").(string)
+	}
+	if strings.EqualFold(allocationMethod, "Static") && (d.Id() == "" || d.HasChanges("allocation_method", "public_ip_address_allocation")) {
+		requirements = append(requirements, capabilities.QuotaRequirement{
+			ResourceProvider: quotaResourceProviderNetwork,
+			Name:             "StaticPublicIPAddresses",
+			Amount:           1,
+		})
+	}
+
+	return requirements, nil
+}
+
+func storageAccountQuotaRequirements(_ context.Context, d *schema.ResourceDiff, _ *clients.Client) ([]capabilities.QuotaRequirement, error) {
+	if d.Id() != "" {
+		return nil, nil
+	}
+
+	return []capabilities.QuotaRequirement{
+		{
+			ResourceProvider: quotaResourceProviderStorage,
+			Name:             "StorageAccounts",
+			Amount:           1,
+		},
+	}, nil
+}
+
+func virtualMachineQuotaRequirements(ctx context.Context, d *schema.ResourceDiff, client *clients.Client) ([]capabilities.QuotaRequirement, error) {
+	if !d.NewValueKnown("vm_size") || (d.Id() != "" && !d.HasChange("vm_size")) {
+		return nil, nil
+	}
+
+	loc := location.Normalize(d.Get("location").(string))
+	oldSize, newSize := d.GetChange("vm_size")
+	cores, err := virtualMachineSizeCores(ctx, client, loc, newSize.(string))
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := make([]capabilities.QuotaRequirement, 0)
+	if d.Id() == "" {
+		requirements = append(requirements, capabilities.QuotaRequirement{
+			ResourceProvider: quotaResourceProviderCompute,
+			Name:             "virtualMachines",
+			Amount:           1,
+		})
+	} else {
+		// resizing an existing Virtual Machine only requires the additional cores
+		existingCores, err := virtualMachineSizeCores(ctx, client, loc, oldSize.(string))
+		if err != nil {
+			return nil, err
+		}
+		cores -= existingCores
+	}
+
+	if cores > 0 {
+		requirements = append(requirements, capabilities.QuotaRequirement{
+			ResourceProvider: quotaResourceProviderCompute,
+			Name:             "cores",
+			Amount:           cores,
+		})
+	}
+
+	return requirements, nil
+}
+
+// virtualMachineSizeCores returns the number of cores for the Virtual Machine Size within the location, which is
+// zero when the Virtual Machine Size isn't available (since this is validated by the Resource itself)
+func virtualMachineSizeCores(ctx context.Context, client *clients.Client, loc, vmSize string) (int64, error) {
+	if vmSize == "" {
+		return 0, nil
+	}
+
+	resp, err := client.Compute.VMSizesClient.List(ctx, loc)
+	if err != nil {
+		return 0, fmt.Errorf("retrieving the Virtual Machine Sizes available within %q: %+v", loc, err)
+	}
+
+	if resp.Value != nil {
+		for _, v := range *resp.Value {
+			if v.Name != nil && strings.EqualFold(*v.Name, vmSize) && v.NumberOfCores != nil {
+				return int64(*v.NumberOfCores), nil
+			}
+		}
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestQuotaCheckedResourcesExist(t *testing.T) {
+	resources := TestAzureProvider().ResourcesMap
+
+	for resourceType := range quotaCheckedResources {
+		resource, ok := resources[resourceType]
+		if !ok {
+			t.Fatalf("%q is listed in `quotaCheckedResources` but doesn't exist", resourceType)
+		}
+
+		for _, field := range []string{"location", "name", "resource_group_name"} {
+			if _, ok := resource.Schema[field]; !ok {
+				t.Fatalf("%q is listed in `quotaCheckedResources` but doesn't have the field %q", resourceType, field)
+			}
+		}
+
+		if resource.CustomizeDiff == nil {
+			t.Fatalf("%q is listed in `quotaCheckedResources` but doesn't have a CustomizeDiff function", resourceType)
+		}
+	}
+}
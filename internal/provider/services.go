@@ -1,11 +1,22 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/authorization"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/batch"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/compute"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/containers"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/dns"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/eventhub"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/iothub"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/managedidentity"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/marketplace"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/monitor"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/policy"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/recoveryservices"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/resource"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/sdk"
@@ -15,11 +26,22 @@ import (
 
 func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 	return []sdk.UntypedServiceRegistration{
+		admin.Registration{},
 		authorization.Registration{},
+		batch.Registration{},
 		compute.Registration{},
+		containers.Registration{},
 		dns.Registration{},
+		eventhub.Registration{},
+		iothub.Registration{},
+		keyvault.Registration{},
 		loadbalancer.Registration{},
+		managedidentity.Registration{},
+		marketplace.Registration{},
+		monitor.Registration{},
 		network.Registration{},
+		policy.Registration{},
+		recoveryservices.Registration{},
 		resource.Registration{},
 		storage.Registration{},
 	}
@@ -28,6 +50,11 @@ func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 func SupportedTypedServices() []sdk.TypedServiceRegistration {
 	return []sdk.TypedServiceRegistration{
 		dns.Registration{},
+		network.Registration{},
 		resource.Registration{},
 	}
 }
+
+func SupportedFrameworkServices() []sdk.FrameworkServiceRegistration {
+	return []sdk.FrameworkServiceRegistration{}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// injectTagsFunc adds tags to those which will be sent to the API, returning the tags which were configured
+type injectTagsFunc func(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error)
+
+// stripTagsFunc removes the tags which were added by an injectTagsFunc (and so aren't configured) from the state
+type stripTagsFunc func(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error
+
+// withTags wraps the Create, Read and Update functions of a Resource supporting tags, so that the tags returned
+// from inject are sent to the API - and then removed from the state using strip, since they're not present in
+// the users configuration
+func withTags(resource *schema.Resource, inject injectTagsFunc, strip stripTagsFunc) *schema.Resource {
+	if s, ok := resource.Schema["tags"]; !ok || s.Type != schema.TypeMap || !(s.Optional || s.Required) {
+		return resource
+	}
+
+	if create := resource.Create; create != nil {
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+			configured, err := inject(d, meta)
+			if err != nil {
+				return err
+			}
+			if err := create(d, meta); err != nil {
+				return err
+			}
+			return strip(d, meta, configured)
+		}
+	}
+
+	if update := resource.Update; update != nil {
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+			configured, err := inject(d, meta)
+			if err != nil {
+				return err
+			}
+			if err := update(d, meta); err != nil {
+				return err
+			}
+			return strip(d, meta, configured)
+		}
+	}
+
+	if read := resource.Read; read != nil {
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+			configured := d.Get("tags").(map[string]interface{})
+			if err := read(d, meta); err != nil {
+				return err
+			}
+			return strip(d, meta, configured)
+		}
+	}
+
+	if create := resource.CreateContext; create != nil {
+		resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured, err := inject(d, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			diags := create(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			if err := strip(d, meta, configured); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+			return diags
+		}
+	}
+
+	if update := resource.UpdateContext; update != nil {
+		resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured, err := inject(d, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			diags := update(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			if err := strip(d, meta, configured); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+			return diags
+		}
+	}
+
+	if read := resource.ReadContext; read != nil {
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			configured := d.Get("tags").(map[string]interface{})
+			diags := read(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+			if err := strip(d, meta, configured); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+			return diags
+		}
+	}
+
+	return resource
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWithTags(t *testing.T) {
+	var sent map[string]interface{}
+	resource := withTags(&schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			sent = d.Get("tags").(map[string]interface{})
+			d.SetId("example")
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}, func(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+		configured := d.Get("tags").(map[string]interface{})
+		return configured, d.Set("tags", map[string]interface{}{"configured": "true", "injected": "true"})
+	}, func(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+		return d.Set("tags", configured)
+	})
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{"configured": "true"},
+	})
+	if err := resource.Create(d, nil); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if len(sent) != 2 || sent["injected"] != "true" {
+		t.Fatalf("Expected the injected tag to be sent but got: %+v", sent)
+	}
+	if actual := d.Get("tags").(map[string]interface{}); len(actual) != 1 || actual["configured"] != "true" {
+		t.Fatalf("Expected only the configured tag in the state but got: %+v", actual)
+	}
+
+	withoutTags := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	if withTags(withoutTags, nil, nil) != withoutTags {
+		t.Fatalf("Expected a Resource without tags to be returned unchanged")
+	}
+}
+
+func TestWithTagsRetainsDiagnostics(t *testing.T) {
+	resource := withTags(&schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  "example warning",
+				},
+			}
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}, nil, func(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+		return fmt.Errorf("example error")
+	})
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	diags := resource.ReadContext(context.TODO(), d, nil)
+	if len(diags) != 2 {
+		t.Fatalf("Expected 2 diagnostics but got %d: %+v", len(diags), diags)
+	}
+	if diags[0].Severity != diag.Warning || diags[0].Summary != "example warning" {
+		t.Fatalf("Expected the warning from the Read function to be retained but got: %+v", diags[0])
+	}
+	if diags[1].Severity != diag.Error || diags[1].Summary != "example error" {
+		t.Fatalf("Expected the error from the strip function to be appended but got: %+v", diags[1])
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func schemaTimeouts() *schema.Schema {
+	duration := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateTimeoutDuration,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The timeouts which should be used for a Resource Type, where the timeouts specified within the `timeouts` block of a Resource take precedence.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The Resource Type which these timeouts should be used for, for example `azurestack_virtual_network_gateway`.",
+				},
+
+				"create": duration(),
+				"read":   duration(),
+				"update": duration(),
+				"delete": duration(),
+
+				"polling_interval": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateTimeoutDuration,
+					Description:  "The interval between polls of the Long Running Operations performed by this Resource Type, which takes precedence over `polling_interval_seconds`.",
+				},
+			},
+		},
+	}
+}
+
+func validateTimeoutDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as `90m` or `2h`: %+v", k, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+	}
+
+	return
+}
+
+func expandTimeoutOverrides(input []interface{}) []timeouts.Override {
+	output := make([]timeouts.Override, 0)
+
+	duration := func(input interface{}) *time.Duration {
+		// the value has already been validated, so this is only empty when it's not specified
+		v, err := time.ParseDuration(input.(string))
+		if err != nil {
+			return nil
+		}
+		return &v
+	}
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		raw := item.(map[string]interface{})
+		output = append(output, timeouts.Override{
+			ResourceType: raw["resource_type"].(string),
+			Create:       duration(raw["create"]),
+			Read:         duration(raw["read"]),
+			Update:       duration(raw["update"]),
+			Delete:       duration(raw["delete"]),
+
+			PollingInterval: duration(raw["polling_interval"]),
+		})
+	}
+
+	return output
+}
+
+// applyTimeoutOverrides replaces the default timeouts of the Resources targeted by the `timeouts` blocks in the
+// Provider block. Since the defaults are read when the Resource is planned (and the Provider is configured before
+// this happens) the timeouts specified within the `timeouts` block of a Resource continue to take precedence.
+func applyTimeoutOverrides(resources map[string]*schema.Resource, overrides []timeouts.Override) error {
+	seen := make(map[string]struct{})
+
+	for _, override := range overrides {
+		if _, ok := seen[override.ResourceType]; ok {
+			return fmt.Errorf("the `timeouts` block for the Resource %q is specified more than once in the Provider block", override.ResourceType)
+		}
+		seen[override.ResourceType] = struct{}{}
+
+		resource, ok := resources[override.ResourceType]
+		if !ok {
+			return fmt.Errorf("the `timeouts` block in the Provider block references the Resource %q which isn't supported by this Provider", override.ResourceType)
+		}
+
+		// the block can specify only a polling interval, which doesn't require the Resource to support custom timeouts
+		if !override.HasTimeouts() {
+			continue
+		}
+
+		resourceTimeouts, err := timeouts.ApplyOverride(resource.Timeouts, override)
+		if err != nil {
+			return fmt.Errorf("applying the `timeouts` block in the Provider block: %+v", err)
+		}
+		resource.Timeouts = resourceTimeouts
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func TestApplyTimeoutOverrides(t *testing.T) {
+	duration := func(input time.Duration) *time.Duration {
+		return &input
+	}
+
+	testData := []struct {
+		Name  string
+		Input []timeouts.Override
+		Error bool
+	}{
+		{
+			Name:  "None",
+			Input: []timeouts.Override{},
+		},
+		{
+			Name: "Supported Resource",
+			Input: []timeouts.Override{
+				{
+					ResourceType: "azurestack_virtual_network_gateway",
+					Create:       duration(2 * time.Hour),
+					Update:       duration(2 * time.Hour),
+				},
+			},
+		},
+		{
+			Name: "Polling Interval Only",
+			Input: []timeouts.Override{
+				{
+					ResourceType:    "azurestack_resource_group",
+					PollingInterval: duration(10 * time.Second),
+				},
+			},
+		},
+		{
+			Name: "Unsupported Resource",
+			Input: []timeouts.Override{
+				{
+					ResourceType: "azurestack_virtual_network_gateways",
+					Create:       duration(2 * time.Hour),
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "Duplicate Resource",
+			Input: []timeouts.Override{
+				{
+					ResourceType: "azurestack_virtual_network_gateway",
+					Create:       duration(2 * time.Hour),
+				},
+				{
+					ResourceType: "azurestack_virtual_network_gateway",
+					Delete:       duration(2 * time.Hour),
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resources := TestAzureProvider().ResourcesMap
+		err := applyTimeoutOverrides(resources, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		for _, override := range v.Input {
+			resourceTimeouts := resources[override.ResourceType].Timeouts
+			if override.Create != nil && *resourceTimeouts.Create != *override.Create {
+				t.Fatalf("Expected the Create timeout for %q to be %s but got %s", override.ResourceType, *override.Create, *resourceTimeouts.Create)
+			}
+			if override.Update != nil && *resourceTimeouts.Update != *override.Update {
+				t.Fatalf("Expected the Update timeout for %q to be %s but got %s", override.ResourceType, *override.Update, *resourceTimeouts.Update)
+			}
+		}
+	}
+}
+
+func TestValidateTimeoutDuration(t *testing.T) {
+	testData := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "90",
+			Valid: false,
+		},
+		{
+			Input: "0s",
+			Valid: false,
+		},
+		{
+			Input: "90m",
+			Valid: true,
+		},
+		{
+			Input: "1h30m",
+			Valid: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		_, errors := validateTimeoutDuration(v.Input, "create")
+		if actual := len(errors) == 0; actual != v.Valid {
+			t.Fatalf("Expected %t but got %t", v.Valid, actual)
+		}
+	}
+}
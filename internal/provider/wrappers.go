@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
+)
+
+// resourceWrapper wraps the functions of a Resource to add Provider-level behaviour
+type resourceWrapper func(key string, resource *schema.Resource) *schema.Resource
+
+// resourceWrappers returns the wrappers applied to each Resource within a Service, in the order they're applied -
+// as such the last wrapper is the outermost, and is called first
+func resourceWrappers(quotaTracker *capabilities.QuotaTracker, armResourceTypes map[string]string, importMetadata map[string][]string) []resourceWrapper {
+	return []resourceWrapper{
+		func(_ string, r *schema.Resource) *schema.Resource {
+			return withDefaultTags(r)
+		},
+		func(_ string, r *schema.Resource) *schema.Resource {
+			return withProvenanceTags(r)
+		},
+		withPollingInterval,
+		withOperationTimings,
+		withProtectedResources,
+		func(key string, r *schema.Resource) *schema.Resource {
+			return withCapabilities(r, armResourceTypes[key])
+		},
+		func(key string, r *schema.Resource) *schema.Resource {
+			return withQuotaCheck(key, quotaTracker, r)
+		},
+		withDisallowedValues,
+		func(key string, r *schema.Resource) *schema.Resource {
+			return withImportDiagnostics(key, r, importMetadata[key])
+		},
+	}
+}
+
+// wrapResource applies each of the wrappers to the Resource in order
+func wrapResource(key string, resource *schema.Resource, wrappers []resourceWrapper) *schema.Resource {
+	for _, wrap := range wrappers {
+		resource = wrap(key, resource)
+	}
+
+	return resource
+}
@@ -0,0 +1,150 @@
+package adminapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// the Marketplace Syndication APIs are exposed by the `Microsoft.AzureBridge.Admin` Resource Provider
+const azureBridgeAPIVersion = "2016-01-01"
+
+// Product is a Marketplace Item available to be downloaded from Azure via an Activation
+type Product struct {
+	autorest.Response `json:"-"`
+	ID                *string            `json:"id,omitempty"`
+	Name              *string            `json:"name,omitempty"`
+	Type              *string            `json:"type,omitempty"`
+	Properties        *ProductProperties `json:"properties,omitempty"`
+}
+
+// ProductProperties are the properties of a Product
+type ProductProperties struct {
+	DisplayName          *string                   `json:"displayName,omitempty"`
+	Description          *string                   `json:"description,omitempty"`
+	PublisherDisplayName *string                   `json:"publisherDisplayName,omitempty"`
+	PublisherIdentifier  *string                   `json:"publisherIdentifier,omitempty"`
+	Offer                *string                   `json:"offer,omitempty"`
+	OfferVersion         *string                   `json:"offerVersion,omitempty"`
+	Sku                  *string                   `json:"sku,omitempty"`
+	GalleryItemIdentity  *string                   `json:"galleryItemIdentity,omitempty"`
+	ProductKind          *string                   `json:"productKind,omitempty"`
+	PayloadLength        *int64                    `json:"payloadLength,omitempty"`
+	VersionProperties    *ProductVersionProperties `json:"productProperties,omitempty"`
+}
+
+// ProductVersionProperties contains the version of a Product
+type ProductVersionProperties struct {
+	Version *string `json:"version,omitempty"`
+}
+
+// ProductList is a page of Products
+type ProductList struct {
+	autorest.Response `json:"-"`
+	Value             *[]Product `json:"value,omitempty"`
+	NextLink          *string    `json:"nextLink,omitempty"`
+}
+
+// DownloadedProduct is a Marketplace Item which has been downloaded onto the Azure Stack Hub Stamp
+type DownloadedProduct struct {
+	autorest.Response `json:"-"`
+	ID                *string                      `json:"id,omitempty"`
+	Name              *string                      `json:"name,omitempty"`
+	Type              *string                      `json:"type,omitempty"`
+	Properties        *DownloadedProductProperties `json:"properties,omitempty"`
+}
+
+// DownloadedProductProperties are the properties of a Downloaded Product
+type DownloadedProductProperties struct {
+	ProductProperties
+
+	// ProvisioningState is the state of the download, which is `Succeeded` once the download has completed
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+// ProductsClient is the client for the Marketplace Products API
+type ProductsClient struct {
+	BaseClient
+}
+
+// NewProductsClientWithBaseURI creates an instance of the ProductsClient client
+func NewProductsClientWithBaseURI(baseURI string) ProductsClient {
+	return ProductsClient{NewWithBaseURI(baseURI)}
+}
+
+// ListComplete retrieves all of the Products available within the Activation with the specified Resource ID
+func (client ProductsClient) ListComplete(ctx context.Context, activationId string) (result []Product, err error) {
+	result = make([]Product, 0)
+
+	var page ProductList
+	if _, err = client.getByID(ctx, "adminapi.ProductsClient", fmt.Sprintf("%s/products", activationId), azureBridgeAPIVersion, &page); err != nil {
+		return nil, err
+	}
+
+	for {
+		if page.Value != nil {
+			result = append(result, *page.Value...)
+		}
+
+		if page.NextLink == nil || *page.NextLink == "" {
+			return result, nil
+		}
+
+		nextLink := *page.NextLink
+		page = ProductList{}
+		if _, err = client.getNextPage(ctx, "adminapi.ProductsClient", nextLink, &page); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Download starts downloading the Product with the specified Resource ID onto the Azure Stack Hub Stamp
+func (client ProductsClient) Download(ctx context.Context, id string) (result autorest.Response, err error) {
+	req, err := client.prepare(ctx, fmt.Sprintf("%s/download", id), azureBridgeAPIVersion, autorest.AsPost())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "adminapi.ProductsClient", "Download", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	result.Response = resp
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "adminapi.ProductsClient", "Download", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByClosing())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "adminapi.ProductsClient", "Download", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// DownloadedProductsClient is the client for the Downloaded Products API
+type DownloadedProductsClient struct {
+	BaseClient
+}
+
+// NewDownloadedProductsClientWithBaseURI creates an instance of the DownloadedProductsClient client
+func NewDownloadedProductsClientWithBaseURI(baseURI string) DownloadedProductsClient {
+	return DownloadedProductsClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the Downloaded Product with the specified Resource ID
+func (client DownloadedProductsClient) Get(ctx context.Context, id string) (result DownloadedProduct, err error) {
+	resp, err := client.getByID(ctx, "adminapi.DownloadedProductsClient", id, azureBridgeAPIVersion, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Delete deletes the Downloaded Product with the specified Resource ID from the Azure Stack Hub Stamp
+func (client DownloadedProductsClient) Delete(ctx context.Context, id string) (result autorest.Response, err error) {
+	resp, err := client.deleteByID(ctx, "adminapi.DownloadedProductsClient", id, azureBridgeAPIVersion)
+	result.Response = resp
+	return
+}
@@ -0,0 +1,138 @@
+// Package adminapi contains a minimal client for the Azure Stack Hub Admin APIs (exposed via the
+// administrative Resource Manager endpoint), which aren't available within the Azure SDK for Go.
+//
+// The clients within this package follow the conventions used by the Azure SDK for Go, so that
+// the responses can be used with the existing helpers (e.g. `utils.ResponseWasNotFound`).
+package adminapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// BaseClient is the base client for the Azure Stack Hub Admin APIs
+type BaseClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+// NewWithBaseURI creates an instance of the BaseClient client using the specified administrative Resource Manager endpoint
+func NewWithBaseURI(baseURI string) BaseClient {
+	return BaseClient{
+		Client:  autorest.NewClientWithUserAgent("Azure-SDK-For-Go/azurestack-admin"),
+		BaseURI: baseURI,
+	}
+}
+
+func (client BaseClient) getByID(ctx context.Context, clientName, resourceId, apiVersion string, result interface{}) (*http.Response, error) {
+	req, err := client.prepare(ctx, resourceId, apiVersion, autorest.AsGet())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, clientName, "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "Get", resp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
+
+// getNextPage retrieves the next page of a List operation, where the nextLink is an absolute URI
+func (client BaseClient) getNextPage(ctx context.Context, clientName, nextLink string, result interface{}) (*http.Response, error) {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsGet(),
+		autorest.WithBaseURL(nextLink))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, clientName, "List", nil, "Failure preparing next results request")
+	}
+
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "List", resp, "Failure sending next results request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "List", resp, "Failure responding to next results request")
+	}
+
+	return resp, nil
+}
+
+func (client BaseClient) createOrUpdateByID(ctx context.Context, clientName, resourceId, apiVersion string, input, result interface{}) (*http.Response, error) {
+	req, err := client.prepare(ctx, resourceId, apiVersion,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithJSON(input))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, clientName, "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
+
+func (client BaseClient) deleteByID(ctx context.Context, clientName, resourceId, apiVersion string) (*http.Response, error) {
+	req, err := client.prepare(ctx, resourceId, apiVersion, autorest.AsDelete())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, clientName, "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "Delete", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, clientName, "Delete", resp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
+
+func (client BaseClient) prepare(ctx context.Context, resourceId, apiVersion string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(resourceId),
+		autorest.WithQueryParameters(queryParameters))
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,164 @@
+package adminapi
+
+import (
+	"context"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// each Resource Provider exposes its own Quotas API, which are versioned independently
+const (
+	computeQuotasAPIVersion = "2018-09-01"
+	networkQuotasAPIVersion = "2015-06-15"
+	storageQuotasAPIVersion = "2019-08-08"
+)
+
+// ComputeQuota defines the limits for the `Microsoft.Compute` Resource Provider, which can be included within a Plan
+type ComputeQuota struct {
+	autorest.Response `json:"-"`
+	ID                *string                 `json:"id,omitempty"`
+	Name              *string                 `json:"name,omitempty"`
+	Type              *string                 `json:"type,omitempty"`
+	Location          *string                 `json:"location,omitempty"`
+	Properties        *ComputeQuotaProperties `json:"properties,omitempty"`
+}
+
+// ComputeQuotaProperties are the properties of a Compute Quota
+type ComputeQuotaProperties struct {
+	AvailabilitySetCount               *int32 `json:"availabilitySetCount,omitempty"`
+	CoresLimit                         *int32 `json:"coresLimit,omitempty"`
+	VirtualMachineCount                *int32 `json:"virtualMachineCount,omitempty"`
+	VMScaleSetCount                    *int32 `json:"vmScaleSetCount,omitempty"`
+	StandardManagedDiskAndSnapshotSize *int32 `json:"standardManagedDiskAndSnapshotSize,omitempty"`
+	PremiumManagedDiskAndSnapshotSize  *int32 `json:"premiumManagedDiskAndSnapshotSize,omitempty"`
+}
+
+// NetworkQuota defines the limits for the `Microsoft.Network` Resource Provider, which can be included within a Plan
+type NetworkQuota struct {
+	autorest.Response `json:"-"`
+	ID                *string                 `json:"id,omitempty"`
+	Name              *string                 `json:"name,omitempty"`
+	Type              *string                 `json:"type,omitempty"`
+	Location          *string                 `json:"location,omitempty"`
+	Properties        *NetworkQuotaProperties `json:"properties,omitempty"`
+}
+
+// NetworkQuotaProperties are the properties of a Network Quota
+type NetworkQuotaProperties struct {
+	MaxPublicIpsPerSubscription                        *int64 `json:"maxPublicIpsPerSubscription,omitempty"`
+	MaxVnetsPerSubscription                            *int64 `json:"maxVnetsPerSubscription,omitempty"`
+	MaxVirtualNetworkGatewaysPerSubscription           *int64 `json:"maxVirtualNetworkGatewaysPerSubscription,omitempty"`
+	MaxVirtualNetworkGatewayConnectionsPerSubscription *int64 `json:"maxVirtualNetworkGatewayConnectionsPerSubscription,omitempty"`
+	MaxLoadBalancersPerSubscription                    *int64 `json:"maxLoadBalancersPerSubscription,omitempty"`
+	MaxNicsPerSubscription                             *int64 `json:"maxNicsPerSubscription,omitempty"`
+	MaxSecurityGroupsPerSubscription                   *int64 `json:"maxSecurityGroupsPerSubscription,omitempty"`
+}
+
+// StorageQuota defines the limits for the `Microsoft.Storage` Resource Provider, which can be included within a Plan
+type StorageQuota struct {
+	autorest.Response `json:"-"`
+	ID                *string                 `json:"id,omitempty"`
+	Name              *string                 `json:"name,omitempty"`
+	Type              *string                 `json:"type,omitempty"`
+	Location          *string                 `json:"location,omitempty"`
+	Properties        *StorageQuotaProperties `json:"properties,omitempty"`
+}
+
+// StorageQuotaProperties are the properties of a Storage Quota
+type StorageQuotaProperties struct {
+	NumberOfStorageAccounts *int32 `json:"numberOfStorageAccounts,omitempty"`
+	CapacityInGb            *int32 `json:"capacityInGb,omitempty"`
+}
+
+// ComputeQuotasClient is the client for the Compute Quotas API
+type ComputeQuotasClient struct {
+	BaseClient
+}
+
+// NewComputeQuotasClientWithBaseURI creates an instance of the ComputeQuotasClient client
+func NewComputeQuotasClientWithBaseURI(baseURI string) ComputeQuotasClient {
+	return ComputeQuotasClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the Compute Quota with the specified Resource ID
+func (client ComputeQuotasClient) Get(ctx context.Context, id string) (result ComputeQuota, err error) {
+	resp, err := client.getByID(ctx, "adminapi.ComputeQuotasClient", id, computeQuotasAPIVersion, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateOrUpdate creates or updates the Compute Quota with the specified Resource ID
+func (client ComputeQuotasClient) CreateOrUpdate(ctx context.Context, id string, input ComputeQuota) (result ComputeQuota, err error) {
+	resp, err := client.createOrUpdateByID(ctx, "adminapi.ComputeQuotasClient", id, computeQuotasAPIVersion, input, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Delete deletes the Compute Quota with the specified Resource ID
+func (client ComputeQuotasClient) Delete(ctx context.Context, id string) (result autorest.Response, err error) {
+	resp, err := client.deleteByID(ctx, "adminapi.ComputeQuotasClient", id, computeQuotasAPIVersion)
+	result.Response = resp
+	return
+}
+
+// NetworkQuotasClient is the client for the Network Quotas API
+type NetworkQuotasClient struct {
+	BaseClient
+}
+
+// NewNetworkQuotasClientWithBaseURI creates an instance of the NetworkQuotasClient client
+func NewNetworkQuotasClientWithBaseURI(baseURI string) NetworkQuotasClient {
+	return NetworkQuotasClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the Network Quota with the specified Resource ID
+func (client NetworkQuotasClient) Get(ctx context.Context, id string) (result NetworkQuota, err error) {
+	resp, err := client.getByID(ctx, "adminapi.NetworkQuotasClient", id, networkQuotasAPIVersion, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateOrUpdate creates or updates the Network Quota with the specified Resource ID
+func (client NetworkQuotasClient) CreateOrUpdate(ctx context.Context, id string, input NetworkQuota) (result NetworkQuota, err error) {
+	resp, err := client.createOrUpdateByID(ctx, "adminapi.NetworkQuotasClient", id, networkQuotasAPIVersion, input, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Delete deletes the Network Quota with the specified Resource ID
+func (client NetworkQuotasClient) Delete(ctx context.Context, id string) (result autorest.Response, err error) {
+	resp, err := client.deleteByID(ctx, "adminapi.NetworkQuotasClient", id, networkQuotasAPIVersion)
+	result.Response = resp
+	return
+}
+
+// StorageQuotasClient is the client for the Storage Quotas API
+type StorageQuotasClient struct {
+	BaseClient
+}
+
+// NewStorageQuotasClientWithBaseURI creates an instance of the StorageQuotasClient client
+func NewStorageQuotasClientWithBaseURI(baseURI string) StorageQuotasClient {
+	return StorageQuotasClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the Storage Quota with the specified Resource ID
+func (client StorageQuotasClient) Get(ctx context.Context, id string) (result StorageQuota, err error) {
+	resp, err := client.getByID(ctx, "adminapi.StorageQuotasClient", id, storageQuotasAPIVersion, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateOrUpdate creates or updates the Storage Quota with the specified Resource ID
+func (client StorageQuotasClient) CreateOrUpdate(ctx context.Context, id string, input StorageQuota) (result StorageQuota, err error) {
+	resp, err := client.createOrUpdateByID(ctx, "adminapi.StorageQuotasClient", id, storageQuotasAPIVersion, input, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Delete deletes the Storage Quota with the specified Resource ID
+func (client StorageQuotasClient) Delete(ctx context.Context, id string) (result autorest.Response, err error) {
+	resp, err := client.deleteByID(ctx, "adminapi.StorageQuotasClient", id, storageQuotasAPIVersion)
+	result.Response = resp
+	return
+}
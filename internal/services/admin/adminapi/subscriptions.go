@@ -0,0 +1,263 @@
+package adminapi
+
+import (
+	"context"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// the Plans, Offers, Offer Delegations and Tenant Subscriptions are exposed by the `Microsoft.Subscriptions.Admin` Resource Provider
+const subscriptionsAPIVersion = "2015-11-01"
+
+// OfferState enumerates the values for the state of an Offer
+type OfferState string
+
+const (
+	// OfferStateDecommissioned means the Offer can no longer be subscribed to, but existing Subscriptions are unaffected
+	OfferStateDecommissioned OfferState = "Decommissioned"
+	// OfferStatePrivate means the Offer is only visible to Cloud Operators
+	OfferStatePrivate OfferState = "Private"
+	// OfferStatePublic means the Offer is visible to Tenants
+	OfferStatePublic OfferState = "Public"
+)
+
+// PossibleOfferStateValues returns an array of possible values for the OfferState const type.
+func PossibleOfferStateValues() []OfferState {
+	return []OfferState{OfferStateDecommissioned, OfferStatePrivate, OfferStatePublic}
+}
+
+// SubscriptionState enumerates the values for the state of a Tenant Subscription
+type SubscriptionState string
+
+const (
+	// SubscriptionStateDeleted means the Subscription has been deleted
+	SubscriptionStateDeleted SubscriptionState = "Deleted"
+	// SubscriptionStateDisabled means the Subscription is disabled and its resources can't be modified
+	SubscriptionStateDisabled SubscriptionState = "Disabled"
+	// SubscriptionStateEnabled means the Subscription is active
+	SubscriptionStateEnabled SubscriptionState = "Enabled"
+	// SubscriptionStateNotDefined means the state of the Subscription hasn't been defined
+	SubscriptionStateNotDefined SubscriptionState = "NotDefined"
+	// SubscriptionStatePastDue means the Subscription is past due
+	SubscriptionStatePastDue SubscriptionState = "PastDue"
+	// SubscriptionStateWarned means the Subscription is active but has been warned
+	SubscriptionStateWarned SubscriptionState = "Warned"
+)
+
+// PossibleSubscriptionStateValues returns an array of possible values for the SubscriptionState const type.
+func PossibleSubscriptionStateValues() []SubscriptionState {
+	return []SubscriptionState{SubscriptionStateDeleted, SubscriptionStateDisabled, SubscriptionStateEnabled, SubscriptionStateNotDefined, SubscriptionStatePastDue, SubscriptionStateWarned}
+}
+
+// Plan is a collection of Quotas which can be included within an Offer
+type Plan struct {
+	autorest.Response `json:"-"`
+	ID                *string         `json:"id,omitempty"`
+	Name              *string         `json:"name,omitempty"`
+	Type              *string         `json:"type,omitempty"`
+	Location          *string         `json:"location,omitempty"`
+	Properties        *PlanProperties `json:"properties,omitempty"`
+}
+
+// PlanProperties are the properties of a Plan
+type PlanProperties struct {
+	Name                *string   `json:"name,omitempty"`
+	DisplayName         *string   `json:"displayName,omitempty"`
+	Description         *string   `json:"description,omitempty"`
+	ExternalReferenceID *string   `json:"externalReferenceId,omitempty"`
+	QuotaIds            *[]string `json:"quotaIds,omitempty"`
+	SkuIds              *[]string `json:"skuIds,omitempty"`
+	// SubscriptionCount is the number of Subscriptions using this Plan (read-only)
+	SubscriptionCount *int64 `json:"subscriptionCount,omitempty"`
+}
+
+// Offer is a collection of Plans which Tenants can subscribe to
+type Offer struct {
+	autorest.Response `json:"-"`
+	ID                *string          `json:"id,omitempty"`
+	Name              *string          `json:"name,omitempty"`
+	Type              *string          `json:"type,omitempty"`
+	Location          *string          `json:"location,omitempty"`
+	Properties        *OfferProperties `json:"properties,omitempty"`
+}
+
+// OfferProperties are the properties of an Offer
+type OfferProperties struct {
+	Name                       *string                `json:"name,omitempty"`
+	DisplayName                *string                `json:"displayName,omitempty"`
+	Description                *string                `json:"description,omitempty"`
+	ExternalReferenceID        *string                `json:"externalReferenceId,omitempty"`
+	State                      OfferState             `json:"state,omitempty"`
+	BasePlanIds                *[]string              `json:"basePlanIds,omitempty"`
+	AddonPlans                 *[]AddonPlanDefinition `json:"addonPlans,omitempty"`
+	MaxSubscriptionsPerAccount *int32                 `json:"maxSubscriptionsPerAccount,omitempty"`
+	// SubscriptionCount is the number of Subscriptions using this Offer (read-only)
+	SubscriptionCount *int64 `json:"subscriptionCount,omitempty"`
+}
+
+// AddonPlanDefinition is a Plan which Tenants can optionally add to their Subscription
+type AddonPlanDefinition struct {
+	PlanID              *string `json:"planId,omitempty"`
+	MaxAcquisitionCount *int32  `json:"maxAcquisitionCount,omitempty"`
+}
+
+// OfferDelegation delegates an Offer to a Delegated Provider Subscription, allowing it to be resold
+type OfferDelegation struct {
+	autorest.Response `json:"-"`
+	ID                *string                    `json:"id,omitempty"`
+	Name              *string                    `json:"name,omitempty"`
+	Type              *string                    `json:"type,omitempty"`
+	Location          *string                    `json:"location,omitempty"`
+	Properties        *OfferDelegationProperties `json:"properties,omitempty"`
+}
+
+// OfferDelegationProperties are the properties of an Offer Delegation
+type OfferDelegationProperties struct {
+	SubscriptionID *string `json:"subscriptionId,omitempty"`
+}
+
+// TenantSubscription is a Subscription created for a Tenant against an Offer
+type TenantSubscription struct {
+	autorest.Response `json:"-"`
+	ID                *string                       `json:"id,omitempty"`
+	Name              *string                       `json:"name,omitempty"`
+	Type              *string                       `json:"type,omitempty"`
+	Location          *string                       `json:"location,omitempty"`
+	Properties        *TenantSubscriptionProperties `json:"properties,omitempty"`
+}
+
+// TenantSubscriptionProperties are the properties of a Tenant Subscription
+type TenantSubscriptionProperties struct {
+	SubscriptionID                  *string           `json:"subscriptionId,omitempty"`
+	DisplayName                     *string           `json:"displayName,omitempty"`
+	Owner                           *string           `json:"owner,omitempty"`
+	OfferID                         *string           `json:"offerId,omitempty"`
+	TenantID                        *string           `json:"tenantId,omitempty"`
+	DelegatedProviderSubscriptionID *string           `json:"delegatedProviderSubscriptionId,omitempty"`
+	ExternalReferenceID             *string           `json:"externalReferenceId,omitempty"`
+	State                           SubscriptionState `json:"state,omitempty"`
+}
+
+// PlansClient is the client for the Plans API
+type PlansClient struct {
+	BaseClient
+}
+
+// NewPlansClientWithBaseURI creates an instance of the PlansClient client
+func NewPlansClientWithBaseURI(baseURI string) PlansClient {
+	return PlansClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the Plan with the specified Resource ID
+func (client PlansClient) Get(ctx context.Context, id string) (result Plan, err error) {
+	resp, err := client.getByID(ctx, "adminapi.PlansClient", id, subscriptionsAPIVersion, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateOrUpdate creates or updates the Plan with the specified Resource ID
+func (client PlansClient) CreateOrUpdate(ctx context.Context, id string, input Plan) (result Plan, err error) {
+	resp, err := client.createOrUpdateByID(ctx, "adminapi.PlansClient", id, subscriptionsAPIVersion, input, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Delete deletes the Plan with the specified Resource ID
+func (client PlansClient) Delete(ctx context.Context, id string) (result autorest.Response, err error) {
+	resp, err := client.deleteByID(ctx, "adminapi.PlansClient", id, subscriptionsAPIVersion)
+	result.Response = resp
+	return
+}
+
+// OffersClient is the client for the Offers API
+type OffersClient struct {
+	BaseClient
+}
+
+// NewOffersClientWithBaseURI creates an instance of the OffersClient client
+func NewOffersClientWithBaseURI(baseURI string) OffersClient {
+	return OffersClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the Offer with the specified Resource ID
+func (client OffersClient) Get(ctx context.Context, id string) (result Offer, err error) {
+	resp, err := client.getByID(ctx, "adminapi.OffersClient", id, subscriptionsAPIVersion, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateOrUpdate creates or updates the Offer with the specified Resource ID
+func (client OffersClient) CreateOrUpdate(ctx context.Context, id string, input Offer) (result Offer, err error) {
+	resp, err := client.createOrUpdateByID(ctx, "adminapi.OffersClient", id, subscriptionsAPIVersion, input, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Delete deletes the Offer with the specified Resource ID
+func (client OffersClient) Delete(ctx context.Context, id string) (result autorest.Response, err error) {
+	resp, err := client.deleteByID(ctx, "adminapi.OffersClient", id, subscriptionsAPIVersion)
+	result.Response = resp
+	return
+}
+
+// OfferDelegationsClient is the client for the Offer Delegations API
+type OfferDelegationsClient struct {
+	BaseClient
+}
+
+// NewOfferDelegationsClientWithBaseURI creates an instance of the OfferDelegationsClient client
+func NewOfferDelegationsClientWithBaseURI(baseURI string) OfferDelegationsClient {
+	return OfferDelegationsClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the Offer Delegation with the specified Resource ID
+func (client OfferDelegationsClient) Get(ctx context.Context, id string) (result OfferDelegation, err error) {
+	resp, err := client.getByID(ctx, "adminapi.OfferDelegationsClient", id, subscriptionsAPIVersion, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateOrUpdate creates or updates the Offer Delegation with the specified Resource ID
+func (client OfferDelegationsClient) CreateOrUpdate(ctx context.Context, id string, input OfferDelegation) (result OfferDelegation, err error) {
+	resp, err := client.createOrUpdateByID(ctx, "adminapi.OfferDelegationsClient", id, subscriptionsAPIVersion, input, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Delete deletes the Offer Delegation with the specified Resource ID
+func (client OfferDelegationsClient) Delete(ctx context.Context, id string) (result autorest.Response, err error) {
+	resp, err := client.deleteByID(ctx, "adminapi.OfferDelegationsClient", id, subscriptionsAPIVersion)
+	result.Response = resp
+	return
+}
+
+// TenantSubscriptionsClient is the client for the Tenant Subscriptions API
+type TenantSubscriptionsClient struct {
+	BaseClient
+}
+
+// NewTenantSubscriptionsClientWithBaseURI creates an instance of the TenantSubscriptionsClient client
+func NewTenantSubscriptionsClientWithBaseURI(baseURI string) TenantSubscriptionsClient {
+	return TenantSubscriptionsClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the Tenant Subscription with the specified Resource ID
+func (client TenantSubscriptionsClient) Get(ctx context.Context, id string) (result TenantSubscription, err error) {
+	resp, err := client.getByID(ctx, "adminapi.TenantSubscriptionsClient", id, subscriptionsAPIVersion, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateOrUpdate creates or updates the Tenant Subscription with the specified Resource ID
+func (client TenantSubscriptionsClient) CreateOrUpdate(ctx context.Context, id string, input TenantSubscription) (result TenantSubscription, err error) {
+	resp, err := client.createOrUpdateByID(ctx, "adminapi.TenantSubscriptionsClient", id, subscriptionsAPIVersion, input, &result)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Delete deletes the Tenant Subscription with the specified Resource ID
+func (client TenantSubscriptionsClient) Delete(ctx context.Context, id string) (result autorest.Response, err error) {
+	resp, err := client.deleteByID(ctx, "adminapi.TenantSubscriptionsClient", id, subscriptionsAPIVersion)
+	result.Response = resp
+	return
+}
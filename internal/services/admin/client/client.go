@@ -0,0 +1,79 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/adminapi"
+)
+
+type Client struct {
+	// SubscriptionId is the ID of the Default Provider Subscription used for the Admin resources
+	SubscriptionId string
+
+	ComputeQuotasClient       *adminapi.ComputeQuotasClient
+	DownloadedProductsClient  *adminapi.DownloadedProductsClient
+	NetworkQuotasClient       *adminapi.NetworkQuotasClient
+	OfferDelegationsClient    *adminapi.OfferDelegationsClient
+	OffersClient              *adminapi.OffersClient
+	PlansClient               *adminapi.PlansClient
+	ProductsClient            *adminapi.ProductsClient
+	StorageQuotasClient       *adminapi.StorageQuotasClient
+	TenantSubscriptionsClient *adminapi.TenantSubscriptionsClient
+
+	enabled bool
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	computeQuotasClient := adminapi.NewComputeQuotasClientWithBaseURI(o.AdminResourceManagerEndpoint)
+	o.ConfigureClient(&computeQuotasClient.Client, o.AdminResourceManagerAuthorizer)
+
+	downloadedProductsClient := adminapi.NewDownloadedProductsClientWithBaseURI(o.AdminResourceManagerEndpoint)
+	o.ConfigureClient(&downloadedProductsClient.Client, o.AdminResourceManagerAuthorizer)
+
+	networkQuotasClient := adminapi.NewNetworkQuotasClientWithBaseURI(o.AdminResourceManagerEndpoint)
+	o.ConfigureClient(&networkQuotasClient.Client, o.AdminResourceManagerAuthorizer)
+
+	offerDelegationsClient := adminapi.NewOfferDelegationsClientWithBaseURI(o.AdminResourceManagerEndpoint)
+	o.ConfigureClient(&offerDelegationsClient.Client, o.AdminResourceManagerAuthorizer)
+
+	offersClient := adminapi.NewOffersClientWithBaseURI(o.AdminResourceManagerEndpoint)
+	o.ConfigureClient(&offersClient.Client, o.AdminResourceManagerAuthorizer)
+
+	plansClient := adminapi.NewPlansClientWithBaseURI(o.AdminResourceManagerEndpoint)
+	o.ConfigureClient(&plansClient.Client, o.AdminResourceManagerAuthorizer)
+
+	productsClient := adminapi.NewProductsClientWithBaseURI(o.AdminResourceManagerEndpoint)
+	o.ConfigureClient(&productsClient.Client, o.AdminResourceManagerAuthorizer)
+
+	storageQuotasClient := adminapi.NewStorageQuotasClientWithBaseURI(o.AdminResourceManagerEndpoint)
+	o.ConfigureClient(&storageQuotasClient.Client, o.AdminResourceManagerAuthorizer)
+
+	tenantSubscriptionsClient := adminapi.NewTenantSubscriptionsClientWithBaseURI(o.AdminResourceManagerEndpoint)
+	o.ConfigureClient(&tenantSubscriptionsClient.Client, o.AdminResourceManagerAuthorizer)
+
+	return &Client{
+		SubscriptionId: o.AdminSubscriptionId,
+
+		ComputeQuotasClient:       &computeQuotasClient,
+		DownloadedProductsClient:  &downloadedProductsClient,
+		NetworkQuotasClient:       &networkQuotasClient,
+		OfferDelegationsClient:    &offerDelegationsClient,
+		OffersClient:              &offersClient,
+		PlansClient:               &plansClient,
+		ProductsClient:            &productsClient,
+		StorageQuotasClient:       &storageQuotasClient,
+		TenantSubscriptionsClient: &tenantSubscriptionsClient,
+
+		enabled: o.AdminResourceManagerEndpoint != "" && o.AdminResourceManagerAuthorizer != nil,
+	}
+}
+
+// EnsureConfigured returns an error when the Admin API hasn't been configured in the Provider block
+func (c *Client) EnsureConfigured() error {
+	if !c.enabled {
+		return fmt.Errorf("the Admin API isn't configured - `admin_endpoint` and `admin_subscription_id` must be specified in the Provider block to manage Admin resources")
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package admin
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/adminapi"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func computeQuota() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: computeQuotaCreateUpdate,
+		Read:   computeQuotaRead,
+		Update: computeQuotaCreateUpdate,
+		Delete: computeQuotaDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ComputeQuotaID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": commonschema.Location(),
+
+			"availability_set_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"cores_limit": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"virtual_machine_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"vm_scale_set_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"standard_managed_disk_and_snapshot_size_in_gb": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      2048,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"premium_managed_disk_and_snapshot_size_in_gb": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      2048,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func computeQuotaCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id := parse.NewComputeQuotaID(client.SubscriptionId, location.Normalize(d.Get("location").(string)), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.ComputeQuotasClient.Get(ctx, id.ID())
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_compute_quota", id.ID())
+		}
+	}
+
+	parameters := adminapi.ComputeQuota{
+		Location: pointer.FromString(id.LocationName),
+		Properties: &adminapi.ComputeQuotaProperties{
+			AvailabilitySetCount:               utils.Int32(int32(d.Get("availability_set_count").(int))),
+			CoresLimit:                         utils.Int32(int32(d.Get("cores_limit").(int))),
+			VirtualMachineCount:                utils.Int32(int32(d.Get("virtual_machine_count").(int))),
+			VMScaleSetCount:                    utils.Int32(int32(d.Get("vm_scale_set_count").(int))),
+			StandardManagedDiskAndSnapshotSize: utils.Int32(int32(d.Get("standard_managed_disk_and_snapshot_size_in_gb").(int))),
+			PremiumManagedDiskAndSnapshotSize:  utils.Int32(int32(d.Get("premium_managed_disk_and_snapshot_size_in_gb").(int))),
+		},
+	}
+
+	if _, err := client.ComputeQuotasClient.CreateOrUpdate(ctx, id.ID(), parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return computeQuotaRead(d, meta)
+}
+
+func computeQuotaRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.ComputeQuotaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ComputeQuotasClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.QuotaName)
+	d.Set("location", location.Normalize(id.LocationName))
+
+	if props := resp.Properties; props != nil {
+		d.Set("availability_set_count", props.AvailabilitySetCount)
+		d.Set("cores_limit", props.CoresLimit)
+		d.Set("virtual_machine_count", props.VirtualMachineCount)
+		d.Set("vm_scale_set_count", props.VMScaleSetCount)
+		d.Set("standard_managed_disk_and_snapshot_size_in_gb", props.StandardManagedDiskAndSnapshotSize)
+		d.Set("premium_managed_disk_and_snapshot_size_in_gb", props.PremiumManagedDiskAndSnapshotSize)
+	}
+
+	return nil
+}
+
+func computeQuotaDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.ComputeQuotaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ComputeQuotasClient.Delete(ctx, id.ID())
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package admin_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type ComputeQuotaResource struct{}
+
+func TestAccComputeQuota_basic(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_compute_quota", "test")
+	r := ComputeQuotaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccComputeQuota_requiresImport(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_compute_quota", "test")
+	r := ComputeQuotaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccComputeQuota_update(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_compute_quota", "test")
+	r := ComputeQuotaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cores_limit").HasValue("200"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ComputeQuotaResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ComputeQuotaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Admin.ComputeQuotasClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (ComputeQuotaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_compute_quota" "test" {
+  name     = "acctestquota-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ComputeQuotaResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_compute_quota" "import" {
+  name     = azurestack_compute_quota.test.name
+  location = azurestack_compute_quota.test.location
+}
+`, r.basic(data))
+}
+
+func (ComputeQuotaResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_compute_quota" "test" {
+  name                   = "acctestquota-%d"
+  location               = %q
+  cores_limit            = 200
+  virtual_machine_count  = 50
+  availability_set_count = 20
+  vm_scale_set_count     = 10
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,361 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/adminapi"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func marketplaceDownload() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: marketplaceDownloadCreate,
+		Read:   marketplaceDownloadRead,
+		Delete: marketplaceDownloadDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DownloadedProductID(id)
+			return err
+		}),
+
+		// Marketplace Items can be several gigabytes in size, which can take a while to download
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(3 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(1 * time.Hour),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"publisher": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"offer": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"sku": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// when omitted the latest version available is downloaded
+			"version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// the Activation is created when the Azure Stack Hub Stamp is registered, which uses these names by default
+			"resource_group_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "azurestack-activation",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"activation_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "default",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"product_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"product_kind": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func marketplaceDownloadCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	activationName := d.Get("activation_name").(string)
+	activationId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AzureBridge.Admin/activations/%s", client.SubscriptionId, resourceGroup, activationName)
+
+	publisher := d.Get("publisher").(string)
+	offer := d.Get("offer").(string)
+	sku := d.Get("sku").(string)
+	desiredVersion := d.Get("version").(string)
+
+	products, err := client.ProductsClient.ListComplete(ctx, activationId)
+	if err != nil {
+		return fmt.Errorf("listing Marketplace Products for Activation %q (Resource Group %q): %+v", activationName, resourceGroup, err)
+	}
+
+	product, err := findMarketplaceProduct(products, publisher, offer, sku, desiredVersion)
+	if err != nil {
+		return fmt.Errorf("locating Marketplace Product within Activation %q (Resource Group %q): %+v", activationName, resourceGroup, err)
+	}
+
+	id := parse.NewDownloadedProductID(client.SubscriptionId, resourceGroup, activationName, *product.Name)
+
+	existing, err := client.DownloadedProductsClient.Get(ctx, id.ID())
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurestack_marketplace_download", id.ID())
+	}
+
+	payloadLength := int64(0)
+	if props := product.Properties; props != nil && props.PayloadLength != nil {
+		payloadLength = *props.PayloadLength
+	}
+	log.Printf("[INFO] Downloading %s (%d bytes)..", id, payloadLength)
+
+	if _, err := client.ProductsClient.Download(ctx, *product.ID); err != nil {
+		return fmt.Errorf("downloading %s: %+v", id, err)
+	}
+
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Queued", "Downloading"},
+		Target:     []string{"Succeeded"},
+		Refresh:    marketplaceDownloadStateRefreshFunc(ctx, client.DownloadedProductsClient, id, time.Now()),
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for download of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return marketplaceDownloadRead(d, meta)
+}
+
+func marketplaceDownloadRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.DownloadedProductID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DownloadedProductsClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("activation_name", id.ActivationName)
+	d.Set("product_name", id.Name)
+
+	if props := resp.Properties; props != nil {
+		d.Set("publisher", props.PublisherIdentifier)
+		d.Set("offer", props.Offer)
+		d.Set("sku", props.Sku)
+		d.Set("version", marketplaceProductVersion(props.VersionProperties))
+		d.Set("display_name", props.DisplayName)
+		d.Set("product_kind", props.ProductKind)
+	}
+
+	return nil
+}
+
+func marketplaceDownloadDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.DownloadedProductID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DownloadedProductsClient.Delete(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	// the Marketplace Item is removed from the Stamp asynchronously
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Target:  []string{"Deleted"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.DownloadedProductsClient.Get(ctx, id.ID())
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "Deleted", nil
+				}
+
+				return nil, "", fmt.Errorf("polling for %s: %+v", *id, err)
+			}
+
+			return resp, "Deleting", nil
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func marketplaceDownloadStateRefreshFunc(ctx context.Context, client *adminapi.DownloadedProductsClient, id parse.DownloadedProductId, started time.Time) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		elapsed := time.Since(started).Round(time.Second)
+
+		resp, err := client.Get(ctx, id.ID())
+		if err != nil {
+			// the Downloaded Product can take a few moments to become available once the download has been requested
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Waiting for the download of %s to start (%s elapsed)..", id, elapsed)
+				return resp, "Queued", nil
+			}
+
+			return nil, "", fmt.Errorf("polling for %s: %+v", id, err)
+		}
+
+		provisioningState := ""
+		if props := resp.Properties; props != nil && props.ProvisioningState != nil {
+			provisioningState = *props.ProvisioningState
+		}
+
+		switch {
+		case strings.EqualFold(provisioningState, "Succeeded"):
+			log.Printf("[INFO] Download of %s completed after %s", id, elapsed)
+			return resp, "Succeeded", nil
+
+		case strings.EqualFold(provisioningState, "Failed"), strings.EqualFold(provisioningState, "Canceled"):
+			return nil, "", fmt.Errorf("the download of %s finished with the Provisioning State %q", id, provisioningState)
+		}
+
+		log.Printf("[INFO] %s is still downloading (Provisioning State %q, %s elapsed)..", id, provisioningState, elapsed)
+		return resp, "Downloading", nil
+	}
+}
+
+// findMarketplaceProduct returns the Product matching the specified publisher, offer and sku - returning either the
+// specified version, or the latest version when no version is specified
+func findMarketplaceProduct(products []adminapi.Product, publisher, offer, sku, desiredVersion string) (*adminapi.Product, error) {
+	var match *adminapi.Product
+	var matchVersion *version.Version
+	availableVersions := make([]string, 0)
+
+	for i := range products {
+		product := products[i]
+		props := product.Properties
+		if product.ID == nil || product.Name == nil || props == nil {
+			continue
+		}
+
+		if !strings.EqualFold(pointer.ToString(props.PublisherIdentifier), publisher) ||
+			!strings.EqualFold(pointer.ToString(props.Offer), offer) ||
+			!strings.EqualFold(pointer.ToString(props.Sku), sku) {
+			continue
+		}
+
+		productVersion := marketplaceProductVersion(props.VersionProperties)
+		availableVersions = append(availableVersions, productVersion)
+
+		if desiredVersion != "" {
+			if strings.EqualFold(productVersion, desiredVersion) {
+				return &product, nil
+			}
+			continue
+		}
+
+		// versions which can't be parsed are only used when there's nothing else available
+		v, err := version.NewVersion(productVersion)
+		if err != nil {
+			if match == nil {
+				match = &product
+			}
+			continue
+		}
+
+		if matchVersion == nil || v.GreaterThan(matchVersion) {
+			match = &product
+			matchVersion = v
+		}
+	}
+
+	if match == nil {
+		if len(availableVersions) > 0 {
+			return nil, fmt.Errorf("version %q of the Product with Publisher %q / Offer %q / SKU %q was not found - available versions are %s", desiredVersion, publisher, offer, sku, strings.Join(availableVersions, ", "))
+		}
+
+		return nil, fmt.Errorf("no Product was found with Publisher %q / Offer %q / SKU %q", publisher, offer, sku)
+	}
+
+	return match, nil
+}
+
+func marketplaceProductVersion(input *adminapi.ProductVersionProperties) string {
+	if input == nil {
+		return ""
+	}
+
+	return pointer.ToString(input.Version)
+}
@@ -0,0 +1,97 @@
+package admin_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type MarketplaceDownloadResource struct{}
+
+func TestAccMarketplaceDownload_basic(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_marketplace_download", "test")
+	r := MarketplaceDownloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").IsSet(),
+				check.That(data.ResourceName).Key("product_name").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMarketplaceDownload_requiresImport(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_marketplace_download", "test")
+	r := MarketplaceDownloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (MarketplaceDownloadResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DownloadedProductID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Admin.DownloadedProductsClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (MarketplaceDownloadResource) basic(data acceptance.TestData) string {
+	return `
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_marketplace_download" "test" {
+  publisher = "Canonical"
+  offer     = "UbuntuServer"
+  sku       = "18.04-LTS"
+}
+`
+}
+
+func (r MarketplaceDownloadResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_marketplace_download" "import" {
+  publisher           = azurestack_marketplace_download.test.publisher
+  offer               = azurestack_marketplace_download.test.offer
+  sku                 = azurestack_marketplace_download.test.sku
+  version             = azurestack_marketplace_download.test.version
+  resource_group_name = azurestack_marketplace_download.test.resource_group_name
+  activation_name     = azurestack_marketplace_download.test.activation_name
+}
+`, r.basic(data))
+}
@@ -0,0 +1,211 @@
+package admin
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/adminapi"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func networkQuota() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: networkQuotaCreateUpdate,
+		Read:   networkQuotaRead,
+		Update: networkQuotaCreateUpdate,
+		Delete: networkQuotaDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkQuotaID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": commonschema.Location(),
+
+			"max_public_ips_per_subscription": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_virtual_networks_per_subscription": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_virtual_network_gateways_per_subscription": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_virtual_network_gateway_connections_per_subscription": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_load_balancers_per_subscription": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_network_interfaces_per_subscription": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_network_security_groups_per_subscription": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func networkQuotaCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkQuotaID(client.SubscriptionId, location.Normalize(d.Get("location").(string)), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.NetworkQuotasClient.Get(ctx, id.ID())
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_network_quota", id.ID())
+		}
+	}
+
+	parameters := adminapi.NetworkQuota{
+		Location: pointer.FromString(id.LocationName),
+		Properties: &adminapi.NetworkQuotaProperties{
+			MaxPublicIpsPerSubscription:                        utils.Int64(int64(d.Get("max_public_ips_per_subscription").(int))),
+			MaxVnetsPerSubscription:                            utils.Int64(int64(d.Get("max_virtual_networks_per_subscription").(int))),
+			MaxVirtualNetworkGatewaysPerSubscription:           utils.Int64(int64(d.Get("max_virtual_network_gateways_per_subscription").(int))),
+			MaxVirtualNetworkGatewayConnectionsPerSubscription: utils.Int64(int64(d.Get("max_virtual_network_gateway_connections_per_subscription").(int))),
+			MaxLoadBalancersPerSubscription:                    utils.Int64(int64(d.Get("max_load_balancers_per_subscription").(int))),
+			MaxNicsPerSubscription:                             utils.Int64(int64(d.Get("max_network_interfaces_per_subscription").(int))),
+			MaxSecurityGroupsPerSubscription:                   utils.Int64(int64(d.Get("max_network_security_groups_per_subscription").(int))),
+		},
+	}
+
+	if _, err := client.NetworkQuotasClient.CreateOrUpdate(ctx, id.ID(), parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return networkQuotaRead(d, meta)
+}
+
+func networkQuotaRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.NetworkQuotaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.NetworkQuotasClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.QuotaName)
+	d.Set("location", location.Normalize(id.LocationName))
+
+	if props := resp.Properties; props != nil {
+		d.Set("max_public_ips_per_subscription", props.MaxPublicIpsPerSubscription)
+		d.Set("max_virtual_networks_per_subscription", props.MaxVnetsPerSubscription)
+		d.Set("max_virtual_network_gateways_per_subscription", props.MaxVirtualNetworkGatewaysPerSubscription)
+		d.Set("max_virtual_network_gateway_connections_per_subscription", props.MaxVirtualNetworkGatewayConnectionsPerSubscription)
+		d.Set("max_load_balancers_per_subscription", props.MaxLoadBalancersPerSubscription)
+		d.Set("max_network_interfaces_per_subscription", props.MaxNicsPerSubscription)
+		d.Set("max_network_security_groups_per_subscription", props.MaxSecurityGroupsPerSubscription)
+	}
+
+	return nil
+}
+
+func networkQuotaDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.NetworkQuotaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.NetworkQuotasClient.Delete(ctx, id.ID())
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
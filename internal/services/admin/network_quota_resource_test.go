@@ -0,0 +1,137 @@
+package admin_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type NetworkQuotaResource struct{}
+
+func TestAccNetworkQuota_basic(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_network_quota", "test")
+	r := NetworkQuotaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkQuota_requiresImport(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_network_quota", "test")
+	r := NetworkQuotaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkQuota_update(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_network_quota", "test")
+	r := NetworkQuotaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("max_public_ips_per_subscription").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (NetworkQuotaResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkQuotaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Admin.NetworkQuotasClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (NetworkQuotaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_network_quota" "test" {
+  name     = "acctestquota-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkQuotaResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_quota" "import" {
+  name     = azurestack_network_quota.test.name
+  location = azurestack_network_quota.test.location
+}
+`, r.basic(data))
+}
+
+func (NetworkQuotaResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_network_quota" "test" {
+  name                                    = "acctestquota-%d"
+  location                                = %q
+  max_public_ips_per_subscription         = 10
+  max_virtual_networks_per_subscription   = 5
+  max_network_interfaces_per_subscription = 25
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,168 @@
+package admin
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/adminapi"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func offerDelegation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: offerDelegationCreate,
+		Read:   offerDelegationRead,
+		Delete: offerDelegationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.OfferDelegationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"offer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.OfferID,
+			},
+
+			"location": commonschema.Location(),
+
+			// the Delegated Provider Subscription which the Offer should be delegated to
+			"subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func offerDelegationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	offerId, err := parse.OfferID(d.Get("offer_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewOfferDelegationID(offerId.SubscriptionId, offerId.ResourceGroup, offerId.Name, d.Get("name").(string))
+
+	existing, err := client.OfferDelegationsClient.Get(ctx, id.ID())
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurestack_offer_delegation", id.ID())
+	}
+
+	parameters := adminapi.OfferDelegation{
+		Location: pointer.FromString(location.Normalize(d.Get("location").(string))),
+		Properties: &adminapi.OfferDelegationProperties{
+			SubscriptionID: pointer.FromString(d.Get("subscription_id").(string)),
+		},
+	}
+
+	if _, err := client.OfferDelegationsClient.CreateOrUpdate(ctx, id.ID(), parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return offerDelegationRead(d, meta)
+}
+
+func offerDelegationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.OfferDelegationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.OfferDelegationsClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("offer_id", parse.NewOfferID(id.SubscriptionId, id.ResourceGroup, id.OfferName).ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.Properties; props != nil {
+		d.Set("subscription_id", props.SubscriptionID)
+	}
+
+	return nil
+}
+
+func offerDelegationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.OfferDelegationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.OfferDelegationsClient.Delete(ctx, id.ID())
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package admin_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type OfferDelegationResource struct{}
+
+func TestAccOfferDelegation_basic(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_offer_delegation", "test")
+	r := OfferDelegationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_id").HasValue(os.Getenv("ARM_SUBSCRIPTION_ID")),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccOfferDelegation_requiresImport(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_offer_delegation", "test")
+	r := OfferDelegationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (OfferDelegationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.OfferDelegationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Admin.OfferDelegationsClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (OfferDelegationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_offer_delegation" "test" {
+  name            = "acctestdelegation-%d"
+  offer_id        = azurestack_offer.test.id
+  location        = azurestack_offer.test.location
+  subscription_id = data.azurestack_client_config.current.subscription_id
+}
+`, OfferResource{}.basic(data), data.RandomInteger)
+}
+
+func (r OfferDelegationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_offer_delegation" "import" {
+  name            = azurestack_offer_delegation.test.name
+  offer_id        = azurestack_offer_delegation.test.offer_id
+  location        = azurestack_offer_delegation.test.location
+  subscription_id = azurestack_offer_delegation.test.subscription_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,291 @@
+package admin
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/adminapi"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func offer() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: offerCreateUpdate,
+		Read:   offerRead,
+		Update: offerCreateUpdate,
+		Delete: offerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.OfferID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"location": commonschema.Location(),
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"external_reference_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(adminapi.OfferStatePrivate),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(adminapi.OfferStateDecommissioned),
+					string(adminapi.OfferStatePrivate),
+					string(adminapi.OfferStatePublic),
+				}, false),
+			},
+
+			"base_plan_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.PlanID,
+				},
+			},
+
+			"addon_plan": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"plan_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.PlanID,
+						},
+
+						"max_acquisition_count": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"max_subscriptions_per_account": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func offerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id := parse.NewOfferID(client.SubscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.OffersClient.Get(ctx, id.ID())
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_offer", id.ID())
+		}
+	}
+
+	displayName := d.Get("display_name").(string)
+	if displayName == "" {
+		displayName = id.Name
+	}
+
+	parameters := adminapi.Offer{
+		Location: pointer.FromString(location.Normalize(d.Get("location").(string))),
+		Properties: &adminapi.OfferProperties{
+			Name:                       pointer.FromString(id.Name),
+			DisplayName:                pointer.FromString(displayName),
+			Description:                pointer.FromString(d.Get("description").(string)),
+			ExternalReferenceID:        pointer.FromString(d.Get("external_reference_id").(string)),
+			State:                      adminapi.OfferState(d.Get("state").(string)),
+			BasePlanIds:                utils.ExpandStringSlice(d.Get("base_plan_ids").(*pluginsdk.Set).List()),
+			AddonPlans:                 expandOfferAddonPlans(d.Get("addon_plan").([]interface{})),
+			MaxSubscriptionsPerAccount: utils.Int32(int32(d.Get("max_subscriptions_per_account").(int))),
+		},
+	}
+
+	if _, err := client.OffersClient.CreateOrUpdate(ctx, id.ID(), parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return offerRead(d, meta)
+}
+
+func offerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.OfferID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.OffersClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.Properties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+		d.Set("external_reference_id", props.ExternalReferenceID)
+		d.Set("state", string(props.State))
+
+		maxSubscriptionsPerAccount := 0
+		if props.MaxSubscriptionsPerAccount != nil {
+			maxSubscriptionsPerAccount = int(*props.MaxSubscriptionsPerAccount)
+		}
+		d.Set("max_subscriptions_per_account", maxSubscriptionsPerAccount)
+
+		if err := d.Set("base_plan_ids", utils.FlattenStringSlice(props.BasePlanIds)); err != nil {
+			return fmt.Errorf("setting `base_plan_ids`: %+v", err)
+		}
+
+		if err := d.Set("addon_plan", flattenOfferAddonPlans(props.AddonPlans)); err != nil {
+			return fmt.Errorf("setting `addon_plan`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func offerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.OfferID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.OffersClient.Delete(ctx, id.ID())
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandOfferAddonPlans(input []interface{}) *[]adminapi.AddonPlanDefinition {
+	output := make([]adminapi.AddonPlanDefinition, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		raw := v.(map[string]interface{})
+		output = append(output, adminapi.AddonPlanDefinition{
+			PlanID:              pointer.FromString(raw["plan_id"].(string)),
+			MaxAcquisitionCount: utils.Int32(int32(raw["max_acquisition_count"].(int))),
+		})
+	}
+
+	return &output
+}
+
+func flattenOfferAddonPlans(input *[]adminapi.AddonPlanDefinition) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		planId := ""
+		if v.PlanID != nil {
+			planId = *v.PlanID
+		}
+
+		maxAcquisitionCount := 0
+		if v.MaxAcquisitionCount != nil {
+			maxAcquisitionCount = int(*v.MaxAcquisitionCount)
+		}
+
+		output = append(output, map[string]interface{}{
+			"plan_id":               planId,
+			"max_acquisition_count": maxAcquisitionCount,
+		})
+	}
+
+	return output
+}
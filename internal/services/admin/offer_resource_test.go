@@ -0,0 +1,183 @@
+package admin_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type OfferResource struct{}
+
+func TestAccOffer_basic(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_offer", "test")
+	r := OfferResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Private"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccOffer_requiresImport(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_offer", "test")
+	r := OfferResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccOffer_complete(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_offer", "test")
+	r := OfferResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "Public"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Public"),
+				check.That(data.ResourceName).Key("addon_plan.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccOffer_update(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_offer", "test")
+	r := OfferResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "Public"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "Decommissioned"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Decommissioned"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (OfferResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.OfferID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Admin.OffersClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (OfferResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_plan" "base" {
+  name                = "acctestplan-base-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+}
+`, adminTemplate(data), data.RandomInteger)
+}
+
+func (r OfferResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_offer" "test" {
+  name                = "acctestoffer-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+  base_plan_ids       = [azurestack_plan.base.id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r OfferResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_offer" "import" {
+  name                = azurestack_offer.test.name
+  resource_group_name = azurestack_offer.test.resource_group_name
+  location            = azurestack_offer.test.location
+  base_plan_ids       = azurestack_offer.test.base_plan_ids
+}
+`, r.basic(data))
+}
+
+func (r OfferResource) complete(data acceptance.TestData, state string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_plan" "addon" {
+  name                = "acctestplan-addon-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+}
+
+resource "azurestack_offer" "test" {
+  name                          = "acctestoffer-%d"
+  resource_group_name           = azurestack_resource_group.test.name
+  location                      = azurestack_resource_group.test.location
+  display_name                  = "Acceptance Test Offer"
+  description                   = "Created by the Terraform Acceptance Tests"
+  external_reference_id         = "acctest-%d"
+  state                         = %q
+  base_plan_ids                 = [azurestack_plan.base.id]
+  max_subscriptions_per_account = 2
+
+  addon_plan {
+    plan_id               = azurestack_plan.addon.id
+    max_acquisition_count = 3
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger, state)
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DownloadedProductId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ActivationName string
+	Name           string
+}
+
+func NewDownloadedProductID(subscriptionId, resourceGroup, activationName, name string) DownloadedProductId {
+	return DownloadedProductId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ActivationName: activationName,
+		Name:           name,
+	}
+}
+
+func (id DownloadedProductId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Activation Name %q", id.ActivationName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Downloaded Product", segmentsStr)
+}
+
+func (id DownloadedProductId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AzureBridge.Admin/activations/%s/downloadedProducts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ActivationName, id.Name)
+}
+
+// DownloadedProductID parses a DownloadedProduct ID into an DownloadedProductId struct
+func DownloadedProductID(input string) (*DownloadedProductId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DownloadedProductId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ActivationName, err = id.PopSegment("activations"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("downloadedProducts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
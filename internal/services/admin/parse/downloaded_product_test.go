@@ -0,0 +1,128 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = DownloadedProductId{}
+
+func TestDownloadedProductIDFormatter(t *testing.T) {
+	actual := NewDownloadedProductID("12345678-1234-9876-4563-123456789012", "azurestack-activation", "default", "product1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/azurestack-activation/providers/Microsoft.AzureBridge.Admin/activations/default/downloadedProducts/product1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestDownloadedProductID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DownloadedProductId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing ActivationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/azurestack-activation/providers/Microsoft.AzureBridge.Admin/",
+			Error: true,
+		},
+
+		{
+			// missing value for ActivationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/azurestack-activation/providers/Microsoft.AzureBridge.Admin/activations/",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/azurestack-activation/providers/Microsoft.AzureBridge.Admin/activations/default/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/azurestack-activation/providers/Microsoft.AzureBridge.Admin/activations/default/downloadedProducts/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/azurestack-activation/providers/Microsoft.AzureBridge.Admin/activations/default/downloadedProducts/product1",
+			Expected: &DownloadedProductId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "azurestack-activation",
+				ActivationName: "default",
+				Name:           "product1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/AZURESTACK-ACTIVATION/PROVIDERS/MICROSOFT.AZUREBRIDGE.ADMIN/ACTIVATIONS/DEFAULT/DOWNLOADEDPRODUCTS/PRODUCT1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := DownloadedProductID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.ActivationName != v.Expected.ActivationName {
+			t.Fatalf("Expected %q but got %q for ActivationName", v.Expected.ActivationName, actual.ActivationName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type OfferDelegationId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	OfferName      string
+	Name           string
+}
+
+func NewOfferDelegationID(subscriptionId, resourceGroup, offerName, name string) OfferDelegationId {
+	return OfferDelegationId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		OfferName:      offerName,
+		Name:           name,
+	}
+}
+
+func (id OfferDelegationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Offer Name %q", id.OfferName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Offer Delegation", segmentsStr)
+}
+
+func (id OfferDelegationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Subscriptions.Admin/offers/%s/offerDelegations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.OfferName, id.Name)
+}
+
+// OfferDelegationID parses a OfferDelegation ID into an OfferDelegationId struct
+func OfferDelegationID(input string) (*OfferDelegationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := OfferDelegationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.OfferName, err = id.PopSegment("offers"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("offerDelegations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,67 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageQuotaId struct {
+	SubscriptionId string
+	LocationName   string
+	QuotaName      string
+}
+
+func NewStorageQuotaID(subscriptionId, locationName, quotaName string) StorageQuotaId {
+	return StorageQuotaId{
+		SubscriptionId: subscriptionId,
+		LocationName:   locationName,
+		QuotaName:      quotaName,
+	}
+}
+
+func (id StorageQuotaId) String() string {
+	segments := []string{
+		fmt.Sprintf("Quota Name %q", id.QuotaName),
+		fmt.Sprintf("Location Name %q", id.LocationName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Quota", segmentsStr)
+}
+
+func (id StorageQuotaId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Storage.Admin/locations/%s/quotas/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.LocationName, id.QuotaName)
+}
+
+// StorageQuotaID parses a StorageQuota ID into an StorageQuotaId struct
+func StorageQuotaID(input string) (*StorageQuotaId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StorageQuotaId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.LocationName, err = id.PopSegment("locations"); err != nil {
+		return nil, err
+	}
+	if resourceId.QuotaName, err = id.PopSegment("quotas"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,112 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = StorageQuotaId{}
+
+func TestStorageQuotaIDFormatter(t *testing.T) {
+	actual := NewStorageQuotaID("12345678-1234-9876-4563-123456789012", "local", "quota1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage.Admin/locations/local/quotas/quota1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageQuotaID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *StorageQuotaId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing LocationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage.Admin/",
+			Error: true,
+		},
+
+		{
+			// missing value for LocationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage.Admin/locations/",
+			Error: true,
+		},
+
+		{
+			// missing QuotaName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage.Admin/locations/local/",
+			Error: true,
+		},
+
+		{
+			// missing value for QuotaName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage.Admin/locations/local/quotas/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage.Admin/locations/local/quotas/quota1",
+			Expected: &StorageQuotaId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				LocationName:   "local",
+				QuotaName:      "quota1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.STORAGE.ADMIN/LOCATIONS/LOCAL/QUOTAS/QUOTA1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageQuotaID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.LocationName != v.Expected.LocationName {
+			t.Fatalf("Expected %q but got %q for LocationName", v.Expected.LocationName, actual.LocationName)
+		}
+		if actual.QuotaName != v.Expected.QuotaName {
+			t.Fatalf("Expected %q but got %q for QuotaName", v.Expected.QuotaName, actual.QuotaName)
+		}
+	}
+}
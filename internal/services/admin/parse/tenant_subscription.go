@@ -0,0 +1,61 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type TenantSubscriptionId struct {
+	SubscriptionId   string
+	SubscriptionName string
+}
+
+func NewTenantSubscriptionID(subscriptionId, subscriptionName string) TenantSubscriptionId {
+	return TenantSubscriptionId{
+		SubscriptionId:   subscriptionId,
+		SubscriptionName: subscriptionName,
+	}
+}
+
+func (id TenantSubscriptionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Subscription Name %q", id.SubscriptionName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Tenant Subscription", segmentsStr)
+}
+
+func (id TenantSubscriptionId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Subscriptions.Admin/subscriptions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.SubscriptionName)
+}
+
+// TenantSubscriptionID parses a TenantSubscription ID into an TenantSubscriptionId struct
+func TenantSubscriptionID(input string) (*TenantSubscriptionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := TenantSubscriptionId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.SubscriptionName, err = id.PopSegment("subscriptions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
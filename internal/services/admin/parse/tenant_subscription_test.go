@@ -0,0 +1,96 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = TenantSubscriptionId{}
+
+func TestTenantSubscriptionIDFormatter(t *testing.T) {
+	actual := NewTenantSubscriptionID("12345678-1234-9876-4563-123456789012", "00000000-0000-0000-0000-000000000000").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Subscriptions.Admin/subscriptions/00000000-0000-0000-0000-000000000000"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestTenantSubscriptionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *TenantSubscriptionId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionName
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Subscriptions.Admin/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Subscriptions.Admin/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: &TenantSubscriptionId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				SubscriptionName: "00000000-0000-0000-0000-000000000000",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.SUBSCRIPTIONS.ADMIN/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := TenantSubscriptionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.SubscriptionName != v.Expected.SubscriptionName {
+			t.Fatalf("Expected %q but got %q for SubscriptionName", v.Expected.SubscriptionName, actual.SubscriptionName)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package admin_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type PlanResource struct{}
+
+func TestAccPlan_basic(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_plan", "test")
+	r := PlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestplan-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPlan_requiresImport(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_plan", "test")
+	r := PlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPlan_complete(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_plan", "test")
+	r := PlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("Acceptance Test Plan"),
+				check.That(data.ResourceName).Key("quota_ids.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPlan_update(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_plan", "test")
+	r := PlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PlanResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PlanID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Admin.PlansClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+// adminTemplate provisions a Resource Group within the Default Provider Subscription, which is
+// where the Admin resources (such as Plans and Offers) are provisioned
+func adminTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+provider "azurestack" {
+  alias                      = "admin"
+  arm_endpoint               = %q
+  subscription_id            = %q
+  skip_provider_registration = true
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  provider = azurestack.admin
+  name     = "acctestRG-admin-%d"
+  location = %q
+}
+`, os.Getenv("ARM_ADMIN_ENDPOINT"), os.Getenv("ARM_ADMIN_SUBSCRIPTION_ID"), data.RandomInteger, data.Locations.Primary)
+}
+
+func (PlanResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_plan" "test" {
+  name                = "acctestplan-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+}
+`, adminTemplate(data), data.RandomInteger)
+}
+
+func (r PlanResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_plan" "import" {
+  name                = azurestack_plan.test.name
+  resource_group_name = azurestack_plan.test.resource_group_name
+  location            = azurestack_plan.test.location
+}
+`, r.basic(data))
+}
+
+func (PlanResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_compute_quota" "test" {
+  name     = "acctestquota-%d"
+  location = azurestack_resource_group.test.location
+}
+
+resource "azurestack_network_quota" "test" {
+  name     = "acctestquota-%d"
+  location = azurestack_resource_group.test.location
+}
+
+resource "azurestack_storage_quota" "test" {
+  name     = "acctestquota-%d"
+  location = azurestack_resource_group.test.location
+}
+
+resource "azurestack_plan" "test" {
+  name                  = "acctestplan-%d"
+  resource_group_name   = azurestack_resource_group.test.name
+  location              = azurestack_resource_group.test.location
+  display_name          = "Acceptance Test Plan"
+  description           = "Created by the Terraform Acceptance Tests"
+  external_reference_id = "acctest-%d"
+
+  quota_ids = [
+    azurestack_compute_quota.test.id,
+    azurestack_network_quota.test.id,
+    azurestack_storage_quota.test.id,
+  ]
+}
+`, adminTemplate(data), data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
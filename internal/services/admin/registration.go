@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Admin"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Admin",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurestack_compute_quota":        computeQuota(),
+		"azurestack_marketplace_download": marketplaceDownload(),
+		"azurestack_network_quota":        networkQuota(),
+		"azurestack_offer":                offer(),
+		"azurestack_offer_delegation":     offerDelegation(),
+		"azurestack_plan":                 plan(),
+		"azurestack_storage_quota":        storageQuota(),
+		"azurestack_tenant_subscription":  tenantSubscription(),
+	}
+}
@@ -0,0 +1,10 @@
+package admin
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ComputeQuota -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Compute.Admin/locations/local/quotas/quota1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DownloadedProduct -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/azurestack-activation/providers/Microsoft.AzureBridge.Admin/activations/default/downloadedProducts/product1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NetworkQuota -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Network.Admin/locations/local/quotas/quota1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Offer -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Subscriptions.Admin/offers/offer1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=OfferDelegation -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Subscriptions.Admin/offers/offer1/offerDelegations/delegation1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Plan -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Subscriptions.Admin/plans/plan1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=StorageQuota -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Storage.Admin/locations/local/quotas/quota1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=TenantSubscription -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Subscriptions.Admin/subscriptions/00000000-0000-0000-0000-000000000000
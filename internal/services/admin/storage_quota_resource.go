@@ -0,0 +1,166 @@
+package admin
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/adminapi"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func storageQuota() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: storageQuotaCreateUpdate,
+		Read:   storageQuotaRead,
+		Update: storageQuotaCreateUpdate,
+		Delete: storageQuotaDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageQuotaID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": commonschema.Location(),
+
+			"number_of_storage_accounts": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"capacity_in_gb": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      2048,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func storageQuotaCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id := parse.NewStorageQuotaID(client.SubscriptionId, location.Normalize(d.Get("location").(string)), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.StorageQuotasClient.Get(ctx, id.ID())
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_storage_quota", id.ID())
+		}
+	}
+
+	parameters := adminapi.StorageQuota{
+		Location: pointer.FromString(id.LocationName),
+		Properties: &adminapi.StorageQuotaProperties{
+			NumberOfStorageAccounts: utils.Int32(int32(d.Get("number_of_storage_accounts").(int))),
+			CapacityInGb:            utils.Int32(int32(d.Get("capacity_in_gb").(int))),
+		},
+	}
+
+	if _, err := client.StorageQuotasClient.CreateOrUpdate(ctx, id.ID(), parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return storageQuotaRead(d, meta)
+}
+
+func storageQuotaRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.StorageQuotaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.StorageQuotasClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.QuotaName)
+	d.Set("location", location.Normalize(id.LocationName))
+
+	if props := resp.Properties; props != nil {
+		d.Set("number_of_storage_accounts", props.NumberOfStorageAccounts)
+		d.Set("capacity_in_gb", props.CapacityInGb)
+	}
+
+	return nil
+}
+
+func storageQuotaDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.StorageQuotaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.StorageQuotasClient.Delete(ctx, id.ID())
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
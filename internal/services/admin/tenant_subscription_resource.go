@@ -0,0 +1,225 @@
+package admin
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/adminapi"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func tenantSubscription() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: tenantSubscriptionCreateUpdate,
+		Read:   tenantSubscriptionRead,
+		Update: tenantSubscriptionCreateUpdate,
+		Delete: tenantSubscriptionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.TenantSubscriptionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"offer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.OfferID,
+			},
+
+			// the User Principal Name of the Tenant user who owns this Subscription
+			"owner": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// when omitted a Subscription ID is generated, which is exported so that it can be used
+			// to configure an aliased Provider block for the new Subscription
+			"subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(adminapi.SubscriptionStateEnabled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(adminapi.SubscriptionStateDisabled),
+					string(adminapi.SubscriptionStateEnabled),
+					string(adminapi.SubscriptionStateWarned),
+				}, false),
+			},
+
+			"delegated_provider_subscription_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func tenantSubscriptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	subscriptionId := d.Get("subscription_id").(string)
+	if subscriptionId == "" {
+		uuid, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("generating UUID for Tenant Subscription: %+v", err)
+		}
+
+		subscriptionId = uuid
+	}
+
+	id := parse.NewTenantSubscriptionID(client.SubscriptionId, subscriptionId)
+
+	if d.IsNewResource() {
+		existing, err := client.TenantSubscriptionsClient.Get(ctx, id.ID())
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_tenant_subscription", id.ID())
+		}
+	}
+
+	properties := adminapi.TenantSubscriptionProperties{
+		SubscriptionID: pointer.FromString(id.SubscriptionName),
+		DisplayName:    pointer.FromString(d.Get("display_name").(string)),
+		OfferID:        pointer.FromString(d.Get("offer_id").(string)),
+		Owner:          pointer.FromString(d.Get("owner").(string)),
+		State:          adminapi.SubscriptionState(d.Get("state").(string)),
+	}
+
+	if v := d.Get("tenant_id").(string); v != "" {
+		properties.TenantID = pointer.FromString(v)
+	}
+
+	parameters := adminapi.TenantSubscription{
+		Properties: &properties,
+	}
+
+	if _, err := client.TenantSubscriptionsClient.CreateOrUpdate(ctx, id.ID(), parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return tenantSubscriptionRead(d, meta)
+}
+
+func tenantSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.TenantSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.TenantSubscriptionsClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("subscription_id", id.SubscriptionName)
+
+	if props := resp.Properties; props != nil {
+		// a Subscription which has been deleted can continue to be returned by the API for a period
+		if props.State == adminapi.SubscriptionStateDeleted {
+			log.Printf("[DEBUG] %s has been deleted - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("display_name", props.DisplayName)
+		d.Set("offer_id", props.OfferID)
+		d.Set("owner", props.Owner)
+		d.Set("tenant_id", props.TenantID)
+		d.Set("state", string(props.State))
+		d.Set("delegated_provider_subscription_id", props.DelegatedProviderSubscriptionID)
+	}
+
+	return nil
+}
+
+func tenantSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Admin
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if err := client.EnsureConfigured(); err != nil {
+		return err
+	}
+
+	id, err := parse.TenantSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.TenantSubscriptionsClient.Delete(ctx, id.ID())
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
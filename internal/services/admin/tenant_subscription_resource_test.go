@@ -0,0 +1,138 @@
+package admin_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/admin/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type TenantSubscriptionResource struct{}
+
+func TestAccTenantSubscription_basic(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_tenant_subscription", "test")
+	r := TenantSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_id").IsSet(),
+				check.That(data.ResourceName).Key("state").HasValue("Enabled"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTenantSubscription_requiresImport(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_tenant_subscription", "test")
+	r := TenantSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccTenantSubscription_complete(t *testing.T) {
+	acceptance.PreCheckAdmin(t)
+	data := acceptance.BuildTestData(t, "azurestack_tenant_subscription", "test")
+	r := TenantSubscriptionResource{}
+
+	subscriptionId, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatalf("generating UUID: %+v", err)
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, subscriptionId, "Enabled"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_id").HasValue(subscriptionId),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, subscriptionId, "Disabled"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Disabled"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (TenantSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.TenantSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Admin.TenantSubscriptionsClient.Get(ctx, id.ID())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (TenantSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_tenant_subscription" "test" {
+  display_name = "acctestsub-%d"
+  offer_id     = azurestack_offer.test.id
+  owner        = "acctestuser-%d@example.com"
+}
+`, OfferResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r TenantSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_tenant_subscription" "import" {
+  subscription_id = azurestack_tenant_subscription.test.subscription_id
+  display_name    = azurestack_tenant_subscription.test.display_name
+  offer_id        = azurestack_tenant_subscription.test.offer_id
+  owner           = azurestack_tenant_subscription.test.owner
+}
+`, r.basic(data))
+}
+
+func (TenantSubscriptionResource) complete(data acceptance.TestData, subscriptionId, state string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_tenant_subscription" "test" {
+  subscription_id = %q
+  display_name    = "acctestsub-%d"
+  offer_id        = azurestack_offer.test.id
+  owner           = "acctestuser-%d@example.com"
+  state           = %q
+}
+`, OfferResource{}.basic(data), subscriptionId, data.RandomInteger, data.RandomInteger, state)
+}
@@ -0,0 +1,124 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func azureADApplicationDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: azureADApplicationDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"display_name", "application_id"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"display_name", "application_id"},
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"object_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"identifier_uris": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"reply_urls": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func azureADApplicationDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.ApplicationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	var filter string
+	if applicationId := d.Get("application_id").(string); applicationId != "" {
+		filter = fmt.Sprintf("appId eq '%s'", applicationId)
+	} else {
+		filter = fmt.Sprintf("displayName eq '%s'", escapeGraphFilterValue(d.Get("display_name").(string)))
+	}
+
+	application, err := findApplication(ctx, client, filter)
+	if err != nil {
+		return err
+	}
+
+	if application.ObjectID == nil {
+		return fmt.Errorf("retrieving Application with filter %q: `objectId` was nil", filter)
+	}
+
+	d.SetId(*application.ObjectID)
+	d.Set("object_id", application.ObjectID)
+	d.Set("application_id", application.AppID)
+	d.Set("display_name", application.DisplayName)
+
+	if err := d.Set("identifier_uris", utils.FlattenStringSlice(application.IdentifierUris)); err != nil {
+		return fmt.Errorf("setting `identifier_uris`: %+v", err)
+	}
+
+	if err := d.Set("reply_urls", utils.FlattenStringSlice(application.ReplyUrls)); err != nil {
+		return fmt.Errorf("setting `reply_urls`: %+v", err)
+	}
+
+	return nil
+}
+
+func findApplication(ctx context.Context, client *graphrbac.ApplicationsClient, filter string) (*graphrbac.Application, error) {
+	iterator, err := client.ListComplete(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing Applications with filter %q: %+v", filter, err)
+	}
+
+	results := make([]graphrbac.Application, 0)
+	for iterator.NotDone() {
+		results = append(results, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Applications with filter %q: %+v", filter, err)
+		}
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no Applications were found with filter %q", filter)
+	}
+	if len(results) > 1 {
+		return nil, fmt.Errorf("expected a single Application with filter %q but got %d", filter, len(results))
+	}
+
+	return &results[0], nil
+}
@@ -0,0 +1,72 @@
+package authorization_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type AzureADApplicationDataSource struct{}
+
+func TestAccAzureADApplicationDataSource_byApplicationId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_azuread_application", "test")
+	clientId := os.Getenv("ARM_CLIENT_ID")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AzureADApplicationDataSource{}.byApplicationId(clientId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_id").HasValue(clientId),
+				check.That(data.ResourceName).Key("object_id").Exists(),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("identifier_uris.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccAzureADApplicationDataSource_byDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_azuread_application", "test")
+	clientId := os.Getenv("ARM_CLIENT_ID")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AzureADApplicationDataSource{}.byDisplayName(clientId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_id").HasValue(clientId),
+				check.That(data.ResourceName).Key("object_id").Exists(),
+			),
+		},
+	})
+}
+
+func (AzureADApplicationDataSource) byApplicationId(applicationId string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_azuread_application" "test" {
+  application_id = %q
+}
+`, applicationId)
+}
+
+func (AzureADApplicationDataSource) byDisplayName(applicationId string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_azuread_application" "client" {
+  application_id = %q
+}
+
+data "azurestack_azuread_application" "test" {
+  display_name = data.azurestack_azuread_application.client.display_name
+}
+`, applicationId)
+}
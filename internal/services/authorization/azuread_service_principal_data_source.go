@@ -0,0 +1,130 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func azureADServicePrincipalDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: azureADServicePrincipalDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"display_name", "application_id"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"display_name", "application_id"},
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"object_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"application_tenant_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"account_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"service_principal_names": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func azureADServicePrincipalDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.ServicePrincipalsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	var filter string
+	if applicationId := d.Get("application_id").(string); applicationId != "" {
+		filter = fmt.Sprintf("appId eq '%s'", applicationId)
+	} else {
+		filter = fmt.Sprintf("displayName eq '%s'", escapeGraphFilterValue(d.Get("display_name").(string)))
+	}
+
+	servicePrincipal, err := findServicePrincipal(ctx, client, filter)
+	if err != nil {
+		return err
+	}
+
+	if servicePrincipal.ObjectID == nil {
+		return fmt.Errorf("retrieving Service Principal with filter %q: `objectId` was nil", filter)
+	}
+
+	d.SetId(*servicePrincipal.ObjectID)
+	d.Set("object_id", servicePrincipal.ObjectID)
+	d.Set("application_id", servicePrincipal.AppID)
+	d.Set("display_name", servicePrincipal.DisplayName)
+	d.Set("application_tenant_id", servicePrincipal.AppOwnerTenantID)
+	d.Set("account_enabled", servicePrincipal.AccountEnabled)
+
+	if err := d.Set("service_principal_names", utils.FlattenStringSlice(servicePrincipal.ServicePrincipalNames)); err != nil {
+		return fmt.Errorf("setting `service_principal_names`: %+v", err)
+	}
+
+	return nil
+}
+
+func findServicePrincipal(ctx context.Context, client *graphrbac.ServicePrincipalsClient, filter string) (*graphrbac.ServicePrincipal, error) {
+	iterator, err := client.ListComplete(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing Service Principals with filter %q: %+v", filter, err)
+	}
+
+	results := make([]graphrbac.ServicePrincipal, 0)
+	for iterator.NotDone() {
+		results = append(results, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Service Principals with filter %q: %+v", filter, err)
+		}
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no Service Principals were found with filter %q", filter)
+	}
+	if len(results) > 1 {
+		return nil, fmt.Errorf("expected a single Service Principal with filter %q but got %d", filter, len(results))
+	}
+
+	return &results[0], nil
+}
+
+// escapeGraphFilterValue escapes a value for use within a single-quoted string in an OData filter
+func escapeGraphFilterValue(input string) string {
+	return strings.ReplaceAll(input, "'", "''")
+}
@@ -0,0 +1,72 @@
+package authorization_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type AzureADServicePrincipalDataSource struct{}
+
+func TestAccAzureADServicePrincipalDataSource_byApplicationId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_azuread_service_principal", "test")
+	clientId := os.Getenv("ARM_CLIENT_ID")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AzureADServicePrincipalDataSource{}.byApplicationId(clientId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_id").HasValue(clientId),
+				check.That(data.ResourceName).Key("object_id").Exists(),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("service_principal_names.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccAzureADServicePrincipalDataSource_byDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_azuread_service_principal", "test")
+	clientId := os.Getenv("ARM_CLIENT_ID")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AzureADServicePrincipalDataSource{}.byDisplayName(clientId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_id").HasValue(clientId),
+				check.That(data.ResourceName).Key("object_id").Exists(),
+			),
+		},
+	})
+}
+
+func (AzureADServicePrincipalDataSource) byApplicationId(applicationId string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_azuread_service_principal" "test" {
+  application_id = %q
+}
+`, applicationId)
+}
+
+func (AzureADServicePrincipalDataSource) byDisplayName(applicationId string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_azuread_service_principal" "client" {
+  application_id = %q
+}
+
+data "azurestack_azuread_service_principal" "test" {
+  display_name = data.azurestack_azuread_service_principal.client.display_name
+}
+`, applicationId)
+}
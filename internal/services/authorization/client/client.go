@@ -1,19 +1,35 @@
 package client
 
 import (
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/authorization/mgmt/authorization"
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
 )
 
 type Client struct {
+	ApplicationsClient      *graphrbac.ApplicationsClient
+	RoleAssignmentsClient   *authorization.RoleAssignmentsClient
+	RoleDefinitionsClient   *authorization.RoleDefinitionsClient
 	ServicePrincipalsClient *graphrbac.ServicePrincipalsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	applicationsClient := graphrbac.NewApplicationsClientWithBaseURI(o.GraphEndpoint, o.TenantID)
+	o.ConfigureClient(&applicationsClient.Client, o.GraphAuthorizer)
+
+	roleAssignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&roleAssignmentsClient.Client, o.ResourceManagerAuthorizer)
+
+	roleDefinitionsClient := authorization.NewRoleDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&roleDefinitionsClient.Client, o.ResourceManagerAuthorizer)
+
 	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(o.GraphEndpoint, o.TenantID)
 	o.ConfigureClient(&servicePrincipalsClient.Client, o.GraphAuthorizer)
 
 	return &Client{
+		ApplicationsClient:      &applicationsClient,
+		RoleAssignmentsClient:   &roleAssignmentsClient,
+		RoleDefinitionsClient:   &roleDefinitionsClient,
 		ServicePrincipalsClient: &servicePrincipalsClient,
 	}
 }
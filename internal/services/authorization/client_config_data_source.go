@@ -59,6 +59,8 @@ func clientConfigRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	objectId := client.Account.ObjectId
+
 	if client.Account.AuthenticatedAsAServicePrincipal {
 		spClient := client.Authorization.ServicePrincipalsClient
 		// Application & Service Principal is 1:1 per tenant. Since we know the appId (client_id)
@@ -78,12 +80,17 @@ func clientConfigRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		if principal := servicePrincipal; principal != nil {
 			d.Set("service_principal_application_id", principal.AppID)
 			d.Set("service_principal_object_id", principal.ObjectID)
+
+			// not all access tokens contain the Object ID (e.g. those issued by AD FS)
+			if objectId == "" && principal.ObjectID != nil {
+				objectId = *principal.ObjectID
+			}
 		}
 	}
 
 	d.SetId(time.Now().UTC().String())
 	d.Set("client_id", client.Account.ClientId)
-	d.Set("object_id", client.Account.ObjectId)
+	d.Set("object_id", objectId)
 	d.Set("subscription_id", client.Account.SubscriptionId)
 	d.Set("tenant_id", client.Account.TenantId)
 
@@ -0,0 +1,58 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NOTE: this is manually maintained since Role Assignments can exist at any Scope, which the generator doesn't support
+
+const roleAssignmentsSegment = "/providers/Microsoft.Authorization/roleAssignments/"
+
+type RoleAssignmentId struct {
+	Scope string
+	Name  string
+}
+
+func NewRoleAssignmentID(scope, name string) RoleAssignmentId {
+	return RoleAssignmentId{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+func (id RoleAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Role Assignment", segmentsStr)
+}
+
+func (id RoleAssignmentId) ID() string {
+	return fmt.Sprintf("%s%s%s", id.Scope, roleAssignmentsSegment, id.Name)
+}
+
+// RoleAssignmentID parses a RoleAssignment ID into an RoleAssignmentId struct
+func RoleAssignmentID(input string) (*RoleAssignmentId, error) {
+	index := strings.LastIndex(strings.ToLower(input), strings.ToLower(roleAssignmentsSegment))
+	if index == -1 {
+		return nil, fmt.Errorf("expected the ID %q to be in the format `{scope}%s{name}`", input, roleAssignmentsSegment)
+	}
+
+	id := RoleAssignmentId{
+		Scope: input[:index],
+		Name:  input[index+len(roleAssignmentsSegment):],
+	}
+
+	if id.Scope == "" || !strings.HasPrefix(id.Scope, "/") {
+		return nil, fmt.Errorf("ID was missing the `scope` element")
+	}
+
+	if id.Name == "" || strings.Contains(id.Name, "/") {
+		return nil, fmt.Errorf("ID was missing the `roleAssignments` element")
+	}
+
+	return &id, nil
+}
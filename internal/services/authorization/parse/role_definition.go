@@ -0,0 +1,67 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NOTE: this is manually maintained since the ID of a Role Definition doesn't include the Scope at which it was
+// created - which is required to retrieve it, as such these are stored in the format `{roleDefinitionResourceId}|{scope}`
+
+const roleDefinitionsSegment = "/providers/Microsoft.Authorization/roleDefinitions/"
+
+type RoleDefinitionId struct {
+	ResourceID string
+	Scope      string
+	RoleID     string
+}
+
+func NewRoleDefinitionID(resourceId, scope, roleId string) RoleDefinitionId {
+	return RoleDefinitionId{
+		ResourceID: resourceId,
+		Scope:      scope,
+		RoleID:     roleId,
+	}
+}
+
+func (id RoleDefinitionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Role ID %q", id.RoleID),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Role Definition", segmentsStr)
+}
+
+func (id RoleDefinitionId) ID() string {
+	return fmt.Sprintf("%s|%s", id.ResourceID, id.Scope)
+}
+
+// RoleDefinitionID parses a RoleDefinition ID into an RoleDefinitionId struct
+func RoleDefinitionID(input string) (*RoleDefinitionId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected the ID %q to be in the format `{roleDefinitionId}|{scope}`", input)
+	}
+
+	id := RoleDefinitionId{
+		ResourceID: segments[0],
+		Scope:      segments[1],
+	}
+
+	if id.Scope == "" || !strings.HasPrefix(id.Scope, "/") {
+		return nil, fmt.Errorf("ID was missing the `scope` element")
+	}
+
+	index := strings.LastIndex(strings.ToLower(id.ResourceID), strings.ToLower(roleDefinitionsSegment))
+	if index == -1 {
+		return nil, fmt.Errorf("expected the Role Definition ID %q to contain %q", id.ResourceID, roleDefinitionsSegment)
+	}
+
+	id.RoleID = id.ResourceID[index+len(roleDefinitionsSegment):]
+	if id.RoleID == "" || strings.Contains(id.RoleID, "/") {
+		return nil, fmt.Errorf("ID was missing the `roleDefinitions` element")
+	}
+
+	return &id, nil
+}
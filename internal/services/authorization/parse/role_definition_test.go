@@ -0,0 +1,82 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestRoleDefinitionIDFormatter(t *testing.T) {
+	actual := NewRoleDefinitionID("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121", "/subscriptions/12345678-1234-9876-4563-123456789012", "23456781-2349-8764-5631-234567890121").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121|/subscriptions/12345678-1234-9876-4563-123456789012"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRoleDefinitionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *RoleDefinitionId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121",
+			Error: true,
+		},
+
+		{
+			// missing value for Scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121|",
+			Error: true,
+		},
+
+		{
+			// missing value for RoleID
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/|/subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Expected: &RoleDefinitionId{
+				ResourceID: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/23456781-2349-8764-5631-234567890121",
+				Scope:      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				RoleID:     "23456781-2349-8764-5631-234567890121",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := RoleDefinitionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ResourceID != v.Expected.ResourceID {
+			t.Fatalf("Expected %q but got %q for ResourceID", v.Expected.ResourceID, actual.ResourceID)
+		}
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.RoleID != v.Expected.RoleID {
+			t.Fatalf("Expected %q but got %q for RoleID", v.Expected.RoleID, actual.RoleID)
+		}
+	}
+}
@@ -21,11 +21,17 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_client_config": clientConfigDataSource(),
+		"azurestack_azuread_application":       azureADApplicationDataSource(),
+		"azurestack_azuread_service_principal": azureADServicePrincipalDataSource(),
+		"azurestack_client_config":             clientConfigDataSource(),
+		"azurestack_role_definition":           roleDefinitionDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azurestack_role_assignment": roleAssignment(),
+		"azurestack_role_definition": roleDefinition(),
+	}
 }
@@ -0,0 +1,298 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/authorization/mgmt/authorization"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func roleAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: roleAssignmentCreate,
+		Read:   roleAssignmentRead,
+		Delete: roleAssignmentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RoleAssignmentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"scope": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"role_definition_id": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"role_definition_name"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"role_definition_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"role_definition_id"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"principal_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func roleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	roleDefinitionsClient := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scope := d.Get("scope").(string)
+	principalId := d.Get("principal_id").(string)
+
+	roleDefinitionId := ""
+	if v, ok := d.GetOk("role_definition_id"); ok {
+		roleDefinitionId = v.(string)
+	} else if v, ok := d.GetOk("role_definition_name"); ok {
+		roleName := v.(string)
+		roleDefinition, err := findRoleDefinitionByName(ctx, roleDefinitionsClient, scope, roleName)
+		if err != nil {
+			return fmt.Errorf("looking up Role Definition ID for Role %q: %+v", roleName, err)
+		}
+		if roleDefinition.ID == nil {
+			return fmt.Errorf("looking up Role Definition ID for Role %q: `id` was nil", roleName)
+		}
+		roleDefinitionId = *roleDefinition.ID
+	}
+
+	if roleDefinitionId == "" {
+		return fmt.Errorf("either `role_definition_id` or `role_definition_name` must be set")
+	}
+
+	name := d.Get("name").(string)
+	if name == "" {
+		uuid, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("generating UUID for Role Assignment: %+v", err)
+		}
+
+		name = uuid
+	}
+
+	id := parse.NewRoleAssignmentID(scope, name)
+
+	existing, err := client.Get(ctx, id.Scope, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurestack_role_assignment", *existing.ID)
+	}
+
+	properties := authorization.RoleAssignmentCreateParameters{
+		Properties: &authorization.RoleAssignmentProperties{
+			RoleDefinitionID: pointer.FromString(roleDefinitionId),
+			PrincipalID:      pointer.FromString(principalId),
+		},
+	}
+
+	// the Principal can take a while to replicate from Azure Active Directory (or ADFS) into Azure Stack Hub
+	if err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), retryRoleAssignmentsClient(ctx, client, id, properties)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	read, err := client.Get(ctx, id.Scope, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("retrieving %s: `id` was nil", id)
+	}
+
+	d.SetId(*read.ID)
+
+	return roleAssignmentRead(d, meta)
+}
+
+func roleAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	roleDefinitionsClient := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetByID(ctx, d.Id())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", resp.Name)
+
+	if props := resp.Properties; props != nil {
+		scope := id.Scope
+		if props.Scope != nil && *props.Scope != "" {
+			scope = *props.Scope
+		}
+		d.Set("scope", scope)
+		d.Set("role_definition_id", props.RoleDefinitionID)
+		d.Set("principal_id", props.PrincipalID)
+
+		// the Role Definition Name is looked up so that this is consistent regardless of how it was specified
+		roleName := ""
+		if props.RoleDefinitionID != nil {
+			role, err := roleDefinitionsClient.GetByID(ctx, *props.RoleDefinitionID)
+			if err != nil {
+				return fmt.Errorf("retrieving Role Definition %q: %+v", *props.RoleDefinitionID, err)
+			}
+
+			if roleProps := role.RoleDefinitionProperties; roleProps != nil && roleProps.RoleName != nil {
+				roleName = *roleProps.RoleName
+			}
+		}
+		d.Set("role_definition_name", roleName)
+	}
+
+	return nil
+}
+
+func roleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.Scope, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func retryRoleAssignmentsClient(ctx context.Context, client *authorization.RoleAssignmentsClient, id parse.RoleAssignmentId, properties authorization.RoleAssignmentCreateParameters) func() *pluginsdk.RetryError {
+	return func() *pluginsdk.RetryError {
+		resp, err := client.Create(ctx, id.Scope, id.Name, properties)
+		if err != nil {
+			if utils.ResponseErrorIsRetryable(err) {
+				return pluginsdk.RetryableError(err)
+			} else if utils.ResponseWasBadRequest(resp.Response) && strings.Contains(err.Error(), "PrincipalNotFound") {
+				// the Principal hasn't replicated yet
+				return pluginsdk.RetryableError(err)
+			}
+
+			return pluginsdk.NonRetryableError(err)
+		}
+
+		// the Role Assignment can take a while to be returned once it's been created, which we wait for
+		// so that subsequent resources (and the Read) can make use of it
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return pluginsdk.NonRetryableError(fmt.Errorf("context had no deadline"))
+		}
+		stateConf := &resource.StateChangeConf{
+			Pending:                   []string{"Pending"},
+			Target:                    []string{"Created"},
+			Refresh:                   roleAssignmentCreateStateRefreshFunc(ctx, client, id),
+			MinTimeout:                5 * time.Second,
+			ContinuousTargetOccurence: 5,
+			Timeout:                   time.Until(deadline),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("waiting for %s to be created: %+v", id, err))
+		}
+
+		return nil
+	}
+}
+
+func roleAssignmentCreateStateRefreshFunc(ctx context.Context, client *authorization.RoleAssignmentsClient, id parse.RoleAssignmentId) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.Scope, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "Pending", nil
+			}
+			return resp, "Failed", err
+		}
+
+		return resp, "Created", nil
+	}
+}
+
+// findRoleDefinitionByName returns the Role Definition with the specified name which is available at the specified Scope,
+// which can be either a built-in Role (such as `Contributor`) or a Custom Role
+func findRoleDefinitionByName(ctx context.Context, client *authorization.RoleDefinitionsClient, scope, name string) (*authorization.RoleDefinition, error) {
+	filter := fmt.Sprintf("roleName eq '%s'", name)
+	roleDefinitions, err := client.List(ctx, scope, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Definitions with filter %q: %+v", filter, err)
+	}
+
+	for _, v := range roleDefinitions.Values() {
+		if props := v.RoleDefinitionProperties; props != nil && props.RoleName != nil && strings.EqualFold(*props.RoleName, name) {
+			role := v
+			return &role, nil
+		}
+	}
+
+	return nil, fmt.Errorf("a Role Definition named %q was not found at Scope %q", name, scope)
+}
@@ -0,0 +1,264 @@
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type RoleAssignmentResource struct{}
+
+func TestAccRoleAssignment_emptyName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_assignment", "test")
+	r := RoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.emptyName(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleAssignment_roleName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_assignment", "test")
+	r := RoleAssignmentResource{}
+	id := r.generateUUID(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.roleName(id),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_definition_id").Exists(),
+				check.That(data.ResourceName).Key("role_definition_name").HasValue("Reader"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_assignment", "test")
+	r := RoleAssignmentResource{}
+	id := r.generateUUID(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.roleName(id),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(id),
+			ExpectError: acceptance.RequiresImportError("azurestack_role_assignment"),
+		},
+	})
+}
+
+func TestAccRoleAssignment_builtin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_assignment", "test")
+	r := RoleAssignmentResource{}
+	id := r.generateUUID(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.builtin(id),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_definition_name").HasValue("Contributor"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleAssignment_custom(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_assignment", "test")
+	r := RoleAssignmentResource{}
+	roleDefinitionId := r.generateUUID(t)
+	roleAssignmentId := r.generateUUID(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.custom(roleDefinitionId, roleAssignmentId, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_definition_name").HasValue(fmt.Sprintf("acctestrd-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleAssignment_resourceGroupScoped(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_assignment", "test")
+	r := RoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceGroupScoped(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (RoleAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Authorization.RoleAssignmentsClient.Get(ctx, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(true), nil
+}
+
+func (RoleAssignmentResource) generateUUID(t *testing.T) string {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatalf("generating UUID: %+v", err)
+	}
+	return id
+}
+
+func (RoleAssignmentResource) emptyName() string {
+	return `
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_role_assignment" "test" {
+  scope                = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+  role_definition_name = "Reader"
+  principal_id         = data.azurestack_client_config.current.object_id
+}
+`
+}
+
+func (RoleAssignmentResource) roleName(id string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_role_assignment" "test" {
+  name                 = "%s"
+  scope                = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+  role_definition_name = "Reader"
+  principal_id         = data.azurestack_client_config.current.object_id
+}
+`, id)
+}
+
+func (r RoleAssignmentResource) requiresImport(id string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_role_assignment" "import" {
+  name               = azurestack_role_assignment.test.name
+  scope              = azurestack_role_assignment.test.scope
+  role_definition_id = azurestack_role_assignment.test.role_definition_id
+  principal_id       = azurestack_role_assignment.test.principal_id
+}
+`, r.roleName(id))
+}
+
+func (RoleAssignmentResource) builtin(id string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_client_config" "current" {}
+
+data "azurestack_role_definition" "test" {
+  name  = "Contributor"
+  scope = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+}
+
+resource "azurestack_role_assignment" "test" {
+  name               = "%s"
+  scope              = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+  role_definition_id = data.azurestack_role_definition.test.id
+  principal_id       = data.azurestack_client_config.current.object_id
+}
+`, id)
+}
+
+func (RoleAssignmentResource) custom(roleDefinitionId, roleAssignmentId string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+  description        = "Created by the Role Assignment Acceptance Test"
+
+  permissions {
+    actions     = ["Microsoft.Resources/subscriptions/resourceGroups/read"]
+    not_actions = []
+  }
+}
+
+resource "azurestack_role_assignment" "test" {
+  name               = "%s"
+  scope              = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+  role_definition_id = azurestack_role_definition.test.role_definition_resource_id
+  principal_id       = data.azurestack_client_config.current.object_id
+}
+`, roleDefinitionId, data.RandomInteger, roleAssignmentId)
+}
+
+func (RoleAssignmentResource) resourceGroupScoped(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_role_assignment" "test" {
+  scope                = azurestack_resource_group.test.id
+  role_definition_name = "Reader"
+  principal_id         = data.azurestack_client_config.current.object_id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
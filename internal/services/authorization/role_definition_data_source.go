@@ -0,0 +1,143 @@
+package authorization
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/authorization/mgmt/authorization"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func roleDefinitionDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: roleDefinitionDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "role_definition_id"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "role_definition_id"},
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"scope": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"permissions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"actions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"not_actions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"assignable_scopes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func roleDefinitionDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scope := d.Get("scope").(string)
+	if scope == "" {
+		// Built-in Roles are available at the Subscription scope
+		scope = fmt.Sprintf("/subscriptions/%s", subscriptionId)
+	}
+
+	var role *authorization.RoleDefinition
+	if roleDefinitionId := d.Get("role_definition_id").(string); roleDefinitionId != "" {
+		resp, err := client.Get(ctx, scope, roleDefinitionId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Role Definition %q (Scope %q) was not found", roleDefinitionId, scope)
+			}
+			return fmt.Errorf("retrieving Role Definition %q (Scope %q): %+v", roleDefinitionId, scope, err)
+		}
+		role = &resp
+	} else {
+		var err error
+		name := d.Get("name").(string)
+		role, err = findRoleDefinitionByName(ctx, client, scope, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if role.ID == nil {
+		return fmt.Errorf("retrieving Role Definition (Scope %q): `id` was nil", scope)
+	}
+
+	d.SetId(*role.ID)
+	d.Set("role_definition_id", role.Name)
+
+	if props := role.RoleDefinitionProperties; props != nil {
+		d.Set("name", props.RoleName)
+		d.Set("description", props.Description)
+		d.Set("type", props.RoleType)
+
+		if err := d.Set("permissions", flattenRoleDefinitionPermissions(props.Permissions)); err != nil {
+			return fmt.Errorf("setting `permissions`: %+v", err)
+		}
+
+		if err := d.Set("assignable_scopes", utils.FlattenStringSlice(props.AssignableScopes)); err != nil {
+			return fmt.Errorf("setting `assignable_scopes`: %+v", err)
+		}
+	}
+
+	return nil
+}
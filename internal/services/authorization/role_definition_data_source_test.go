@@ -0,0 +1,92 @@
+package authorization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type RoleDefinitionDataSource struct{}
+
+func TestAccRoleDefinitionDataSource_builtIn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_role_definition", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: RoleDefinitionDataSource{}.builtIn("Contributor"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role_definition_id").Exists(),
+				check.That(data.ResourceName).Key("description").Exists(),
+				check.That(data.ResourceName).Key("type").Exists(),
+				check.That(data.ResourceName).Key("permissions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("permissions.0.actions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("permissions.0.actions.0").HasValue("*"),
+			),
+		},
+	})
+}
+
+func TestAccRoleDefinitionDataSource_custom(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_role_definition", "test")
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatalf("generating UUID: %+v", err)
+	}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: RoleDefinitionDataSource{}.custom(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestrd-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("description").HasValue("Created by the Data Source Role Definition Acceptance Test"),
+				check.That(data.ResourceName).Key("type").HasValue("CustomRole"),
+				check.That(data.ResourceName).Key("permissions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("permissions.0.actions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("permissions.0.not_actions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("assignable_scopes.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (d RoleDefinitionDataSource) builtIn(name string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_role_definition" "test" {
+  name = %q
+}
+`, name)
+}
+
+func (d RoleDefinitionDataSource) custom(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+  description        = "Created by the Data Source Role Definition Acceptance Test"
+
+  permissions {
+    actions     = ["*"]
+    not_actions = ["Microsoft.Authorization/*/Delete"]
+  }
+}
+
+data "azurestack_role_definition" "test" {
+  role_definition_id = azurestack_role_definition.test.role_definition_id
+  scope              = azurestack_role_definition.test.scope
+}
+`, id, data.RandomInteger)
+}
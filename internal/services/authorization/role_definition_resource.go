@@ -0,0 +1,339 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/authorization/mgmt/authorization"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func roleDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: roleDefinitionCreateUpdate,
+		Read:   roleDefinitionRead,
+		Update: roleDefinitionCreateUpdate,
+		Delete: roleDefinitionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RoleDefinitionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"scope": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"permissions": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"actions": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"not_actions": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"assignable_scopes": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"role_definition_resource_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func roleDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	roleDefinitionId := d.Get("role_definition_id").(string)
+	if roleDefinitionId == "" {
+		uuid, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("generating UUID for Role Definition: %+v", err)
+		}
+
+		roleDefinitionId = uuid
+	}
+
+	name := d.Get("name").(string)
+	scope := d.Get("scope").(string)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, scope, roleDefinitionId)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Role Definition %q (Scope %q): %+v", roleDefinitionId, scope, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			importId := parse.NewRoleDefinitionID(*existing.ID, scope, roleDefinitionId)
+			return tf.ImportAsExistsError("azurestack_role_definition", importId.ID())
+		}
+	}
+
+	assignableScopes := utils.ExpandStringSlice(d.Get("assignable_scopes").([]interface{}))
+	if len(*assignableScopes) == 0 {
+		// the Scope at which the Role Definition is created must be assignable
+		assignableScopes = &[]string{scope}
+	}
+
+	properties := authorization.RoleDefinition{
+		RoleDefinitionProperties: &authorization.RoleDefinitionProperties{
+			RoleName:         pointer.FromString(name),
+			Description:      pointer.FromString(d.Get("description").(string)),
+			RoleType:         pointer.FromString("CustomRole"),
+			Permissions:      expandRoleDefinitionPermissions(d.Get("permissions").([]interface{})),
+			AssignableScopes: assignableScopes,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, scope, roleDefinitionId, properties); err != nil {
+		return fmt.Errorf("creating/updating Role Definition %q (Scope %q): %+v", roleDefinitionId, scope, err)
+	}
+
+	read, err := client.Get(ctx, scope, roleDefinitionId)
+	if err != nil {
+		return fmt.Errorf("retrieving Role Definition %q (Scope %q): %+v", roleDefinitionId, scope, err)
+	}
+	if read.ID == nil || *read.ID == "" {
+		return fmt.Errorf("retrieving Role Definition %q (Scope %q): `id` was nil", roleDefinitionId, scope)
+	}
+
+	// the Role Definition can take a while to replicate, as such we wait for the changes to be consistently returned
+	log.Printf("[DEBUG] Waiting for Role Definition %q (Scope %q) to replicate..", roleDefinitionId, scope)
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"Pending"},
+		Target:                    []string{"Updated"},
+		Refresh:                   roleDefinitionUpdateRefreshFunc(ctx, client, scope, roleDefinitionId, name),
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Role Definition %q (Scope %q) to replicate: %+v", roleDefinitionId, scope, err)
+	}
+
+	id := parse.NewRoleDefinitionID(*read.ID, scope, roleDefinitionId)
+	d.SetId(id.ID())
+
+	return roleDefinitionRead(d, meta)
+}
+
+func roleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.Scope, id.RoleID)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("scope", id.Scope)
+	d.Set("role_definition_id", id.RoleID)
+	d.Set("role_definition_resource_id", id.ResourceID)
+
+	if props := resp.RoleDefinitionProperties; props != nil {
+		d.Set("name", props.RoleName)
+		d.Set("description", props.Description)
+
+		if err := d.Set("permissions", flattenRoleDefinitionPermissions(props.Permissions)); err != nil {
+			return fmt.Errorf("setting `permissions`: %+v", err)
+		}
+
+		if err := d.Set("assignable_scopes", utils.FlattenStringSlice(props.AssignableScopes)); err != nil {
+			return fmt.Errorf("setting `assignable_scopes`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func roleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.Scope, id.RoleID)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	// the deletion can take a while to replicate, as such we wait for it to be consistently not found
+	log.Printf("[DEBUG] Waiting for %s to be deleted..", *id)
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"Exists"},
+		Target:                    []string{"NotFound"},
+		Refresh:                   roleDefinitionDeleteRefreshFunc(ctx, client, id.Scope, id.RoleID),
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be deleted: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func roleDefinitionUpdateRefreshFunc(ctx context.Context, client *authorization.RoleDefinitionsClient, scope, roleDefinitionId, expectedName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, scope, roleDefinitionId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "Pending", nil
+			}
+			return resp, "Failed", err
+		}
+
+		if resp.RoleDefinitionProperties == nil || resp.RoleDefinitionProperties.RoleName == nil || *resp.RoleDefinitionProperties.RoleName != expectedName {
+			return resp, "Pending", nil
+		}
+
+		return resp, "Updated", nil
+	}
+}
+
+func roleDefinitionDeleteRefreshFunc(ctx context.Context, client *authorization.RoleDefinitionsClient, scope, roleDefinitionId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, scope, roleDefinitionId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "NotFound", nil
+			}
+			return nil, "", fmt.Errorf("retrieving Role Definition %q (Scope %q): %+v", roleDefinitionId, scope, err)
+		}
+
+		return resp, "Exists", nil
+	}
+}
+
+func expandRoleDefinitionPermissions(input []interface{}) *[]authorization.Permission {
+	output := make([]authorization.Permission, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		raw := v.(map[string]interface{})
+		output = append(output, authorization.Permission{
+			Actions:    utils.ExpandStringSlice(raw["actions"].([]interface{})),
+			NotActions: utils.ExpandStringSlice(raw["not_actions"].([]interface{})),
+		})
+	}
+
+	return &output
+}
+
+func flattenRoleDefinitionPermissions(input *[]authorization.Permission) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, permission := range *input {
+		output = append(output, map[string]interface{}{
+			"actions":     utils.FlattenStringSlice(permission.Actions),
+			"not_actions": utils.FlattenStringSlice(permission.NotActions),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,254 @@
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type RoleDefinitionResource struct{}
+
+func TestAccRoleDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_definition", "test")
+	r := RoleDefinitionResource{}
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatalf("generating UUID: %+v", err)
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_definition", "test")
+	r := RoleDefinitionResource{}
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatalf("generating UUID: %+v", err)
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(id, data),
+			ExpectError: acceptance.RequiresImportError("azurestack_role_definition"),
+		},
+	})
+}
+
+func TestAccRoleDefinition_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_definition", "test")
+	r := RoleDefinitionResource{}
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatalf("generating UUID: %+v", err)
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permissions.0.actions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("permissions.0.not_actions.#").HasValue("3"),
+				check.That(data.ResourceName).Key("assignable_scopes.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_definition", "test")
+	r := RoleDefinitionResource{}
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatalf("generating UUID: %+v", err)
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permissions.0.actions.0").HasValue("*"),
+				check.That(data.ResourceName).Key("permissions.0.not_actions.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permissions.0.actions.0").HasValue("*"),
+				check.That(data.ResourceName).Key("permissions.0.not_actions.0").HasValue("Microsoft.Authorization/*/read"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleDefinition_emptyName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_role_definition", "test")
+	r := RoleDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.emptyId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_definition_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (RoleDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RoleDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Authorization.RoleDefinitionsClient.Get(ctx, id.Scope, id.RoleID)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(true), nil
+}
+
+func (RoleDefinitionResource) basic(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+
+  permissions {
+    actions     = ["*"]
+    not_actions = []
+  }
+}
+`, id, data.RandomInteger)
+}
+
+func (r RoleDefinitionResource) requiresImport(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_role_definition" "import" {
+  role_definition_id = azurestack_role_definition.test.role_definition_id
+  name               = azurestack_role_definition.test.name
+  scope              = azurestack_role_definition.test.scope
+
+  permissions {
+    actions     = ["*"]
+    not_actions = []
+  }
+}
+`, r.basic(id, data))
+}
+
+func (RoleDefinitionResource) complete(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+  description        = "Acceptance Test Role Definition"
+
+  permissions {
+    actions = ["*"]
+    not_actions = [
+      "Microsoft.Authorization/*/Delete",
+      "Microsoft.Authorization/*/Write",
+      "Microsoft.Authorization/elevateAccess/Action",
+    ]
+  }
+
+  assignable_scopes = [
+    "/subscriptions/${data.azurestack_client_config.current.subscription_id}",
+  ]
+}
+`, id, data.RandomInteger)
+}
+
+func (RoleDefinitionResource) updated(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d-updated"
+  scope              = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+  description        = "Acceptance Test Role Definition Updated"
+
+  permissions {
+    actions     = ["*"]
+    not_actions = ["Microsoft.Authorization/*/read"]
+  }
+}
+`, id, data.RandomInteger)
+}
+
+func (RoleDefinitionResource) emptyId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_client_config" "current" {}
+
+resource "azurestack_role_definition" "test" {
+  name  = "acctestrd-%d"
+  scope = "/subscriptions/${data.azurestack_client_config.current.subscription_id}"
+
+  permissions {
+    actions     = ["*"]
+    not_actions = []
+  }
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,320 @@
+package batch
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2017-09-01/batch"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/batch/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/batch/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func batchAccount() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: batchAccountCreate,
+		Read:   batchAccountRead,
+		Update: batchAccountUpdate,
+		Delete: batchAccountDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.AccountID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"location": commonschema.Location(),
+
+			"pool_allocation_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(batch.BatchService),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(batch.BatchService),
+					string(batch.UserSubscription),
+				}, false),
+			},
+
+			"key_vault_reference": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: keyVaultValidate.VaultID,
+						},
+
+						"url": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+					},
+				},
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"tags": tags.Schema(),
+
+			"account_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"primary_access_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func batchAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Batch.AccountClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewAccountID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.BatchAccountName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurestack_batch_account", id.ID())
+	}
+
+	poolAllocationMode := batch.PoolAllocationMode(d.Get("pool_allocation_mode").(string))
+	keyVaultReference := expandBatchAccountKeyVaultReference(d.Get("key_vault_reference").([]interface{}))
+
+	// the Pools of a Batch Account using the UserSubscription mode are provisioned into the Subscription, which
+	// requires a Key Vault to store the credentials in
+	if poolAllocationMode == batch.UserSubscription && keyVaultReference == nil {
+		return fmt.Errorf("`key_vault_reference` must be specified when `pool_allocation_mode` is %q", string(batch.UserSubscription))
+	}
+
+	parameters := batch.AccountCreateParameters{
+		Location: pointer.FromString(location.Normalize(d.Get("location").(string))),
+		AccountCreateProperties: &batch.AccountCreateProperties{
+			PoolAllocationMode: poolAllocationMode,
+			KeyVaultReference:  keyVaultReference,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("storage_account_id"); ok {
+		parameters.AccountCreateProperties.AutoStorage = &batch.AutoStorageBaseProperties{
+			StorageAccountID: pointer.FromString(v.(string)),
+		}
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.BatchAccountName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return batchAccountRead(d, meta)
+}
+
+func batchAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Batch.AccountClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := batch.AccountUpdateParameters{
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		AccountUpdateProperties: &batch.AccountUpdateProperties{
+			AutoStorage: &batch.AutoStorageBaseProperties{
+				StorageAccountID: pointer.FromString(d.Get("storage_account_id").(string)),
+			},
+		},
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroup, id.BatchAccountName, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return batchAccountRead(d, meta)
+}
+
+func batchAccountRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Batch.AccountClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.BatchAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.BatchAccountName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.AccountProperties; props != nil {
+		d.Set("account_endpoint", props.AccountEndpoint)
+		d.Set("pool_allocation_mode", string(props.PoolAllocationMode))
+
+		storageAccountId := ""
+		if props.AutoStorage != nil && props.AutoStorage.StorageAccountID != nil {
+			storageAccountId = *props.AutoStorage.StorageAccountID
+		}
+		d.Set("storage_account_id", storageAccountId)
+
+		if err := d.Set("key_vault_reference", flattenBatchAccountKeyVaultReference(props.KeyVaultReference)); err != nil {
+			return fmt.Errorf("setting `key_vault_reference`: %+v", err)
+		}
+	}
+
+	// the access keys are only available when the Pools are allocated by the Batch Service
+	primaryAccessKey := ""
+	secondaryAccessKey := ""
+	if d.Get("pool_allocation_mode").(string) == string(batch.BatchService) {
+		keys, err := client.GetKeys(ctx, id.ResourceGroup, id.BatchAccountName)
+		if err != nil {
+			return fmt.Errorf("retrieving the Access Keys for %s: %+v", *id, err)
+		}
+
+		if keys.Primary != nil {
+			primaryAccessKey = *keys.Primary
+		}
+		if keys.Secondary != nil {
+			secondaryAccessKey = *keys.Secondary
+		}
+	}
+	d.Set("primary_access_key", primaryAccessKey)
+	d.Set("secondary_access_key", secondaryAccessKey)
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func batchAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Batch.AccountClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.BatchAccountName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandBatchAccountKeyVaultReference(input []interface{}) *batch.KeyVaultReference {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &batch.KeyVaultReference{
+		ID:  pointer.FromString(raw["id"].(string)),
+		URL: pointer.FromString(raw["url"].(string)),
+	}
+}
+
+func flattenBatchAccountKeyVaultReference(input *batch.KeyVaultReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	id := ""
+	if input.ID != nil {
+		id = *input.ID
+	}
+
+	url := ""
+	if input.URL != nil {
+		url = *input.URL
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":  id,
+			"url": url,
+		},
+	}
+}
@@ -0,0 +1,721 @@
+package batch
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2017-09-01/batch"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/batch/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/batch/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func batchPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: batchPoolCreate,
+		Read:   batchPoolRead,
+		Update: batchPoolUpdate,
+		Delete: batchPoolDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.PoolID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PoolName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountName,
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"vm_size": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"node_agent_sku_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"storage_image_reference": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"publisher": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"offer": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"sku": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"version": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"max_tasks_per_node": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"fixed_scale": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"fixed_scale", "auto_scale"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"target_dedicated_nodes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"target_low_priority_nodes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"resize_timeout": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "PT15M",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"auto_scale": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"fixed_scale", "auto_scale"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"evaluation_interval": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "PT15M",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"formula": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"start_task": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"command_line": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"max_task_retry_count": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(-1),
+						},
+
+						"wait_for_success": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"environment": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"user_identity": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"user_name": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"auto_user": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"scope": {
+													Type:     pluginsdk.TypeString,
+													Optional: true,
+													Default:  string(batch.AutoUserScopeTask),
+													ValidateFunc: validation.StringInSlice([]string{
+														string(batch.AutoUserScopeTask),
+														string(batch.AutoUserScopePool),
+													}, false),
+												},
+
+												"elevation_level": {
+													Type:     pluginsdk.TypeString,
+													Optional: true,
+													Default:  string(batch.NonAdmin),
+													ValidateFunc: validation.StringInSlice([]string{
+														string(batch.NonAdmin),
+														string(batch.Admin),
+													}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func batchPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Batch.PoolClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewPoolID(subscriptionId, d.Get("resource_group_name").(string), d.Get("account_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.BatchAccountName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurestack_batch_pool", id.ID())
+	}
+
+	imageReference, err := expandBatchPoolImageReference(d.Get("storage_image_reference").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	startTask, err := expandBatchPoolStartTask(d.Get("start_task").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := batch.Pool{
+		PoolProperties: &batch.PoolProperties{
+			VMSize:          pointer.FromString(d.Get("vm_size").(string)),
+			MaxTasksPerNode: utils.Int32(int32(d.Get("max_tasks_per_node").(int))),
+			DeploymentConfiguration: &batch.DeploymentConfiguration{
+				VirtualMachineConfiguration: &batch.VirtualMachineConfiguration{
+					ImageReference: imageReference,
+					NodeAgentSkuID: pointer.FromString(d.Get("node_agent_sku_id").(string)),
+				},
+			},
+			ScaleSettings: expandBatchPoolScaleSettings(d),
+			StartTask:     startTask,
+		},
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		parameters.PoolProperties.DisplayName = pointer.FromString(v.(string))
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.BatchAccountName, id.Name, parameters, "", "")
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return batchPoolRead(d, meta)
+}
+
+func batchPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Batch.PoolClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := batch.Pool{
+		PoolProperties: &batch.PoolProperties{},
+	}
+
+	if d.HasChanges("fixed_scale", "auto_scale") {
+		parameters.PoolProperties.ScaleSettings = expandBatchPoolScaleSettings(d)
+	}
+
+	if d.HasChange("start_task") {
+		startTask, err := expandBatchPoolStartTask(d.Get("start_task").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		// the Start Task is removed from the Pool by sending an empty object
+		if startTask == nil {
+			startTask = &batch.StartTask{}
+		}
+		parameters.PoolProperties.StartTask = startTask
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroup, id.BatchAccountName, id.Name, parameters, ""); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return batchPoolRead(d, meta)
+}
+
+func batchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Batch.PoolClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.BatchAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.BatchAccountName)
+
+	if props := resp.PoolProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("vm_size", props.VMSize)
+
+		maxTasksPerNode := 1
+		if props.MaxTasksPerNode != nil {
+			maxTasksPerNode = int(*props.MaxTasksPerNode)
+		}
+		d.Set("max_tasks_per_node", maxTasksPerNode)
+
+		nodeAgentSkuId := ""
+		var imageReference *batch.ImageReference
+		if config := props.DeploymentConfiguration; config != nil && config.VirtualMachineConfiguration != nil {
+			if config.VirtualMachineConfiguration.NodeAgentSkuID != nil {
+				nodeAgentSkuId = *config.VirtualMachineConfiguration.NodeAgentSkuID
+			}
+			imageReference = config.VirtualMachineConfiguration.ImageReference
+		}
+		d.Set("node_agent_sku_id", nodeAgentSkuId)
+
+		if err := d.Set("storage_image_reference", flattenBatchPoolImageReference(imageReference)); err != nil {
+			return fmt.Errorf("setting `storage_image_reference`: %+v", err)
+		}
+
+		fixedScale, autoScale := flattenBatchPoolScaleSettings(props.ScaleSettings)
+		if err := d.Set("fixed_scale", fixedScale); err != nil {
+			return fmt.Errorf("setting `fixed_scale`: %+v", err)
+		}
+		if err := d.Set("auto_scale", autoScale); err != nil {
+			return fmt.Errorf("setting `auto_scale`: %+v", err)
+		}
+
+		if err := d.Set("start_task", flattenBatchPoolStartTask(props.StartTask)); err != nil {
+			return fmt.Errorf("setting `start_task`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func batchPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Batch.PoolClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.BatchAccountName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandBatchPoolImageReference(input []interface{}) (*batch.ImageReference, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, fmt.Errorf("`storage_image_reference` must be specified")
+	}
+
+	raw := input[0].(map[string]interface{})
+	if id := raw["id"].(string); id != "" {
+		return &batch.ImageReference{
+			ID: pointer.FromString(id),
+		}, nil
+	}
+
+	publisher := raw["publisher"].(string)
+	offer := raw["offer"].(string)
+	sku := raw["sku"].(string)
+	if publisher == "" || offer == "" || sku == "" {
+		return nil, fmt.Errorf("either `id` or `publisher`, `offer` and `sku` must be specified within `storage_image_reference`")
+	}
+
+	imageReference := &batch.ImageReference{
+		Publisher: pointer.FromString(publisher),
+		Offer:     pointer.FromString(offer),
+		Sku:       pointer.FromString(sku),
+	}
+	if version := raw["version"].(string); version != "" {
+		imageReference.Version = pointer.FromString(version)
+	}
+
+	return imageReference, nil
+}
+
+func flattenBatchPoolImageReference(input *batch.ImageReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	id := ""
+	if input.ID != nil {
+		id = *input.ID
+	}
+
+	publisher := ""
+	if input.Publisher != nil {
+		publisher = *input.Publisher
+	}
+
+	offer := ""
+	if input.Offer != nil {
+		offer = *input.Offer
+	}
+
+	sku := ""
+	if input.Sku != nil {
+		sku = *input.Sku
+	}
+
+	version := ""
+	if input.Version != nil {
+		version = *input.Version
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":        id,
+			"publisher": publisher,
+			"offer":     offer,
+			"sku":       sku,
+			"version":   version,
+		},
+	}
+}
+
+func expandBatchPoolScaleSettings(d *pluginsdk.ResourceData) *batch.ScaleSettings {
+	if v := d.Get("auto_scale").([]interface{}); len(v) > 0 && v[0] != nil {
+		raw := v[0].(map[string]interface{})
+		return &batch.ScaleSettings{
+			AutoScale: &batch.AutoScaleSettings{
+				EvaluationInterval: pointer.FromString(raw["evaluation_interval"].(string)),
+				Formula:            pointer.FromString(raw["formula"].(string)),
+			},
+		}
+	}
+
+	// an empty `fixed_scale` block is sent as the defaults, since at least one of the targets has to be specified
+	fixedScale := &batch.FixedScaleSettings{
+		TargetDedicatedNodes:   utils.Int32(1),
+		TargetLowPriorityNodes: utils.Int32(0),
+		ResizeTimeout:          pointer.FromString("PT15M"),
+	}
+	if v := d.Get("fixed_scale").([]interface{}); len(v) > 0 && v[0] != nil {
+		raw := v[0].(map[string]interface{})
+		fixedScale.TargetDedicatedNodes = utils.Int32(int32(raw["target_dedicated_nodes"].(int)))
+		fixedScale.TargetLowPriorityNodes = utils.Int32(int32(raw["target_low_priority_nodes"].(int)))
+		fixedScale.ResizeTimeout = pointer.FromString(raw["resize_timeout"].(string))
+	}
+
+	return &batch.ScaleSettings{
+		FixedScale: fixedScale,
+	}
+}
+
+func flattenBatchPoolScaleSettings(input *batch.ScaleSettings) ([]interface{}, []interface{}) {
+	fixedScale := make([]interface{}, 0)
+	autoScale := make([]interface{}, 0)
+	if input == nil {
+		return fixedScale, autoScale
+	}
+
+	if v := input.FixedScale; v != nil {
+		targetDedicatedNodes := 0
+		if v.TargetDedicatedNodes != nil {
+			targetDedicatedNodes = int(*v.TargetDedicatedNodes)
+		}
+
+		targetLowPriorityNodes := 0
+		if v.TargetLowPriorityNodes != nil {
+			targetLowPriorityNodes = int(*v.TargetLowPriorityNodes)
+		}
+
+		resizeTimeout := ""
+		if v.ResizeTimeout != nil {
+			resizeTimeout = *v.ResizeTimeout
+		}
+
+		fixedScale = append(fixedScale, map[string]interface{}{
+			"target_dedicated_nodes":    targetDedicatedNodes,
+			"target_low_priority_nodes": targetLowPriorityNodes,
+			"resize_timeout":            resizeTimeout,
+		})
+	}
+
+	if v := input.AutoScale; v != nil {
+		evaluationInterval := ""
+		if v.EvaluationInterval != nil {
+			evaluationInterval = *v.EvaluationInterval
+		}
+
+		formula := ""
+		if v.Formula != nil {
+			formula = *v.Formula
+		}
+
+		autoScale = append(autoScale, map[string]interface{}{
+			"evaluation_interval": evaluationInterval,
+			"formula":             formula,
+		})
+	}
+
+	return fixedScale, autoScale
+}
+
+func expandBatchPoolStartTask(input []interface{}) (*batch.StartTask, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	environmentSettings := make([]batch.EnvironmentSetting, 0)
+	for k, v := range raw["environment"].(map[string]interface{}) {
+		environmentSettings = append(environmentSettings, batch.EnvironmentSetting{
+			Name:  pointer.FromString(k),
+			Value: pointer.FromString(v.(string)),
+		})
+	}
+
+	userIdentity := &batch.UserIdentity{}
+	if v := raw["user_identity"].([]interface{}); len(v) > 0 && v[0] != nil {
+		identityRaw := v[0].(map[string]interface{})
+		userName := identityRaw["user_name"].(string)
+		autoUser := identityRaw["auto_user"].([]interface{})
+
+		// the `user_name` and `auto_user` are mutually exclusive, but one of them has to be specified
+		if (userName == "") == (len(autoUser) == 0) {
+			return nil, fmt.Errorf("exactly one of `user_name` or `auto_user` must be specified within `start_task.0.user_identity`")
+		}
+
+		if userName != "" {
+			userIdentity.UserName = pointer.FromString(userName)
+		}
+
+		if len(autoUser) > 0 {
+			// an empty `auto_user` block is sent as the defaults
+			userIdentity.AutoUser = &batch.AutoUserSpecification{
+				Scope:          batch.AutoUserScopeTask,
+				ElevationLevel: batch.NonAdmin,
+			}
+			if autoUserRaw, ok := autoUser[0].(map[string]interface{}); ok {
+				userIdentity.AutoUser.Scope = batch.AutoUserScope(autoUserRaw["scope"].(string))
+				userIdentity.AutoUser.ElevationLevel = batch.ElevationLevel(autoUserRaw["elevation_level"].(string))
+			}
+		}
+	}
+
+	return &batch.StartTask{
+		CommandLine:         pointer.FromString(raw["command_line"].(string)),
+		MaxTaskRetryCount:   utils.Int32(int32(raw["max_task_retry_count"].(int))),
+		WaitForSuccess:      pointer.FromBool(raw["wait_for_success"].(bool)),
+		EnvironmentSettings: &environmentSettings,
+		UserIdentity:        userIdentity,
+	}, nil
+}
+
+func flattenBatchPoolStartTask(input *batch.StartTask) []interface{} {
+	if input == nil || input.CommandLine == nil {
+		return []interface{}{}
+	}
+
+	maxTaskRetryCount := 0
+	if input.MaxTaskRetryCount != nil {
+		maxTaskRetryCount = int(*input.MaxTaskRetryCount)
+	}
+
+	waitForSuccess := false
+	if input.WaitForSuccess != nil {
+		waitForSuccess = *input.WaitForSuccess
+	}
+
+	environment := make(map[string]interface{})
+	if input.EnvironmentSettings != nil {
+		for _, setting := range *input.EnvironmentSettings {
+			if setting.Name == nil {
+				continue
+			}
+
+			value := ""
+			if setting.Value != nil {
+				value = *setting.Value
+			}
+			environment[*setting.Name] = value
+		}
+	}
+
+	userIdentity := make([]interface{}, 0)
+	if identity := input.UserIdentity; identity != nil {
+		userName := ""
+		if identity.UserName != nil {
+			userName = *identity.UserName
+		}
+
+		autoUser := make([]interface{}, 0)
+		if identity.AutoUser != nil {
+			autoUser = append(autoUser, map[string]interface{}{
+				"scope":           string(identity.AutoUser.Scope),
+				"elevation_level": string(identity.AutoUser.ElevationLevel),
+			})
+		}
+
+		userIdentity = append(userIdentity, map[string]interface{}{
+			"user_name": userName,
+			"auto_user": autoUser,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"command_line":         *input.CommandLine,
+			"max_task_retry_count": maxTaskRetryCount,
+			"wait_for_success":     waitForSuccess,
+			"environment":          environment,
+			"user_identity":        userIdentity,
+		},
+	}
+}
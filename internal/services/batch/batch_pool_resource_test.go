@@ -0,0 +1,274 @@
+package batch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/batch/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type BatchPoolResource struct{}
+
+func TestAccBatchPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_batch_pool", "test")
+	r := BatchPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fixedScale(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vm_size").HasValue(data.Skus.Sku("STANDARD_A1")),
+				check.That(data.ResourceName).Key("node_agent_sku_id").HasValue("batch.node.ubuntu 16.04"),
+				check.That(data.ResourceName).Key("fixed_scale.0.target_dedicated_nodes").HasValue("1"),
+				check.That(data.ResourceName).Key("fixed_scale.0.resize_timeout").HasValue("PT15M"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBatchPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_batch_pool", "test")
+	r := BatchPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fixedScale(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccBatchPool_scaleSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_batch_pool", "test")
+	r := BatchPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fixedScale(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fixed_scale.0.target_dedicated_nodes").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.fixedScale(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fixed_scale.0.target_dedicated_nodes").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoScale(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fixed_scale.#").HasValue("0"),
+				check.That(data.ResourceName).Key("auto_scale.#").HasValue("1"),
+				check.That(data.ResourceName).Key("auto_scale.0.evaluation_interval").HasValue("PT15M"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBatchPool_startTask(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_batch_pool", "test")
+	r := BatchPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.startTask(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("start_task.0.command_line").HasValue("echo 'Hello World from $env'"),
+				check.That(data.ResourceName).Key("start_task.0.max_task_retry_count").HasValue("1"),
+				check.That(data.ResourceName).Key("start_task.0.wait_for_success").HasValue("true"),
+				check.That(data.ResourceName).Key("start_task.0.environment.%").HasValue("1"),
+				check.That(data.ResourceName).Key("start_task.0.environment.env").HasValue("TEST"),
+				check.That(data.ResourceName).Key("start_task.0.user_identity.0.auto_user.0.scope").HasValue("Task"),
+				check.That(data.ResourceName).Key("start_task.0.user_identity.0.auto_user.0.elevation_level").HasValue("NonAdmin"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.fixedScale(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("start_task.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (BatchPoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Batch.PoolClient.Get(ctx, id.ResourceGroup, id.BatchAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (BatchPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-batch-%d"
+  location = "%s"
+}
+
+resource "azurestack_batch_account" "test" {
+  name                = "acctestba%s"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r BatchPoolResource) fixedScale(data acceptance.TestData, targetDedicatedNodes int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_batch_pool" "test" {
+  name                = "acctestpool%s"
+  resource_group_name = azurestack_resource_group.test.name
+  account_name        = azurestack_batch_account.test.name
+  vm_size             = "STANDARD_A1"
+  node_agent_sku_id   = "batch.node.ubuntu 16.04"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  fixed_scale {
+    target_dedicated_nodes = %d
+  }
+}
+`, r.template(data), data.RandomString, targetDedicatedNodes)
+}
+
+func (r BatchPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_batch_pool" "import" {
+  name                = azurestack_batch_pool.test.name
+  resource_group_name = azurestack_batch_pool.test.resource_group_name
+  account_name        = azurestack_batch_pool.test.account_name
+  vm_size             = azurestack_batch_pool.test.vm_size
+  node_agent_sku_id   = azurestack_batch_pool.test.node_agent_sku_id
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  fixed_scale {
+    target_dedicated_nodes = 1
+  }
+}
+`, r.fixedScale(data, 1))
+}
+
+func (r BatchPoolResource) autoScale(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_batch_pool" "test" {
+  name                = "acctestpool%s"
+  resource_group_name = azurestack_resource_group.test.name
+  account_name        = azurestack_batch_account.test.name
+  vm_size             = "STANDARD_A1"
+  node_agent_sku_id   = "batch.node.ubuntu 16.04"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  auto_scale {
+    formula = <<EOF
+      startingNumberOfVMs = 1;
+      maxNumberofVMs = 2;
+      pendingTaskSamplePercent = $PendingTasks.GetSamplePercent(180 * TimeInterval_Second);
+      pendingTaskSamples = pendingTaskSamplePercent < 70 ? startingNumberOfVMs : avg($PendingTasks.GetSample(180 * TimeInterval_Second));
+      $TargetDedicatedNodes = min(maxNumberofVMs, pendingTaskSamples);
+EOF
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r BatchPoolResource) startTask(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_batch_pool" "test" {
+  name                = "acctestpool%s"
+  resource_group_name = azurestack_resource_group.test.name
+  account_name        = azurestack_batch_account.test.name
+  vm_size             = "STANDARD_A1"
+  node_agent_sku_id   = "batch.node.ubuntu 16.04"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  fixed_scale {
+    target_dedicated_nodes = 1
+  }
+
+  start_task {
+    command_line         = "echo 'Hello World from $env'"
+    max_task_retry_count = 1
+    wait_for_success     = true
+
+    environment = {
+      env = "TEST"
+    }
+
+    user_identity {
+      auto_user {
+        elevation_level = "NonAdmin"
+        scope           = "Task"
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomString)
+}
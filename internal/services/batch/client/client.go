@@ -0,0 +1,24 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2017-09-01/batch"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+type Client struct {
+	AccountClient *batch.AccountClient
+	PoolClient    *batch.PoolClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	accountClient := batch.NewAccountClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&accountClient.Client, o.ResourceManagerAuthorizer)
+
+	poolClient := batch.NewPoolClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&poolClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AccountClient: &accountClient,
+		PoolClient:    &poolClient,
+	}
+}
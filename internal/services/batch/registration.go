@@ -0,0 +1,47 @@
+package batch
+
+import (
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/sdk"
+)
+
+var (
+	_ sdk.UntypedServiceRegistration                 = Registration{}
+	_ sdk.UntypedServiceRegistrationWithCapabilities = Registration{}
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Batch"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Batch",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurestack_batch_account": batchAccount(),
+		"azurestack_batch_pool":    batchPool(),
+	}
+}
+
+// ResourceProviderTypes returns the Resource Types provisioned by the Resources supported by this Service,
+// which are checked against the Resource Types supported by the Azure Stack Hub at plan time
+func (r Registration) ResourceProviderTypes() map[string]string {
+	return map[string]string{
+		"azurestack_batch_account": "Microsoft.Batch/batchAccounts",
+		"azurestack_batch_pool":    "Microsoft.Batch/batchAccounts/pools",
+	}
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func AccountName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9]{3,24}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 24 characters and can only contain lowercase letters and numbers", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,33 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAccountName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "ab", Valid: false},
+		{Value: "abc", Valid: true},
+		{Value: "acctestba123", Valid: true},
+		{Value: "acctestBA123", Valid: false},
+		{Value: "acctest-ba", Valid: false},
+		{Value: "acctest_ba", Valid: false},
+		{Value: strings.Repeat("a", 24), Valid: true},
+		{Value: strings.Repeat("a", 25), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := AccountName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
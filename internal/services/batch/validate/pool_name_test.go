@@ -0,0 +1,32 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPoolName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "a", Valid: true},
+		{Value: "acctest-pool_1", Valid: true},
+		{Value: "AccTestPool", Valid: true},
+		{Value: "acctest.pool", Valid: false},
+		{Value: "acctest pool", Valid: false},
+		{Value: strings.Repeat("a", 64), Valid: true},
+		{Value: strings.Repeat("a", 65), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := PoolName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
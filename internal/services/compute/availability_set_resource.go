@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,23 +9,26 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/compute/mgmt/compute"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
 )
 
 func availabilitySet() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceAvailabilitySetCreateUpdate,
+		Create: resourceAvailabilitySetCreate,
 		Read:   resourceAvailabilitySetRead,
-		Update: resourceAvailabilitySetCreateUpdate,
+		Update: resourceAvailabilitySetUpdate,
 		Delete: resourceAvailabilitySetDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.AvailabilitySetID(id)
@@ -57,7 +61,6 @@ func availabilitySet() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      5,
-				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(1, 20),
 			},
 
@@ -65,10 +68,17 @@ func availabilitySet() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      3,
-				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(1, 3),
 			},
 
+			"proximity_placement_group_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.ProximityPlacementGroupID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
 			"managed": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -78,29 +88,78 @@ func availabilitySet() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(availabilitySetCustomizeDiff),
 	}
 }
 
-func resourceAvailabilitySetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+// availabilitySetCustomizeDiff handles changes to the Fault and Update Domain counts, which Azure Stack Hub doesn't allow
+// to be updated once the Availability Set has been created. When the Availability Set is empty it's recreated - however
+// since Virtual Machines can't be moved out of an Availability Set, an error is raised at plan time when it contains any
+func availabilitySetCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if err := validateProximityPlacementGroupsAreSupported(d, meta.(*clients.Client).Capabilities, "Microsoft.Compute/availabilitySets"); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	changed := make([]string, 0)
+	for _, field := range []string{"platform_fault_domain_count", "platform_update_domain_count"} {
+		if d.HasChange(field) {
+			changed = append(changed, field)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	client := meta.(*clients.Client).Compute.AvailabilitySetsClient
+	id, err := parse.AvailabilitySetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := existing.AvailabilitySetProperties; props != nil && props.VirtualMachines != nil && len(*props.VirtualMachines) > 0 {
+		return fmt.Errorf("`%s` cannot be changed once %s has been created, and the Availability Set can't be recreated since it contains %d Virtual Machine(s) - these must be removed from the Availability Set first", strings.Join(changed, "` and `"), *id, len(*props.VirtualMachines))
+	}
+
+	for _, field := range changed {
+		if err := d.ForceNew(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceAvailabilitySetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.AvailabilitySetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for azurestack Availability Set creation.")
 	id := parse.NewAvailabilitySetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
-		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
-			}
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %s", id, err)
 		}
+	}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurestack_availability_set", *existing.ID)
-		}
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurestack_availability_set", *existing.ID)
 	}
 
 	location := location.Normalize(d.Get("location").(string))
@@ -119,6 +178,12 @@ func resourceAvailabilitySetCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		Tags: tags.Expand(t),
 	}
 
+	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
+		availSet.AvailabilitySetProperties.ProximityPlacementGroup = &compute.SubResource{
+			ID: pointer.FromString(v.(string)),
+		}
+	}
+
 	if managed {
 		n := "Aligned"
 		availSet.Sku = &compute.Sku{
@@ -126,12 +191,36 @@ func resourceAvailabilitySetCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	_, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, availSet)
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, availSet); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
+
+	return resourceAvailabilitySetRead(d, meta)
+}
+
+func resourceAvailabilitySetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.AvailabilitySetsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AvailabilitySetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
+	// the Fault and Update Domain counts can't be updated (and are handled in the CustomizeDiff) - so only
+	// the tags can change, which are PATCH'd to avoid resending the rest of the Availability Set
+	if d.HasChange("tags") {
+		update := compute.AvailabilitySetUpdate{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, update); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
 
 	return resourceAvailabilitySetRead(d, meta)
 }
@@ -165,6 +254,12 @@ func resourceAvailabilitySetRead(d *pluginsdk.ResourceData, meta interface{}) er
 	if props := resp.AvailabilitySetProperties; props != nil {
 		d.Set("platform_update_domain_count", props.PlatformUpdateDomainCount)
 		d.Set("platform_fault_domain_count", props.PlatformFaultDomainCount)
+
+		proximityPlacementGroupId := ""
+		if props.ProximityPlacementGroup != nil && props.ProximityPlacementGroup.ID != nil {
+			proximityPlacementGroupId = *props.ProximityPlacementGroup.ID
+		}
+		d.Set("proximity_placement_group_id", proximityPlacementGroupId)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -3,6 +3,7 @@ package compute_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -108,6 +109,50 @@ func TestAccAvailabilitySet_withDomainCounts(t *testing.T) {
 	})
 }
 
+func TestAccAvailabilitySet_updateDomainCounts(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_availability_set", "test")
+	r := AvailabilitySetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.domainCounts(data, 3, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// an empty Availability Set is recreated
+			Config: r.domainCounts(data, 5, 3),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("platform_update_domain_count").HasValue("5"),
+				check.That(data.ResourceName).Key("platform_fault_domain_count").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAvailabilitySet_updateDomainCountsWithVirtualMachine(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_availability_set", "test")
+	r := AvailabilitySetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withVirtualMachine(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.withVirtualMachine(data, 3),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("`platform_fault_domain_count` cannot be changed once"),
+		},
+	})
+}
+
 func TestAccAvailabilitySet_unmanaged(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_availability_set", "test")
 	r := AvailabilitySetResource{}
@@ -124,6 +169,22 @@ func TestAccAvailabilitySet_unmanaged(t *testing.T) {
 	})
 }
 
+func TestAccAvailabilitySet_proximityPlacementGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_availability_set", "test")
+	r := AvailabilitySetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.proximityPlacementGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("proximity_placement_group_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (AvailabilitySetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.AvailabilitySetID(state.ID)
 	if err != nil {
@@ -185,6 +246,32 @@ resource "azurestack_availability_set" "import" {
 `, r.basic(data))
 }
 
+func (AvailabilitySetResource) proximityPlacementGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_proximity_placement_group" "test" {
+  name                = "acctestPPG-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_availability_set" "test" {
+  name                         = "acctestavset-%[1]d"
+  location                     = azurestack_resource_group.test.location
+  resource_group_name          = azurestack_resource_group.test.name
+  proximity_placement_group_id = azurestack_proximity_placement_group.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (AvailabilitySetResource) withTags(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -253,6 +340,108 @@ resource "azurestack_availability_set" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (AvailabilitySetResource) domainCounts(data acceptance.TestData, updateDomainCount, faultDomainCount int) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_availability_set" "test" {
+  name                         = "acctestavset-%d"
+  location                     = azurestack_resource_group.test.location
+  resource_group_name          = azurestack_resource_group.test.name
+  platform_update_domain_count = %d
+  platform_fault_domain_count  = %d
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, updateDomainCount, faultDomainCount)
+}
+
+func (AvailabilitySetResource) withVirtualMachine(data acceptance.TestData, faultDomainCount int) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_availability_set" "test" {
+  name                        = "acctestavset-%d"
+  location                    = azurestack_resource_group.test.location
+  resource_group_name         = azurestack_resource_group.test.name
+  platform_fault_domain_count = %d
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurestack_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = azurestack_resource_group.test.location
+  resource_group_name   = azurestack_resource_group.test.name
+  network_interface_ids = [azurestack_network_interface.test.id]
+  availability_set_id   = azurestack_availability_set.test.id
+  vm_size               = "Standard_D1_v2"
+
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, faultDomainCount, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (AvailabilitySetResource) unmanaged(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -8,6 +8,9 @@ import (
 type Client struct {
 	AvailabilitySetsClient          *compute.AvailabilitySetsClient
 	DisksClient                     *compute.DisksClient
+	ImagesClient                    *compute.ImagesClient
+	ProximityPlacementGroupsClient  *compute.ProximityPlacementGroupsClient
+	UsageClient                     *compute.UsageClient
 	VMExtensionImageClient          *compute.VirtualMachineExtensionImagesClient
 	VMExtensionClient               *compute.VirtualMachineExtensionsClient
 	VMScaleSetClient                *compute.VirtualMachineScaleSetsClient
@@ -16,6 +19,7 @@ type Client struct {
 	VMScaleSetVMsClient             *compute.VirtualMachineScaleSetVMsClient
 	VMClient                        *compute.VirtualMachinesClient
 	VMImageClient                   *compute.VirtualMachineImagesClient
+	VMSizesClient                   *compute.VirtualMachineSizesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -28,6 +32,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	imagesClient := compute.NewImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&imagesClient.Client, o.ResourceManagerAuthorizer)
 
+	proximityPlacementGroupsClient := compute.NewProximityPlacementGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&proximityPlacementGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	usageClient := compute.NewUsageClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&usageClient.Client, o.ResourceManagerAuthorizer)
+
 	vmExtensionImageClient := compute.NewVirtualMachineExtensionImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmExtensionImageClient.Client, o.ResourceManagerAuthorizer)
 
@@ -52,9 +62,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmClient := compute.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmClient.Client, o.ResourceManagerAuthorizer)
 
+	vmSizesClient := compute.NewVirtualMachineSizesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vmSizesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AvailabilitySetsClient:          &availabilitySetsClient,
 		DisksClient:                     &disksClient,
+		ImagesClient:                    &imagesClient,
+		ProximityPlacementGroupsClient:  &proximityPlacementGroupsClient,
+		UsageClient:                     &usageClient,
 		VMExtensionImageClient:          &vmExtensionImageClient,
 		VMExtensionClient:               &vmExtensionClient,
 		VMScaleSetClient:                &vmScaleSetClient,
@@ -63,5 +79,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		VMScaleSetVMsClient:             &vmScaleSetVMsClient,
 		VMClient:                        &vmClient,
 		VMImageClient:                   &vmImageClient,
+		VMSizesClient:                   &vmSizesClient,
 	}
 }
@@ -0,0 +1,324 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/compute/mgmt/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func imageDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: imageDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"name_regex", "required_tags"},
+				AtLeastOneOf:  []string{"name", "name_regex", "required_tags"},
+			},
+
+			"name_regex": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				AtLeastOneOf: []string{"name", "name_regex", "required_tags"},
+			},
+
+			"required_tags": {
+				Type:         pluginsdk.TypeMap,
+				Optional:     true,
+				ValidateFunc: tags.Validate,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+				AtLeastOneOf: []string{"name", "name_regex", "required_tags"},
+			},
+
+			"sort_descending": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"location": commonschema.LocationComputed(),
+
+			"os_disk": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"blob_uri": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"caching": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"managed_disk_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"os_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"os_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"size_gb": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"data_disk": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"blob_uri": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"caching": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"lun": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"managed_disk_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"size_gb": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func imageDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.ImagesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+
+	var img compute.Image
+	if name != "" {
+		id := parse.NewImageID(subscriptionId, resourceGroup, name)
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("%s was not found", id)
+			}
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		img = resp
+	} else {
+		var nameRegex *regexp.Regexp
+		if v := d.Get("name_regex").(string); v != "" {
+			nameRegex = regexp.MustCompile(v)
+		}
+		requiredTags := d.Get("required_tags").(map[string]interface{})
+
+		images := make([]compute.Image, 0)
+		iter, err := client.ListByResourceGroupComplete(ctx, resourceGroup)
+		if err != nil {
+			return fmt.Errorf("listing Images (Resource Group %q): %+v", resourceGroup, err)
+		}
+		for iter.NotDone() {
+			image := iter.Value()
+			if imageMatchesFilter(image, nameRegex, requiredTags) {
+				images = append(images, image)
+			}
+
+			if err := iter.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing next page of Images (Resource Group %q): %+v", resourceGroup, err)
+			}
+		}
+
+		if len(images) == 0 {
+			return fmt.Errorf("no Images were found in Resource Group %q matching the specified filter", resourceGroup)
+		}
+
+		// there's no creation timestamp available for Images, so the newest Image is determined
+		// by the name - which matches the versioned naming conventions used by image pipelines.
+		// Numbers within the name are compared numerically, so that `img-v10` is newer than `img-v2`
+		sortDescending := d.Get("sort_descending").(bool)
+		sort.SliceStable(images, func(i, j int) bool {
+			if sortDescending {
+				return utils.NaturalLess(*images[j].Name, *images[i].Name)
+			}
+			return utils.NaturalLess(*images[i].Name, *images[j].Name)
+		})
+		img = images[0]
+		log.Printf("[DEBUG] Found %d Images in Resource Group %q matching the filter - using %q", len(images), resourceGroup, *img.Name)
+	}
+
+	if img.ID == nil || *img.ID == "" {
+		return fmt.Errorf("retrieving Image (Resource Group %q): `id` was nil", resourceGroup)
+	}
+
+	id, err := parse.ImageID(*img.ID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(img.Location))
+
+	osDisk := make([]interface{}, 0)
+	dataDisks := make([]interface{}, 0)
+	if props := img.ImageProperties; props != nil && props.StorageProfile != nil {
+		osDisk = flattenImageOSDisk(props.StorageProfile.OsDisk)
+		dataDisks = flattenImageDataDisks(props.StorageProfile.DataDisks)
+	}
+
+	if err := d.Set("os_disk", osDisk); err != nil {
+		return fmt.Errorf("setting `os_disk`: %+v", err)
+	}
+
+	if err := d.Set("data_disk", dataDisks); err != nil {
+		return fmt.Errorf("setting `data_disk`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, img.Tags)
+}
+
+func imageMatchesFilter(image compute.Image, nameRegex *regexp.Regexp, requiredTags map[string]interface{}) bool {
+	if image.Name == nil || image.ID == nil {
+		return false
+	}
+
+	if nameRegex != nil && !nameRegex.MatchString(*image.Name) {
+		return false
+	}
+
+	for k, v := range requiredTags {
+		tagValue, ok := image.Tags[k]
+		if !ok || tagValue == nil || *tagValue != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenImageOSDisk(input *compute.ImageOSDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	blobUri := ""
+	if input.BlobURI != nil {
+		blobUri = *input.BlobURI
+	}
+
+	managedDiskId := ""
+	if input.ManagedDisk != nil && input.ManagedDisk.ID != nil {
+		managedDiskId = *input.ManagedDisk.ID
+	}
+
+	diskSizeGB := 0
+	if input.DiskSizeGB != nil {
+		diskSizeGB = int(*input.DiskSizeGB)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"blob_uri":        blobUri,
+			"caching":         string(input.Caching),
+			"managed_disk_id": managedDiskId,
+			"os_state":        string(input.OsState),
+			"os_type":         string(input.OsType),
+			"size_gb":         diskSizeGB,
+		},
+	}
+}
+
+func flattenImageDataDisks(input *[]compute.ImageDataDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, disk := range *input {
+		blobUri := ""
+		if disk.BlobURI != nil {
+			blobUri = *disk.BlobURI
+		}
+
+		lun := 0
+		if disk.Lun != nil {
+			lun = int(*disk.Lun)
+		}
+
+		managedDiskId := ""
+		if disk.ManagedDisk != nil && disk.ManagedDisk.ID != nil {
+			managedDiskId = *disk.ManagedDisk.ID
+		}
+
+		diskSizeGB := 0
+		if disk.DiskSizeGB != nil {
+			diskSizeGB = int(*disk.DiskSizeGB)
+		}
+
+		output = append(output, map[string]interface{}{
+			"blob_uri":        blobUri,
+			"caching":         string(disk.Caching),
+			"lun":             lun,
+			"managed_disk_id": managedDiskId,
+			"size_gb":         diskSizeGB,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,176 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type ImageDataSource struct{}
+
+func TestAccImageDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_image", "test")
+	r := ImageDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestimg-%d-v1", data.RandomInteger)),
+				check.That(data.ResourceName).Key("os_disk.#").HasValue("1"),
+				check.That(data.ResourceName).Key("os_disk.0.os_type").HasValue("Linux"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccImageDataSource_nameRegex(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_image", "test")
+	r := ImageDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.nameRegex(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestimg-%d-v2", data.RandomInteger)),
+			),
+		},
+		{
+			Config: r.nameRegex(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestimg-%d-v1", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func TestAccImageDataSource_requiredTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_image", "test")
+	r := ImageDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.requiredTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestimg-%d-v2", data.RandomInteger)),
+				check.That(data.ResourceName).Key("tags.channel").HasValue("stable"),
+			),
+		},
+	})
+}
+
+func (ImageDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_image" "test" {
+  name                = "acctestimg-%d-v1"
+  resource_group_name = azurestack_resource_group.test.name
+
+  depends_on = [azurestack_template_deployment.test]
+}
+`, ImageDataSource{}.template(data), data.RandomInteger)
+}
+
+func (ImageDataSource) nameRegex(data acceptance.TestData, sortDescending bool) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_image" "test" {
+  name_regex          = "^acctestimg-%d-"
+  sort_descending     = %t
+  resource_group_name = azurestack_resource_group.test.name
+
+  depends_on = [azurestack_template_deployment.test]
+}
+`, ImageDataSource{}.template(data), data.RandomInteger, sortDescending)
+}
+
+func (ImageDataSource) requiredTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_image" "test" {
+  resource_group_name = azurestack_resource_group.test.name
+
+  required_tags = {
+    channel = "stable"
+  }
+
+  depends_on = [azurestack_template_deployment.test]
+}
+`, ImageDataSource{}.template(data))
+}
+
+// template provisions two Images from an empty Managed Disk, since there's no Image resource available
+func (ImageDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_managed_disk" "test" {
+  name                 = "acctestmd-%d"
+  location             = azurestack_resource_group.test.location
+  resource_group_name  = azurestack_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "1"
+}
+
+resource "azurestack_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Compute/images",
+      "apiVersion": "2020-06-01",
+      "name": "acctestimg-%d-v1",
+      "location": "${azurestack_resource_group.test.location}",
+      "tags": { "channel": "beta" },
+      "properties": {
+        "storageProfile": {
+          "osDisk": {
+            "osType": "Linux",
+            "osState": "Generalized",
+            "managedDisk": { "id": "${azurestack_managed_disk.test.id}" }
+          }
+        }
+      }
+    },
+    {
+      "type": "Microsoft.Compute/images",
+      "apiVersion": "2020-06-01",
+      "name": "acctestimg-%d-v2",
+      "location": "${azurestack_resource_group.test.location}",
+      "tags": { "channel": "stable" },
+      "properties": {
+        "storageProfile": {
+          "osDisk": {
+            "osType": "Linux",
+            "osState": "Generalized",
+            "managedDisk": { "id": "${azurestack_managed_disk.test.id}" }
+          }
+        }
+      }
+    }
+  ]
+}
+DEPLOY
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
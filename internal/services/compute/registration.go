@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
 )
 
@@ -22,8 +23,10 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurestack_availability_set": availabilitySetDataSource(),
+		"azurestack_image":            imageDataSource(),
 		"azurestack_managed_disk":     managedDiskDataSource(),
 		"azurestack_platform_image":   platformImageDataSource(),
+		"azurestack_virtual_machine":  virtualMachineDataSource(),
 	}
 }
 
@@ -32,6 +35,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	resources := map[string]*pluginsdk.Resource{
 		"azurestack_availability_set":                     availabilitySet(),
 		"azurestack_managed_disk":                         managedDisk(),
+		"azurestack_proximity_placement_group":            proximityPlacementGroup(),
+		"azurestack_ssh_key_pair":                         sshKeyPair(),
 		"azurestack_virtual_machine":                      virtualMachine(),
 		"azurestack_virtual_machine_data_disk_attachment": virtualMachineDataDiskAttachment(),
 		"azurestack_virtual_machine_extension":            virtualMachineExtension(),
@@ -41,3 +46,41 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 	return resources
 }
+
+// AttributesNotReturnedByAPI returns the attributes of the Resources supported by this Service which
+// aren't returned by the API, and as such aren't populated when the Resource is imported
+func (r Registration) AttributesNotReturnedByAPI() map[string][]string {
+	return map[string][]string{
+		// the `delete_*_on_termination` fields only control the behaviour of the Provider during deletion, and
+		// the Admin Password and Custom Data are write-only
+		"azurestack_virtual_machine": {
+			"delete_data_disks_on_termination",
+			"delete_os_disk_on_termination",
+			"os_profile.admin_password",
+			"os_profile.custom_data",
+		},
+		// Protected Settings are write-only
+		"azurestack_virtual_machine_extension": {
+			"protected_settings",
+		},
+		// the Admin Password, Custom Data and the Protected Settings of each Extension are write-only
+		"azurestack_virtual_machine_scale_set": {
+			"extension.protected_settings",
+			"os_profile.admin_password",
+			"os_profile.custom_data",
+		},
+		// Protected Settings are write-only
+		"azurestack_virtual_machine_scale_set_extension": {
+			"protected_settings",
+		},
+	}
+}
+
+// ResourceProviderTypes returns the Resource Types provisioned by the Resources supported by this Service,
+// which are checked against the Resource Types supported by the Azure Stack Hub at plan time
+func (r Registration) ResourceProviderTypes() map[string]string {
+	return map[string]string{
+		"azurestack_managed_disk":              "Microsoft.Compute/disks",
+		"azurestack_proximity_placement_group": capabilities.ProximityPlacementGroupsResourceType,
+	}
+}
@@ -0,0 +1,242 @@
+package compute
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sshKeyPairAlgorithmED25519 = "ED25519"
+	sshKeyPairAlgorithmRSA     = "RSA"
+)
+
+// sshKeyPair generates an SSH Key Pair locally, without making any API calls - which allows generating
+// keys without depending on the `tls` provider, which may not be available in air-gapped environments
+func sshKeyPair() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: sshKeyPairCreate,
+		Read:   sshKeyPairRead,
+		Delete: sshKeyPairDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"algorithm": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  sshKeyPairAlgorithmRSA,
+				ValidateFunc: validation.StringInSlice([]string{
+					sshKeyPairAlgorithmED25519,
+					sshKeyPairAlgorithmRSA,
+				}, false),
+			},
+
+			"rsa_bits": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      4096,
+				ValidateFunc: validation.IntInSlice([]int{2048, 3072, 4096}),
+			},
+
+			"private_key_pem": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"private_key_openssh": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"public_key_openssh": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_key_fingerprint_md5": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_key_fingerprint_sha256": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func sshKeyPairCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	algorithm := d.Get("algorithm").(string)
+
+	var privateKey interface{}
+	var privateKeyPem *pem.Block
+	switch algorithm {
+	case sshKeyPairAlgorithmRSA:
+		key, err := rsa.GenerateKey(rand.Reader, d.Get("rsa_bits").(int))
+		if err != nil {
+			return fmt.Errorf("generating RSA key: %+v", err)
+		}
+		privateKey = key
+		privateKeyPem = &pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}
+
+	case sshKeyPairAlgorithmED25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return fmt.Errorf("generating ED25519 key: %+v", err)
+		}
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return fmt.Errorf("encoding ED25519 key: %+v", err)
+		}
+		privateKey = key
+		privateKeyPem = &pem.Block{
+			Type:  "PRIVATE KEY",
+			Bytes: der,
+		}
+
+	default:
+		return fmt.Errorf("unsupported algorithm %q", algorithm)
+	}
+
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		return fmt.Errorf("building SSH public key: %+v", err)
+	}
+	publicKey := signer.PublicKey()
+
+	privateKeyOpenSSH, err := marshalOpenSSHPrivateKey(privateKey, publicKey)
+	if err != nil {
+		return fmt.Errorf("encoding private key in the OpenSSH format: %+v", err)
+	}
+
+	publicKeyOpenSSH := string(ssh.MarshalAuthorizedKey(publicKey))
+
+	// there's nothing to look up remotely, so the ID is a hash of the Public Key
+	hash := sha1.Sum(publicKey.Marshal())
+	d.SetId(hex.EncodeToString(hash[:]))
+
+	d.Set("private_key_pem", string(pem.EncodeToMemory(privateKeyPem)))
+	d.Set("private_key_openssh", string(pem.EncodeToMemory(privateKeyOpenSSH)))
+	d.Set("public_key_openssh", publicKeyOpenSSH)
+	d.Set("public_key_fingerprint_md5", ssh.FingerprintLegacyMD5(publicKey))
+	d.Set("public_key_fingerprint_sha256", ssh.FingerprintSHA256(publicKey))
+
+	return sshKeyPairRead(d, meta)
+}
+
+func sshKeyPairRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	// the Key Pair only exists within the State
+	return nil
+}
+
+func sshKeyPairDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// marshalOpenSSHPrivateKey encodes the private key using the `openssh-key-v1` format, since
+// this isn't supported by the version of `golang.org/x/crypto/ssh` in use
+func marshalOpenSSHPrivateKey(privateKey interface{}, publicKey ssh.PublicKey) (*pem.Block, error) {
+	// the check value is used to verify a decryption, which is a no-op for unencrypted keys
+	checkBytes := make([]byte, 4)
+	if _, err := rand.Read(checkBytes); err != nil {
+		return nil, err
+	}
+	check := binary.BigEndian.Uint32(checkBytes)
+
+	var keyFields []byte
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		key.Precompute()
+		keyFields = ssh.Marshal(struct {
+			N    *big.Int
+			E    *big.Int
+			D    *big.Int
+			Iqmp *big.Int
+			P    *big.Int
+			Q    *big.Int
+		}{
+			N:    key.N,
+			E:    big.NewInt(int64(key.E)),
+			D:    key.D,
+			Iqmp: key.Precomputed.Qinv,
+			P:    key.Primes[0],
+			Q:    key.Primes[1],
+		})
+
+	case ed25519.PrivateKey:
+		keyFields = ssh.Marshal(struct {
+			Public  []byte
+			Private []byte
+		}{
+			Public:  key.Public().(ed25519.PublicKey),
+			Private: key,
+		})
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", privateKey)
+	}
+
+	privateSection := ssh.Marshal(struct {
+		Check1  uint32
+		Check2  uint32
+		KeyType string
+		Rest    []byte `ssh:"rest"`
+	}{
+		Check1:  check,
+		Check2:  check,
+		KeyType: publicKey.Type(),
+		Rest:    keyFields,
+	})
+	privateSection = append(privateSection, ssh.Marshal(struct{ Comment string }{})...)
+
+	// the private section is padded to the cipher's block size, which is 8 for unencrypted keys
+	for i := 1; len(privateSection)%8 != 0; i++ {
+		privateSection = append(privateSection, byte(i))
+	}
+
+	body := ssh.Marshal(struct {
+		CipherName   string
+		KdfName      string
+		KdfOptions   string
+		NumberOfKeys uint32
+		PublicKey    []byte
+		Private      []byte
+	}{
+		CipherName:   "none",
+		KdfName:      "none",
+		NumberOfKeys: 1,
+		PublicKey:    publicKey.Marshal(),
+		Private:      privateSection,
+	})
+
+	return &pem.Block{
+		Type:  "OPENSSH PRIVATE KEY",
+		Bytes: append([]byte("openssh-key-v1\x00"), body...),
+	}, nil
+}
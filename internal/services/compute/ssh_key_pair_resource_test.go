@@ -0,0 +1,200 @@
+package compute_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"golang.org/x/crypto/ssh"
+)
+
+type SshKeyPairResource struct{}
+
+func TestAccSshKeyPair_rsa(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_ssh_key_pair", "test")
+	r := SshKeyPairResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rsa(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("private_key_pem").Exists(),
+				check.That(data.ResourceName).Key("public_key_fingerprint_md5").Exists(),
+				check.That(data.ResourceName).Key("public_key_fingerprint_sha256").Exists(),
+				data.CheckWithClient(r.keysMatch("ssh-rsa")),
+			),
+		},
+	})
+}
+
+func TestAccSshKeyPair_ed25519(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_ssh_key_pair", "test")
+	r := SshKeyPairResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ed25519(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("private_key_pem").Exists(),
+				data.CheckWithClient(r.keysMatch("ssh-ed25519")),
+			),
+		},
+	})
+}
+
+func TestAccSshKeyPair_virtualMachine(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_machine", "test")
+	r := VirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: SshKeyPairResource{}.virtualMachine(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+// Exists returns true since the Key Pair only exists within the State
+func (SshKeyPairResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	exists := state.ID != ""
+	return &exists, nil
+}
+
+func (SshKeyPairResource) keysMatch(keyType string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		signer, err := ssh.ParsePrivateKey([]byte(state.Attributes["private_key_openssh"]))
+		if err != nil {
+			return fmt.Errorf("parsing `private_key_openssh`: %+v", err)
+		}
+
+		publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(state.Attributes["public_key_openssh"]))
+		if err != nil {
+			return fmt.Errorf("parsing `public_key_openssh`: %+v", err)
+		}
+
+		if publicKey.Type() != keyType {
+			return fmt.Errorf("expected the public key to be of type %q but got %q", keyType, publicKey.Type())
+		}
+
+		if !bytes.Equal(signer.PublicKey().Marshal(), publicKey.Marshal()) {
+			return fmt.Errorf("`public_key_openssh` doesn't match `private_key_openssh`")
+		}
+
+		if fingerprint := ssh.FingerprintSHA256(publicKey); fingerprint != state.Attributes["public_key_fingerprint_sha256"] {
+			return fmt.Errorf("expected `public_key_fingerprint_sha256` to be %q but got %q", fingerprint, state.Attributes["public_key_fingerprint_sha256"])
+		}
+
+		return nil
+	}
+}
+
+func (SshKeyPairResource) rsa() string {
+	return `
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_ssh_key_pair" "test" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+`
+}
+
+func (SshKeyPairResource) ed25519() string {
+	return `
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_ssh_key_pair" "test" {
+  algorithm = "ED25519"
+}
+`
+}
+
+func (SshKeyPairResource) virtualMachine(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_ssh_key_pair" "test" {}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurestack_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = azurestack_resource_group.test.location
+  resource_group_name   = azurestack_resource_group.test.name
+  network_interface_ids = [azurestack_network_interface.test.id]
+  vm_size               = "Standard_D1_v2"
+
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = true
+
+    ssh_keys {
+      path     = "/home/testadmin/.ssh/authorized_keys"
+      key_data = azurestack_ssh_key_pair.test.public_key_openssh
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
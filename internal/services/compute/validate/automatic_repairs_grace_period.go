@@ -0,0 +1,30 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// AutomaticRepairsGracePeriod validates that the Grace Period for Automatic Instance Repairs is an ISO 8601 duration
+// in minutes (e.g. `PT30M`) between 30 and 90 minutes
+func AutomaticRepairsGracePeriod(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	matches := regexp.MustCompile(`^PT(\d+)M$`).FindStringSubmatch(value)
+	if len(matches) != 2 {
+		errors = append(errors, fmt.Errorf("%q must be an ISO 8601 duration in minutes, such as `PT30M`, got %q", k, value))
+		return warnings, errors
+	}
+
+	minutes, err := strconv.Atoi(matches[1])
+	if err != nil || minutes < 30 || minutes > 90 {
+		errors = append(errors, fmt.Errorf("%q must be between 30 and 90 minutes (`PT30M` and `PT90M`), got %q", k, value))
+	}
+
+	return warnings, errors
+}
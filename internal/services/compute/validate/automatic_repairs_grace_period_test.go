@@ -0,0 +1,57 @@
+package validate
+
+import "testing"
+
+func TestAutomaticRepairsGracePeriod(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "30",
+			Errors: 1,
+		},
+		{
+			Value:  "PT1H",
+			Errors: 1,
+		},
+		{
+			Value:  "PT29M",
+			Errors: 1,
+		},
+		{
+			Value:  "PT30M",
+			Errors: 0,
+		},
+		{
+			Value:  "PT45M",
+			Errors: 0,
+		},
+		{
+			Value:  "PT90M",
+			Errors: 0,
+		},
+		{
+			Value:  "PT91M",
+			Errors: 1,
+		},
+		{
+			Value:  "pt30m",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			_, errors := AutomaticRepairsGracePeriod(tc.Value, "grace_period")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected AutomaticRepairsGracePeriod to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package compute
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/compute/mgmt/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func virtualMachineDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: virtualMachineDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"location": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"zones": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"vm_size": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"os_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"availability_set_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"license_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"network_interface_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"primary_network_interface_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"identity_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"storage_os_disk": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"os_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"vhd_uri": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"image_uri": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"managed_disk_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"managed_disk_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"create_option": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"caching": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"disk_size_gb": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"write_accelerator_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"storage_data_disk": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"vhd_uri": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"managed_disk_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"managed_disk_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"create_option": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"caching": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"disk_size_gb": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"lun": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"write_accelerator_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func virtualMachineDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewVirtualMachineID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("zones", utils.FlattenStringSlice(resp.Zones))
+
+	if err := d.Set("identity", flattenazurestackVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	availabilitySetId := ""
+	licenseType := ""
+	networkInterfaceIds := make([]interface{}, 0)
+	osType := ""
+	primaryNetworkInterfaceId := ""
+	osDisk := make([]interface{}, 0)
+	var dataDisks interface{} = make([]interface{}, 0)
+	vmSize := ""
+
+	if props := resp.VirtualMachineProperties; props != nil {
+		if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
+			// the Resource Group within the Availability Set ID can be returned with the incorrect casing
+			availabilitySetId = strings.ToLower(*props.AvailabilitySet.ID)
+		}
+
+		if props.LicenseType != nil {
+			licenseType = *props.LicenseType
+		}
+
+		if profile := props.HardwareProfile; profile != nil {
+			vmSize = string(profile.VMSize)
+		}
+
+		if profile := props.StorageProfile; profile != nil {
+			if disk := profile.OsDisk; disk != nil {
+				osType = string(disk.OsType)
+
+				diskInfo, err := virtualMachineGetManagedDiskInfo(d, disk.ManagedDisk, meta)
+				if err != nil {
+					return fmt.Errorf("retrieving the OS Disk for %s: %+v", id, err)
+				}
+				osDisk = flattenazurestackVirtualMachineOsDisk(disk, diskInfo)
+			}
+
+			if disks := profile.DataDisks; disks != nil {
+				disksInfo := make([]*compute.Disk, len(*disks))
+				for i, disk := range *disks {
+					diskInfo, err := virtualMachineGetManagedDiskInfo(d, disk.ManagedDisk, meta)
+					if err != nil {
+						return fmt.Errorf("retrieving the Data Disks for %s: %+v", id, err)
+					}
+					disksInfo[i] = diskInfo
+				}
+				dataDisks = flattenazurestackVirtualMachineDataDisk(disks, disksInfo)
+			}
+		}
+
+		if profile := props.NetworkProfile; profile != nil && profile.NetworkInterfaces != nil {
+			networkInterfaceIds = flattenazurestackVirtualMachineNetworkInterfaces(profile)
+
+			for _, nic := range *profile.NetworkInterfaces {
+				if nic.NetworkInterfaceReferenceProperties != nil && nic.Primary != nil && *nic.Primary && nic.ID != nil {
+					primaryNetworkInterfaceId = *nic.ID
+					break
+				}
+			}
+
+			// when a single Network Interface is attached it's implicitly the primary
+			if primaryNetworkInterfaceId == "" && len(networkInterfaceIds) == 1 {
+				primaryNetworkInterfaceId = networkInterfaceIds[0].(string)
+			}
+		}
+	}
+
+	d.Set("availability_set_id", availabilitySetId)
+	d.Set("license_type", licenseType)
+	d.Set("os_type", osType)
+	d.Set("primary_network_interface_id", primaryNetworkInterfaceId)
+	d.Set("vm_size", vmSize)
+
+	if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+		return fmt.Errorf("setting `network_interface_ids`: %+v", err)
+	}
+
+	if err := d.Set("storage_os_disk", osDisk); err != nil {
+		return fmt.Errorf("setting `storage_os_disk`: %+v", err)
+	}
+
+	if err := d.Set("storage_data_disk", dataDisks); err != nil {
+		return fmt.Errorf("setting `storage_data_disk`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
@@ -0,0 +1,73 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type VirtualMachineDataSource struct{}
+
+func TestAccVirtualMachineDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_virtual_machine", "test")
+	r := VirtualMachineDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("vm_size").HasValue(data.Skus.Sku("Standard_D1_v2")),
+				check.That(data.ResourceName).Key("os_type").HasValue("Linux"),
+				check.That(data.ResourceName).Key("network_interface_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("primary_network_interface_id").Exists(),
+				check.That(data.ResourceName).Key("storage_os_disk.#").HasValue("1"),
+				check.That(data.ResourceName).Key("storage_os_disk.0.managed_disk_id").Exists(),
+				check.That(data.ResourceName).Key("storage_os_disk.0.managed_disk_type").HasValue(data.Skus.Sku("Standard_LRS")),
+				check.That(data.ResourceName).Key("storage_data_disk.#").HasValue("1"),
+				check.That(data.ResourceName).Key("storage_data_disk.0.lun").HasValue("0"),
+				check.That(data.ResourceName).Key("storage_data_disk.0.disk_size_gb").HasValue("1"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccVirtualMachineDataSource_identity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_virtual_machine", "test")
+	r := VirtualMachineDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.identity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("identity.#").HasValue("1"),
+				check.That(data.ResourceName).Key("identity.0.type").HasValue("SystemAssigned"),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualMachineDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_virtual_machine" "test" {
+  name                = azurestack_virtual_machine.test.name
+  resource_group_name = azurestack_virtual_machine.test.resource_group_name
+}
+`, VirtualMachineResource{}.withDataDisk_managedDisk_explicit(data))
+}
+
+func (VirtualMachineDataSource) identity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_virtual_machine" "test" {
+  name                = azurestack_virtual_machine.test.name
+  resource_group_name = azurestack_virtual_machine.test.resource_group_name
+}
+`, VirtualMachineResource{}.withManagedServiceIdentity(data))
+}
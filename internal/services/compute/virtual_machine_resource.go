@@ -16,12 +16,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/zones"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/parse"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/validate"
+	managedIdentityValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/managedidentity/validate"
+	managedIdentityParse "github.com/hashicorp/terraform-provider-azurestack/internal/services/managedidentity/parse"
 	networkParse "github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
 	intStor "github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
@@ -62,6 +65,8 @@ func virtualMachine() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualMachineCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -117,6 +122,14 @@ func virtualMachine() *pluginsdk.Resource {
 				ConflictsWith: []string{"zones"},
 			},
 
+			"proximity_placement_group_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.ProximityPlacementGroupID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
 			"identity": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -134,6 +147,14 @@ func virtualMachine() *pluginsdk.Resource {
 								string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
 							}, false),
 						},
+						"identity_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: managedIdentityValidate.UserAssignedIdentityID,
+							},
+						},
 						"principal_id": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -264,6 +285,11 @@ func virtualMachine() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
 							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.CachingTypesNone),
+								string(compute.CachingTypesReadOnly),
+								string(compute.CachingTypesReadWrite),
+							}, false),
 						},
 
 						"create_option": {
@@ -337,6 +363,11 @@ func virtualMachine() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
 							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.CachingTypesNone),
+								string(compute.CachingTypesReadOnly),
+								string(compute.CachingTypesReadWrite),
+							}, false),
 						},
 
 						"disk_size_gb": {
@@ -590,6 +621,54 @@ func virtualMachine() *pluginsdk.Resource {
 	}
 }
 
+func virtualMachineCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMSizesClient
+
+	if err := validateZonesAreSupported(d, meta.(*clients.Client).Capabilities, "Microsoft.Compute/virtualMachines"); err != nil {
+		return err
+	}
+
+	if err := validateProximityPlacementGroupsAreSupported(d, meta.(*clients.Client).Capabilities, "Microsoft.Compute/virtualMachines"); err != nil {
+		return err
+	}
+
+	disks := make([]capabilities.VirtualMachineDisk, 0)
+	if v, ok := d.GetOk("storage_os_disk"); ok {
+		for i, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			disks = append(disks, expandVirtualMachineDiskCapabilities(fmt.Sprintf("storage_os_disk.%d", i), raw.(map[string]interface{})))
+		}
+	}
+	if v, ok := d.GetOk("storage_data_disk"); ok {
+		for i, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			disks = append(disks, expandVirtualMachineDiskCapabilities(fmt.Sprintf("storage_data_disk.%d", i), raw.(map[string]interface{})))
+		}
+	}
+
+	return validateVirtualMachineDisksForSize(ctx, d, client, "vm_size", "storage_data_disk", disks)
+}
+
+func expandVirtualMachineDiskCapabilities(field string, input map[string]interface{}) capabilities.VirtualMachineDisk {
+	disk := capabilities.VirtualMachineDisk{
+		Field: field,
+	}
+	if v, ok := input["managed_disk_type"].(string); ok {
+		disk.StorageAccountType = v
+	}
+	if v, ok := input["caching"].(string); ok {
+		disk.Caching = v
+	}
+	if v, ok := input["write_accelerator_enabled"].(bool); ok {
+		disk.WriteAcceleratorEnabled = v
+	}
+	return disk
+}
+
 func virtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.VMClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -680,6 +759,12 @@ func virtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		properties.AvailabilitySet = &availSet
 	}
 
+	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
+		properties.ProximityPlacementGroup = &compute.SubResource{
+			ID: pointer.FromString(v.(string)),
+		}
+	}
+
 	vm := compute.VirtualMachine{
 		Name:                     &id.Name,
 		Location:                 &location,
@@ -689,7 +774,11 @@ func virtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	if _, ok := d.GetOk("identity"); ok {
-		vm.Identity = expandazurestackVirtualMachineIdentity(d)
+		identity, err := expandazurestackVirtualMachineIdentity(d)
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		vm.Identity = identity
 	}
 
 	if _, ok := d.GetOk("plan"); ok {
@@ -780,6 +869,13 @@ func virtualMachineRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			d.Set("availability_set_id", strings.ToLower(*availabilitySet.ID))
 		}
 
+		proximityPlacementGroupId := ""
+		if props.ProximityPlacementGroup != nil && props.ProximityPlacementGroup.ID != nil {
+			proximityPlacementGroupId = *props.ProximityPlacementGroup.ID
+		}
+		d.Set("proximity_placement_group_id", proximityPlacementGroupId)
+		d.Set("license_type", props.LicenseType)
+
 		if profile := props.HardwareProfile; profile != nil {
 			d.Set("vm_size", profile.VMSize)
 		}
@@ -877,6 +973,20 @@ func virtualMachineDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving Virtual Machine %q : %s", id.String(), err)
 	}
 
+	virtualMachineFeatures := meta.(*clients.Client).Features.VirtualMachine
+	if virtualMachineFeatures.GracefulShutdown {
+		log.Printf("[DEBUG] Gracefully shutting down Virtual Machine %q (Resource Group %q) prior to deletion..", id.Name, id.ResourceGroup)
+		skipShutdown := false
+		future, err := client.PowerOff(ctx, id.ResourceGroup, id.Name, utils.Bool(skipShutdown))
+		if err != nil {
+			return fmt.Errorf("sending Power Off to Virtual Machine %q : %s", id.String(), err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for Power Off of Virtual Machine %q : %s", id.String(), err)
+		}
+	}
+
 	var forceDeletion *bool = nil
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name, forceDeletion)
 	if err != nil {
@@ -888,7 +998,7 @@ func virtualMachineDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	// delete OS Disk if opted in
-	deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool)
+	deleteOsDisk := d.Get("delete_os_disk_on_termination").(bool) || virtualMachineFeatures.DeleteOSDiskOnDeletion
 	deleteDataDisks := d.Get("delete_data_disks_on_termination").(bool)
 
 	if deleteOsDisk || deleteDataDisks {
@@ -904,7 +1014,7 @@ func virtualMachineDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 
 		if deleteOsDisk {
-			log.Printf("[INFO] delete_os_disk_on_termination is enabled, deleting disk from %s", id.Name)
+			log.Printf("[INFO] delete_os_disk_on_termination or the delete_os_disk_on_deletion feature is enabled, deleting disk from %s", id.Name)
 			osDisk := storageProfile.OsDisk
 			if osDisk == nil {
 				return fmt.Errorf("deleting OS Disk for Virtual Machine %q - `osDisk` was nil", id.Name)
@@ -1081,6 +1191,16 @@ func flattenazurestackVirtualMachineIdentity(identity *compute.VirtualMachineIde
 		result["principal_id"] = *identity.PrincipalID
 	}
 
+	identityIds := make([]interface{}, 0)
+	for key := range identity.UserAssignedIdentities {
+		// the API can return the Resource Group segment with a different casing, so normalize these where possible
+		if id, err := managedIdentityParse.UserAssignedIdentityIDInsensitively(key); err == nil {
+			key = id.ID()
+		}
+		identityIds = append(identityIds, key)
+	}
+	result["identity_ids"] = identityIds
+
 	return []interface{}{result}
 }
 
@@ -1338,17 +1458,34 @@ func expandazurestackVirtualMachinePlan(d *pluginsdk.ResourceData) *compute.Plan
 	}
 }
 
-func expandazurestackVirtualMachineIdentity(d *pluginsdk.ResourceData) *compute.VirtualMachineIdentity {
+func expandazurestackVirtualMachineIdentity(d *pluginsdk.ResourceData) (*compute.VirtualMachineIdentity, error) {
 	v := d.Get("identity")
 	identities := v.([]interface{})
 	identity := identities[0].(map[string]interface{})
 	identityType := compute.ResourceIdentityType(identity["type"].(string))
 
+	identityIds := make(map[string]*compute.VirtualMachineIdentityUserAssignedIdentitiesValue)
+	for _, id := range identity["identity_ids"].(*pluginsdk.Set).List() {
+		identityIds[id.(string)] = &compute.VirtualMachineIdentityUserAssignedIdentitiesValue{}
+	}
+
 	vmIdentity := compute.VirtualMachineIdentity{
 		Type: identityType,
 	}
 
-	return &vmIdentity
+	switch identityType {
+	case compute.ResourceIdentityTypeUserAssigned, compute.ResourceIdentityTypeSystemAssignedUserAssigned:
+		if len(identityIds) == 0 {
+			return nil, fmt.Errorf("`identity_ids` must be specified when `type` is set to %q", string(identityType))
+		}
+		vmIdentity.UserAssignedIdentities = identityIds
+	default:
+		if len(identityIds) > 0 {
+			return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+		}
+	}
+
+	return &vmIdentity, nil
 }
 
 func expandazurestackVirtualMachineOsProfile(d *pluginsdk.ResourceData) (*compute.OSProfile, error) {
@@ -272,32 +272,41 @@ func TestAccVirtualMachine_managedServiceIdentity(t *testing.T) {
 	})
 }
 
-func TestAccVirtualMachine_enableAnWithVM(t *testing.T) {
+func TestAccVirtualMachine_userAssignedIdentity(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_virtual_machine", "test")
 	r := VirtualMachineResource{}
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.anWithVM(data),
+			Config: r.withUserAssignedIdentity(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.type").HasValue("UserAssigned"),
+				check.That(data.ResourceName).Key("identity.0.identity_ids.#").HasValue("1"),
 			),
 		},
 	})
 }
 
-func TestAccVirtualMachine_basicLinuxMachine_managedDisk_changeOsWriteAcceleratorEnabled(t *testing.T) {
+func TestAccVirtualMachine_enableAnWithVM(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_virtual_machine", "test")
 	r := VirtualMachineResource{}
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.basicLinuxMachine_managedDisk_withOsWriteAcceleratorEnabled(data, "true"),
+			Config: r.anWithVM(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("storage_os_disk.0.write_accelerator_enabled").HasValue("true"),
 			),
 		},
+	})
+}
+
+func TestAccVirtualMachine_basicLinuxMachine_managedDisk_changeOsWriteAcceleratorEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_machine", "test")
+	r := VirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
 			Config: r.basicLinuxMachine_managedDisk_withOsWriteAcceleratorEnabled(data, "false"),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -305,6 +314,11 @@ func TestAccVirtualMachine_basicLinuxMachine_managedDisk_changeOsWriteAccelerato
 				check.That(data.ResourceName).Key("storage_os_disk.0.write_accelerator_enabled").HasValue("false"),
 			),
 		},
+		{
+			// the `DS` series doesn't support Write Accelerator, which is only available on the `M` series
+			Config:      r.basicLinuxMachine_managedDisk_withOsWriteAcceleratorEnabled(data, "true"),
+			ExpectError: regexp.MustCompile("doesn't support Write Accelerator"),
+		},
 	})
 }
 
@@ -314,11 +328,8 @@ func TestAccVirtualMachine_basicLinuxMachine_managedDisk_withWriteAcceleratorEna
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.basicLinuxMachine_managedDisk_withWriteAcceleratorEnabled(data, "true"),
-			Check: acceptance.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("storage_data_disk.0.write_accelerator_enabled").HasValue("true"),
-			),
+			Config:      r.basicLinuxMachine_managedDisk_withWriteAcceleratorEnabled(data, "true"),
+			ExpectError: regexp.MustCompile("doesn't support Write Accelerator"),
 		},
 	})
 }
@@ -336,11 +347,44 @@ func TestAccVirtualMachine_basicLinuxMachine_managedDisk_changeWriteAcceleratorE
 			),
 		},
 		{
-			Config: r.basicLinuxMachine_managedDisk_withWriteAcceleratorEnabled(data, "true"),
-			Check: acceptance.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("storage_data_disk.0.write_accelerator_enabled").HasValue("true"),
-			),
+			Config:      r.basicLinuxMachine_managedDisk_withWriteAcceleratorEnabled(data, "true"),
+			ExpectError: regexp.MustCompile("doesn't support Write Accelerator"),
+		},
+	})
+}
+
+func TestAccVirtualMachine_basicLinuxMachine_managedDisk_premiumUnsupportedSize(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_machine", "test")
+	r := VirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.basicLinuxMachine_managedDisk_diskPerformance(data, "Standard_D1_v2", "Premium_LRS", "ReadWrite"),
+			ExpectError: regexp.MustCompile("doesn't support \"Premium_LRS\" Disks"),
+		},
+	})
+}
+
+func TestAccVirtualMachine_basicLinuxMachine_managedDisk_sizeUnavailable(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_machine", "test")
+	r := VirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.basicLinuxMachine_managedDisk_diskPerformance(data, "Standard_Imaginary1", "Standard_LRS", "ReadWrite"),
+			ExpectError: regexp.MustCompile("isn't available in"),
+		},
+	})
+}
+
+func TestAccVirtualMachine_basicLinuxMachine_managedDisk_invalidCaching(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_machine", "test")
+	r := VirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.basicLinuxMachine_managedDisk_diskPerformance(data, "Standard_DS1_v2", "Premium_LRS", "WriteOnly"),
+			ExpectError: regexp.MustCompile("expected storage_os_disk.0.caching to be one of"),
 		},
 	})
 }
@@ -354,7 +398,7 @@ func TestAccVirtualMachine_hasDiskInfoWhenStopped(t *testing.T) {
 			Config: r.hasDiskInfoWhenStopped(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("storage_os_disk.0.managed_disk_type").HasValue("Standard_LRS"),
+				check.That(data.ResourceName).Key("storage_os_disk.0.managed_disk_type").HasValue(data.Skus.Sku("Standard_LRS")),
 				check.That(data.ResourceName).Key("storage_data_disk.0.disk_size_gb").HasValue("64"),
 			),
 		},
@@ -362,7 +406,7 @@ func TestAccVirtualMachine_hasDiskInfoWhenStopped(t *testing.T) {
 			Config: r.hasDiskInfoWhenStopped(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				data.CheckWithClient(r.deallocate),
-				check.That(data.ResourceName).Key("storage_os_disk.0.managed_disk_type").HasValue("Standard_LRS"),
+				check.That(data.ResourceName).Key("storage_os_disk.0.managed_disk_type").HasValue(data.Skus.Sku("Standard_LRS")),
 				check.That(data.ResourceName).Key("storage_data_disk.0.disk_size_gb").HasValue("64"),
 			),
 		},
@@ -582,6 +626,80 @@ resource "azurestack_virtual_machine" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, enabled, data.RandomInteger)
 }
 
+func (VirtualMachineResource) basicLinuxMachine_managedDisk_diskPerformance(data acceptance.TestData, vmSize, diskType, caching string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurestack_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = "%s"
+  resource_group_name   = azurestack_resource_group.test.name
+  network_interface_ids = [azurestack_network_interface.test.id]
+  vm_size               = "%s"
+
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%d"
+    caching           = "%s"
+    create_option     = "FromImage"
+    disk_size_gb      = "50"
+    managed_disk_type = "%s"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.Locations.Primary, vmSize, data.RandomInteger, caching, diskType, data.RandomInteger)
+}
+
 func (VirtualMachineResource) withManagedServiceIdentity(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -667,6 +785,89 @@ output "principal_id" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (VirtualMachineResource) withUserAssignedIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_user_assigned_identity" "test" {
+  name                = "acctestUAI-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurestack_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = azurestack_resource_group.test.location
+  resource_group_name   = azurestack_resource_group.test.name
+  network_interface_ids = [azurestack_network_interface.test.id]
+  vm_size               = "Standard_D1_v2"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurestack_user_assigned_identity.test.id]
+  }
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    disk_size_gb      = "50"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (VirtualMachineResource) basicLinuxMachine_managedDisk_explicit(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -147,14 +147,14 @@ func TestAccVirtualMachine_updateMachineSize(t *testing.T) {
 			Config: r.basicLinuxMachine(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("vm_size").HasValue("Standard_D1_v2"),
+				check.That(data.ResourceName).Key("vm_size").HasValue(data.Skus.Sku("Standard_D1_v2")),
 			),
 		},
 		{
 			Config: r.updatedLinuxMachine(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
-				check.That(data.ResourceName).Key("vm_size").HasValue("Standard_D2_v2"),
+				check.That(data.ResourceName).Key("vm_size").HasValue(data.Skus.Sku("Standard_D2_v2")),
 			),
 		},
 	})
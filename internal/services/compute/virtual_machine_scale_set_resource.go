@@ -13,12 +13,17 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/zones"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/parse"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/validate"
+	managedIdentityValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/managedidentity/validate"
+	managedIdentityParse "github.com/hashicorp/terraform-provider-azurestack/internal/services/managedidentity/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	loadBalancerValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/suppress"
@@ -79,6 +84,14 @@ func virtualMachineScaleSet() *pluginsdk.Resource {
 								string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
 							}, false),
 						},
+						"identity_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: managedIdentityValidate.UserAssignedIdentityID,
+							},
+						},
 						"principal_id": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -140,7 +153,7 @@ func virtualMachineScaleSet() *pluginsdk.Resource {
 			"health_probe_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ValidateFunc: resourceid.ValidateResourceID,
+				ValidateFunc: loadBalancerValidate.LoadBalancerProbeID,
 			},
 
 			"rolling_upgrade_policy": {
@@ -181,6 +194,35 @@ func virtualMachineScaleSet() *pluginsdk.Resource {
 				DiffSuppressFunc: azurestackVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff,
 			},
 
+			"proximity_placement_group_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.ProximityPlacementGroupID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"automatic_instance_repair": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"grace_period": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "PT30M",
+							ValidateFunc: validate.AutomaticRepairsGracePeriod,
+						},
+					},
+				},
+			},
+
 			"overprovision": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -257,7 +299,7 @@ func virtualMachineScaleSet() *pluginsdk.Resource {
 						"source_vault_id": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: resourceid.ValidateResourceID,
+							ValidateFunc: keyVaultValidate.VaultID,
 						},
 
 						"vault_certificates": {
@@ -407,7 +449,7 @@ func virtualMachineScaleSet() *pluginsdk.Resource {
 						"network_security_group_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
-							ValidateFunc: resourceid.ValidateResourceID,
+							ValidateFunc: networkValidate.NetworkSecurityGroupID,
 						},
 
 						"dns_settings": {
@@ -442,7 +484,7 @@ func virtualMachineScaleSet() *pluginsdk.Resource {
 									"subnet_id": {
 										Type:         pluginsdk.TypeString,
 										Required:     true,
-										ValidateFunc: resourceid.ValidateResourceID,
+										ValidateFunc: networkValidate.SubnetID,
 									},
 
 									"application_gateway_backend_address_pool_ids": {
@@ -562,6 +604,11 @@ func virtualMachineScaleSet() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
 							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.CachingTypesNone),
+								string(compute.CachingTypesReadOnly),
+								string(compute.CachingTypesReadWrite),
+							}, false),
 						},
 
 						"os_type": {
@@ -597,6 +644,11 @@ func virtualMachineScaleSet() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
 							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.CachingTypesNone),
+								string(compute.CachingTypesReadOnly),
+								string(compute.CachingTypesReadWrite),
+							}, false),
 						},
 
 						"disk_size_gb": {
@@ -814,8 +866,9 @@ func virtualMachineScaleSetCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 			ExtensionProfile: extensions,
 			Priority:         compute.VirtualMachinePriorityTypes(priority),
 		},
-		Overprovision:        &overprovision,
-		SinglePlacementGroup: &singlePlacementGroup,
+		Overprovision:          &overprovision,
+		SinglePlacementGroup:   &singlePlacementGroup,
+		AutomaticRepairsPolicy: expandazurestackVirtualMachineScaleSetAutomaticRepairsPolicy(d.Get("automatic_instance_repair").([]interface{})),
 	}
 
 	if strings.EqualFold(priority, string(compute.Low)) {
@@ -827,6 +880,12 @@ func virtualMachineScaleSetCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		scaleSetProps.VirtualMachineProfile.DiagnosticsProfile = &diagnosticProfile
 	}
 
+	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
+		scaleSetProps.ProximityPlacementGroup = &compute.SubResource{
+			ID: pointer.FromString(v.(string)),
+		}
+	}
+
 	if v, ok := d.GetOk("health_probe_id"); ok {
 		scaleSetProps.VirtualMachineProfile.NetworkProfile.HealthProbe = &compute.APIEntityReference{
 			ID: pointer.FromString(v.(string)),
@@ -843,7 +902,11 @@ func virtualMachineScaleSetCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	}
 
 	if _, ok := d.GetOk("identity"); ok {
-		properties.Identity = expandazurestackVirtualMachineScaleSetIdentity(d)
+		identity, err := expandazurestackVirtualMachineScaleSetIdentity(d)
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		properties.Identity = identity
 	}
 
 	if v, ok := d.GetOk("license_type"); ok {
@@ -913,9 +976,19 @@ func virtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta interface{}) err
 				}
 			}
 		}
+		if err := d.Set("automatic_instance_repair", flattenazurestackVirtualMachineScaleSetAutomaticRepairsPolicy(d, properties.AutomaticRepairsPolicy)); err != nil {
+			return fmt.Errorf("setting `automatic_instance_repair`: %+v", err)
+		}
+
 		d.Set("overprovision", properties.Overprovision)
 		d.Set("single_placement_group", properties.SinglePlacementGroup)
 
+		proximityPlacementGroupId := ""
+		if properties.ProximityPlacementGroup != nil && properties.ProximityPlacementGroup.ID != nil {
+			proximityPlacementGroupId = *properties.ProximityPlacementGroup.ID
+		}
+		d.Set("proximity_placement_group_id", proximityPlacementGroupId)
+
 		if profile := properties.VirtualMachineProfile; profile != nil {
 			d.Set("license_type", profile.LicenseType)
 			d.Set("priority", string(profile.Priority))
@@ -1050,6 +1123,16 @@ func flattenazurestackVirtualMachineScaleSetIdentity(identity *compute.VirtualMa
 		result["principal_id"] = *identity.PrincipalID
 	}
 
+	identityIds := make([]interface{}, 0)
+	for key := range identity.UserAssignedIdentities {
+		// the API can return the Resource Group segment with a different casing, so normalize these where possible
+		if id, err := managedIdentityParse.UserAssignedIdentityIDInsensitively(key); err == nil {
+			key = id.ID()
+		}
+		identityIds = append(identityIds, key)
+	}
+	result["identity_ids"] = identityIds
+
 	return []interface{}{result}
 }
 
@@ -1193,6 +1276,32 @@ func flattenazurestackVirtualMachineScaleSetRollingUpgradePolicy(rollingUpgradeP
 	return []interface{}{b}
 }
 
+func flattenazurestackVirtualMachineScaleSetAutomaticRepairsPolicy(d *pluginsdk.ResourceData, policy *compute.AutomaticRepairsPolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	enabled := policy.Enabled != nil && *policy.Enabled
+
+	// the API returns a disabled policy when this hasn't been configured, so this is only surfaced when it's
+	// either enabled or has been explicitly disabled in the configuration
+	if !enabled && len(d.Get("automatic_instance_repair").([]interface{})) == 0 {
+		return []interface{}{}
+	}
+
+	gracePeriod := "PT30M"
+	if policy.GracePeriod != nil {
+		gracePeriod = *policy.GracePeriod
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":      enabled,
+			"grace_period": gracePeriod,
+		},
+	}
+}
+
 func flattenazurestackVirtualMachineScaleSetNetworkProfile(profile *compute.VirtualMachineScaleSetNetworkProfile) []map[string]interface{} {
 	networkConfigurations := profile.NetworkInterfaceConfigurations
 	result := make([]map[string]interface{}, 0, len(*networkConfigurations))
@@ -1638,6 +1747,22 @@ func expandazurestackRollingUpgradePolicy(d *pluginsdk.ResourceData) *compute.Ro
 	return nil
 }
 
+func expandazurestackVirtualMachineScaleSetAutomaticRepairsPolicy(input []interface{}) *compute.AutomaticRepairsPolicy {
+	// the policy has to be explicitly disabled when removed, since omitting it leaves the existing policy in place
+	if len(input) == 0 || input[0] == nil {
+		return &compute.AutomaticRepairsPolicy{
+			Enabled: pointer.FromBool(false),
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	return &compute.AutomaticRepairsPolicy{
+		Enabled:     pointer.FromBool(raw["enabled"].(bool)),
+		GracePeriod: pointer.FromString(raw["grace_period"].(string)),
+	}
+}
+
 func expandazurestackVirtualMachineScaleSetNetworkProfile(d *pluginsdk.ResourceData) *compute.VirtualMachineScaleSetNetworkProfile {
 	scaleSetNetworkProfileConfigs := d.Get("network_profile").(*pluginsdk.Set).List()
 	networkProfileConfig := make([]compute.VirtualMachineScaleSetNetworkConfiguration, 0, len(scaleSetNetworkProfileConfigs))
@@ -2073,17 +2198,34 @@ func expandazurestackVirtualMachineScaleSetOsProfileWindowsConfig(d *pluginsdk.R
 	return config
 }
 
-func expandazurestackVirtualMachineScaleSetIdentity(d *pluginsdk.ResourceData) *compute.VirtualMachineScaleSetIdentity {
+func expandazurestackVirtualMachineScaleSetIdentity(d *pluginsdk.ResourceData) (*compute.VirtualMachineScaleSetIdentity, error) {
 	v := d.Get("identity")
 	identities := v.([]interface{})
 	identity := identities[0].(map[string]interface{})
 	identityType := compute.ResourceIdentityType(identity["type"].(string))
 
+	identityIds := make(map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue)
+	for _, id := range identity["identity_ids"].(*pluginsdk.Set).List() {
+		identityIds[id.(string)] = &compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{}
+	}
+
 	vmssIdentity := compute.VirtualMachineScaleSetIdentity{
 		Type: identityType,
 	}
 
-	return &vmssIdentity
+	switch identityType {
+	case compute.ResourceIdentityTypeUserAssigned, compute.ResourceIdentityTypeSystemAssignedUserAssigned:
+		if len(identityIds) == 0 {
+			return nil, fmt.Errorf("`identity_ids` must be specified when `type` is set to %q", string(identityType))
+		}
+		vmssIdentity.UserAssignedIdentities = identityIds
+	default:
+		if len(identityIds) > 0 {
+			return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+		}
+	}
+
+	return &vmssIdentity, nil
 }
 
 func expandazurestackVirtualMachineScaleSetOsProfileSecrets(d *pluginsdk.ResourceData) *[]compute.VaultSecretGroup {
@@ -2207,8 +2349,8 @@ func azurestackVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff(k, _, new
 	return false
 }
 
-// Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling.
-func azurestackVirtualMachineScaleSetCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+func azurestackVirtualMachineScaleSetCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling.
 	mode := d.Get("upgrade_policy_mode").(string)
 	if strings.ToLower(mode) != "rolling" {
 		if policyRaw, ok := d.GetOk("rolling_upgrade_policy.0"); ok {
@@ -2222,5 +2364,41 @@ func azurestackVirtualMachineScaleSetCustomizeDiff(ctx context.Context, d *plugi
 			}
 		}
 	}
-	return nil
+
+	// Automatic Instance Repairs are driven by the health of each instance, which requires a Health Probe
+	if enabled, ok := d.GetOk("automatic_instance_repair.0.enabled"); ok && enabled.(bool) {
+		if _, ok := d.GetOk("health_probe_id"); !ok {
+			return fmt.Errorf("`health_probe_id` must be specified when `automatic_instance_repair` is enabled")
+		}
+	}
+
+	if err := validateZonesAreSupported(d, meta.(*clients.Client).Capabilities, "Microsoft.Compute/virtualMachineScaleSets"); err != nil {
+		return err
+	}
+
+	if err := validateProximityPlacementGroupsAreSupported(d, meta.(*clients.Client).Capabilities, "Microsoft.Compute/virtualMachineScaleSets"); err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).Compute.VMSizesClient
+
+	disks := make([]capabilities.VirtualMachineDisk, 0)
+	if v, ok := d.GetOk("storage_profile_os_disk"); ok {
+		for _, raw := range v.(*pluginsdk.Set).List() {
+			if raw == nil {
+				continue
+			}
+			disks = append(disks, expandVirtualMachineDiskCapabilities("storage_profile_os_disk", raw.(map[string]interface{})))
+		}
+	}
+	if v, ok := d.GetOk("storage_profile_data_disk"); ok {
+		for i, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			disks = append(disks, expandVirtualMachineDiskCapabilities(fmt.Sprintf("storage_profile_data_disk.%d", i), raw.(map[string]interface{})))
+		}
+	}
+
+	return validateVirtualMachineDisksForSize(ctx, d, client, "sku.0.name", "storage_profile_data_disk", disks)
 }
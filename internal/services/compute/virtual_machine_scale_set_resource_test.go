@@ -640,6 +640,30 @@ func TestAccVirtualMachineScaleSet_AutoUpdates(t *testing.T) {
 	})
 }
 
+func TestAccVirtualMachineScaleSet_automaticInstanceRepair(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_machine_scale_set", "test")
+	r := VirtualMachineScaleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.automaticInstanceRepair(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("automatic_instance_repair.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("automatic_instance_repair.0.grace_period").HasValue("PT45M"),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+		{
+			Config: r.automaticInstanceRepair(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("automatic_instance_repair.0.enabled").HasValue("false"),
+			),
+		},
+	})
+}
+
 func TestAccVirtualMachineScaleSet_upgradeModeUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_virtual_machine_scale_set", "test")
 	r := VirtualMachineScaleSetResource{}
@@ -4606,6 +4630,139 @@ resource "azurestack_virtual_machine_scale_set" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (VirtualMachineScaleSetResource) automaticInstanceRepair(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/8"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.0.0/16"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                    = "acctestpip-%[1]d"
+  location                = azurestack_resource_group.test.location
+  resource_group_name     = azurestack_resource_group.test.name
+  allocation_method       = "Dynamic"
+  idle_timeout_in_minutes = 4
+}
+
+resource "azurestack_lb" "test" {
+  name                = "acctestlb-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  frontend_ip_configuration {
+    name                 = "PublicIPAddress"
+    public_ip_address_id = azurestack_public_ip.test.id
+  }
+}
+
+resource "azurestack_lb_rule" "test" {
+  resource_group_name            = azurestack_resource_group.test.name
+  loadbalancer_id                = azurestack_lb.test.id
+  name                           = "AccTestLBRule"
+  protocol                       = "Tcp"
+  frontend_port                  = 22
+  backend_port                   = 22
+  frontend_ip_configuration_name = "PublicIPAddress"
+  probe_id                       = azurestack_lb_probe.test.id
+  backend_address_pool_id        = azurestack_lb_backend_address_pool.test.id
+}
+
+resource "azurestack_lb_probe" "test" {
+  resource_group_name = azurestack_resource_group.test.name
+  loadbalancer_id     = azurestack_lb.test.id
+  name                = "acctest-lb-probe"
+  port                = 22
+  protocol            = "Tcp"
+}
+
+resource "azurestack_lb_backend_address_pool" "test" {
+  name                = "acctestbapool"
+  resource_group_name = azurestack_resource_group.test.name
+  loadbalancer_id     = azurestack_lb.test.id
+}
+
+resource "azurestack_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  upgrade_policy_mode = "Manual"
+  health_probe_id     = azurestack_lb_probe.test.id
+  depends_on          = [azurestack_lb_rule.test]
+
+  automatic_instance_repair {
+    enabled      = %[3]t
+    grace_period = "PT45M"
+  }
+
+  sku {
+    name     = "Standard_F2"
+    tier     = "Standard"
+    capacity = 1
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile"
+    primary = true
+
+    ip_configuration {
+      name                                   = "TestIPConfiguration"
+      subnet_id                              = azurestack_subnet.test.id
+      load_balancer_backend_address_pool_ids = [azurestack_lb_backend_address_pool.test.id]
+      primary                                = true
+    }
+  }
+
+  storage_profile_os_disk {
+    name              = ""
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_data_disk {
+    lun               = 0
+    caching           = "ReadWrite"
+    create_option     = "Empty"
+    disk_size_gb      = 10
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, enabled)
+}
+
 func (VirtualMachineScaleSetResource) upgradeModeUpdate(data acceptance.TestData, mode string) string {
 	policy := ""
 	if mode == "Rolling" {
@@ -0,0 +1,102 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/compute/mgmt/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+// virtualMachineSizeCapabilities returns the capabilities of the Virtual Machine Size within the specified Location,
+// returning an error if the Virtual Machine Size isn't available in that Location
+func virtualMachineSizeCapabilities(ctx context.Context, client *compute.VirtualMachineSizesClient, loc, vmSize string) (*capabilities.VirtualMachineSize, error) {
+	loc = location.Normalize(loc)
+	resp, err := client.List(ctx, loc)
+	if err != nil {
+		return nil, fmt.Errorf("listing Virtual Machine Sizes available in %q: %+v", loc, err)
+	}
+
+	available := make([]string, 0)
+	if resp.Value != nil {
+		for _, v := range *resp.Value {
+			if v.Name == nil {
+				continue
+			}
+
+			if strings.EqualFold(*v.Name, vmSize) {
+				size := capabilities.VirtualMachineSize{
+					Name: *v.Name,
+				}
+				if v.MaxDataDiskCount != nil {
+					size.MaxDataDiskCount = int(*v.MaxDataDiskCount)
+				}
+				return &size, nil
+			}
+
+			available = append(available, *v.Name)
+		}
+	}
+
+	sort.Strings(available)
+	return nil, fmt.Errorf("the Virtual Machine Size %q isn't available in %q - the available sizes are: %s", vmSize, loc, strings.Join(available, ", "))
+}
+
+// validateVirtualMachineDisksForSize validates the performance attributes of the Disks against the Virtual Machine Size
+// at plan time, rather than failing during the creation of the Virtual Machine (after any dependencies have been created)
+func validateVirtualMachineDisksForSize(ctx context.Context, d *pluginsdk.ResourceDiff, client *compute.VirtualMachineSizesClient, sizeField, dataDisksField string, disks []capabilities.VirtualMachineDisk) error {
+	var size *capabilities.VirtualMachineSize
+
+	// the size can only be validated once both it and the location are known
+	if d.NewValueKnown(sizeField) && d.NewValueKnown("location") {
+		vmSize := d.Get(sizeField).(string)
+		loc := d.Get("location").(string)
+		if vmSize != "" && loc != "" {
+			var err error
+			size, err = virtualMachineSizeCapabilities(ctx, client, loc, vmSize)
+			if err != nil {
+				return fmt.Errorf("`%s`: %+v", sizeField, err)
+			}
+
+			if err := size.ValidateDataDiskCount(dataDisksField, len(d.Get(dataDisksField).([]interface{}))); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, disk := range disks {
+		if err := capabilities.ValidateDisk(disk, size); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateZonesAreSupported returns an error if Availability Zones are specified for the Resource Type, but the
+// Compute API versions supporting them aren't available on the Azure Stack Hub
+func validateZonesAreSupported(d *pluginsdk.ResourceDiff, resourceTypes *capabilities.ResourceTypes, resourceType string) error {
+	if v, ok := d.GetOk("zones"); ok && len(v.([]interface{})) > 0 {
+		return resourceTypes.ValidateAPIVersion(resourceType, capabilities.ZonesMinimumAPIVersion, "`zones`")
+	}
+
+	return nil
+}
+
+// validateProximityPlacementGroupsAreSupported returns an error if a Proximity Placement Group is specified for the Resource
+// Type, but either Proximity Placement Groups or the Compute API versions supporting them aren't available on the Azure Stack Hub
+func validateProximityPlacementGroupsAreSupported(d *pluginsdk.ResourceDiff, resourceTypes *capabilities.ResourceTypes, resourceType string) error {
+	if v, ok := d.GetOk("proximity_placement_group_id"); ok && v.(string) != "" {
+		if err := resourceTypes.ValidateAPIVersion(capabilities.ProximityPlacementGroupsResourceType, capabilities.ProximityPlacementGroupsMinimumAPIVersion, "`proximity_placement_group_id`"); err != nil {
+			return err
+		}
+
+		return resourceTypes.ValidateAPIVersion(resourceType, capabilities.ProximityPlacementGroupsMinimumAPIVersion, "`proximity_placement_group_id`")
+	}
+
+	return nil
+}
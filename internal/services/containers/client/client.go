@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2019-05-01/containerregistry"
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-11-01/containerservice"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+type Client struct {
+	KubernetesClustersClient *containerservice.ManagedClustersClient
+	RegistriesClient         *containerregistry.RegistriesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&kubernetesClustersClient.Client, o.ResourceManagerAuthorizer)
+
+	registriesClient := containerregistry.NewRegistriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&registriesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		KubernetesClustersClient: &kubernetesClustersClient,
+		RegistriesClient:         &registriesClient,
+	}
+}
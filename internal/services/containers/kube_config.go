@@ -0,0 +1,64 @@
+package containers
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// kubeConfig is the subset of a kubeconfig file which is exposed by the `kube_config` block
+type kubeConfig struct {
+	Clusters []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// parseKubeConfig parses the first cluster and user from a kubeconfig file - the certificates and key are returned
+// base64 encoded (as they're stored in the file), which can be passed to `base64decode` in the configuration
+func parseKubeConfig(input string) (*kubeConfig, error) {
+	var config kubeConfig
+	if err := yaml.Unmarshal([]byte(input), &config); err != nil {
+		return nil, fmt.Errorf("unmarshaling the kubeconfig: %+v", err)
+	}
+
+	if len(config.Clusters) == 0 {
+		return nil, fmt.Errorf("the kubeconfig didn't contain any clusters")
+	}
+	if len(config.Users) == 0 {
+		return nil, fmt.Errorf("the kubeconfig didn't contain any users")
+	}
+
+	return &config, nil
+}
+
+func flattenKubeConfig(config *kubeConfig) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	cluster := config.Clusters[0]
+	user := config.Users[0]
+
+	return []interface{}{
+		map[string]interface{}{
+			"host":                   cluster.Cluster.Server,
+			"cluster_ca_certificate": cluster.Cluster.CertificateAuthorityData,
+			"username":               user.Name,
+			"client_certificate":     user.User.ClientCertificateData,
+			"client_key":             user.User.ClientKeyData,
+			"token":                  user.User.Token,
+		},
+	}
+}
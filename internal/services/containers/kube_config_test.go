@@ -0,0 +1,86 @@
+package containers
+
+import (
+	"testing"
+)
+
+func TestParseKubeConfig(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Input       string
+		ExpectError bool
+		Expected    map[string]interface{}
+	}{
+		{
+			Name:        "empty",
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Name: "no users",
+			Input: `
+apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: Y2E=
+    server: https://example.hcp.local.cloudapp.azurestack.external:443
+  name: example
+`,
+			ExpectError: true,
+		},
+		{
+			Name: "client certificate",
+			Input: `
+apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: Y2E=
+    server: https://example.hcp.local.cloudapp.azurestack.external:443
+  name: example
+contexts:
+- context:
+    cluster: example
+    user: clusterUser_example-resources_example
+  name: example
+current-context: example
+kind: Config
+users:
+- name: clusterUser_example-resources_example
+  user:
+    client-certificate-data: Y2VydA==
+    client-key-data: a2V5
+    token: abc123
+`,
+			Expected: map[string]interface{}{
+				"host":                   "https://example.hcp.local.cloudapp.azurestack.external:443",
+				"cluster_ca_certificate": "Y2E=",
+				"username":               "clusterUser_example-resources_example",
+				"client_certificate":     "Y2VydA==",
+				"client_key":             "a2V5",
+				"token":                  "abc123",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		config, err := parseKubeConfig(v.Input)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		actual := flattenKubeConfig(config)[0].(map[string]interface{})
+		for key, expected := range v.Expected {
+			if actual[key] != expected {
+				t.Fatalf("Expected %q to be %q but got %q", key, expected, actual[key])
+			}
+		}
+	}
+}
@@ -0,0 +1,734 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-11-01/containerservice"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/containers/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func kubernetesCluster() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: kubernetesClusterCreate,
+		Read:   kubernetesClusterRead,
+		Update: kubernetesClusterUpdate,
+		Delete: kubernetesClusterDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ClusterID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"location": commonschema.Location(),
+
+			"dns_prefix": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KubernetesDNSPrefix,
+			},
+
+			"kubernetes_version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"default_node_pool": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9]{0,11}$`), "`name` must be between 1 and 12 characters, can only contain lowercase letters and numbers and must start with a letter"),
+						},
+
+						"vm_size": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"node_count": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"os_disk_size_gb": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(30, 1023),
+						},
+
+						"vnet_subnet_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: networkValidate.SubnetID,
+						},
+					},
+				},
+			},
+
+			"service_principal": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"client_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"client_secret": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"linux_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"admin_username": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"ssh_key": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"key_data": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"network_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"network_plugin": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerservice.Azure),
+								string(containerservice.Kubenet),
+							}, false),
+						},
+
+						"load_balancer_sku": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  string(containerservice.Basic),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerservice.Basic),
+								string(containerservice.Standard),
+							}, false),
+						},
+
+						"pod_cidr": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+
+						"service_cidr": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+
+						"dns_service_ip": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+
+						"docker_bridge_cidr": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+
+			"fqdn": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"node_resource_group": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"kube_config_raw": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"kube_config": {
+				Type:      pluginsdk.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"username": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"token": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"client_certificate": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"client_key": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"cluster_ca_certificate": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewClusterID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurestack_kubernetes_cluster", id.ID())
+	}
+
+	parameters := containerservice.ManagedCluster{
+		Location: pointer.FromString(location.Normalize(d.Get("location").(string))),
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			DNSPrefix:               pointer.FromString(d.Get("dns_prefix").(string)),
+			AgentPoolProfiles:       expandKubernetesClusterDefaultNodePool(d.Get("default_node_pool").([]interface{})),
+			ServicePrincipalProfile: expandKubernetesClusterServicePrincipal(d.Get("service_principal").([]interface{})),
+			LinuxProfile:            expandKubernetesClusterLinuxProfile(d.Get("linux_profile").([]interface{})),
+			NetworkProfile:          expandKubernetesClusterNetworkProfile(d.Get("network_profile").([]interface{})),
+			EnableRBAC:              pointer.FromBool(true),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("kubernetes_version"); ok {
+		parameters.ManagedClusterProperties.KubernetesVersion = pointer.FromString(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return kubernetesClusterRead(d, meta)
+}
+
+func kubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ClusterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Service Principal is updated using a separate API, since it's not returned by the API
+	if d.HasChange("service_principal") {
+		future, err := client.ResetServicePrincipalProfile(ctx, id.ResourceGroup, id.ManagedClusterName, *expandKubernetesClusterServicePrincipal(d.Get("service_principal").([]interface{})))
+		if err != nil {
+			return fmt.Errorf("updating the Service Principal for %s: %+v", *id, err)
+		}
+
+		if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+			return fmt.Errorf("waiting for the Service Principal for %s to be updated: %+v", *id, err)
+		}
+	}
+
+	if d.HasChanges("kubernetes_version", "default_node_pool", "tags") {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		if existing.ManagedClusterProperties == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+		}
+
+		props := existing.ManagedClusterProperties
+		props.KubernetesVersion = pointer.FromString(d.Get("kubernetes_version").(string))
+		props.AgentPoolProfiles = expandKubernetesClusterDefaultNodePool(d.Get("default_node_pool").([]interface{}))
+		// the Secret isn't returned by the API, so has to be sent from the configuration
+		props.ServicePrincipalProfile = expandKubernetesClusterServicePrincipal(d.Get("service_principal").([]interface{}))
+		existing.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+
+		// the Kubernetes Version of the Node Pool is upgraded alongside the Control Plane
+		if profiles := props.AgentPoolProfiles; profiles != nil && len(*profiles) > 0 {
+			(*profiles)[0].OrchestratorVersion = props.KubernetesVersion
+		}
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, existing)
+		if err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+
+		if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+		}
+	}
+
+	return kubernetesClusterRead(d, meta)
+}
+
+func kubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ClusterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ManagedClusterName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.ManagedClusterProperties; props != nil {
+		d.Set("dns_prefix", props.DNSPrefix)
+		d.Set("kubernetes_version", props.KubernetesVersion)
+		d.Set("fqdn", props.Fqdn)
+		d.Set("node_resource_group", props.NodeResourceGroup)
+
+		if err := d.Set("default_node_pool", flattenKubernetesClusterDefaultNodePool(props.AgentPoolProfiles)); err != nil {
+			return fmt.Errorf("setting `default_node_pool`: %+v", err)
+		}
+
+		if err := d.Set("service_principal", flattenKubernetesClusterServicePrincipal(props.ServicePrincipalProfile, d)); err != nil {
+			return fmt.Errorf("setting `service_principal`: %+v", err)
+		}
+
+		if err := d.Set("linux_profile", flattenKubernetesClusterLinuxProfile(props.LinuxProfile)); err != nil {
+			return fmt.Errorf("setting `linux_profile`: %+v", err)
+		}
+
+		if err := d.Set("network_profile", flattenKubernetesClusterNetworkProfile(props.NetworkProfile)); err != nil {
+			return fmt.Errorf("setting `network_profile`: %+v", err)
+		}
+	}
+
+	credentials, err := client.ListClusterUserCredentials(ctx, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		return fmt.Errorf("retrieving the User Credentials for %s: %+v", *id, err)
+	}
+
+	kubeConfigRaw := ""
+	if credentials.Kubeconfigs != nil && len(*credentials.Kubeconfigs) > 0 {
+		if v := (*credentials.Kubeconfigs)[0].Value; v != nil {
+			kubeConfigRaw = string(*v)
+		}
+	}
+	if kubeConfigRaw == "" {
+		return fmt.Errorf("retrieving the User Credentials for %s: no kubeconfig was returned", *id)
+	}
+	d.Set("kube_config_raw", kubeConfigRaw)
+
+	kubeConfig, err := parseKubeConfig(kubeConfigRaw)
+	if err != nil {
+		return fmt.Errorf("parsing the User Credentials for %s: %+v", *id, err)
+	}
+	if err := d.Set("kube_config", flattenKubeConfig(kubeConfig)); err != nil {
+		return fmt.Errorf("setting `kube_config`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func kubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ClusterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandKubernetesClusterDefaultNodePool(input []interface{}) *[]containerservice.ManagedClusterAgentPoolProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	profile := containerservice.ManagedClusterAgentPoolProfile{
+		Name:   pointer.FromString(raw["name"].(string)),
+		Count:  utils.Int32(int32(raw["node_count"].(int))),
+		VMSize: containerservice.VMSizeTypes(raw["vm_size"].(string)),
+		OsType: containerservice.Linux,
+		Mode:   containerservice.System,
+		Type:   containerservice.VirtualMachineScaleSets,
+	}
+
+	if v := raw["os_disk_size_gb"].(int); v > 0 {
+		profile.OsDiskSizeGB = utils.Int32(int32(v))
+	}
+
+	if v := raw["vnet_subnet_id"].(string); v != "" {
+		profile.VnetSubnetID = pointer.FromString(v)
+	}
+
+	return &[]containerservice.ManagedClusterAgentPoolProfile{profile}
+}
+
+func flattenKubernetesClusterDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the default node pool is the System pool, which (since additional node pools aren't supported) is the only pool
+	for _, profile := range *input {
+		if profile.Mode != "" && profile.Mode != containerservice.System {
+			continue
+		}
+
+		name := ""
+		if profile.Name != nil {
+			name = *profile.Name
+		}
+
+		nodeCount := 0
+		if profile.Count != nil {
+			nodeCount = int(*profile.Count)
+		}
+
+		osDiskSizeGB := 0
+		if profile.OsDiskSizeGB != nil {
+			osDiskSizeGB = int(*profile.OsDiskSizeGB)
+		}
+
+		vnetSubnetId := ""
+		if profile.VnetSubnetID != nil {
+			vnetSubnetId = *profile.VnetSubnetID
+		}
+
+		return []interface{}{
+			map[string]interface{}{
+				"name":            name,
+				"vm_size":         string(profile.VMSize),
+				"node_count":      nodeCount,
+				"os_disk_size_gb": osDiskSizeGB,
+				"vnet_subnet_id":  vnetSubnetId,
+			},
+		}
+	}
+
+	return []interface{}{}
+}
+
+func expandKubernetesClusterServicePrincipal(input []interface{}) *containerservice.ManagedClusterServicePrincipalProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &containerservice.ManagedClusterServicePrincipalProfile{
+		ClientID: pointer.FromString(raw["client_id"].(string)),
+		Secret:   pointer.FromString(raw["client_secret"].(string)),
+	}
+}
+
+func flattenKubernetesClusterServicePrincipal(input *containerservice.ManagedClusterServicePrincipalProfile, d *pluginsdk.ResourceData) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	clientId := ""
+	if input.ClientID != nil {
+		clientId = *input.ClientID
+	}
+
+	// the Client Secret isn't returned by the API, so is retrieved from the state
+	clientSecret := ""
+	if v, ok := d.GetOk("service_principal.0.client_secret"); ok {
+		clientSecret = v.(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"client_id":     clientId,
+			"client_secret": clientSecret,
+		},
+	}
+}
+
+func expandKubernetesClusterLinuxProfile(input []interface{}) *containerservice.LinuxProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	publicKeys := make([]containerservice.SSHPublicKey, 0)
+	for _, v := range raw["ssh_key"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+		key := v.(map[string]interface{})
+		publicKeys = append(publicKeys, containerservice.SSHPublicKey{
+			KeyData: pointer.FromString(key["key_data"].(string)),
+		})
+	}
+
+	return &containerservice.LinuxProfile{
+		AdminUsername: pointer.FromString(raw["admin_username"].(string)),
+		SSH: &containerservice.SSHConfiguration{
+			PublicKeys: &publicKeys,
+		},
+	}
+}
+
+func flattenKubernetesClusterLinuxProfile(input *containerservice.LinuxProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	adminUsername := ""
+	if input.AdminUsername != nil {
+		adminUsername = *input.AdminUsername
+	}
+
+	sshKeys := make([]interface{}, 0)
+	if input.SSH != nil && input.SSH.PublicKeys != nil {
+		for _, key := range *input.SSH.PublicKeys {
+			keyData := ""
+			if key.KeyData != nil {
+				keyData = *key.KeyData
+			}
+			sshKeys = append(sshKeys, map[string]interface{}{
+				"key_data": keyData,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"admin_username": adminUsername,
+			"ssh_key":        sshKeys,
+		},
+	}
+}
+
+func expandKubernetesClusterNetworkProfile(input []interface{}) *containerservice.NetworkProfile {
+	if len(input) == 0 || input[0] == nil {
+		// Azure Stack Hub only supports Basic Load Balancers, as such this is set even when the block is omitted
+		return &containerservice.NetworkProfile{
+			NetworkPlugin:   containerservice.Kubenet,
+			LoadBalancerSku: containerservice.Basic,
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	profile := &containerservice.NetworkProfile{
+		NetworkPlugin:   containerservice.NetworkPlugin(raw["network_plugin"].(string)),
+		LoadBalancerSku: containerservice.LoadBalancerSku(raw["load_balancer_sku"].(string)),
+	}
+
+	if v := raw["pod_cidr"].(string); v != "" {
+		profile.PodCidr = pointer.FromString(v)
+	}
+
+	if v := raw["service_cidr"].(string); v != "" {
+		profile.ServiceCidr = pointer.FromString(v)
+	}
+
+	if v := raw["dns_service_ip"].(string); v != "" {
+		profile.DNSServiceIP = pointer.FromString(v)
+	}
+
+	if v := raw["docker_bridge_cidr"].(string); v != "" {
+		profile.DockerBridgeCidr = pointer.FromString(v)
+	}
+
+	return profile
+}
+
+func flattenKubernetesClusterNetworkProfile(input *containerservice.NetworkProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	podCidr := ""
+	if input.PodCidr != nil {
+		podCidr = *input.PodCidr
+	}
+
+	serviceCidr := ""
+	if input.ServiceCidr != nil {
+		serviceCidr = *input.ServiceCidr
+	}
+
+	dnsServiceIP := ""
+	if input.DNSServiceIP != nil {
+		dnsServiceIP = *input.DNSServiceIP
+	}
+
+	dockerBridgeCidr := ""
+	if input.DockerBridgeCidr != nil {
+		dockerBridgeCidr = *input.DockerBridgeCidr
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"network_plugin":     string(input.NetworkPlugin),
+			"load_balancer_sku":  string(input.LoadBalancerSku),
+			"pod_cidr":           podCidr,
+			"service_cidr":       serviceCidr,
+			"dns_service_ip":     dnsServiceIP,
+			"docker_bridge_cidr": dockerBridgeCidr,
+		},
+	}
+}
@@ -0,0 +1,190 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type KubernetesClusterResource struct{}
+
+func TestAccKubernetesCluster_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdn").IsSet(),
+				check.That(data.ResourceName).Key("kubernetes_version").IsSet(),
+				check.That(data.ResourceName).Key("node_resource_group").IsSet(),
+				check.That(data.ResourceName).Key("network_profile.0.load_balancer_sku").HasValue("basic"),
+				check.That(data.ResourceName).Key("kube_config_raw").IsSet(),
+				check.That(data.ResourceName).Key("kube_config.0.host").IsSet(),
+				check.That(data.ResourceName).Key("kube_config.0.cluster_ca_certificate").IsSet(),
+			),
+		},
+		data.ImportStep("service_principal.0.client_secret"),
+	})
+}
+
+func TestAccKubernetesCluster_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesCluster_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_node_pool.0.node_count").HasValue("1"),
+			),
+		},
+		data.ImportStep("service_principal.0.client_secret"),
+		{
+			Config: r.complete(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_node_pool.0.node_count").HasValue("2"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags.environment").HasValue("Production"),
+			),
+		},
+		data.ImportStep("service_principal.0.client_secret"),
+	})
+}
+
+func (KubernetesClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ClusterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.KubernetesClustersClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (KubernetesClusterResource) basic(data acceptance.TestData, nodeCount int) string {
+	clientData := data.Client()
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurestack_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = %d
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, nodeCount, clientData.Default.ClientID, clientData.Default.ClientSecret)
+}
+
+func (r KubernetesClusterResource) requiresImport(data acceptance.TestData) string {
+	clientData := data.Client()
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_kubernetes_cluster" "import" {
+  name                = azurestack_kubernetes_cluster.test.name
+  location            = azurestack_kubernetes_cluster.test.location
+  resource_group_name = azurestack_kubernetes_cluster.test.resource_group_name
+  dns_prefix          = azurestack_kubernetes_cluster.test.dns_prefix
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+}
+`, r.basic(data, 1), clientData.Default.ClientID, clientData.Default.ClientSecret)
+}
+
+func (KubernetesClusterResource) complete(data acceptance.TestData, nodeCount int) string {
+	clientData := data.Client()
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurestack_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = %d
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, nodeCount, clientData.Default.ClientID, clientData.Default.ClientSecret)
+}
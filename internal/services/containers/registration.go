@@ -0,0 +1,47 @@
+package containers
+
+import (
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/sdk"
+)
+
+var (
+	_ sdk.UntypedServiceRegistration                 = Registration{}
+	_ sdk.UntypedServiceRegistrationWithCapabilities = Registration{}
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Containers"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Containers",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurestack_container_registry": containerRegistry(),
+		"azurestack_kubernetes_cluster": kubernetesCluster(),
+	}
+}
+
+// ResourceProviderTypes returns the Resource Types provisioned by the Resources supported by this Service,
+// which are checked against the Resource Types supported by the Azure Stack Hub at plan time
+func (r Registration) ResourceProviderTypes() map[string]string {
+	return map[string]string{
+		"azurestack_container_registry": "Microsoft.ContainerRegistry/registries",
+		"azurestack_kubernetes_cluster": "Microsoft.ContainerService/managedClusters",
+	}
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ContainerRegistryName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]{5,50}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 5 and 50 characters and can only contain alphanumeric characters", k))
+	}
+
+	return warnings, errors
+}
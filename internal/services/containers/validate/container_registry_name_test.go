@@ -0,0 +1,32 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestContainerRegistryName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "abcd", Valid: false},
+		{Value: "abcde", Valid: true},
+		{Value: "AcctestACR1", Valid: true},
+		{Value: "acctest-acr", Valid: false},
+		{Value: "acctest_acr", Valid: false},
+		{Value: strings.Repeat("a", 50), Valid: true},
+		{Value: strings.Repeat("a", 51), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := ContainerRegistryName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
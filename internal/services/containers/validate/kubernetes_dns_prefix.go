@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func KubernetesDNSPrefix(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]{0,43}[a-zA-Z0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 45 characters, can only contain alphanumeric characters and hyphens, must start with a letter and must end with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
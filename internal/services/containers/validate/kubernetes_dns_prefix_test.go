@@ -0,0 +1,33 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKubernetesDNSPrefix(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "a", Valid: false},
+		{Value: "ab", Valid: true},
+		{Value: "acctestaks-1", Valid: true},
+		{Value: "1acctestaks", Valid: false},
+		{Value: "acctestaks-", Valid: false},
+		{Value: "acctest_aks", Valid: false},
+		{Value: strings.Repeat("a", 45), Valid: true},
+		{Value: strings.Repeat("a", 46), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := KubernetesDNSPrefix(tc.Value, "dns_prefix")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
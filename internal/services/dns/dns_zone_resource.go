@@ -74,7 +74,6 @@ func dnsZone() *pluginsdk.Resource {
 				MaxItems: 1,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"email": {
@@ -83,9 +82,11 @@ func dnsZone() *pluginsdk.Resource {
 							ValidateFunc: validate.DnsZoneSOARecordEmail,
 						},
 
+						// when omitted the Host Name assigned by Azure Stack is used
 						"host_name": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
@@ -185,7 +186,7 @@ func dnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("creating/updating DNS Zone %q (Resource Group %q): %s", name, resGroup, err)
 	}
 
-	if v, ok := d.GetOk("soa_record"); ok {
+	if v, ok := d.GetOk("soa_record"); ok && d.HasChange("soa_record") {
 		soaRecord := v.([]interface{})[0].(map[string]interface{})
 		rsParameters := dns.RecordSet{
 			RecordSetProperties: &dns.RecordSetProperties{
@@ -195,6 +196,17 @@ func dnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 			},
 		}
 
+		// the Host Name is assigned by Azure Stack when the DNS Zone is created, so is retained when omitted
+		if rsParameters.RecordSetProperties.SoaRecord.Host == nil {
+			existing, err := recordSetsClient.Get(ctx, resGroup, name, "@", dns.SOA)
+			if err != nil {
+				return fmt.Errorf("retrieving DNS SOA Record @ (Zone %q / Resource Group %q): %s", name, resGroup, err)
+			}
+			if existing.RecordSetProperties != nil && existing.RecordSetProperties.SoaRecord != nil {
+				rsParameters.RecordSetProperties.SoaRecord.Host = existing.RecordSetProperties.SoaRecord.Host
+			}
+		}
+
 		if len(name+strings.TrimSuffix(*rsParameters.RecordSetProperties.SoaRecord.Email, ".")) > 253 {
 			return fmt.Errorf("`email` which is concatenated with DNS Zone `name` cannot exceed 253 characters excluding a trailing period")
 		}
@@ -279,15 +291,20 @@ func dnsZoneDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 }
 
 func expandArmDNSZoneSOARecord(input map[string]interface{}) *dns.SoaRecord {
-	return &dns.SoaRecord{
+	record := &dns.SoaRecord{
 		Email:        pointer.FromString(input["email"].(string)),
-		Host:         pointer.FromString(input["host_name"].(string)),
 		ExpireTime:   pointer.FromInt64(int64(input["expire_time"].(int))),
 		MinimumTTL:   pointer.FromInt64(int64(input["minimum_ttl"].(int))),
 		RefreshTime:  pointer.FromInt64(int64(input["refresh_time"].(int))),
 		RetryTime:    pointer.FromInt64(int64(input["retry_time"].(int))),
 		SerialNumber: pointer.FromInt64(int64(input["serial_number"].(int))),
 	}
+
+	if v := input["host_name"].(string); v != "" {
+		record.Host = pointer.FromString(v)
+	}
+
+	return record
 }
 
 func flattenArmDNSZoneSOARecord(input *dns.RecordSet) []interface{} {
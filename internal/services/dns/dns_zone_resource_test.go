@@ -100,6 +100,31 @@ func TestAccDnsZone_withSOARecord(t *testing.T) {
 	})
 }
 
+func TestAccDnsZone_withSOARecordComputedHostName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_dns_zone", "test")
+	r := DnsZoneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withSOARecordEmail(data, "first.contoso.com"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soa_record.0.email").HasValue("first.contoso.com"),
+				check.That(data.ResourceName).Key("soa_record.0.host_name").IsSet(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withSOARecordEmail(data, "second.contoso.com"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("soa_record.0.email").HasValue("second.contoso.com"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (DnsZoneResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.DnsZoneID(state.ID)
 	if err != nil {
@@ -212,6 +237,30 @@ resource "azurestack_dns_zone" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (DnsZoneResource) withSOARecordEmail(data acceptance.TestData, email string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-dns-%d"
+  location = "%s"
+}
+
+resource "azurestack_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurestack_resource_group.test.name
+
+  soa_record {
+    email       = "%s"
+    retry_time  = 600
+    minimum_ttl = 600
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, email)
+}
+
 func (DnsZoneResource) withCompletedSOARecord(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -24,6 +24,7 @@ func TestCaaRecordID(t *testing.T) {
 		Error    bool
 		Expected *CaaRecordId
 	}{
+
 		{
 			// empty
 			Input: "",
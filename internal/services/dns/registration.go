@@ -6,8 +6,9 @@ import (
 )
 
 var (
-	_ sdk.TypedServiceRegistration   = Registration{}
-	_ sdk.UntypedServiceRegistration = Registration{}
+	_ sdk.TypedServiceRegistration                   = Registration{}
+	_ sdk.UntypedServiceRegistration                 = Registration{}
+	_ sdk.UntypedServiceRegistrationWithCapabilities = Registration{}
 )
 
 type Registration struct{}
@@ -55,3 +56,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{}
 }
+
+// ResourceProviderTypes returns the Resource Types provisioned by the Resources supported by this Service,
+// which are checked against the Resource Types supported by the Azure Stack Hub at plan time
+func (r Registration) ResourceProviderTypes() map[string]string {
+	return map[string]string{
+		"azurestack_dns_zone": "Microsoft.Network/dnszones",
+	}
+}
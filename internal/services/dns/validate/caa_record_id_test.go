@@ -9,6 +9,7 @@ func TestCaaRecordID(t *testing.T) {
 		Input string
 		Valid bool
 	}{
+
 		{
 			// empty
 			Input: "",
@@ -0,0 +1,121 @@
+package eventhub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+func authorizationRuleSchemaFrom(s map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	authSchema := map[string]*pluginsdk.Schema{
+		"listen": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"send": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"manage": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"primary_connection_string": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_connection_string": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+
+	for k, v := range s {
+		authSchema[k] = v
+	}
+
+	return authSchema
+}
+
+func authorizationRuleCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	listen, hasListen := d.GetOk("listen")
+	send, hasSend := d.GetOk("send")
+	manage, hasManage := d.GetOk("manage")
+
+	if !hasListen && !hasSend && !hasManage {
+		return fmt.Errorf("One of the `listen`, `send` or `manage` properties needs to be set")
+	}
+
+	if manage.(bool) && (!listen.(bool) || !send.(bool)) {
+		return fmt.Errorf("if `manage` is set both `listen` and `send` must be set to true too")
+	}
+
+	return nil
+}
+
+func expandAuthorizationRuleRights(d *pluginsdk.ResourceData) *[]eventhub.AccessRights {
+	rights := make([]eventhub.AccessRights, 0)
+
+	if d.Get("listen").(bool) {
+		rights = append(rights, eventhub.Listen)
+	}
+
+	if d.Get("send").(bool) {
+		rights = append(rights, eventhub.SendEnumValue)
+	}
+
+	if d.Get("manage").(bool) {
+		rights = append(rights, eventhub.Manage)
+	}
+
+	return &rights
+}
+
+func flattenAuthorizationRuleRights(rights *[]eventhub.AccessRights) (listen, send, manage bool) {
+	if rights == nil {
+		return false, false, false
+	}
+
+	for _, right := range *rights {
+		switch right {
+		case eventhub.Listen:
+			listen = true
+		case eventhub.SendEnumValue:
+			send = true
+		case eventhub.Manage:
+			manage = true
+		}
+	}
+
+	return listen, send, manage
+}
+
+func setAuthorizationRuleKeys(d *pluginsdk.ResourceData, keys eventhub.AccessKeys) {
+	d.Set("primary_key", keys.PrimaryKey)
+	d.Set("primary_connection_string", keys.PrimaryConnectionString)
+	d.Set("secondary_key", keys.SecondaryKey)
+	d.Set("secondary_connection_string", keys.SecondaryConnectionString)
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+type Client struct {
+	ConsumerGroupsClient *eventhub.ConsumerGroupsClient
+	EventHubsClient      *eventhub.EventHubsClient
+	NamespacesClient     *eventhub.NamespacesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	ConsumerGroupsClient := eventhub.NewConsumerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ConsumerGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	EventHubsClient := eventhub.NewEventHubsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&EventHubsClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespacesClient := eventhub.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&NamespacesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ConsumerGroupsClient: &ConsumerGroupsClient,
+		EventHubsClient:      &EventHubsClient,
+		NamespacesClient:     &NamespacesClient,
+	}
+}
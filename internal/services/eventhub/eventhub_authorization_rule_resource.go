@@ -0,0 +1,171 @@
+package eventhub
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventhub/mgmt/2017-04-01/eventhub"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/eventhub/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/eventhub/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func eventHubAuthorizationRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: eventHubAuthorizationRuleCreateUpdate,
+		Read:   eventHubAuthorizationRuleRead,
+		Update: eventHubAuthorizationRuleCreateUpdate,
+		Delete: eventHubAuthorizationRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.EventHubAuthorizationRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: authorizationRuleSchemaFrom(map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AuthorizationRuleName,
+			},
+
+			"namespace_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NamespaceName,
+			},
+
+			"eventhub_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.EventHubName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(authorizationRuleCustomizeDiff),
+	}
+}
+
+func eventHubAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventHub.EventHubsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewEventHubAuthorizationRuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("namespace_name").(string), d.Get("eventhub_name").(string), d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.AuthorizationRuleName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurestack_eventhub_authorization_rule", id.ID())
+		}
+	}
+
+	// Authorization Rules are updated on the parent Event Hub, so concurrent changes need to be serialized
+	locks.ByName(id.EventhubName, eventHubResourceName)
+	defer locks.UnlockByName(id.EventhubName, eventHubResourceName)
+
+	parameters := eventhub.AuthorizationRule{
+		Name: pointer.FromString(id.AuthorizationRuleName),
+		AuthorizationRuleProperties: &eventhub.AuthorizationRuleProperties{
+			Rights: expandAuthorizationRuleRights(d),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.AuthorizationRuleName, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return eventHubAuthorizationRuleRead(d, meta)
+}
+
+func eventHubAuthorizationRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventHub.EventHubsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.EventHubAuthorizationRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.AuthorizationRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.AuthorizationRuleName)
+	d.Set("namespace_name", id.NamespaceName)
+	d.Set("eventhub_name", id.EventhubName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.AuthorizationRuleProperties; props != nil {
+		listen, send, manage := flattenAuthorizationRuleRights(props.Rights)
+		d.Set("listen", listen)
+		d.Set("send", send)
+		d.Set("manage", manage)
+	}
+
+	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.AuthorizationRuleName)
+	if err != nil {
+		return fmt.Errorf("listing keys for %s: %+v", *id, err)
+	}
+	setAuthorizationRuleKeys(d, keys)
+
+	return nil
+}
+
+func eventHubAuthorizationRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).EventHub.EventHubsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.EventHubAuthorizationRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.EventhubName, eventHubResourceName)
+	defer locks.UnlockByName(id.EventhubName, eventHubResourceName)
+
+	resp, err := client.DeleteAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.AuthorizationRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
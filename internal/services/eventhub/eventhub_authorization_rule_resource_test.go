@@ -0,0 +1,185 @@
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/eventhub/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type EventHubAuthorizationRuleResource struct{}
+
+func TestAccEventHubAuthorizationRule_listen(t *testing.T) {
+	testAccEventHubAuthorizationRule(t, true, false, false)
+}
+
+func TestAccEventHubAuthorizationRule_send(t *testing.T) {
+	testAccEventHubAuthorizationRule(t, false, true, false)
+}
+
+func TestAccEventHubAuthorizationRule_readWrite(t *testing.T) {
+	testAccEventHubAuthorizationRule(t, true, true, false)
+}
+
+func TestAccEventHubAuthorizationRule_manage(t *testing.T) {
+	testAccEventHubAuthorizationRule(t, true, true, true)
+}
+
+func testAccEventHubAuthorizationRule(t *testing.T, listen, send, manage bool) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub_authorization_rule", "test")
+	r := EventHubAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.base(data, listen, send, manage),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("namespace_name").Exists(),
+				check.That(data.ResourceName).Key("eventhub_name").Exists(),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+				check.That(data.ResourceName).Key("primary_connection_string").Exists(),
+				check.That(data.ResourceName).Key("secondary_connection_string").Exists(),
+				check.That(data.ResourceName).Key("listen").HasValue(fmt.Sprintf("%t", listen)),
+				check.That(data.ResourceName).Key("send").HasValue(fmt.Sprintf("%t", send)),
+				check.That(data.ResourceName).Key("manage").HasValue(fmt.Sprintf("%t", manage)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubAuthorizationRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub_authorization_rule", "test")
+	r := EventHubAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.base(data, true, true, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data, true, true, true),
+			ExpectError: acceptance.RequiresImportError("azurestack_eventhub_authorization_rule"),
+		},
+	})
+}
+
+func TestAccEventHubAuthorizationRule_rightsUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub_authorization_rule", "test")
+	r := EventHubAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.base(data, true, false, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("listen").HasValue("true"),
+				check.That(data.ResourceName).Key("send").HasValue("false"),
+				check.That(data.ResourceName).Key("manage").HasValue("false"),
+			),
+		},
+		{
+			Config: r.base(data, true, true, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("listen").HasValue("true"),
+				check.That(data.ResourceName).Key("send").HasValue("true"),
+				check.That(data.ResourceName).Key("manage").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubAuthorizationRule_manageWithoutListenAndSend(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub_authorization_rule", "test")
+	r := EventHubAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.base(data, false, false, true),
+			ExpectError: regexp.MustCompile("if `manage` is set both `listen` and `send` must be set to true too"),
+		},
+	})
+}
+
+func (EventHubAuthorizationRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.EventHubAuthorizationRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventHub.EventHubsClient.GetAuthorizationRule(ctx, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.AuthorizationRuleName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.AuthorizationRuleProperties != nil), nil
+}
+
+func (EventHubAuthorizationRuleResource) base(data acceptance.TestData, listen, send, manage bool) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-eventhub-%d"
+  location = "%s"
+}
+
+resource "azurestack_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurestack_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = azurestack_eventhub_namespace.test.name
+  resource_group_name = azurestack_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurestack_eventhub_authorization_rule" "test" {
+  name                = "acctesteventhubrule-%d"
+  namespace_name      = azurestack_eventhub_namespace.test.name
+  eventhub_name       = azurestack_eventhub.test.name
+  resource_group_name = azurestack_resource_group.test.name
+
+  listen = %t
+  send   = %t
+  manage = %t
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, listen, send, manage)
+}
+
+func (r EventHubAuthorizationRuleResource) requiresImport(data acceptance.TestData, listen, send, manage bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_eventhub_authorization_rule" "import" {
+  name                = azurestack_eventhub_authorization_rule.test.name
+  namespace_name      = azurestack_eventhub_authorization_rule.test.namespace_name
+  eventhub_name       = azurestack_eventhub_authorization_rule.test.eventhub_name
+  resource_group_name = azurestack_eventhub_authorization_rule.test.resource_group_name
+
+  listen = azurestack_eventhub_authorization_rule.test.listen
+  send   = azurestack_eventhub_authorization_rule.test.send
+  manage = azurestack_eventhub_authorization_rule.test.manage
+}
+`, r.base(data, listen, send, manage))
+}
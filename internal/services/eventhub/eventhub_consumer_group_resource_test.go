@@ -0,0 +1,154 @@
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/eventhub/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type EventHubConsumerGroupResource struct{}
+
+func TestAccEventHubConsumerGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub_consumer_group", "test")
+	r := EventHubConsumerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubConsumerGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub_consumer_group", "test")
+	r := EventHubConsumerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_eventhub_consumer_group"),
+		},
+	})
+}
+
+func TestAccEventHubConsumerGroup_userMetadataUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub_consumer_group", "test")
+	r := EventHubConsumerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_metadata").HasValue("some-meta-data"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventHubConsumerGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.EventHubConsumerGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventHub.ConsumerGroupsClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.ConsumergroupName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ConsumerGroupProperties != nil), nil
+}
+
+func (r EventHubConsumerGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_eventhub_consumer_group" "test" {
+  name                = "acctesteventhubcg-%d"
+  namespace_name      = azurestack_eventhub_namespace.test.name
+  eventhub_name       = azurestack_eventhub.test.name
+  resource_group_name = azurestack_resource_group.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventHubConsumerGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_eventhub_consumer_group" "import" {
+  name                = azurestack_eventhub_consumer_group.test.name
+  namespace_name      = azurestack_eventhub_consumer_group.test.namespace_name
+  eventhub_name       = azurestack_eventhub_consumer_group.test.eventhub_name
+  resource_group_name = azurestack_eventhub_consumer_group.test.resource_group_name
+}
+`, r.basic(data))
+}
+
+func (r EventHubConsumerGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_eventhub_consumer_group" "test" {
+  name                = "acctesteventhubcg-%d"
+  namespace_name      = azurestack_eventhub_namespace.test.name
+  eventhub_name       = azurestack_eventhub.test.name
+  resource_group_name = azurestack_resource_group.test.name
+  user_metadata       = "some-meta-data"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (EventHubConsumerGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-eventhub-%d"
+  location = "%s"
+}
+
+resource "azurestack_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurestack_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = azurestack_eventhub_namespace.test.name
+  resource_group_name = azurestack_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 7
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
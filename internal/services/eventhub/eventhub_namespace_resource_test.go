@@ -0,0 +1,152 @@
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/eventhub/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type EventHubNamespaceResource struct{}
+
+func TestAccEventHubNamespace_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub_namespace", "test")
+	r := EventHubNamespaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Standard"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_primary_connection_string").Exists(),
+				check.That(data.ResourceName).Key("default_secondary_connection_string").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespace_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub_namespace", "test")
+	r := EventHubNamespaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Standard"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_eventhub_namespace"),
+		},
+	})
+}
+
+func TestAccEventHubNamespace_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub_namespace", "test")
+	r := EventHubNamespaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Basic"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku").HasValue("Standard"),
+				check.That(data.ResourceName).Key("capacity").HasValue("2"),
+				check.That(data.ResourceName).Key("auto_inflate_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("maximum_throughput_units").HasValue("10"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventHubNamespaceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.EventHubNamespaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventHub.NamespacesClient.Get(ctx, id.ResourceGroup, id.NamespaceName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.EHNamespaceProperties != nil), nil
+}
+
+func (EventHubNamespaceResource) basic(data acceptance.TestData, sku string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-eventhub-%d"
+  location = "%s"
+}
+
+resource "azurestack_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  sku                 = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, sku)
+}
+
+func (r EventHubNamespaceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_eventhub_namespace" "import" {
+  name                = azurestack_eventhub_namespace.test.name
+  location            = azurestack_eventhub_namespace.test.location
+  resource_group_name = azurestack_eventhub_namespace.test.resource_group_name
+  sku                 = azurestack_eventhub_namespace.test.sku
+}
+`, r.basic(data, "Standard"))
+}
+
+func (EventHubNamespaceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-eventhub-%d"
+  location = "%s"
+}
+
+resource "azurestack_eventhub_namespace" "test" {
+  name                     = "acctesteventhubnamespace-%d"
+  location                 = azurestack_resource_group.test.location
+  resource_group_name      = azurestack_resource_group.test.name
+  sku                      = "Standard"
+  capacity                 = 2
+  auto_inflate_enabled     = true
+  maximum_throughput_units = 10
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,130 @@
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/eventhub/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type EventHubResource struct{}
+
+func TestAccEventHub_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub", "test")
+	r := EventHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("partition_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHub_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub", "test")
+	r := EventHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_eventhub"),
+		},
+	})
+}
+
+func TestAccEventHub_messageRetentionUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_eventhub", "test")
+	r := EventHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("message_retention").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("message_retention").HasValue("5"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventHubResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.EventHubID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventHub.EventHubsClient.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.Properties != nil), nil
+}
+
+func (EventHubResource) basic(data acceptance.TestData, messageRetention int) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-eventhub-%d"
+  location = "%s"
+}
+
+resource "azurestack_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurestack_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = azurestack_eventhub_namespace.test.name
+  resource_group_name = azurestack_resource_group.test.name
+  partition_count     = 2
+  message_retention   = %d
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, messageRetention)
+}
+
+func (r EventHubResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_eventhub" "import" {
+  name                = azurestack_eventhub.test.name
+  namespace_name      = azurestack_eventhub.test.namespace_name
+  resource_group_name = azurestack_eventhub.test.resource_group_name
+  partition_count     = azurestack_eventhub.test.partition_count
+  message_retention   = azurestack_eventhub.test.message_retention
+}
+`, r.basic(data, 1))
+}
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type EventHubAuthorizationRuleId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	NamespaceName         string
+	EventhubName          string
+	AuthorizationRuleName string
+}
+
+func NewEventHubAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, eventhubName, authorizationRuleName string) EventHubAuthorizationRuleId {
+	return EventHubAuthorizationRuleId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		NamespaceName:         namespaceName,
+		EventhubName:          eventhubName,
+		AuthorizationRuleName: authorizationRuleName,
+	}
+}
+
+func (id EventHubAuthorizationRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Authorization Rule Name %q", id.AuthorizationRuleName),
+		fmt.Sprintf("Eventhub Name %q", id.EventhubName),
+		fmt.Sprintf("Namespace Name %q", id.NamespaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Event Hub Authorization Rule", segmentsStr)
+}
+
+func (id EventHubAuthorizationRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s/authorizationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.AuthorizationRuleName)
+}
+
+// EventHubAuthorizationRuleID parses a EventHubAuthorizationRule ID into an EventHubAuthorizationRuleId struct
+func EventHubAuthorizationRuleID(input string) (*EventHubAuthorizationRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := EventHubAuthorizationRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.EventhubName, err = id.PopSegment("eventhubs"); err != nil {
+		return nil, err
+	}
+	if resourceId.AuthorizationRuleName, err = id.PopSegment("authorizationRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,47 @@
+package eventhub
+
+import (
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "EventHub"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Messaging",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurestack_eventhub":                    eventHub(),
+		"azurestack_eventhub_authorization_rule": eventHubAuthorizationRule(),
+		"azurestack_eventhub_consumer_group":     eventHubConsumerGroup(),
+		"azurestack_eventhub_namespace":          eventHubNamespace(),
+	}
+}
+
+// ResourceProviderTypes returns the Resource Types provisioned by the Resources supported by this Service,
+// which are checked against the Resource Types supported by the Azure Stack Hub at plan time
+func (r Registration) ResourceProviderTypes() map[string]string {
+	// the Resources nested within a Namespace are checked against the Namespace, since these are only
+	// available when the EventHub Resource Provider is installed on the Azure Stack Hub
+	return map[string]string{
+		"azurestack_eventhub":                    "Microsoft.EventHub/namespaces",
+		"azurestack_eventhub_authorization_rule": "Microsoft.EventHub/namespaces",
+		"azurestack_eventhub_consumer_group":     "Microsoft.EventHub/namespaces",
+		"azurestack_eventhub_namespace":          "Microsoft.EventHub/namespaces",
+	}
+}
@@ -0,0 +1,6 @@
+package eventhub
+
+var (
+	eventHubResourceName          = "azurestack_eventhub"
+	eventHubNamespaceResourceName = "azurestack_eventhub_namespace"
+)
@@ -0,0 +1,31 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAuthorizationRuleName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "rule-", Valid: false},
+		{Value: "rule/1", Valid: false},
+		{Value: "RootManageSharedAccessKey", Valid: true},
+		{Value: "acctest_rule-1.a", Valid: true},
+		{Value: strings.Repeat("a", 50), Valid: true},
+		{Value: strings.Repeat("a", 51), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := AuthorizationRuleName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
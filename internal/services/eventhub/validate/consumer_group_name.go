@@ -0,0 +1,25 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ConsumerGroupName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	// `$Default` is created by the service and can't be created or deleted, but is valid to reference
+	if value == "$Default" {
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z\d]([-._a-zA-Z\d]{0,48}[a-zA-Z\d])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 50 characters, start and end with a letter or number and can only contain letters, numbers, periods, hyphens and underscores", k))
+	}
+
+	return warnings, errors
+}
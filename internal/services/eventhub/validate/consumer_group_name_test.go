@@ -0,0 +1,31 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConsumerGroupName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "$Default", Valid: true},
+		{Value: "$default2", Valid: false},
+		{Value: "-group", Valid: false},
+		{Value: "acctest_consumer-group.1", Valid: true},
+		{Value: strings.Repeat("a", 50), Valid: true},
+		{Value: strings.Repeat("a", 51), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := ConsumerGroupName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
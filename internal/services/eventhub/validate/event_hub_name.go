@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func EventHubName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z\d]([-._a-zA-Z\d]{0,254}[a-zA-Z\d])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 256 characters, start and end with a letter or number and can only contain letters, numbers, periods, hyphens and underscores", k))
+	}
+
+	return warnings, errors
+}
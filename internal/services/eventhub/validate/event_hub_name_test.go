@@ -0,0 +1,31 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEventHubName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "-eventhub", Valid: false},
+		{Value: "eventhub.", Valid: false},
+		{Value: "e", Valid: true},
+		{Value: "acctest_event-hub.1", Valid: true},
+		{Value: strings.Repeat("a", 256), Valid: true},
+		{Value: strings.Repeat("a", 257), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := EventHubName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func NamespaceName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z][-a-zA-Z\d]{4,48}[a-zA-Z\d]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 6 and 50 characters, start with a letter, end with a letter or number and can only contain letters, numbers and hyphens", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,32 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNamespaceName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "short", Valid: false},
+		{Value: "1namespace", Valid: false},
+		{Value: "namespace-", Valid: false},
+		{Value: "namespace_1", Valid: false},
+		{Value: "acctest-namespace1", Valid: true},
+		{Value: strings.Repeat("a", 50), Valid: true},
+		{Value: strings.Repeat("a", 51), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := NamespaceName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/iothub/mgmt/devices"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+type Client struct {
+	ResourceClient *devices.IotHubResourceClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	ResourceClient := devices.NewIotHubResourceClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ResourceClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ResourceClient: &ResourceClient,
+	}
+}
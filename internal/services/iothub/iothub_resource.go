@@ -0,0 +1,810 @@
+package iothub
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/iothub/mgmt/devices"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/iothub/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/iothub/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+const (
+	iotHubEndpointTypeEventHub         = "AzureIotHub.EventHub"
+	iotHubEndpointTypeServiceBusQueue  = "AzureIotHub.ServiceBusQueue"
+	iotHubEndpointTypeServiceBusTopic  = "AzureIotHub.ServiceBusTopic"
+	iotHubEndpointTypeStorageContainer = "AzureIotHub.StorageContainer"
+)
+
+func iotHub() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: iotHubCreateUpdate,
+		Read:   iotHubRead,
+		Update: iotHubCreateUpdate,
+		Delete: iotHubDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IotHubID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.IotHubName,
+			},
+
+			"location": commonschema.Location(),
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(devices.B1),
+								string(devices.B2),
+								string(devices.B3),
+								string(devices.F1),
+								string(devices.S1),
+								string(devices.S2),
+								string(devices.S3),
+							}, false),
+						},
+
+						"capacity": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"event_hub_partition_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(2, 128),
+			},
+
+			"event_hub_retention_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 7),
+			},
+
+			"endpoint": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								iotHubEndpointTypeEventHub,
+								iotHubEndpointTypeServiceBusQueue,
+								iotHubEndpointTypeServiceBusTopic,
+								iotHubEndpointTypeStorageContainer,
+							}, false),
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringMatch(regexp.MustCompile(`^[-_.a-zA-Z0-9]{1,64}$`), "`name` must be between 1 and 64 characters and can only contain alphanumeric characters, periods, underscores and hyphens"),
+								// these names are reserved by the service
+								validation.StringNotInSlice([]string{"events", "operationsMonitoringEvents", "fileNotifications", "$default"}, true),
+							),
+						},
+
+						"connection_string": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: iotHubEndpointConnectionStringDiffSuppress,
+						},
+
+						"batch_frequency_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntBetween(60, 720),
+						},
+
+						"max_chunk_size_in_bytes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      314572800,
+							ValidateFunc: validation.IntBetween(10485760, 524288000),
+						},
+
+						"container_name": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"encoding": {
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(devices.Avro),
+								string(devices.AvroDeflate),
+								string(devices.JSON),
+							}, true),
+						},
+
+						"file_name_format": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"route": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[-_.a-zA-Z0-9]{1,64}$`), "`name` must be between 1 and 64 characters and can only contain alphanumeric characters, periods, underscores and hyphens"),
+						},
+
+						"source": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iotHubRoutingSources(), false),
+						},
+
+						"condition": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  "true",
+						},
+
+						"endpoint_names": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							// the service only supports a single endpoint per route
+							MaxItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"fallback_route": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(devices.RoutingSourceDeviceMessages),
+							ValidateFunc: validation.StringInSlice(iotHubRoutingSources(), false),
+						},
+
+						"condition": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  "true",
+						},
+
+						"endpoint_names": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"hostname": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"event_hub_events_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"event_hub_events_path": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"event_hub_operations_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"event_hub_operations_path": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"shared_access_policy": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"primary_key": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"secondary_key": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"permissions": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func iotHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTHub.ResourceClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewIotHubID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	locks.ByName(id.Name, iotHubResourceName)
+	defer locks.UnlockByName(id.Name, iotHubResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if d.IsNewResource() && !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurestack_iothub", id.ID())
+	}
+
+	routingProperties := devices.RoutingProperties{}
+
+	if _, ok := d.GetOk("route"); ok {
+		routingProperties.Routes = expandIotHubRoutes(d.Get("route").([]interface{}))
+	}
+
+	if _, ok := d.GetOk("fallback_route"); ok {
+		routingProperties.FallbackRoute = expandIotHubFallbackRoute(d.Get("fallback_route").([]interface{}))
+	}
+
+	endpoints, err := expandIotHubEndpoints(d.Get("endpoint").([]interface{}), subscriptionId)
+	if err != nil {
+		return fmt.Errorf("expanding `endpoint`: %+v", err)
+	}
+	routingProperties.Endpoints = endpoints
+
+	eventHubEndpoint := &devices.EventHubProperties{}
+	if v, ok := d.GetOk("event_hub_partition_count"); ok {
+		eventHubEndpoint.PartitionCount = utils.Int32(int32(v.(int)))
+	}
+	if v, ok := d.GetOk("event_hub_retention_in_days"); ok {
+		eventHubEndpoint.RetentionTimeInDays = utils.Int64(int64(v.(int)))
+	}
+
+	props := devices.IotHubDescription{
+		Name:     pointer.FromString(id.Name),
+		Location: pointer.FromString(location.Normalize(d.Get("location").(string))),
+		Sku:      expandIotHubSku(d.Get("sku").([]interface{})),
+		Properties: &devices.IotHubProperties{
+			Routing: &routingProperties,
+			EventHubEndpoints: map[string]*devices.EventHubProperties{
+				"events": eventHubEndpoint,
+			},
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	// the Shared Access Policies can be managed using the `azurestack_iothub_shared_access_policy` resource,
+	// as such we need to retain any existing policies when updating the IoT Hub
+	if existing.Properties != nil {
+		props.Properties.AuthorizationPolicies = existing.Properties.AuthorizationPolicies
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, props, "")
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return iotHubRead(d, meta)
+}
+
+func iotHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTHub.ResourceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IotHubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	hub, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(hub.Location))
+	d.Set("type", hub.Type)
+
+	if err := d.Set("sku", flattenIotHubSku(hub.Sku)); err != nil {
+		return fmt.Errorf("setting `sku`: %+v", err)
+	}
+
+	keysResp, err := client.ListKeysComplete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing keys for %s: %+v", *id, err)
+	}
+
+	sharedAccessPolicies := make([]interface{}, 0)
+	for keysResp.NotDone() {
+		key := keysResp.Value()
+		sharedAccessPolicies = append(sharedAccessPolicies, map[string]interface{}{
+			"key_name":      pointer.ToString(key.KeyName),
+			"primary_key":   pointer.ToString(key.PrimaryKey),
+			"secondary_key": pointer.ToString(key.SecondaryKey),
+			"permissions":   string(key.Rights),
+		})
+
+		if err := keysResp.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing next page of keys for %s: %+v", *id, err)
+		}
+	}
+
+	if err := d.Set("shared_access_policy", sharedAccessPolicies); err != nil {
+		return fmt.Errorf("setting `shared_access_policy`: %+v", err)
+	}
+
+	if props := hub.Properties; props != nil {
+		d.Set("hostname", props.HostName)
+
+		for k, v := range props.EventHubEndpoints {
+			if v == nil {
+				continue
+			}
+
+			switch k {
+			case "events":
+				d.Set("event_hub_events_endpoint", v.Endpoint)
+				d.Set("event_hub_events_path", v.Path)
+				d.Set("event_hub_partition_count", v.PartitionCount)
+				d.Set("event_hub_retention_in_days", v.RetentionTimeInDays)
+			case "operationsMonitoringEvents":
+				d.Set("event_hub_operations_endpoint", v.Endpoint)
+				d.Set("event_hub_operations_path", v.Path)
+			}
+		}
+
+		endpoints := make([]interface{}, 0)
+		routes := make([]interface{}, 0)
+		fallbackRoute := make([]interface{}, 0)
+		if routing := props.Routing; routing != nil {
+			endpoints = flattenIotHubEndpoints(routing.Endpoints)
+			routes = flattenIotHubRoutes(routing.Routes)
+			fallbackRoute = flattenIotHubFallbackRoute(routing.FallbackRoute)
+		}
+
+		if err := d.Set("endpoint", endpoints); err != nil {
+			return fmt.Errorf("setting `endpoint`: %+v", err)
+		}
+
+		if err := d.Set("route", routes); err != nil {
+			return fmt.Errorf("setting `route`: %+v", err)
+		}
+
+		if err := d.Set("fallback_route", fallbackRoute); err != nil {
+			return fmt.Errorf("setting `fallback_route`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, hub.Tags)
+}
+
+func iotHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTHub.ResourceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IotHubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, iotHubResourceName)
+	defer locks.UnlockByName(id.Name, iotHubResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func iotHubRoutingSources() []string {
+	return []string{
+		string(devices.RoutingSourceDeviceJobLifecycleEvents),
+		string(devices.RoutingSourceDeviceLifecycleEvents),
+		string(devices.RoutingSourceDeviceMessages),
+		string(devices.RoutingSourceTwinChangeEvents),
+	}
+}
+
+// iotHubEndpointConnectionStringDiffSuppress suppresses the diff on the connection string of an endpoint, since
+// the keys within the connection string are masked by the API - and the Service Bus endpoint has the port appended
+func iotHubEndpointConnectionStringDiffSuppress(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	secretKeyRegex := regexp.MustCompile("(SharedAccessKey|AccountKey)=[^;]+")
+	sbProtocolRegex := regexp.MustCompile("sb://([^:]+)(:5671)?/;")
+
+	maskedNew := sbProtocolRegex.ReplaceAllString(new, "sb://$1:5671/;")
+	maskedNew = secretKeyRegex.ReplaceAllString(maskedNew, "$1=****")
+	return (new == old) || (maskedNew == old)
+}
+
+func expandIotHubSku(input []interface{}) *devices.IotHubSkuInfo {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &devices.IotHubSkuInfo{
+		Name:     devices.IotHubSku(v["name"].(string)),
+		Capacity: utils.Int64(int64(v["capacity"].(int))),
+	}
+}
+
+func flattenIotHubSku(input *devices.IotHubSkuInfo) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	capacity := 0
+	if input.Capacity != nil {
+		capacity = int(*input.Capacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":     string(input.Name),
+			"capacity": capacity,
+		},
+	}
+}
+
+func expandIotHubEndpoints(input []interface{}, subscriptionId string) (*devices.RoutingEndpoints, error) {
+	serviceBusQueueEndpointProperties := make([]devices.RoutingServiceBusQueueEndpointProperties, 0)
+	serviceBusTopicEndpointProperties := make([]devices.RoutingServiceBusTopicEndpointProperties, 0)
+	eventHubProperties := make([]devices.RoutingEventHubProperties, 0)
+	storageContainerProperties := make([]devices.RoutingStorageContainerProperties, 0)
+
+	for _, endpointRaw := range input {
+		endpoint := endpointRaw.(map[string]interface{})
+
+		endpointType := endpoint["type"].(string)
+		name := endpoint["name"].(string)
+		connectionString := endpoint["connection_string"].(string)
+
+		switch endpointType {
+		case iotHubEndpointTypeEventHub:
+			eventHubProperties = append(eventHubProperties, devices.RoutingEventHubProperties{
+				ConnectionString: pointer.FromString(connectionString),
+				Name:             pointer.FromString(name),
+				SubscriptionID:   pointer.FromString(subscriptionId),
+			})
+
+		case iotHubEndpointTypeServiceBusQueue:
+			serviceBusQueueEndpointProperties = append(serviceBusQueueEndpointProperties, devices.RoutingServiceBusQueueEndpointProperties{
+				ConnectionString: pointer.FromString(connectionString),
+				Name:             pointer.FromString(name),
+				SubscriptionID:   pointer.FromString(subscriptionId),
+			})
+
+		case iotHubEndpointTypeServiceBusTopic:
+			serviceBusTopicEndpointProperties = append(serviceBusTopicEndpointProperties, devices.RoutingServiceBusTopicEndpointProperties{
+				ConnectionString: pointer.FromString(connectionString),
+				Name:             pointer.FromString(name),
+				SubscriptionID:   pointer.FromString(subscriptionId),
+			})
+
+		case iotHubEndpointTypeStorageContainer:
+			containerName := endpoint["container_name"].(string)
+			if containerName == "" {
+				return nil, fmt.Errorf("`container_name` must be specified for the endpoint %q of type %q", name, endpointType)
+			}
+
+			storageContainer := devices.RoutingStorageContainerProperties{
+				ConnectionString:        pointer.FromString(connectionString),
+				Name:                    pointer.FromString(name),
+				SubscriptionID:          pointer.FromString(subscriptionId),
+				ContainerName:           pointer.FromString(containerName),
+				BatchFrequencyInSeconds: utils.Int32(int32(endpoint["batch_frequency_in_seconds"].(int))),
+				MaxChunkSizeInBytes:     utils.Int32(int32(endpoint["max_chunk_size_in_bytes"].(int))),
+				Encoding:                devices.Encoding(endpoint["encoding"].(string)),
+			}
+
+			if v := endpoint["file_name_format"].(string); v != "" {
+				storageContainer.FileNameFormat = pointer.FromString(v)
+			}
+
+			storageContainerProperties = append(storageContainerProperties, storageContainer)
+		}
+	}
+
+	return &devices.RoutingEndpoints{
+		ServiceBusQueues:  &serviceBusQueueEndpointProperties,
+		ServiceBusTopics:  &serviceBusTopicEndpointProperties,
+		EventHubs:         &eventHubProperties,
+		StorageContainers: &storageContainerProperties,
+	}, nil
+}
+
+func flattenIotHubEndpoints(input *devices.RoutingEndpoints) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	if containers := input.StorageContainers; containers != nil {
+		for _, container := range *containers {
+			batchFrequencyInSeconds := 0
+			if container.BatchFrequencyInSeconds != nil {
+				batchFrequencyInSeconds = int(*container.BatchFrequencyInSeconds)
+			}
+
+			maxChunkSizeInBytes := 0
+			if container.MaxChunkSizeInBytes != nil {
+				maxChunkSizeInBytes = int(*container.MaxChunkSizeInBytes)
+			}
+
+			results = append(results, map[string]interface{}{
+				"type":                       iotHubEndpointTypeStorageContainer,
+				"name":                       pointer.ToString(container.Name),
+				"connection_string":          pointer.ToString(container.ConnectionString),
+				"container_name":             pointer.ToString(container.ContainerName),
+				"file_name_format":           pointer.ToString(container.FileNameFormat),
+				"batch_frequency_in_seconds": batchFrequencyInSeconds,
+				"max_chunk_size_in_bytes":    maxChunkSizeInBytes,
+				"encoding":                   string(container.Encoding),
+			})
+		}
+	}
+
+	if queues := input.ServiceBusQueues; queues != nil {
+		for _, queue := range *queues {
+			results = append(results, map[string]interface{}{
+				"type":              iotHubEndpointTypeServiceBusQueue,
+				"name":              pointer.ToString(queue.Name),
+				"connection_string": pointer.ToString(queue.ConnectionString),
+			})
+		}
+	}
+
+	if topics := input.ServiceBusTopics; topics != nil {
+		for _, topic := range *topics {
+			results = append(results, map[string]interface{}{
+				"type":              iotHubEndpointTypeServiceBusTopic,
+				"name":              pointer.ToString(topic.Name),
+				"connection_string": pointer.ToString(topic.ConnectionString),
+			})
+		}
+	}
+
+	if eventHubs := input.EventHubs; eventHubs != nil {
+		for _, eventHub := range *eventHubs {
+			results = append(results, map[string]interface{}{
+				"type":              iotHubEndpointTypeEventHub,
+				"name":              pointer.ToString(eventHub.Name),
+				"connection_string": pointer.ToString(eventHub.ConnectionString),
+			})
+		}
+	}
+
+	return results
+}
+
+func expandIotHubRoutes(input []interface{}) *[]devices.RouteProperties {
+	routes := make([]devices.RouteProperties, 0)
+
+	for _, routeRaw := range input {
+		route := routeRaw.(map[string]interface{})
+
+		routes = append(routes, devices.RouteProperties{
+			Name:          pointer.FromString(route["name"].(string)),
+			Source:        devices.RoutingSource(route["source"].(string)),
+			Condition:     pointer.FromString(route["condition"].(string)),
+			EndpointNames: utils.ExpandStringSlice(route["endpoint_names"].([]interface{})),
+			IsEnabled:     pointer.FromBool(route["enabled"].(bool)),
+		})
+	}
+
+	return &routes
+}
+
+func flattenIotHubRoutes(input *[]devices.RouteProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, route := range *input {
+		enabled := false
+		if route.IsEnabled != nil {
+			enabled = *route.IsEnabled
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":           pointer.ToString(route.Name),
+			"source":         string(route.Source),
+			"condition":      pointer.ToString(route.Condition),
+			"endpoint_names": utils.FlattenStringSlice(route.EndpointNames),
+			"enabled":        enabled,
+		})
+	}
+
+	return results
+}
+
+func expandIotHubFallbackRoute(input []interface{}) *devices.FallbackRouteProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	route := input[0].(map[string]interface{})
+	return &devices.FallbackRouteProperties{
+		Source:        pointer.FromString(route["source"].(string)),
+		Condition:     pointer.FromString(route["condition"].(string)),
+		EndpointNames: utils.ExpandStringSlice(route["endpoint_names"].([]interface{})),
+		IsEnabled:     pointer.FromBool(route["enabled"].(bool)),
+	}
+}
+
+func flattenIotHubFallbackRoute(input *devices.FallbackRouteProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.IsEnabled != nil {
+		enabled = *input.IsEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"source":         pointer.ToString(input.Source),
+			"condition":      pointer.ToString(input.Condition),
+			"endpoint_names": utils.FlattenStringSlice(input.EndpointNames),
+			"enabled":        enabled,
+		},
+	}
+}
+
+func iotHubAccessRights(registryRead, registryWrite, serviceConnect, deviceConnect bool) devices.AccessRights {
+	rights := make([]string, 0)
+
+	if registryRead {
+		rights = append(rights, string(devices.RegistryRead))
+	}
+
+	if registryWrite {
+		rights = append(rights, string(devices.RegistryWrite))
+	}
+
+	if serviceConnect {
+		rights = append(rights, string(devices.ServiceConnect))
+	}
+
+	if deviceConnect {
+		rights = append(rights, string(devices.DeviceConnect))
+	}
+
+	return devices.AccessRights(strings.Join(rights, ", "))
+}
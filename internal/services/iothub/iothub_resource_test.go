@@ -0,0 +1,257 @@
+package iothub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/iothub/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type IotHubResource struct{}
+
+func TestAccIotHub_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_iothub", "test")
+	r := IotHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hostname").Exists(),
+				check.That(data.ResourceName).Key("event_hub_events_endpoint").Exists(),
+				check.That(data.ResourceName).Key("shared_access_policy.#").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHub_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_iothub", "test")
+	r := IotHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_iothub"),
+		},
+	})
+}
+
+func TestAccIotHub_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_iothub", "test")
+	r := IotHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku.0.capacity").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku.0.capacity").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHub_customRoutes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_iothub", "test")
+	r := IotHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customRoutes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("endpoint.#").HasValue("2"),
+				check.That(data.ResourceName).Key("route.#").HasValue("2"),
+				check.That(data.ResourceName).Key("fallback_route.0.enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (IotHubResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.IotHubID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.IoTHub.ResourceClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.Properties != nil), nil
+}
+
+func (IotHubResource) basic(data acceptance.TestData, capacity int) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-iothub-%d"
+  location = "%s"
+}
+
+resource "azurestack_iothub" "test" {
+  name                = "acctestIoTHub-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = %d
+  }
+
+  tags = {
+    purpose = "testing"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, capacity)
+}
+
+func (r IotHubResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_iothub" "import" {
+  name                = azurestack_iothub.test.name
+  resource_group_name = azurestack_iothub.test.resource_group_name
+  location            = azurestack_iothub.test.location
+
+  sku {
+    name     = "S1"
+    capacity = 1
+  }
+}
+`, r.basic(data, 1))
+}
+
+func (IotHubResource) customRoutes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-iothub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_storage_account" "test" {
+  name                     = "acc%[3]s"
+  resource_group_name      = azurestack_resource_group.test.name
+  location                 = azurestack_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurestack_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurestack_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurestack_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+  sku                 = "Standard"
+}
+
+resource "azurestack_eventhub" "test" {
+  name                = "acctesteventhub-%[1]d"
+  namespace_name      = azurestack_eventhub_namespace.test.name
+  resource_group_name = azurestack_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurestack_eventhub_authorization_rule" "test" {
+  name                = "acctest-%[1]d"
+  namespace_name      = azurestack_eventhub_namespace.test.name
+  eventhub_name       = azurestack_eventhub.test.name
+  resource_group_name = azurestack_resource_group.test.name
+
+  listen = false
+  send   = true
+  manage = false
+}
+
+resource "azurestack_iothub" "test" {
+  name                = "acctestIoTHub-%[1]d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = 1
+  }
+
+  event_hub_retention_in_days = 7
+  event_hub_partition_count   = 77
+
+  endpoint {
+    type                       = "AzureIotHub.StorageContainer"
+    connection_string          = azurestack_storage_account.test.primary_blob_connection_string
+    name                       = "export"
+    batch_frequency_in_seconds = 60
+    max_chunk_size_in_bytes    = 10485760
+    container_name             = azurestack_storage_container.test.name
+    encoding                   = "Avro"
+    file_name_format           = "{iothub}/{partition}_{YYYY}_{MM}_{DD}_{HH}_{mm}"
+  }
+
+  endpoint {
+    type              = "AzureIotHub.EventHub"
+    connection_string = azurestack_eventhub_authorization_rule.test.primary_connection_string
+    name              = "export2"
+  }
+
+  route {
+    name           = "export"
+    source         = "DeviceMessages"
+    condition      = "true"
+    endpoint_names = ["export"]
+    enabled        = true
+  }
+
+  route {
+    name           = "export2"
+    source         = "DeviceMessages"
+    condition      = "true"
+    endpoint_names = ["export2"]
+    enabled        = true
+  }
+
+  fallback_route {
+    enabled = true
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,323 @@
+package iothub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/iothub/mgmt/devices"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/iothub/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/iothub/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func iotHubSharedAccessPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: iotHubSharedAccessPolicyCreateUpdate,
+		Read:   iotHubSharedAccessPolicyRead,
+		Update: iotHubSharedAccessPolicyCreateUpdate,
+		Delete: iotHubSharedAccessPolicyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SharedAccessPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SharedAccessPolicyName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"iothub_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.IotHubName,
+			},
+
+			"registry_read": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"registry_write": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"service_connect": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"device_connect": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"primary_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(iotHubSharedAccessPolicyCustomizeDiff),
+	}
+}
+
+func iotHubSharedAccessPolicyCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	registryRead := d.Get("registry_read").(bool)
+	registryWrite := d.Get("registry_write").(bool)
+	serviceConnect := d.Get("service_connect").(bool)
+	deviceConnect := d.Get("device_connect").(bool)
+
+	if !registryRead && !registryWrite && !serviceConnect && !deviceConnect {
+		return fmt.Errorf("at least one of `registry_read`, `registry_write`, `service_connect` or `device_connect` must be set to `true`")
+	}
+
+	if registryWrite && !registryRead {
+		return fmt.Errorf("`registry_read` must be set to `true` when `registry_write` is set to `true`")
+	}
+
+	return nil
+}
+
+func iotHubSharedAccessPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTHub.ResourceClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewSharedAccessPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("iothub_name").(string), d.Get("name").(string))
+
+	locks.ByName(id.IotHubName, iotHubResourceName)
+	defer locks.UnlockByName(id.IotHubName, iotHubResourceName)
+
+	iotHub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(iotHub.Response) {
+			return fmt.Errorf("IoT Hub %q (Resource Group %q) was not found", id.IotHubName, id.ResourceGroup)
+		}
+		return fmt.Errorf("retrieving IoT Hub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
+	}
+
+	if iotHub.Properties == nil {
+		return fmt.Errorf("retrieving IoT Hub %q (Resource Group %q): `properties` was nil", id.IotHubName, id.ResourceGroup)
+	}
+
+	rights := iotHubAccessRights(d.Get("registry_read").(bool), d.Get("registry_write").(bool), d.Get("service_connect").(bool), d.Get("device_connect").(bool))
+	expectedPolicy := devices.SharedAccessSignatureAuthorizationRule{
+		KeyName: pointer.FromString(id.IotHubKeyName),
+		Rights:  rights,
+	}
+
+	policies := make([]devices.SharedAccessSignatureAuthorizationRule, 0)
+	alreadyExists := false
+
+	if iotHub.Properties.AuthorizationPolicies != nil {
+		for _, policy := range *iotHub.Properties.AuthorizationPolicies {
+			if policy.KeyName == nil || !strings.EqualFold(*policy.KeyName, id.IotHubKeyName) {
+				policies = append(policies, policy)
+				continue
+			}
+
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurestack_iothub_shared_access_policy", id.ID())
+			}
+
+			// retain the existing keys, which would otherwise be regenerated
+			policy.Rights = rights
+			policies = append(policies, policy)
+			alreadyExists = true
+		}
+	}
+
+	if !alreadyExists {
+		if !d.IsNewResource() {
+			return fmt.Errorf("unable to find %s", id)
+		}
+
+		policies = append(policies, expectedPolicy)
+	}
+
+	iotHub.Properties.AuthorizationPolicies = &policies
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iotHub, "")
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return iotHubSharedAccessPolicyRead(d, meta)
+}
+
+func iotHubSharedAccessPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTHub.ResourceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SharedAccessPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	iotHub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(iotHub.Response) {
+			log.Printf("[DEBUG] IoT Hub %q (Resource Group %q) was not found - removing %s from state", id.IotHubName, id.ResourceGroup, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving IoT Hub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
+	}
+
+	accessPolicy, err := client.GetKeysForKeyName(ctx, id.ResourceGroup, id.IotHubName, id.IotHubKeyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(accessPolicy.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.IotHubKeyName)
+	d.Set("iothub_name", id.IotHubName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	rights := strings.Split(string(accessPolicy.Rights), ",")
+	d.Set("registry_read", iotHubAccessRightsContains(rights, devices.RegistryRead))
+	d.Set("registry_write", iotHubAccessRightsContains(rights, devices.RegistryWrite))
+	d.Set("service_connect", iotHubAccessRightsContains(rights, devices.ServiceConnect))
+	d.Set("device_connect", iotHubAccessRightsContains(rights, devices.DeviceConnect))
+
+	primaryKey := pointer.ToString(accessPolicy.PrimaryKey)
+	secondaryKey := pointer.ToString(accessPolicy.SecondaryKey)
+	d.Set("primary_key", primaryKey)
+	d.Set("secondary_key", secondaryKey)
+
+	hostName := ""
+	if props := iotHub.Properties; props != nil {
+		hostName = pointer.ToString(props.HostName)
+	}
+	d.Set("primary_connection_string", iotHubSharedAccessPolicyConnectionString(hostName, id.IotHubKeyName, primaryKey))
+	d.Set("secondary_connection_string", iotHubSharedAccessPolicyConnectionString(hostName, id.IotHubKeyName, secondaryKey))
+
+	return nil
+}
+
+func iotHubSharedAccessPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTHub.ResourceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SharedAccessPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.IotHubName, iotHubResourceName)
+	defer locks.UnlockByName(id.IotHubName, iotHubResourceName)
+
+	iotHub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(iotHub.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving IoT Hub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
+	}
+
+	if iotHub.Properties == nil || iotHub.Properties.AuthorizationPolicies == nil {
+		return nil
+	}
+
+	policies := make([]devices.SharedAccessSignatureAuthorizationRule, 0)
+	found := false
+	for _, policy := range *iotHub.Properties.AuthorizationPolicies {
+		if policy.KeyName != nil && strings.EqualFold(*policy.KeyName, id.IotHubKeyName) {
+			found = true
+			continue
+		}
+
+		policies = append(policies, policy)
+	}
+
+	if !found {
+		return nil
+	}
+
+	iotHub.Properties.AuthorizationPolicies = &policies
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iotHub, "")
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func iotHubAccessRightsContains(rights []string, right devices.AccessRights) bool {
+	for _, r := range rights {
+		if strings.EqualFold(strings.TrimSpace(r), string(right)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func iotHubSharedAccessPolicyConnectionString(hostName, keyName, key string) string {
+	return fmt.Sprintf("HostName=%s;SharedAccessKeyName=%s;SharedAccessKey=%s", hostName, keyName, key)
+}
@@ -0,0 +1,152 @@
+package iothub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/iothub/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type IotHubSharedAccessPolicyResource struct{}
+
+func TestAccIotHubSharedAccessPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_iothub_shared_access_policy", "test")
+	r := IotHubSharedAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("registry_read").HasValue("true"),
+				check.That(data.ResourceName).Key("registry_write").HasValue("true"),
+				check.That(data.ResourceName).Key("service_connect").HasValue("false"),
+				check.That(data.ResourceName).Key("device_connect").HasValue("false"),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+				check.That(data.ResourceName).Key("primary_connection_string").Exists(),
+				check.That(data.ResourceName).Key("secondary_connection_string").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHubSharedAccessPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_iothub_shared_access_policy", "test")
+	r := IotHubSharedAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurestack_iothub_shared_access_policy"),
+		},
+	})
+}
+
+func TestAccIotHubSharedAccessPolicy_writeWithoutRead(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_iothub_shared_access_policy", "test")
+	r := IotHubSharedAccessPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.writeWithoutRead(data),
+			ExpectError: regexp.MustCompile("`registry_read` must be set to `true` when `registry_write` is set to `true`"),
+		},
+	})
+}
+
+func (IotHubSharedAccessPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SharedAccessPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.IoTHub.ResourceClient.GetKeysForKeyName(ctx, id.ResourceGroup, id.IotHubName, id.IotHubKeyName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.KeyName != nil), nil
+}
+
+func (IotHubSharedAccessPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-iothub-%d"
+  location = "%s"
+}
+
+resource "azurestack_iothub" "test" {
+  name                = "acctestIoTHub-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+
+  sku {
+    name     = "B1"
+    capacity = 1
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r IotHubSharedAccessPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_iothub_shared_access_policy" "test" {
+  name                = "acctest"
+  resource_group_name = azurestack_resource_group.test.name
+  iothub_name         = azurestack_iothub.test.name
+
+  registry_read  = true
+  registry_write = true
+}
+`, r.template(data))
+}
+
+func (r IotHubSharedAccessPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_iothub_shared_access_policy" "import" {
+  name                = azurestack_iothub_shared_access_policy.test.name
+  resource_group_name = azurestack_iothub_shared_access_policy.test.resource_group_name
+  iothub_name         = azurestack_iothub_shared_access_policy.test.iothub_name
+
+  registry_read  = true
+  registry_write = true
+}
+`, r.basic(data))
+}
+
+func (r IotHubSharedAccessPolicyResource) writeWithoutRead(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_iothub_shared_access_policy" "test" {
+  name                = "acctest"
+  resource_group_name = azurestack_resource_group.test.name
+  iothub_name         = azurestack_iothub.test.name
+
+  registry_write = true
+}
+`, r.template(data))
+}
@@ -0,0 +1,3 @@
+package iothub
+
+var iotHubResourceName = "azurestack_iothub"
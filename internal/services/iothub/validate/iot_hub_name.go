@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func IotHubName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	// Portal: The value must contain only alphanumeric characters or the following: -
+	if !regexp.MustCompile(`^[0-9a-zA-Z][-0-9a-zA-Z]{1,48}[0-9a-zA-Z]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 50 characters, can only contain alphanumeric characters and hyphens and cannot start or end with a hyphen", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,33 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIotHubName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "ab", Valid: false},
+		{Value: "abc", Valid: true},
+		{Value: "-hub", Valid: false},
+		{Value: "hub-", Valid: false},
+		{Value: "iot_hub", Valid: false},
+		{Value: "acctest-IoTHub-1", Valid: true},
+		{Value: strings.Repeat("a", 50), Valid: true},
+		{Value: strings.Repeat("a", 51), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := IotHubName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func SharedAccessPolicyName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	// Portal: The value must contain only alphanumeric characters or the following: - . _
+	if !regexp.MustCompile(`^[-._0-9a-zA-Z]{1,64}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 64 characters and can only contain alphanumeric characters, periods, underscores and hyphens", k))
+	}
+
+	return warnings, errors
+}
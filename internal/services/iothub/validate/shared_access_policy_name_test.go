@@ -0,0 +1,31 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSharedAccessPolicyName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "iothubowner", Valid: true},
+		{Value: "acctest_policy-1.read", Valid: true},
+		{Value: "policy/1", Valid: false},
+		{Value: "policy 1", Valid: false},
+		{Value: strings.Repeat("a", 64), Valid: true},
+		{Value: strings.Repeat("a", 65), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := SharedAccessPolicyName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
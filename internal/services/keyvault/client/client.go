@@ -0,0 +1,25 @@
+package client
+
+import (
+	dataplane "github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/keyvault/keyvault"
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/keyvault/mgmt/keyvault"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+type Client struct {
+	ManagementClient *dataplane.BaseClient
+	VaultsClient     *keyvault.VaultsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	managementClient := dataplane.New()
+	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
+
+	VaultsClient := keyvault.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VaultsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ManagementClient: &managementClient,
+		VaultsClient:     &VaultsClient,
+	}
+}
@@ -0,0 +1,268 @@
+package keyvault
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+	"golang.org/x/crypto/pkcs12"
+)
+
+const (
+	certificateContentTypePEM    = "application/x-pem-file"
+	certificateContentTypePKCS12 = "application/x-pkcs12"
+)
+
+func keyVaultCertificateDataDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: keyVaultCertificateDataDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"key_vault_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VaultID,
+			},
+
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"hex": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"pem": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"certificates_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"expires": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"not_before": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func keyVaultCertificateDataDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	vaultsClient := meta.(*clients.Client).KeyVault.VaultsClient
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	vault, err := vaultsClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(vault.Response) {
+			return fmt.Errorf("%s was not found", *keyVaultId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *keyVaultId, err)
+	}
+	if vault.Properties == nil || vault.Properties.VaultURI == nil {
+		return fmt.Errorf("retrieving %s: `properties.vaultUri` was nil", *keyVaultId)
+	}
+	vaultUri := *vault.Properties.VaultURI
+
+	cert, err := client.GetCertificate(ctx, vaultUri, name, version)
+	if err != nil {
+		if utils.ResponseWasNotFound(cert.Response) {
+			return fmt.Errorf("the Certificate %q was not found within %s", name, *keyVaultId)
+		}
+		return fmt.Errorf("retrieving the Certificate %q within %s: %+v", name, *keyVaultId, err)
+	}
+	if cert.ID == nil || *cert.ID == "" {
+		return fmt.Errorf("retrieving the Certificate %q within %s: `id` was nil", name, *keyVaultId)
+	}
+
+	// the ID is in the format `{vaultUri}/certificates/{name}/{version}`
+	certificateId := *cert.ID
+	version = certificateId[strings.LastIndex(certificateId, "/")+1:]
+
+	// the Private Key is only available from the Secret backing the Certificate, which has the same name and version
+	secret, err := client.GetSecret(ctx, vaultUri, name, version)
+	if err != nil {
+		return fmt.Errorf("retrieving the Secret backing the Certificate %q (Version %q) within %s - check that the Certificate's Private Key is exportable: %+v", name, version, *keyVaultId, err)
+	}
+	if secret.Value == nil {
+		return fmt.Errorf("retrieving the Secret backing the Certificate %q (Version %q) within %s: `value` was nil", name, version, *keyVaultId)
+	}
+
+	contentType := ""
+	if secret.ContentType != nil {
+		contentType = *secret.ContentType
+	}
+	certificates, privateKey, err := flattenKeyVaultCertificateData(contentType, *secret.Value)
+	if err != nil {
+		return fmt.Errorf("parsing the Certificate %q (Version %q) within %s: %+v", name, version, *keyVaultId, err)
+	}
+
+	d.SetId(certificateId)
+	d.Set("name", name)
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("version", version)
+	d.Set("pem", strings.Join(certificates, ""))
+	d.Set("key", privateKey)
+	d.Set("certificates_count", len(certificates))
+
+	certificateHex := ""
+	if cert.Cer != nil {
+		certificateHex = strings.ToUpper(hex.EncodeToString(*cert.Cer))
+	}
+	d.Set("hex", certificateHex)
+
+	expires := ""
+	notBefore := ""
+	if attributes := cert.Attributes; attributes != nil {
+		if attributes.Expires != nil {
+			expires = time.Time(*attributes.Expires).Format(time.RFC3339)
+		}
+		if attributes.NotBefore != nil {
+			notBefore = time.Time(*attributes.NotBefore).Format(time.RFC3339)
+		}
+	}
+	d.Set("expires", expires)
+	d.Set("not_before", notBefore)
+
+	return tags.FlattenAndSet(d, cert.Tags)
+}
+
+// flattenKeyVaultCertificateData returns the PEM encoded Certificates (the leaf Certificate followed by any intermediates)
+// and the PEM encoded (PKCS#8) Private Key from the value of the Secret backing a Key Vault Certificate
+func flattenKeyVaultCertificateData(contentType, value string) ([]string, string, error) {
+	var blocks []*pem.Block
+
+	switch strings.ToLower(contentType) {
+	case certificateContentTypePKCS12:
+		pfx, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, "", fmt.Errorf("decoding the PKCS#12 bundle: %+v", err)
+		}
+
+		// Key Vault stores the PKCS#12 bundle without a password
+		blocks, err = pkcs12.ToPEM(pfx, "")
+		if err != nil {
+			return nil, "", fmt.Errorf("converting the PKCS#12 bundle to PEM: %+v", err)
+		}
+
+	case certificateContentTypePEM:
+		rest := []byte(value)
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			blocks = append(blocks, block)
+		}
+
+	default:
+		return nil, "", fmt.Errorf("unsupported content type %q - expected %q or %q", contentType, certificateContentTypePKCS12, certificateContentTypePEM)
+	}
+
+	certificates := make([]string, 0)
+	privateKey := ""
+	for _, block := range blocks {
+		if block.Type == "CERTIFICATE" {
+			certificates = append(certificates, string(pem.EncodeToMemory(&pem.Block{
+				Type:  block.Type,
+				Bytes: block.Bytes,
+			})))
+			continue
+		}
+
+		if !strings.HasSuffix(block.Type, "PRIVATE KEY") || privateKey != "" {
+			continue
+		}
+
+		key, err := parseKeyVaultCertificatePrivateKey(block.Bytes)
+		if err != nil {
+			return nil, "", err
+		}
+		keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, "", fmt.Errorf("encoding the Private Key: %+v", err)
+		}
+		privateKey = string(pem.EncodeToMemory(&pem.Block{
+			Type:  "PRIVATE KEY",
+			Bytes: keyBytes,
+		}))
+	}
+
+	if len(certificates) == 0 {
+		return nil, "", fmt.Errorf("no certificates were found")
+	}
+	if privateKey == "" {
+		return nil, "", fmt.Errorf("no private key was found - check that the Certificate's Private Key is exportable")
+	}
+
+	return certificates, privateKey, nil
+}
+
+// parseKeyVaultCertificatePrivateKey parses a Private Key which (depending on the content type and key type) can be
+// encoded as PKCS#1, PKCS#8 or SEC 1
+func parseKeyVaultCertificatePrivateKey(der []byte) (interface{}, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("parsing the Private Key: unsupported key format")
+}
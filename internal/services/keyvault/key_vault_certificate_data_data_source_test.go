@@ -0,0 +1,52 @@
+package keyvault_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type KeyVaultCertificateDataDataSource struct{}
+
+// since Certificates can't be provisioned into a Key Vault using this provider, this test requires an existing
+// Certificate (with an exportable Private Key) to be specified using the environment variables below
+func TestAccKeyVaultCertificateDataDataSource_basic(t *testing.T) {
+	keyVaultId := os.Getenv("ARM_TEST_KEY_VAULT_ID")
+	certificateName := os.Getenv("ARM_TEST_KEY_VAULT_CERTIFICATE_NAME")
+	if keyVaultId == "" || certificateName == "" {
+		t.Skip("Skipping since `ARM_TEST_KEY_VAULT_ID` and `ARM_TEST_KEY_VAULT_CERTIFICATE_NAME` are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurestack_key_vault_certificate_data", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: KeyVaultCertificateDataDataSource{}.basic(keyVaultId, certificateName),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("version").Exists(),
+				check.That(data.ResourceName).Key("hex").Exists(),
+				check.That(data.ResourceName).Key("pem").Exists(),
+				check.That(data.ResourceName).Key("key").Exists(),
+				check.That(data.ResourceName).Key("certificates_count").Exists(),
+				check.That(data.ResourceName).Key("expires").Exists(),
+				check.That(data.ResourceName).Key("not_before").Exists(),
+			),
+		},
+	})
+}
+
+func (KeyVaultCertificateDataDataSource) basic(keyVaultId, name string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_key_vault_certificate_data" "test" {
+  name         = "%s"
+  key_vault_id = "%s"
+}
+`, name, keyVaultId)
+}
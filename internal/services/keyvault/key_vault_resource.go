@@ -0,0 +1,570 @@
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/keyvault/mgmt/keyvault"
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func keyVault() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: keyVaultCreate,
+		Read:   keyVaultRead,
+		Update: keyVaultUpdate,
+		Delete: keyVaultDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VaultID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VaultName,
+			},
+
+			"location": commonschema.Location(),
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(keyvault.Standard),
+					string(keyvault.Premium),
+				}, false),
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"access_policy": {
+				Type:       pluginsdk.TypeList,
+				ConfigMode: pluginsdk.SchemaConfigModeAttr,
+				Optional:   true,
+				Computed:   true,
+				MaxItems:   1024,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"tenant_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"object_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"application_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"certificate_permissions": keyVaultPermissionsSchema(keyVaultCertificatePermissions()),
+
+						"key_permissions": keyVaultPermissionsSchema(keyVaultKeyPermissions()),
+
+						"secret_permissions": keyVaultPermissionsSchema(keyVaultSecretPermissions()),
+					},
+				},
+			},
+
+			"enabled_for_deployment": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"enabled_for_disk_encryption": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"enabled_for_template_deployment": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"soft_delete_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"purge_protection_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"tags": tags.Schema(),
+
+			"vault_uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(keyVaultCustomizeDiff),
+	}
+}
+
+func keyVaultPermissionsSchema(permissions []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.StringInSlice(permissions, false),
+		},
+	}
+}
+
+// keyVaultCustomizeDiff validates the SKU against those supported by the Azure Stack Hub, since the `premium` SKU
+// (which uses HSM-backed keys) is only available on some Stamps
+func keyVaultCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("sku_name") {
+		return nil
+	}
+
+	return meta.(*clients.Client).Capabilities.ValidateKeyVaultSku("sku_name", d.Get("sku_name").(string))
+}
+
+func keyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.VaultsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewVaultID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	location := location.Normalize(d.Get("location").(string))
+
+	locks.ByName(id.Name, keyVaultResourceName)
+	defer locks.UnlockByName(id.Name, keyVaultResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurestack_key_vault", id.ID())
+	}
+
+	// a Key Vault which has been soft-deleted retains its name until it's purged, so when one exists we either
+	// recover it or tell the user how to proceed, since otherwise the creation fails with an unhelpful error
+	recoverSoftDeletedKeyVault := false
+	softDeleted, err := client.GetDeleted(ctx, id.Name, location)
+	if err != nil {
+		if !utils.ResponseWasNotFound(softDeleted.Response) {
+			return fmt.Errorf("checking for the presence of an existing Soft-Deleted Key Vault %q (Location %q): %+v", id.Name, location, err)
+		}
+	} else {
+		if !meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults {
+			return fmt.Errorf(`a Soft-Deleted Key Vault %q exists in the Location %q - this needs to either be recovered or purged before a Key Vault with the same name can be created.
+
+This can be done automatically by enabling the 'recover_soft_deleted_key_vaults' option within the 'key_vault' block of the 'features' block of the Provider, or by purging the Key Vault manually`, id.Name, location)
+		}
+
+		log.Printf("[DEBUG] Recovering the Soft-Deleted Key Vault %q (Location %q)..", id.Name, location)
+		recoverSoftDeletedKeyVault = true
+	}
+
+	tenantId, err := uuid.FromString(d.Get("tenant_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `tenant_id`: %+v", err)
+	}
+
+	accessPolicies, err := expandKeyVaultAccessPolicies(d.Get("access_policy").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `access_policy`: %+v", err)
+	}
+
+	parameters := keyvault.VaultCreateOrUpdateParameters{
+		Location: pointer.FromString(location),
+		Properties: &keyvault.VaultProperties{
+			TenantID: &tenantId,
+			Sku: &keyvault.Sku{
+				Family: pointer.FromString("A"),
+				Name:   keyvault.SkuName(d.Get("sku_name").(string)),
+			},
+			AccessPolicies:               accessPolicies,
+			EnabledForDeployment:         utils.Bool(d.Get("enabled_for_deployment").(bool)),
+			EnabledForDiskEncryption:     utils.Bool(d.Get("enabled_for_disk_encryption").(bool)),
+			EnabledForTemplateDeployment: utils.Bool(d.Get("enabled_for_template_deployment").(bool)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	// these can only be enabled (and can't be disabled once they are), so are only sent when enabled
+	if d.Get("soft_delete_enabled").(bool) {
+		parameters.Properties.EnableSoftDelete = utils.Bool(true)
+	}
+	if d.Get("purge_protection_enabled").(bool) {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(true)
+	}
+
+	if recoverSoftDeletedKeyVault {
+		parameters.Properties.CreateMode = keyvault.CreateModeRecover
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return keyVaultRead(d, meta)
+}
+
+func keyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.VaultsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VaultID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, keyVaultResourceName)
+	defer locks.UnlockByName(id.Name, keyVaultResourceName)
+
+	if d.HasChange("soft_delete_enabled") && !d.Get("soft_delete_enabled").(bool) {
+		return fmt.Errorf("once Soft Delete has been enabled it's not possible to disable it")
+	}
+	if d.HasChange("purge_protection_enabled") && !d.Get("purge_protection_enabled").(bool) {
+		return fmt.Errorf("once Purge Protection has been enabled it's not possible to disable it")
+	}
+
+	tenantId, err := uuid.FromString(d.Get("tenant_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `tenant_id`: %+v", err)
+	}
+
+	accessPolicies, err := expandKeyVaultAccessPolicies(d.Get("access_policy").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `access_policy`: %+v", err)
+	}
+
+	parameters := keyvault.VaultPatchParameters{
+		Properties: &keyvault.VaultPatchProperties{
+			TenantID: &tenantId,
+			Sku: &keyvault.Sku{
+				Family: pointer.FromString("A"),
+				Name:   keyvault.SkuName(d.Get("sku_name").(string)),
+			},
+			AccessPolicies:               accessPolicies,
+			EnabledForDeployment:         utils.Bool(d.Get("enabled_for_deployment").(bool)),
+			EnabledForDiskEncryption:     utils.Bool(d.Get("enabled_for_disk_encryption").(bool)),
+			EnabledForTemplateDeployment: utils.Bool(d.Get("enabled_for_template_deployment").(bool)),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if d.Get("soft_delete_enabled").(bool) {
+		parameters.Properties.EnableSoftDelete = utils.Bool(true)
+	}
+	if d.Get("purge_protection_enabled").(bool) {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(true)
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return keyVaultRead(d, meta)
+}
+
+func keyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.VaultsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VaultID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.Properties; props != nil {
+		tenantId := ""
+		if props.TenantID != nil {
+			tenantId = props.TenantID.String()
+		}
+		d.Set("tenant_id", tenantId)
+
+		skuName := ""
+		if props.Sku != nil {
+			skuName = string(props.Sku.Name)
+		}
+		d.Set("sku_name", skuName)
+
+		d.Set("enabled_for_deployment", props.EnabledForDeployment)
+		d.Set("enabled_for_disk_encryption", props.EnabledForDiskEncryption)
+		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
+		d.Set("soft_delete_enabled", props.EnableSoftDelete)
+		d.Set("purge_protection_enabled", props.EnablePurgeProtection)
+		d.Set("vault_uri", props.VaultURI)
+
+		if err := d.Set("access_policy", flattenKeyVaultAccessPolicies(props.AccessPolicies)); err != nil {
+			return fmt.Errorf("setting `access_policy`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func keyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.VaultsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VaultID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, keyVaultResourceName)
+	defer locks.UnlockByName(id.Name, keyVaultResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *id)
+	}
+	location := location.Normalize(*existing.Location)
+
+	softDeleteEnabled := false
+	purgeProtectionEnabled := false
+	if props := existing.Properties; props != nil {
+		softDeleteEnabled = props.EnableSoftDelete != nil && *props.EnableSoftDelete
+		purgeProtectionEnabled = props.EnablePurgeProtection != nil && *props.EnablePurgeProtection
+	}
+
+	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	if !softDeleteEnabled || !meta.(*clients.Client).Features.KeyVault.PurgeSoftDeleteOnDestroy {
+		return nil
+	}
+
+	if purgeProtectionEnabled {
+		log.Printf("[DEBUG] Purge Protection is enabled for %s - skipping purging the Soft-Deleted Key Vault", *id)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Purging the Soft-Deleted %s (Location %q)..", *id, location)
+	future, err := client.PurgeDeleted(ctx, id.Name, location)
+	if err != nil {
+		return fmt.Errorf("purging the Soft-Deleted %s (Location %q): %+v", *id, location, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the Soft-Deleted %s (Location %q) to be purged: %+v", *id, location, err)
+	}
+
+	return nil
+}
+
+func expandKeyVaultAccessPolicies(input []interface{}) (*[]keyvault.AccessPolicyEntry, error) {
+	output := make([]keyvault.AccessPolicyEntry, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		tenantId, err := uuid.FromString(v["tenant_id"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `tenant_id`: %+v", err)
+		}
+
+		policy := keyvault.AccessPolicyEntry{
+			TenantID: &tenantId,
+			ObjectID: pointer.FromString(v["object_id"].(string)),
+			Permissions: &keyvault.Permissions{
+				Certificates: expandKeyVaultCertificatePermissions(v["certificate_permissions"].([]interface{})),
+				Keys:         expandKeyVaultKeyPermissions(v["key_permissions"].([]interface{})),
+				Secrets:      expandKeyVaultSecretPermissions(v["secret_permissions"].([]interface{})),
+			},
+		}
+
+		if applicationIdRaw := v["application_id"].(string); applicationIdRaw != "" {
+			applicationId, err := uuid.FromString(applicationIdRaw)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `application_id`: %+v", err)
+			}
+			policy.ApplicationID = &applicationId
+		}
+
+		output = append(output, policy)
+	}
+
+	return &output, nil
+}
+
+func flattenKeyVaultAccessPolicies(input *[]keyvault.AccessPolicyEntry) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, policy := range *input {
+		tenantId := ""
+		if policy.TenantID != nil {
+			tenantId = policy.TenantID.String()
+		}
+
+		objectId := ""
+		if policy.ObjectID != nil {
+			objectId = *policy.ObjectID
+		}
+
+		applicationId := ""
+		if policy.ApplicationID != nil {
+			applicationId = policy.ApplicationID.String()
+		}
+
+		certificatePermissions := make([]interface{}, 0)
+		keyPermissions := make([]interface{}, 0)
+		secretPermissions := make([]interface{}, 0)
+		if permissions := policy.Permissions; permissions != nil {
+			if permissions.Certificates != nil {
+				for _, v := range *permissions.Certificates {
+					certificatePermissions = append(certificatePermissions, string(v))
+				}
+			}
+			if permissions.Keys != nil {
+				for _, v := range *permissions.Keys {
+					keyPermissions = append(keyPermissions, string(v))
+				}
+			}
+			if permissions.Secrets != nil {
+				for _, v := range *permissions.Secrets {
+					secretPermissions = append(secretPermissions, string(v))
+				}
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"tenant_id":               tenantId,
+			"object_id":               objectId,
+			"application_id":          applicationId,
+			"certificate_permissions": certificatePermissions,
+			"key_permissions":         keyPermissions,
+			"secret_permissions":      secretPermissions,
+		})
+	}
+
+	return output
+}
+
+func expandKeyVaultCertificatePermissions(input []interface{}) *[]keyvault.CertificatePermissions {
+	output := make([]keyvault.CertificatePermissions, 0)
+	for _, v := range input {
+		output = append(output, keyvault.CertificatePermissions(v.(string)))
+	}
+	return &output
+}
+
+func expandKeyVaultKeyPermissions(input []interface{}) *[]keyvault.KeyPermissions {
+	output := make([]keyvault.KeyPermissions, 0)
+	for _, v := range input {
+		output = append(output, keyvault.KeyPermissions(v.(string)))
+	}
+	return &output
+}
+
+func expandKeyVaultSecretPermissions(input []interface{}) *[]keyvault.SecretPermissions {
+	output := make([]keyvault.SecretPermissions, 0)
+	for _, v := range input {
+		output = append(output, keyvault.SecretPermissions(v.(string)))
+	}
+	return &output
+}
+
+func keyVaultCertificatePermissions() []string {
+	output := make([]string, 0)
+	for _, v := range keyvault.PossibleCertificatePermissionsValues() {
+		output = append(output, string(v))
+	}
+	return output
+}
+
+func keyVaultKeyPermissions() []string {
+	output := make([]string, 0)
+	for _, v := range keyvault.PossibleKeyPermissionsValues() {
+		output = append(output, string(v))
+	}
+	return output
+}
+
+func keyVaultSecretPermissions() []string {
+	output := make([]string, 0)
+	for _, v := range keyvault.PossibleSecretPermissionsValues() {
+		output = append(output, string(v))
+	}
+	return output
+}
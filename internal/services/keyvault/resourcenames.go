@@ -0,0 +1,3 @@
+package keyvault
+
+var keyVaultResourceName = "azurestack_key_vault"
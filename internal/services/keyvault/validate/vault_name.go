@@ -0,0 +1,24 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func VaultName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]{1,22}[a-zA-Z0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 24 characters, can only contain alphanumeric characters and hyphens, must start with a letter and cannot end with a hyphen", k))
+	}
+
+	if regexp.MustCompile(`--`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot contain consecutive hyphens", k))
+	}
+
+	return warnings, errors
+}
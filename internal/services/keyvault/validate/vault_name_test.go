@@ -0,0 +1,35 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVaultName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{Value: "", Valid: false},
+		{Value: "ab", Valid: false},
+		{Value: "abc", Valid: true},
+		{Value: "1vault", Valid: false},
+		{Value: "-vault", Valid: false},
+		{Value: "vault-", Valid: false},
+		{Value: "key--vault", Valid: false},
+		{Value: "key_vault", Valid: false},
+		{Value: "acctest-KV-1", Valid: true},
+		{Value: strings.Repeat("a", 24), Valid: true},
+		{Value: strings.Repeat("a", 25), Valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Value)
+		_, errors := VaultName(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package loadbalancer
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -118,35 +119,27 @@ func loadBalancerBackendAddressPoolCreateUpdate(d *pluginsdk.ResourceData, meta
 	locks.ByID(loadBalancerId.ID())
 	defer locks.UnlockByID(loadBalancerId.ID())
 
-	lb, err := lbClient.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(lb.Response) {
-			return fmt.Errorf("Load Balancer %q for Backend Address Pool %q was not found", loadBalancerId, id)
+	err = updateLoadBalancer(ctx, meta.(*clients.Client).Network.RetryOnConflict, lbClient, *loadBalancerId, func(lb *network.LoadBalancer) (bool, error) {
+		param := network.BackendAddressPool{
+			Name: &id.BackendAddressPoolName,
 		}
-		return fmt.Errorf("failed to retrieve Load Balancer %q for Backend Address Pool %q: %+v", loadBalancerId, id, err)
-	}
 
-	param := network.BackendAddressPool{
-		Name: &id.BackendAddressPoolName,
-	}
-
-	// Insert this BAP and update the LB since the dedicated BAP endpoint doesn't work for the Basic sku.
-	backendAddressPools := append(*lb.LoadBalancerPropertiesFormat.BackendAddressPools, param)
-	_, existingPoolIndex, exists := FindLoadBalancerBackEndAddressPoolByName(&lb, id.BackendAddressPoolName)
-	if exists {
-		// this pool is being updated/reapplied remove the old copy from the slice
-		backendAddressPools = append(backendAddressPools[:existingPoolIndex], backendAddressPools[existingPoolIndex+1:]...)
-	}
-
-	lb.LoadBalancerPropertiesFormat.BackendAddressPools = &backendAddressPools
+		// Insert this BAP and update the LB since the dedicated BAP endpoint doesn't work for the Basic sku.
+		backendAddressPools := append(*lb.LoadBalancerPropertiesFormat.BackendAddressPools, param)
+		_, existingPoolIndex, exists := FindLoadBalancerBackEndAddressPoolByName(lb, id.BackendAddressPoolName)
+		if exists {
+			// this pool is being updated/reapplied remove the old copy from the slice
+			backendAddressPools = append(backendAddressPools[:existingPoolIndex], backendAddressPools[existingPoolIndex+1:]...)
+		}
 
-	future, err := lbClient.CreateOrUpdate(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, lb)
+		lb.LoadBalancerPropertiesFormat.BackendAddressPools = &backendAddressPools
+		return true, nil
+	})
 	if err != nil {
-		return fmt.Errorf("updating Load Balancer %q for Backend Address Pool %q: %+v", loadBalancerId, id, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, lbClient.Client); err != nil {
-		return fmt.Errorf("waiting for update of Load Balancer %q for Backend Address Pool %q: %+v", loadBalancerId, id, err)
+		if errors.Is(err, errLoadBalancerNotFound) {
+			return fmt.Errorf("Load Balancer %q for Backend Address Pool %q was not found", loadBalancerId, id)
+		}
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
@@ -229,31 +222,23 @@ func loadBalancerBackendAddressPoolDelete(d *pluginsdk.ResourceData, meta interf
 	locks.ByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
 	defer locks.UnlockByName(id.BackendAddressPoolName, backendAddressPoolResourceName)
 
-	lb, err := lbClient.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
+	err = updateLoadBalancer(ctx, meta.(*clients.Client).Network.RetryOnConflict, lbClient, loadBalancerId, func(lb *network.LoadBalancer) (bool, error) {
+		_, index, exists := FindLoadBalancerBackEndAddressPoolByName(lb, id.BackendAddressPoolName)
+		if !exists {
+			return false, nil
+		}
+
+		backEndPools := *lb.LoadBalancerPropertiesFormat.BackendAddressPools
+		backEndPools = append(backEndPools[:index], backEndPools[index+1:]...)
+		lb.LoadBalancerPropertiesFormat.BackendAddressPools = &backEndPools
+		return true, nil
+	})
 	if err != nil {
-		if utils.ResponseWasNotFound(lb.Response) {
+		if errors.Is(err, errLoadBalancerNotFound) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Backend Address Pool %q: %+v", loadBalancerId.Name, loadBalancerId.ResourceGroup, id.BackendAddressPoolName, err)
-	}
-
-	_, index, exists := FindLoadBalancerBackEndAddressPoolByName(&lb, id.BackendAddressPoolName)
-	if !exists {
-		return nil
-	}
-
-	backEndPools := *lb.LoadBalancerPropertiesFormat.BackendAddressPools
-	backEndPools = append(backEndPools[:index], backEndPools[index+1:]...)
-	lb.LoadBalancerPropertiesFormat.BackendAddressPools = &backEndPools
-
-	future, err := lbClient.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, lb)
-	if err != nil {
-		return fmt.Errorf("updating Load Balancer %q (resource group %q) to remove Backend Address Pool %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.BackendAddressPoolName, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, lbClient.Client); err != nil {
-		return fmt.Errorf("waiting for update of Load Balancer %q (resource group %q) for Backend Address Pool %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.BackendAddressPoolName, err)
+		return fmt.Errorf("removing %s from the Load Balancer: %+v", id, err)
 	}
 
 	return nil
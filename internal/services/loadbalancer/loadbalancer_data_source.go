@@ -36,6 +36,11 @@ func loadBalancerDataSource() *pluginsdk.Resource {
 
 			"location": commonschema.LocationComputed(),
 
+			"sku": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"frontend_ip_configuration": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -66,7 +71,7 @@ func loadBalancerDataSource() *pluginsdk.Resource {
 							Computed: true,
 						},
 
-						"zones": zones.SchemaZoneComputed(),
+						"zones": zones.SchemaComputed(),
 
 						"id": {
 							Type:     pluginsdk.TypeString,
@@ -113,6 +118,12 @@ func dataSourceArmLoadBalancerRead(d *pluginsdk.ResourceData, meta interface{})
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
+	sku := string(network.LoadBalancerSkuNameBasic)
+	if resp.Sku != nil && resp.Sku.Name != "" {
+		sku = string(resp.Sku.Name)
+	}
+	d.Set("sku", sku)
+
 	privateIpAddress := ""
 	privateIpAddresses := make([]string, 0)
 	if props := resp.LoadBalancerPropertiesFormat; props != nil {
@@ -1,6 +1,7 @@
 package loadbalancer
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -123,43 +124,36 @@ func loadBalancerNatPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	locks.ByID(loadBalancerID)
 	defer locks.UnlockByID(loadBalancerID)
 
-	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(loadBalancer.Response) {
-			d.SetId("")
-			log.Printf("[INFO] Load Balancer %q not found. Removing from state", id.LoadBalancerName)
-			return nil
+	err = updateLoadBalancer(ctx, meta.(*clients.Client).Network.RetryOnConflict, client, *loadBalancerId, func(loadBalancer *network.LoadBalancer) (bool, error) {
+		newNatPool, err := expandazurestackLoadBalancerNatPool(d, loadBalancer)
+		if err != nil {
+			return false, fmt.Errorf("expanding NAT Pool: %+v", err)
 		}
-		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Nat Pool %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.InboundNatPoolName, err)
-	}
-	newNatPool, err := expandazurestackLoadBalancerNatPool(d, &loadBalancer)
-	if err != nil {
-		return fmt.Errorf("expanding NAT Pool: %+v", err)
-	}
 
-	natPools := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools, *newNatPool)
+		natPools := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools, *newNatPool)
 
-	existingNatPool, existingNatPoolIndex, exists := FindLoadBalancerNatPoolByName(&loadBalancer, id.InboundNatPoolName)
-	if exists {
-		if id.InboundNatPoolName == *existingNatPool.Name {
-			if d.IsNewResource() {
-				return tf.ImportAsExistsError("azurestack_lb_nat_pool", *existingNatPool.ID)
-			}
+		existingNatPool, existingNatPoolIndex, exists := FindLoadBalancerNatPoolByName(loadBalancer, id.InboundNatPoolName)
+		if exists {
+			if id.InboundNatPoolName == *existingNatPool.Name {
+				if d.IsNewResource() {
+					return false, tf.ImportAsExistsError("azurestack_lb_nat_pool", *existingNatPool.ID)
+				}
 
-			// this pool is being updated/reapplied remove old copy from the slice
-			natPools = append(natPools[:existingNatPoolIndex], natPools[existingNatPoolIndex+1:]...)
+				// this pool is being updated/reapplied remove old copy from the slice
+				natPools = append(natPools[:existingNatPoolIndex], natPools[existingNatPoolIndex+1:]...)
+			}
 		}
-	}
-
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools = &natPools
 
-	future, err := client.CreateOrUpdate(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, loadBalancer)
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools = &natPools
+		return true, nil
+	})
 	if err != nil {
-		return fmt.Errorf("updating Load Balancer %q (Resource Group %q) for Nat Pool %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.InboundNatPoolName, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the update of Load Balancer %q (Resource Group %q) for Nat Pool %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.InboundNatPoolName, err)
+		if errors.Is(err, errLoadBalancerNotFound) {
+			d.SetId("")
+			log.Printf("[INFO] Load Balancer %q not found. Removing from state", id.LoadBalancerName)
+			return nil
+		}
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
@@ -196,6 +190,7 @@ func loadBalancerNatPoolRead(d *pluginsdk.ResourceData, meta interface{}) error
 
 	d.Set("name", config.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("loadbalancer_id", parse.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName).ID())
 
 	if props := config.InboundNatPoolPropertiesFormat; props != nil {
 		backendPort := 0
@@ -250,31 +245,23 @@ func loadBalancerNatPoolDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 	locks.ByID(loadBalancerID)
 	defer locks.UnlockByID(loadBalancerID)
 
-	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
+	err = updateLoadBalancer(ctx, meta.(*clients.Client).Network.RetryOnConflict, client, loadBalancerId, func(loadBalancer *network.LoadBalancer) (bool, error) {
+		_, index, exists := FindLoadBalancerNatPoolByName(loadBalancer, id.InboundNatPoolName)
+		if !exists {
+			return false, nil
+		}
+
+		natPools := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools
+		natPools = append(natPools[:index], natPools[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools = &natPools
+		return true, nil
+	})
 	if err != nil {
-		if utils.ResponseWasNotFound(loadBalancer.Response) {
+		if errors.Is(err, errLoadBalancerNotFound) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for deletion of Nat Pool %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.InboundNatPoolName, err)
-	}
-
-	_, index, exists := FindLoadBalancerNatPoolByName(&loadBalancer, id.InboundNatPoolName)
-	if !exists {
-		return nil
-	}
-
-	natPools := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools
-	natPools = append(natPools[:index], natPools[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatPools = &natPools
-
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, loadBalancer)
-	if err != nil {
-		return fmt.Errorf("updating Load Balancer %q (Resource Group %q) for Nat Pool %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.InboundNatPoolName, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of the Load Balancer %q (Resource Group %q) for Nat Pool %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.InboundNatPoolName, err)
+		return fmt.Errorf("removing %s from the Load Balancer: %+v", id, err)
 	}
 
 	return nil
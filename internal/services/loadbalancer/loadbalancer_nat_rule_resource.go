@@ -1,6 +1,7 @@
 package loadbalancer
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -95,7 +96,14 @@ func loadBalancerNatRule() *pluginsdk.Resource {
 			"enable_floating_ip": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				Computed: true,
+				Default:  false,
+			},
+
+			// TCP Reset is only supported by Standard Load Balancers, which is checked when the rule is applied
+			"enable_tcp_reset": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"idle_timeout_in_minutes": {
@@ -134,44 +142,36 @@ func loadBalancerNatRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	locks.ByID(loadBalancerIdRaw)
 	defer locks.UnlockByID(loadBalancerIdRaw)
 
-	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(loadBalancer.Response) {
-			d.SetId("")
-			log.Printf("[INFO] Load Balancer %q not found. Removing from state", id.LoadBalancerName)
-			return nil
+	err = updateLoadBalancer(ctx, meta.(*clients.Client).Network.RetryOnConflict, client, *loadBalancerId, func(loadBalancer *network.LoadBalancer) (bool, error) {
+		newNatRule, err := expandazurestackLoadBalancerNatRule(d, loadBalancer, *loadBalancerId)
+		if err != nil {
+			return false, fmt.Errorf("expanding NAT Rule: %+v", err)
 		}
-		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Nat Rule %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.InboundNatRuleName, err)
-	}
 
-	newNatRule, err := expandazurestackLoadBalancerNatRule(d, &loadBalancer, *loadBalancerId)
-	if err != nil {
-		return fmt.Errorf("expanding NAT Rule: %+v", err)
-	}
+		natRules := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules, *newNatRule)
 
-	natRules := append(*loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules, *newNatRule)
+		existingNatRule, existingNatRuleIndex, exists := FindLoadBalancerNatRuleByName(loadBalancer, id.InboundNatRuleName)
+		if exists {
+			if id.InboundNatRuleName == *existingNatRule.Name {
+				if d.IsNewResource() {
+					return false, tf.ImportAsExistsError("azurestack_lb_nat_rule", *existingNatRule.ID)
+				}
 
-	existingNatRule, existingNatRuleIndex, exists := FindLoadBalancerNatRuleByName(&loadBalancer, id.InboundNatRuleName)
-	if exists {
-		if id.InboundNatRuleName == *existingNatRule.Name {
-			if d.IsNewResource() {
-				return tf.ImportAsExistsError("azurestack_lb_nat_rule", *existingNatRule.ID)
+				// this nat rule is being updated/reapplied remove old copy from the slice
+				natRules = append(natRules[:existingNatRuleIndex], natRules[existingNatRuleIndex+1:]...)
 			}
-
-			// this nat rule is being updated/reapplied remove old copy from the slice
-			natRules = append(natRules[:existingNatRuleIndex], natRules[existingNatRuleIndex+1:]...)
 		}
-	}
-
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &natRules
 
-	future, err := client.CreateOrUpdate(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, loadBalancer)
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &natRules
+		return true, nil
+	})
 	if err != nil {
-		return fmt.Errorf("updating Load Balancer %q (Resource Group %q) for Nat Rule %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.InboundNatRuleName, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of Load Balancer %q (Resource Group %q) for Nat Rule %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.InboundNatRuleName, err)
+		if errors.Is(err, errLoadBalancerNotFound) {
+			d.SetId("")
+			log.Printf("[INFO] Load Balancer %q not found. Removing from state", id.LoadBalancerName)
+			return nil
+		}
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
@@ -208,6 +208,7 @@ func loadBalancerNatRuleRead(d *pluginsdk.ResourceData, meta interface{}) error
 
 	d.Set("name", config.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("loadbalancer_id", parse.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName).ID())
 
 	if props := config.InboundNatRulePropertiesFormat; props != nil {
 		backendIPConfigId := ""
@@ -222,6 +223,7 @@ func loadBalancerNatRuleRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 		d.Set("backend_port", backendPort)
 		d.Set("enable_floating_ip", props.EnableFloatingIP)
+		d.Set("enable_tcp_reset", props.EnableTCPReset)
 
 		frontendIPConfigName := ""
 		frontendIPConfigID := ""
@@ -269,30 +271,23 @@ func loadBalancerNatRuleDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 	locks.ByID(loadBalancerID)
 	defer locks.UnlockByID(loadBalancerID)
 
-	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
+	err = updateLoadBalancer(ctx, meta.(*clients.Client).Network.RetryOnConflict, client, loadBalancerId, func(loadBalancer *network.LoadBalancer) (bool, error) {
+		_, index, exists := FindLoadBalancerNatRuleByName(loadBalancer, id.InboundNatRuleName)
+		if !exists {
+			return false, nil
+		}
+
+		natRules := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules
+		natRules = append(natRules[:index], natRules[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &natRules
+		return true, nil
+	})
 	if err != nil {
-		if utils.ResponseWasNotFound(loadBalancer.Response) {
+		if errors.Is(err, errLoadBalancerNotFound) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Nat Rule %q: %+v", loadBalancerId.Name, loadBalancerId.ResourceGroup, id.InboundNatRuleName, err)
-	}
-	_, index, exists := FindLoadBalancerNatRuleByName(&loadBalancer, id.InboundNatRuleName)
-	if !exists {
-		return nil
-	}
-
-	natRules := *loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules
-	natRules = append(natRules[:index], natRules[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.InboundNatRules = &natRules
-
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Creating/Updating Load Balancer %q (Resource Group %q) %+v", id.LoadBalancerName, id.ResourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the completion of Load Balancer updates for %q (Resource Group %q) %+v", id.LoadBalancerName, id.ResourceGroup, err)
+		return fmt.Errorf("removing %s from the Load Balancer: %+v", id, err)
 	}
 
 	return nil
@@ -300,13 +295,21 @@ func loadBalancerNatRuleDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 
 func expandazurestackLoadBalancerNatRule(d *pluginsdk.ResourceData, lb *network.LoadBalancer, loadBalancerId parse.LoadBalancerId) (*network.InboundNatRule, error) {
 	properties := network.InboundNatRulePropertiesFormat{
-		Protocol:     network.TransportProtocol(d.Get("protocol").(string)),
-		FrontendPort: utils.Int32(int32(d.Get("frontend_port").(int))),
-		BackendPort:  utils.Int32(int32(d.Get("backend_port").(int))),
+		Protocol:         network.TransportProtocol(d.Get("protocol").(string)),
+		FrontendPort:     utils.Int32(int32(d.Get("frontend_port").(int))),
+		BackendPort:      utils.Int32(int32(d.Get("backend_port").(int))),
+		EnableFloatingIP: pointer.FromBool(d.Get("enable_floating_ip").(bool)),
 	}
 
-	if v, ok := d.GetOk("enable_floating_ip"); ok {
-		properties.EnableFloatingIP = pointer.FromBool(v.(bool))
+	if d.Get("enable_tcp_reset").(bool) {
+		if lb.Sku == nil || lb.Sku.Name != network.LoadBalancerSkuNameStandard {
+			return nil, fmt.Errorf("`enable_tcp_reset` is only supported for Load Balancers using the `Standard` SKU")
+		}
+		if properties.Protocol != network.TransportProtocolTCP {
+			return nil, fmt.Errorf("`enable_tcp_reset` can only be enabled when `protocol` is `Tcp`")
+		}
+
+		properties.EnableTCPReset = pointer.FromBool(true)
 	}
 
 	if v, ok := d.GetOk("idle_timeout_in_minutes"); ok {
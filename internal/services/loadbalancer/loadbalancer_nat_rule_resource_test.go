@@ -3,6 +3,7 @@ package loadbalancer_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
@@ -76,6 +77,18 @@ func TestAccLoadBalancerNatRule_update(t *testing.T) {
 	})
 }
 
+func TestAccLoadBalancerNatRule_tcpResetRequiresStandardSku(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_lb_nat_rule", "test")
+	r := LoadBalancerNatRule{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.tcpReset(data, "Basic"),
+			ExpectError: regexp.MustCompile("`enable_tcp_reset` is only supported for Load Balancers using the `Standard` SKU"),
+		},
+	})
+}
+
 func TestAccLoadBalancerNatRule_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_lb_nat_rule", "test")
 	r := LoadBalancerNatRule{}
@@ -275,6 +288,26 @@ resource "azurestack_lb_nat_rule" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r LoadBalancerNatRule) tcpReset(data acceptance.TestData, sku string) string {
+	template := r.template(data, sku)
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_lb_nat_rule" "test" {
+  name                = "NatRule-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  loadbalancer_id     = azurestack_lb.test.id
+
+  protocol         = "Tcp"
+  frontend_port    = 3389
+  backend_port     = 3389
+  enable_tcp_reset = true
+
+  frontend_ip_configuration_name = azurestack_lb.test.frontend_ip_configuration.0.name
+}
+`, template, data.RandomInteger)
+}
+
 func (r LoadBalancerNatRule) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data, "Basic")
 	return fmt.Sprintf(`
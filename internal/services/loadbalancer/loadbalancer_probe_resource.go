@@ -1,6 +1,7 @@
 package loadbalancer
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -124,40 +125,32 @@ func loadBalancerProbeCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 	locks.ByID(loadBalancerIDRaw)
 	defer locks.UnlockByID(loadBalancerIDRaw)
 
-	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(loadBalancer.Response) {
-			d.SetId("")
-			log.Printf("[INFO] Load Balancer %q not found. Removing Proe %q from state", id.LoadBalancerName, id.ProbeName)
-			return nil
-		}
-		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Probe %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.ProbeName, err)
-	}
+	err = updateLoadBalancer(ctx, meta.(*clients.Client).Network.RetryOnConflict, client, *loadBalancerId, func(loadBalancer *network.LoadBalancer) (bool, error) {
+		newProbe := expandazurestackLoadBalancerProbe(d)
+		probes := append(*loadBalancer.LoadBalancerPropertiesFormat.Probes, *newProbe)
 
-	newProbe := expandazurestackLoadBalancerProbe(d)
-	probes := append(*loadBalancer.LoadBalancerPropertiesFormat.Probes, *newProbe)
+		existingProbe, existingProbeIndex, exists := FindLoadBalancerProbeByName(loadBalancer, id.ProbeName)
+		if exists {
+			if id.ProbeName == *existingProbe.Name {
+				if d.IsNewResource() {
+					return false, tf.ImportAsExistsError("azurestack_lb_probe", *existingProbe.ID)
+				}
 
-	existingProbe, existingProbeIndex, exists := FindLoadBalancerProbeByName(&loadBalancer, id.ProbeName)
-	if exists {
-		if id.ProbeName == *existingProbe.Name {
-			if d.IsNewResource() {
-				return tf.ImportAsExistsError("azurestack_lb_probe", *existingProbe.ID)
+				// this probe is being updated/reapplied remove old copy from the slice
+				probes = append(probes[:existingProbeIndex], probes[existingProbeIndex+1:]...)
 			}
-
-			// this probe is being updated/reapplied remove old copy from the slice
-			probes = append(probes[:existingProbeIndex], probes[existingProbeIndex+1:]...)
 		}
-	}
-
-	loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
 
-	future, err := client.CreateOrUpdate(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, loadBalancer)
+		loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
+		return true, nil
+	})
 	if err != nil {
-		return fmt.Errorf("updating Load Balancer %q (Resource Group %q) for Probe %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.ProbeName, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of Load Balancer %q (Resource Group %q) for Probe %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.ProbeName, err)
+		if errors.Is(err, errLoadBalancerNotFound) {
+			d.SetId("")
+			log.Printf("[INFO] Load Balancer %q not found. Removing Proe %q from state", id.LoadBalancerName, id.ProbeName)
+			return nil
+		}
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
@@ -194,6 +187,7 @@ func loadBalancerProbeRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	d.Set("name", config.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("loadbalancer_id", parse.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName).ID())
 
 	if props := config.ProbePropertiesFormat; props != nil {
 		intervalInSeconds := 0
@@ -248,31 +242,23 @@ func loadBalancerProbeDelete(d *pluginsdk.ResourceData, meta interface{}) error
 	locks.ByID(loadBalancerID)
 	defer locks.UnlockByID(loadBalancerID)
 
-	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
+	err = updateLoadBalancer(ctx, meta.(*clients.Client).Network.RetryOnConflict, client, loadBalancerId, func(loadBalancer *network.LoadBalancer) (bool, error) {
+		_, index, exists := FindLoadBalancerProbeByName(loadBalancer, id.ProbeName)
+		if !exists {
+			return false, nil
+		}
+
+		probes := *loadBalancer.LoadBalancerPropertiesFormat.Probes
+		probes = append(probes[:index], probes[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
+		return true, nil
+	})
 	if err != nil {
-		if utils.ResponseWasNotFound(loadBalancer.Response) {
+		if errors.Is(err, errLoadBalancerNotFound) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Probe %q: %+v", loadBalancerId.Name, loadBalancerId.ResourceGroup, id.ProbeName, err)
-	}
-
-	_, index, exists := FindLoadBalancerProbeByName(&loadBalancer, id.ProbeName)
-	if !exists {
-		return nil
-	}
-
-	probes := *loadBalancer.LoadBalancerPropertiesFormat.Probes
-	probes = append(probes[:index], probes[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
-
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, loadBalancer)
-	if err != nil {
-		return fmt.Errorf("updating Load Balancer %q (Resource Group %q) for deletion of Probe %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.ProbeName, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of Load Balancer %q (Resource Group %q) for deletion of Probe %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.ProbeName, err)
+		return fmt.Errorf("removing %s from the Load Balancer: %+v", id, err)
 	}
 
 	return nil
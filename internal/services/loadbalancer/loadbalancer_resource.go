@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
@@ -11,10 +12,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/zones"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/state"
@@ -53,6 +56,18 @@ func loadBalancer() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 
+			"sku": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(network.LoadBalancerSkuNameBasic),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.LoadBalancerSkuNameBasic),
+					string(network.LoadBalancerSkuNameStandard),
+				}, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
 			"frontend_ip_configuration": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -69,7 +84,7 @@ func loadBalancer() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: resourceid.ValidateResourceIDOrEmpty,
+							ValidateFunc: validation.Any(validation.StringIsEmpty, networkValidate.SubnetID),
 						},
 
 						"private_ip_address": {
@@ -86,7 +101,7 @@ func loadBalancer() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: resourceid.ValidateResourceIDOrEmpty,
+							ValidateFunc: validation.Any(validation.StringIsEmpty, networkValidate.PublicIpAddressID),
 						},
 
 						"private_ip_address_allocation": {
@@ -101,6 +116,8 @@ func loadBalancer() *pluginsdk.Resource {
 							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
+						"zones": zones.SchemaZones(),
+
 						"load_balancer_rules": {
 							Type:     pluginsdk.TypeSet,
 							Computed: true,
@@ -144,21 +161,84 @@ func loadBalancer() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
-			if ok := d.HasChange("frontend_ip_configuration"); ok {
-				configs := d.Get("frontend_ip_configuration").([]interface{})
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(loadBalancerCustomizeDiff),
+	}
+}
+
+func loadBalancerCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	resourceTypes := meta.(*clients.Client).Capabilities
 
-				for index := range configs {
-					// TODO - Remove in 3.0
-					if d.HasChange(fmt.Sprintf("frontend_ip_configuration.%d.zones", index)) && !d.HasChange(fmt.Sprintf("frontend_ip_configuration.%d.name", index)) {
-						return fmt.Errorf("in place change of the `frontend_ip_configuration.%[1]d.zones` is not allowed. It is allowed to do this while also changing `frontend_ip_configuration.%[1]d.name`", index)
-					}
-				}
+	isStandardSku := strings.EqualFold(d.Get("sku").(string), string(network.LoadBalancerSkuNameStandard))
+	if isStandardSku {
+		if err := resourceTypes.ValidateAPIVersion(capabilities.LoadBalancerResourceType, capabilities.LoadBalancerStandardSkuMinimumAPIVersion, "the `Standard` SKU"); err != nil {
+			return err
+		}
+	}
+
+	configs := d.Get("frontend_ip_configuration").([]interface{})
+	for index, raw := range configs {
+		if raw == nil {
+			continue
+		}
+		config := raw.(map[string]interface{})
+
+		if v, ok := config["zones"].([]interface{}); ok && len(v) > 0 {
+			field := fmt.Sprintf("`frontend_ip_configuration.%d.zones`", index)
+			if !isStandardSku && d.NewValueKnown("sku") {
+				return fmt.Errorf("%s can only be specified when `sku` is set to %q", field, string(network.LoadBalancerSkuNameStandard))
 			}
 
-			return nil
-		}),
+			if err := resourceTypes.ValidateAPIVersion(capabilities.LoadBalancerResourceType, capabilities.LoadBalancerZonesMinimumAPIVersion, field); err != nil {
+				return err
+			}
+		}
+
+		// TODO - Remove in 3.0
+		if d.HasChange(fmt.Sprintf("frontend_ip_configuration.%d.zones", index)) && !d.HasChange(fmt.Sprintf("frontend_ip_configuration.%d.name", index)) {
+			return fmt.Errorf("in place change of the `frontend_ip_configuration.%[1]d.zones` is not allowed. It is allowed to do this while also changing `frontend_ip_configuration.%[1]d.name`", index)
+		}
+	}
+
+	return loadBalancerValidateFrontendLocations(ctx, d, meta)
+}
+
+// loadBalancerValidateFrontendLocations confirms that the Public IP Addresses and Subnets used by the Frontend IP
+// Configurations are in the same location as the Load Balancer, so that this surfaces at plan time rather than as
+// a 400 error from the Azure Stack Hub during the apply
+func loadBalancerValidateFrontendLocations(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("location") {
+		return nil
+	}
+
+	// the references are only validated when they (or the location) change, to avoid retrieving them during every plan
+	if !d.HasChange("location") && !d.HasChange("frontend_ip_configuration") {
+		return nil
+	}
+	networkClient := meta.(*clients.Client).Network
+	loadBalancerLocation := d.Get("location").(string)
+
+	for index, raw := range d.Get("frontend_ip_configuration").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		config := raw.(map[string]interface{})
+
+		publicIPField := fmt.Sprintf("frontend_ip_configuration.%d.public_ip_address_id", index)
+		if v, ok := config["public_ip_address_id"].(string); ok && v != "" && d.NewValueKnown(publicIPField) {
+			if err := networkClient.ValidatePublicIPLocation(ctx, publicIPField, v, loadBalancerLocation); err != nil {
+				return err
+			}
+		}
+
+		subnetField := fmt.Sprintf("frontend_ip_configuration.%d.subnet_id", index)
+		if v, ok := config["subnet_id"].(string); ok && v != "" && d.NewValueKnown(subnetField) {
+			if err := networkClient.ValidateSubnetLocation(ctx, subnetField, v, loadBalancerLocation); err != nil {
+				return err
+			}
+		}
 	}
+
+	return nil
 }
 
 func loadBalancerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -196,14 +276,24 @@ func loadBalancerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		Location:                     pointer.FromString(location.Normalize(d.Get("location").(string))),
 		Tags:                         tags.Expand(d.Get("tags").(map[string]interface{})),
 		LoadBalancerPropertiesFormat: &properties,
+		Sku: &network.LoadBalancerSku{
+			Name: network.LoadBalancerSkuName(d.Get("sku").(string)),
+		},
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, loadBalancer)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, loadBalancer)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
@@ -235,6 +325,12 @@ func loadBalancerRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
+	sku := string(network.LoadBalancerSkuNameBasic)
+	if resp.Sku != nil && resp.Sku.Name != "" {
+		sku = string(resp.Sku.Name)
+	}
+	d.Set("sku", sku)
+
 	if props := resp.LoadBalancerPropertiesFormat; props != nil {
 		if feipConfigs := props.FrontendIPConfigurations; feipConfigs != nil {
 			if err := d.Set("frontend_ip_configuration", flattenLoadBalancerFrontendIpConfiguration(feipConfigs)); err != nil {
@@ -318,6 +414,7 @@ func expandazurestackLoadBalancerFrontendIpConfigurations(d *pluginsdk.ResourceD
 		frontEndConfig := network.FrontendIPConfiguration{
 			Name:                                    &name,
 			FrontendIPConfigurationPropertiesFormat: &properties,
+			Zones:                                   zones.ExpandZones(data["zones"].([]interface{})),
 		}
 
 		frontEndConfigs = append(frontEndConfigs, frontEndConfig)
@@ -343,6 +440,8 @@ func flattenLoadBalancerFrontendIpConfiguration(ipConfigs *[]network.FrontendIPC
 			ipConfig["id"] = *config.ID
 		}
 
+		ipConfig["zones"] = zones.FlattenZones(config.Zones)
+
 		if props := config.FrontendIPConfigurationPropertiesFormat; props != nil {
 			ipConfig["private_ip_address_allocation"] = string(props.PrivateIPAllocationMethod)
 
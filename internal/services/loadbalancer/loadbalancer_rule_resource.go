@@ -1,6 +1,8 @@
 package loadbalancer
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -9,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
@@ -132,6 +135,14 @@ func loadBalancerRule() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			if d.Get("disable_outbound_snat").(bool) {
+				return meta.(*clients.Client).Capabilities.ValidateAPIVersion(capabilities.LoadBalancerResourceType, capabilities.LoadBalancerStandardSkuMinimumAPIVersion, "`disable_outbound_snat`")
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -152,44 +163,36 @@ func resourceArmLoadBalancerRuleCreateUpdate(d *pluginsdk.ResourceData, meta int
 	locks.ByID(loadBalancerID)
 	defer locks.UnlockByID(loadBalancerID)
 
-	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(loadBalancer.Response) {
-			d.SetId("")
-			log.Printf("[INFO] Load Balancer %q not found. Removing from state", id.LoadBalancerName)
-			return nil
+	err = updateLoadBalancer(ctx, meta.(*clients.Client).Network.RetryOnConflict, client, *loadBalancerId, func(loadBalancer *network.LoadBalancer) (bool, error) {
+		newLbRule, err := expandazurestackLoadBalancerRule(d, loadBalancer)
+		if err != nil {
+			return false, fmt.Errorf("expanding Load Balancer Rule: %+v", err)
 		}
-		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Rule %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.Name, err)
-	}
 
-	newLbRule, err := expandazurestackLoadBalancerRule(d, &loadBalancer)
-	if err != nil {
-		return fmt.Errorf("expanding Load Balancer Rule: %+v", err)
-	}
+		lbRules := append(*loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules, *newLbRule)
 
-	lbRules := append(*loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules, *newLbRule)
+		existingRule, existingRuleIndex, exists := FindLoadBalancerRuleByName(loadBalancer, id.Name)
+		if exists {
+			if id.Name == *existingRule.Name {
+				if d.IsNewResource() {
+					return false, tf.ImportAsExistsError("azurestack_lb_rule", *existingRule.ID)
+				}
 
-	existingRule, existingRuleIndex, exists := FindLoadBalancerRuleByName(&loadBalancer, id.Name)
-	if exists {
-		if id.Name == *existingRule.Name {
-			if d.IsNewResource() {
-				return tf.ImportAsExistsError("azurestack_lb_rule", *existingRule.ID)
+				// this rule is being updated/reapplied remove old copy from the slice
+				lbRules = append(lbRules[:existingRuleIndex], lbRules[existingRuleIndex+1:]...)
 			}
-
-			// this rule is being updated/reapplied remove old copy from the slice
-			lbRules = append(lbRules[:existingRuleIndex], lbRules[existingRuleIndex+1:]...)
 		}
-	}
-
-	loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &lbRules
 
-	future, err := client.CreateOrUpdate(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, loadBalancer)
+		loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &lbRules
+		return true, nil
+	})
 	if err != nil {
-		return fmt.Errorf("updating Loadbalancer %q (resource group %q) for Rule %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.Name, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of Load Balancer %q (resource group %q) for Rule %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.Name, err)
+		if errors.Is(err, errLoadBalancerNotFound) {
+			d.SetId("")
+			log.Printf("[INFO] Load Balancer %q not found. Removing from state", id.LoadBalancerName)
+			return nil
+		}
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
@@ -226,6 +229,7 @@ func loadBalancerRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	d.Set("name", config.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("loadbalancer_id", parse.NewLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName).ID())
 
 	if props := config.LoadBalancingRulePropertiesFormat; props != nil {
 		d.Set("disable_outbound_snat", props.DisableOutboundSnat)
@@ -307,31 +311,23 @@ func loadBalancerRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	locks.ByID(loadBalancerIDRaw)
 	defer locks.UnlockByID(loadBalancerIDRaw)
 
-	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
+	err = updateLoadBalancer(ctx, meta.(*clients.Client).Network.RetryOnConflict, client, loadBalancerId, func(loadBalancer *network.LoadBalancer) (bool, error) {
+		_, index, exists := FindLoadBalancerRuleByName(loadBalancer, d.Get("name").(string))
+		if !exists {
+			return false, nil
+		}
+
+		lbRules := *loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules
+		lbRules = append(lbRules[:index], lbRules[index+1:]...)
+		loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &lbRules
+		return true, nil
+	})
 	if err != nil {
-		if utils.ResponseWasNotFound(loadBalancer.Response) {
+		if errors.Is(err, errLoadBalancerNotFound) {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Rule %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.Name, err)
-	}
-
-	_, index, exists := FindLoadBalancerRuleByName(&loadBalancer, d.Get("name").(string))
-	if !exists {
-		return nil
-	}
-
-	lbRules := *loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules
-	lbRules = append(lbRules[:index], lbRules[index+1:]...)
-	loadBalancer.LoadBalancerPropertiesFormat.LoadBalancingRules = &lbRules
-
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LoadBalancerName, loadBalancer)
-	if err != nil {
-		return fmt.Errorf("Creating/Updating Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of Load Balancer %q (Resource Group %q): %+v", id.LoadBalancerName, id.ResourceGroup, err)
+		return fmt.Errorf("removing %s from the Load Balancer: %+v", id, err)
 	}
 
 	return nil
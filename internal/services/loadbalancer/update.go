@@ -0,0 +1,57 @@
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// errLoadBalancerNotFound is returned from updateLoadBalancer when the Load Balancer doesn't exist
+var errLoadBalancerNotFound = errors.New("the Load Balancer was not found")
+
+// retryOnConflictFunc retries `createOrUpdate` when it fails due to a conflicting operation, see the Network Client's
+// RetryOnConflict
+type retryOnConflictFunc func(ctx context.Context, createOrUpdate func() error) error
+
+// updateLoadBalancer retrieves the Load Balancer, applies the change to it using `update` and then sends it. Each attempt
+// made by `retryOnConflict` retrieves the Load Balancer again, so that a retry is built from its latest version (and ETag)
+// rather than failing again, or overwriting the change made by the conflicting operation.
+//
+// `update` returns false when the Load Balancer doesn't need to be updated.
+func updateLoadBalancer(ctx context.Context, retryOnConflict retryOnConflictFunc, client *network.LoadBalancersClient, id parse.LoadBalancerId, update func(loadBalancer *network.LoadBalancer) (bool, error)) error {
+	return retryOnConflict(ctx, func() error {
+		loadBalancer, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(loadBalancer.Response) {
+				return errLoadBalancerNotFound
+			}
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if loadBalancer.LoadBalancerPropertiesFormat == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", id)
+		}
+
+		changed, err := update(&loadBalancer)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, loadBalancer)
+		if err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of %s: %+v", id, err)
+		}
+
+		return nil
+	})
+}
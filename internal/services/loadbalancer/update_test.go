@@ -0,0 +1,118 @@
+package loadbalancer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/loadbalancer/parse"
+)
+
+func TestUpdateLoadBalancerRetrievesBeforeEachAttempt(t *testing.T) {
+	gets := 0
+	puts := make([]network.LoadBalancer, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			// each retrieval returns a newer version of the Load Balancer, as if it was updated by another operation
+			gets++
+			_ = json.NewEncoder(w).Encode(network.LoadBalancer{
+				Name: pointer.FromString("lb1"),
+				Etag: pointer.FromString(fmt.Sprintf("version-%d", gets)),
+				LoadBalancerPropertiesFormat: &network.LoadBalancerPropertiesFormat{
+					Probes: &[]network.Probe{
+						{Name: pointer.FromString(fmt.Sprintf("probe-from-version-%d", gets))},
+					},
+					ProvisioningState: pointer.FromString("Succeeded"),
+				},
+			})
+
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			var loadBalancer network.LoadBalancer
+			if err := json.Unmarshal(body, &loadBalancer); err != nil {
+				t.Errorf("unmarshaling the request body: %+v", err)
+			}
+			puts = append(puts, loadBalancer)
+
+			if len(puts) == 1 {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_, _ = w.Write([]byte(`{"error": {"code": "PreconditionFailed", "message": "The ETag doesn't match."}}`))
+				return
+			}
+
+			loadBalancer.ProvisioningState = pointer.FromString("Succeeded")
+			_ = json.NewEncoder(w).Encode(loadBalancer)
+
+		default:
+			t.Errorf("unexpected %s request to %q", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := network.NewLoadBalancersClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	retryOnce := func(ctx context.Context, createOrUpdate func() error) error {
+		if err := createOrUpdate(); err != nil {
+			return createOrUpdate()
+		}
+		return nil
+	}
+
+	id := parse.NewLoadBalancerID("00000000-0000-0000-0000-000000000000", "group1", "lb1")
+	err := updateLoadBalancer(context.TODO(), retryOnce, &client, id, func(loadBalancer *network.LoadBalancer) (bool, error) {
+		probes := append(*loadBalancer.LoadBalancerPropertiesFormat.Probes, network.Probe{Name: pointer.FromString("new-probe")})
+		loadBalancer.LoadBalancerPropertiesFormat.Probes = &probes
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if gets != 2 {
+		t.Fatalf("Expected the Load Balancer to be retrieved twice but it was retrieved %d times", gets)
+	}
+	if len(puts) != 2 {
+		t.Fatalf("Expected the Load Balancer to be sent twice but it was sent %d times", len(puts))
+	}
+
+	retry := puts[1]
+	if retry.Etag == nil || *retry.Etag != "version-2" {
+		t.Fatalf("Expected the retry to be built from the latest version of the Load Balancer but got the ETag %v", retry.Etag)
+	}
+	if retry.LoadBalancerPropertiesFormat == nil || retry.LoadBalancerPropertiesFormat.Probes == nil || len(*retry.LoadBalancerPropertiesFormat.Probes) != 2 {
+		t.Fatalf("Expected the retry to contain 2 Probes but got: %+v", retry.LoadBalancerPropertiesFormat)
+	}
+	probes := *retry.LoadBalancerPropertiesFormat.Probes
+	if *probes[0].Name != "probe-from-version-2" || *probes[1].Name != "new-probe" {
+		t.Fatalf("Expected the retry to contain the Probe from the latest version and the new Probe but got %q and %q", *probes[0].Name, *probes[1].Name)
+	}
+}
+
+func TestUpdateLoadBalancerNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := network.NewLoadBalancersClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	retry := func(ctx context.Context, createOrUpdate func() error) error {
+		return createOrUpdate()
+	}
+
+	id := parse.NewLoadBalancerID("00000000-0000-0000-0000-000000000000", "group1", "lb1")
+	err := updateLoadBalancer(context.TODO(), retry, &client, id, func(loadBalancer *network.LoadBalancer) (bool, error) {
+		t.Fatalf("Expected the update not to be called when the Load Balancer doesn't exist")
+		return false, nil
+	})
+	if err != errLoadBalancerNotFound {
+		t.Fatalf("Expected errLoadBalancerNotFound but got: %+v", err)
+	}
+}
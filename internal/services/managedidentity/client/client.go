@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+type Client struct {
+	UserAssignedIdentitiesClient *msi.UserAssignedIdentitiesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	userAssignedIdentitiesClient := msi.NewUserAssignedIdentitiesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&userAssignedIdentitiesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		UserAssignedIdentitiesClient: &userAssignedIdentitiesClient,
+	}
+}
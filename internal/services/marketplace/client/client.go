@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/marketplaceordering/mgmt/2015-06-01/marketplaceordering"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+type Client struct {
+	MarketplaceAgreementsClient *marketplaceordering.MarketplaceAgreementsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	marketplaceAgreementsClient := marketplaceordering.NewMarketplaceAgreementsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&marketplaceAgreementsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		MarketplaceAgreementsClient: &marketplaceAgreementsClient,
+	}
+}
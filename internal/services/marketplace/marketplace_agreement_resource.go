@@ -0,0 +1,173 @@
+package marketplace
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/marketplace/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func marketplaceAgreement() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: marketplaceAgreementCreate,
+		Read:   marketplaceAgreementRead,
+		Delete: marketplaceAgreementDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.MarketplaceAgreementID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"publisher": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"offer": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"plan": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"accepted": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"license_text_link": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"privacy_policy_link": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func marketplaceAgreementCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Marketplace.MarketplaceAgreementsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewMarketplaceAgreementID(subscriptionId, d.Get("publisher").(string), d.Get("offer").(string), d.Get("plan").(string))
+
+	terms, err := client.Get(ctx, id.AgreementName, id.OfferName, id.PlanName)
+	if err != nil {
+		if utils.ResponseWasNotFound(terms.Response) {
+			return fmt.Errorf("the legal terms for %s were not found - check that the Publisher, Offer and Plan are available in the Marketplace", id)
+		}
+
+		return fmt.Errorf("retrieving the legal terms for %s: %+v", id, err)
+	}
+	if terms.AgreementProperties == nil {
+		return fmt.Errorf("retrieving the legal terms for %s: `properties` was nil", id)
+	}
+
+	// the legal terms are accepted once for the Subscription, as such when these have already been accepted (for
+	// example by another configuration) there's nothing to do
+	if terms.AgreementProperties.Accepted != nil && *terms.AgreementProperties.Accepted {
+		log.Printf("[DEBUG] The legal terms for %s have already been accepted", id)
+	} else {
+		terms.AgreementProperties.Accepted = pointer.FromBool(true)
+
+		if _, err := client.Create(ctx, id.AgreementName, id.OfferName, id.PlanName, terms); err != nil {
+			return fmt.Errorf("accepting the legal terms for %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return marketplaceAgreementRead(d, meta)
+}
+
+func marketplaceAgreementRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Marketplace.MarketplaceAgreementsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MarketplaceAgreementID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.AgreementName, id.OfferName, id.PlanName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	accepted := false
+	if props := resp.AgreementProperties; props != nil && props.Accepted != nil {
+		accepted = *props.Accepted
+	}
+	if !accepted {
+		// the legal terms have been cancelled outside of Terraform, so they need to be accepted again
+		log.Printf("[DEBUG] The legal terms for %s are no longer accepted - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("publisher", id.AgreementName)
+	d.Set("offer", id.OfferName)
+	d.Set("plan", id.PlanName)
+	d.Set("accepted", accepted)
+
+	if props := resp.AgreementProperties; props != nil {
+		d.Set("license_text_link", props.LicenseTextLink)
+		d.Set("privacy_policy_link", props.PrivacyPolicyLink)
+	}
+
+	return nil
+}
+
+func marketplaceAgreementDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Marketplace.MarketplaceAgreementsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MarketplaceAgreementID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Cancel(ctx, id.AgreementName, id.OfferName, id.PlanName); err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("cancelling the legal terms for %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package marketplace_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/marketplace/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type MarketplaceAgreementResource struct{}
+
+func TestAccMarketplaceAgreement_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_marketplace_agreement", "test")
+	r := MarketplaceAgreementResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("accepted").HasValue("true"),
+				check.That(data.ResourceName).Key("license_text_link").Exists(),
+				check.That(data.ResourceName).Key("privacy_policy_link").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MarketplaceAgreementResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.MarketplaceAgreementID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Marketplace.MarketplaceAgreementsClient.Get(ctx, id.AgreementName, id.OfferName, id.PlanName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	accepted := false
+	if props := resp.AgreementProperties; props != nil && props.Accepted != nil {
+		accepted = *props.Accepted
+	}
+
+	return pointer.FromBool(accepted), nil
+}
+
+func (MarketplaceAgreementResource) basic() string {
+	return `
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_marketplace_agreement" "test" {
+  publisher = "barracudanetworks"
+  offer     = "waf"
+  plan      = "hourly"
+}
+`
+}
@@ -0,0 +1,73 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type MarketplaceAgreementId struct {
+	SubscriptionId string
+	AgreementName  string
+	OfferName      string
+	PlanName       string
+}
+
+func NewMarketplaceAgreementID(subscriptionId, agreementName, offerName, planName string) MarketplaceAgreementId {
+	return MarketplaceAgreementId{
+		SubscriptionId: subscriptionId,
+		AgreementName:  agreementName,
+		OfferName:      offerName,
+		PlanName:       planName,
+	}
+}
+
+func (id MarketplaceAgreementId) String() string {
+	segments := []string{
+		fmt.Sprintf("Plan Name %q", id.PlanName),
+		fmt.Sprintf("Offer Name %q", id.OfferName),
+		fmt.Sprintf("Agreement Name %q", id.AgreementName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Marketplace Agreement", segmentsStr)
+}
+
+func (id MarketplaceAgreementId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.MarketplaceOrdering/agreements/%s/offers/%s/plans/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.AgreementName, id.OfferName, id.PlanName)
+}
+
+// MarketplaceAgreementID parses a MarketplaceAgreement ID into an MarketplaceAgreementId struct
+func MarketplaceAgreementID(input string) (*MarketplaceAgreementId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MarketplaceAgreementId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.AgreementName, err = id.PopSegment("agreements"); err != nil {
+		return nil, err
+	}
+	if resourceId.OfferName, err = id.PopSegment("offers"); err != nil {
+		return nil, err
+	}
+	if resourceId.PlanName, err = id.PopSegment("plans"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,3 @@
+package marketplace
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=MarketplaceAgreement -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.MarketplaceOrdering/agreements/agreement1/offers/offer1/plans/hourly
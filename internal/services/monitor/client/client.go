@@ -0,0 +1,29 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/monitor/mgmt/insights"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+type Client struct {
+	ActionGroupsClient      *insights.ActionGroupsClient
+	ActivityLogAlertsClient *insights.ActivityLogAlertsClient
+	MetricAlertsClient      *insights.MetricAlertsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	actionGroupsClient := insights.NewActionGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&actionGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	activityLogAlertsClient := insights.NewActivityLogAlertsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&activityLogAlertsClient.Client, o.ResourceManagerAuthorizer)
+
+	metricAlertsClient := insights.NewMetricAlertsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&metricAlertsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ActionGroupsClient:      &actionGroupsClient,
+		ActivityLogAlertsClient: &activityLogAlertsClient,
+		MetricAlertsClient:      &metricAlertsClient,
+	}
+}
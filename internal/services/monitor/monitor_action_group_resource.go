@@ -0,0 +1,392 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/monitor/mgmt/insights"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// monitorGlobalLocation is the location used for Action Groups and Alerts, which aren't tied to a specific location
+const monitorGlobalLocation = "global"
+
+func monitorActionGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: monitorActionGroupCreateUpdate,
+		Read:   monitorActionGroupRead,
+		Update: monitorActionGroupCreateUpdate,
+		Delete: monitorActionGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ActionGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"short_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 12),
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"email_receiver": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"email_address": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"use_common_alert_schema": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"sms_receiver": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"country_code": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"phone_number": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"webhook_receiver": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"service_uri": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"use_common_alert_schema": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func monitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewActionGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_monitor_action_group", id.ID())
+		}
+	}
+
+	parameters := insights.ActionGroupResource{
+		Location: pointer.FromString(monitorGlobalLocation),
+		ActionGroup: &insights.ActionGroup{
+			GroupShortName:   pointer.FromString(d.Get("short_name").(string)),
+			Enabled:          pointer.FromBool(d.Get("enabled").(bool)),
+			EmailReceivers:   expandMonitorActionGroupEmailReceivers(d.Get("email_receiver").([]interface{})),
+			SmsReceivers:     expandMonitorActionGroupSmsReceivers(d.Get("sms_receiver").([]interface{})),
+			WebhookReceivers: expandMonitorActionGroupWebhookReceivers(d.Get("webhook_receiver").([]interface{})),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return monitorActionGroupRead(d, meta)
+}
+
+func monitorActionGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ActionGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if group := resp.ActionGroup; group != nil {
+		d.Set("short_name", group.GroupShortName)
+		d.Set("enabled", group.Enabled)
+
+		if err := d.Set("email_receiver", flattenMonitorActionGroupEmailReceivers(group.EmailReceivers)); err != nil {
+			return fmt.Errorf("setting `email_receiver`: %+v", err)
+		}
+
+		if err := d.Set("sms_receiver", flattenMonitorActionGroupSmsReceivers(group.SmsReceivers)); err != nil {
+			return fmt.Errorf("setting `sms_receiver`: %+v", err)
+		}
+
+		if err := d.Set("webhook_receiver", flattenMonitorActionGroupWebhookReceivers(group.WebhookReceivers)); err != nil {
+			return fmt.Errorf("setting `webhook_receiver`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func monitorActionGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ActionGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorActionGroupEmailReceivers(input []interface{}) *[]insights.EmailReceiver {
+	receivers := make([]insights.EmailReceiver, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		receivers = append(receivers, insights.EmailReceiver{
+			Name:                 pointer.FromString(v["name"].(string)),
+			EmailAddress:         pointer.FromString(v["email_address"].(string)),
+			UseCommonAlertSchema: pointer.FromBool(v["use_common_alert_schema"].(bool)),
+		})
+	}
+	return &receivers
+}
+
+func flattenMonitorActionGroupEmailReceivers(input *[]insights.EmailReceiver) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, receiver := range *input {
+		name := ""
+		if receiver.Name != nil {
+			name = *receiver.Name
+		}
+
+		emailAddress := ""
+		if receiver.EmailAddress != nil {
+			emailAddress = *receiver.EmailAddress
+		}
+
+		useCommonAlertSchema := false
+		if receiver.UseCommonAlertSchema != nil {
+			useCommonAlertSchema = *receiver.UseCommonAlertSchema
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                    name,
+			"email_address":           emailAddress,
+			"use_common_alert_schema": useCommonAlertSchema,
+		})
+	}
+	return results
+}
+
+func expandMonitorActionGroupSmsReceivers(input []interface{}) *[]insights.SmsReceiver {
+	receivers := make([]insights.SmsReceiver, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		receivers = append(receivers, insights.SmsReceiver{
+			Name:        pointer.FromString(v["name"].(string)),
+			CountryCode: pointer.FromString(v["country_code"].(string)),
+			PhoneNumber: pointer.FromString(v["phone_number"].(string)),
+		})
+	}
+	return &receivers
+}
+
+func flattenMonitorActionGroupSmsReceivers(input *[]insights.SmsReceiver) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, receiver := range *input {
+		name := ""
+		if receiver.Name != nil {
+			name = *receiver.Name
+		}
+
+		countryCode := ""
+		if receiver.CountryCode != nil {
+			countryCode = *receiver.CountryCode
+		}
+
+		phoneNumber := ""
+		if receiver.PhoneNumber != nil {
+			phoneNumber = *receiver.PhoneNumber
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":         name,
+			"country_code": countryCode,
+			"phone_number": phoneNumber,
+		})
+	}
+	return results
+}
+
+func expandMonitorActionGroupWebhookReceivers(input []interface{}) *[]insights.WebhookReceiver {
+	receivers := make([]insights.WebhookReceiver, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		receivers = append(receivers, insights.WebhookReceiver{
+			Name:                 pointer.FromString(v["name"].(string)),
+			ServiceURI:           pointer.FromString(v["service_uri"].(string)),
+			UseCommonAlertSchema: pointer.FromBool(v["use_common_alert_schema"].(bool)),
+		})
+	}
+	return &receivers
+}
+
+func flattenMonitorActionGroupWebhookReceivers(input *[]insights.WebhookReceiver) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, receiver := range *input {
+		name := ""
+		if receiver.Name != nil {
+			name = *receiver.Name
+		}
+
+		serviceUri := ""
+		if receiver.ServiceURI != nil {
+			serviceUri = *receiver.ServiceURI
+		}
+
+		useCommonAlertSchema := false
+		if receiver.UseCommonAlertSchema != nil {
+			useCommonAlertSchema = *receiver.UseCommonAlertSchema
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                    name,
+			"service_uri":             serviceUri,
+			"use_common_alert_schema": useCommonAlertSchema,
+		})
+	}
+	return results
+}
@@ -0,0 +1,401 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/monitor/mgmt/insights"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func monitorActivityLogAlert() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: monitorActivityLogAlertCreateUpdate,
+		Read:   monitorActivityLogAlertRead,
+		Update: monitorActivityLogAlertCreateUpdate,
+		Delete: monitorActivityLogAlertDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ActivityLogAlertID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"scopes": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"criteria": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"category": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Administrative",
+								"Autoscale",
+								"Policy",
+								"Recommendation",
+								"ResourceHealth",
+								"Security",
+								"ServiceHealth",
+							}, false),
+						},
+
+						"operation_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"caller": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"level": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Verbose",
+								"Informational",
+								"Warning",
+								"Error",
+								"Critical",
+							}, false),
+						},
+
+						"resource_provider": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"resource_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"resource_group": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"resource_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"status": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"sub_status": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"action": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"action_group_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ActionGroupID,
+						},
+
+						"webhook_properties": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+// monitorActivityLogAlertCriteriaFields maps the fields within the `criteria` block to the field names used by the
+// API, which are compared case-insensitively
+var monitorActivityLogAlertCriteriaFields = map[string]string{
+	"operation_name":    "operationName",
+	"caller":            "caller",
+	"level":             "level",
+	"resource_provider": "resourceProvider",
+	"resource_type":     "resourceType",
+	"resource_group":    "resourceGroup",
+	"resource_id":       "resourceId",
+	"status":            "status",
+	"sub_status":        "subStatus",
+}
+
+func monitorActivityLogAlertCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewActivityLogAlertID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_monitor_activity_log_alert", id.ID())
+		}
+	}
+
+	parameters := insights.ActivityLogAlertResource{
+		Location: pointer.FromString(monitorGlobalLocation),
+		ActivityLogAlert: &insights.ActivityLogAlert{
+			Scopes:      utils.ExpandStringSlice(d.Get("scopes").(*pluginsdk.Set).List()),
+			Enabled:     pointer.FromBool(d.Get("enabled").(bool)),
+			Description: pointer.FromString(d.Get("description").(string)),
+			Condition:   expandMonitorActivityLogAlertCriteria(d.Get("criteria").([]interface{})),
+			Actions:     expandMonitorActivityLogAlertActions(d.Get("action").([]interface{})),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return monitorActivityLogAlertRead(d, meta)
+}
+
+func monitorActivityLogAlertRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ActivityLogAlertID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if alert := resp.ActivityLogAlert; alert != nil {
+		d.Set("enabled", alert.Enabled)
+		d.Set("description", alert.Description)
+
+		if err := d.Set("scopes", utils.FlattenStringSlice(alert.Scopes)); err != nil {
+			return fmt.Errorf("setting `scopes`: %+v", err)
+		}
+
+		if err := d.Set("criteria", flattenMonitorActivityLogAlertCriteria(alert.Condition)); err != nil {
+			return fmt.Errorf("setting `criteria`: %+v", err)
+		}
+
+		if err := d.Set("action", flattenMonitorActivityLogAlertActions(alert.Actions)); err != nil {
+			return fmt.Errorf("setting `action`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func monitorActivityLogAlertDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ActivityLogAlertID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorActivityLogAlertCriteria(input []interface{}) *insights.ActivityLogAlertAllOfCondition {
+	conditions := make([]insights.ActivityLogAlertLeafCondition, 0)
+	if len(input) == 0 || input[0] == nil {
+		return &insights.ActivityLogAlertAllOfCondition{
+			AllOf: &conditions,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+		Field:  pointer.FromString("category"),
+		Equals: pointer.FromString(v["category"].(string)),
+	})
+
+	for key, field := range monitorActivityLogAlertCriteriaFields {
+		if value := v[key].(string); value != "" {
+			conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+				Field:  pointer.FromString(field),
+				Equals: pointer.FromString(value),
+			})
+		}
+	}
+
+	return &insights.ActivityLogAlertAllOfCondition{
+		AllOf: &conditions,
+	}
+}
+
+func flattenMonitorActivityLogAlertCriteria(input *insights.ActivityLogAlertAllOfCondition) []interface{} {
+	if input == nil || input.AllOf == nil {
+		return []interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"category": "",
+	}
+	for key := range monitorActivityLogAlertCriteriaFields {
+		result[key] = ""
+	}
+
+	for _, condition := range *input.AllOf {
+		if condition.Field == nil || condition.Equals == nil {
+			continue
+		}
+
+		if strings.EqualFold(*condition.Field, "category") {
+			result["category"] = *condition.Equals
+			continue
+		}
+
+		for key, field := range monitorActivityLogAlertCriteriaFields {
+			if strings.EqualFold(*condition.Field, field) {
+				result[key] = *condition.Equals
+				break
+			}
+		}
+	}
+
+	return []interface{}{result}
+}
+
+func expandMonitorActivityLogAlertActions(input []interface{}) *insights.ActivityLogAlertActionList {
+	actions := make([]insights.ActivityLogAlertActionGroup, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		actions = append(actions, insights.ActivityLogAlertActionGroup{
+			ActionGroupID:     pointer.FromString(v["action_group_id"].(string)),
+			WebhookProperties: utils.ExpandMapStringPtrString(v["webhook_properties"].(map[string]interface{})),
+		})
+	}
+
+	return &insights.ActivityLogAlertActionList{
+		ActionGroups: &actions,
+	}
+}
+
+func flattenMonitorActivityLogAlertActions(input *insights.ActivityLogAlertActionList) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.ActionGroups == nil {
+		return results
+	}
+
+	for _, action := range *input.ActionGroups {
+		actionGroupId := ""
+		if action.ActionGroupID != nil {
+			actionGroupId = *action.ActionGroupID
+		}
+
+		results = append(results, map[string]interface{}{
+			"action_group_id":    actionGroupId,
+			"webhook_properties": utils.FlattenMapStringPtrString(action.WebhookProperties),
+		})
+	}
+	return results
+}
@@ -0,0 +1,185 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type MonitorActivityLogAlertResource struct{}
+
+func TestAccMonitorActivityLogAlert_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("scopes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("criteria.0.category").HasValue("Recommendation"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorActivityLogAlert_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorActivityLogAlert_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("criteria.0.category").HasValue("Administrative"),
+				check.That(data.ResourceName).Key("criteria.0.operation_name").HasValue("Microsoft.Storage/storageAccounts/write"),
+				check.That(data.ResourceName).Key("criteria.0.resource_type").HasValue("Microsoft.Storage/storageAccounts"),
+				check.That(data.ResourceName).Key("action.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.operation_name").HasValue(""),
+				check.That(data.ResourceName).Key("action.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MonitorActivityLogAlertResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ActivityLogAlertID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Monitor.ActivityLogAlertsClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (MonitorActivityLogAlertResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-monitor-%d"
+  location = "%s"
+}
+
+resource "azurestack_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  scopes              = [azurestack_resource_group.test.id]
+
+  criteria {
+    category = "Recommendation"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r MonitorActivityLogAlertResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_monitor_activity_log_alert" "import" {
+  name                = azurestack_monitor_activity_log_alert.test.name
+  resource_group_name = azurestack_monitor_activity_log_alert.test.resource_group_name
+  scopes              = azurestack_monitor_activity_log_alert.test.scopes
+
+  criteria {
+    category = "Recommendation"
+  }
+}
+`, r.basic(data))
+}
+
+func (MonitorActivityLogAlertResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-monitor-%d"
+  location = "%s"
+}
+
+resource "azurestack_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurestack_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  scopes              = [azurestack_resource_group.test.id]
+  description         = "This is just a test acceptance."
+  enabled             = false
+
+  criteria {
+    category       = "Administrative"
+    operation_name = "Microsoft.Storage/storageAccounts/write"
+    resource_type  = "Microsoft.Storage/storageAccounts"
+    level          = "Informational"
+    status         = "Succeeded"
+  }
+
+  action {
+    action_group_id = azurestack_monitor_action_group.test.id
+
+    webhook_properties = {
+      from = "terraform"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
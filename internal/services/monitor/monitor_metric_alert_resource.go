@@ -0,0 +1,538 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/monitor/mgmt/insights"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func monitorMetricAlert() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: monitorMetricAlertCreateUpdate,
+		Read:   monitorMetricAlertRead,
+		Update: monitorMetricAlertCreateUpdate,
+		Delete: monitorMetricAlertDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.MetricAlertID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"scopes": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"criteria": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"metric_namespace": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"metric_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"aggregation": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Average",
+								"Count",
+								"Minimum",
+								"Maximum",
+								"Total",
+							}, false),
+						},
+
+						"operator": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(insights.OperatorEquals),
+								string(insights.OperatorNotEquals),
+								string(insights.OperatorGreaterThan),
+								string(insights.OperatorGreaterThanOrEqual),
+								string(insights.OperatorLessThan),
+								string(insights.OperatorLessThanOrEqual),
+							}, false),
+						},
+
+						"threshold": {
+							Type:     pluginsdk.TypeFloat,
+							Required: true,
+						},
+
+						"dimension": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"operator": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Include",
+											"Exclude",
+										}, false),
+									},
+
+									"values": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"action": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"action_group_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ActionGroupID,
+						},
+
+						"webhook_properties": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"auto_mitigate": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"frequency": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "PT1M",
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT1M",
+					"PT5M",
+					"PT15M",
+					"PT30M",
+					"PT1H",
+				}, false),
+			},
+
+			"severity": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(0, 4),
+			},
+
+			"window_size": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "PT5M",
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT1M",
+					"PT5M",
+					"PT15M",
+					"PT30M",
+					"PT1H",
+					"PT6H",
+					"PT12H",
+					"P1D",
+				}, false),
+			},
+
+			// these are required by the API when the Alert is scoped to multiple Resources
+			"target_resource_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_resource_location": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				StateFunc:    location.StateFunc,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func monitorMetricAlertCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.MetricAlertsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewMetricAlertID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_monitor_metric_alert", id.ID())
+		}
+	}
+
+	scopes := d.Get("scopes").(*pluginsdk.Set).List()
+	targetResourceType := d.Get("target_resource_type").(string)
+	targetResourceLocation := d.Get("target_resource_location").(string)
+
+	// an Alert scoped to multiple Resources uses a different type of criteria, which requires the Resource Type
+	// and Location of the Resources being monitored
+	multipleResources := len(scopes) > 1
+	if multipleResources && (targetResourceType == "" || targetResourceLocation == "") {
+		return fmt.Errorf("`target_resource_type` and `target_resource_location` must be specified when `scopes` contains multiple Resources")
+	}
+
+	props := &insights.MetricAlertProperties{
+		Enabled:             pointer.FromBool(d.Get("enabled").(bool)),
+		AutoMitigate:        pointer.FromBool(d.Get("auto_mitigate").(bool)),
+		Description:         pointer.FromString(d.Get("description").(string)),
+		Severity:            utils.Int32(int32(d.Get("severity").(int))),
+		EvaluationFrequency: pointer.FromString(d.Get("frequency").(string)),
+		WindowSize:          pointer.FromString(d.Get("window_size").(string)),
+		Scopes:              utils.ExpandStringSlice(scopes),
+		Criteria:            expandMonitorMetricAlertCriteria(d.Get("criteria").([]interface{}), multipleResources),
+		Actions:             expandMonitorMetricAlertActions(d.Get("action").([]interface{})),
+	}
+
+	if targetResourceType != "" {
+		props.TargetResourceType = pointer.FromString(targetResourceType)
+	}
+	if targetResourceLocation != "" {
+		props.TargetResourceRegion = pointer.FromString(location.Normalize(targetResourceLocation))
+	}
+
+	parameters := insights.MetricAlertResource{
+		Location:              pointer.FromString(monitorGlobalLocation),
+		MetricAlertProperties: props,
+		Tags:                  tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return monitorMetricAlertRead(d, meta)
+}
+
+func monitorMetricAlertRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.MetricAlertsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MetricAlertID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.MetricAlertProperties; props != nil {
+		d.Set("enabled", props.Enabled)
+		d.Set("auto_mitigate", props.AutoMitigate)
+		d.Set("description", props.Description)
+		d.Set("frequency", props.EvaluationFrequency)
+		d.Set("window_size", props.WindowSize)
+		d.Set("target_resource_type", props.TargetResourceType)
+		d.Set("target_resource_location", location.NormalizeNilable(props.TargetResourceRegion))
+
+		severity := 0
+		if props.Severity != nil {
+			severity = int(*props.Severity)
+		}
+		d.Set("severity", severity)
+
+		if err := d.Set("scopes", utils.FlattenStringSlice(props.Scopes)); err != nil {
+			return fmt.Errorf("setting `scopes`: %+v", err)
+		}
+
+		if err := d.Set("criteria", flattenMonitorMetricAlertCriteria(props.Criteria)); err != nil {
+			return fmt.Errorf("setting `criteria`: %+v", err)
+		}
+
+		if err := d.Set("action", flattenMonitorMetricAlertActions(props.Actions)); err != nil {
+			return fmt.Errorf("setting `action`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func monitorMetricAlertDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.MetricAlertsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MetricAlertID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorMetricAlertCriteria(input []interface{}, multipleResources bool) insights.BasicMetricAlertCriteria {
+	criteria := make([]insights.MetricCriteria, 0)
+	for i, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		dimensions := make([]insights.MetricDimension, 0)
+		for _, dimension := range v["dimension"].([]interface{}) {
+			if dimension == nil {
+				continue
+			}
+			dv := dimension.(map[string]interface{})
+			dimensions = append(dimensions, insights.MetricDimension{
+				Name:     pointer.FromString(dv["name"].(string)),
+				Operator: pointer.FromString(dv["operator"].(string)),
+				Values:   utils.ExpandStringSlice(dv["values"].([]interface{})),
+			})
+		}
+
+		criteria = append(criteria, insights.MetricCriteria{
+			// the name of each criterion only needs to be unique within the Alert
+			Name:            pointer.FromString(fmt.Sprintf("Metric%d", i+1)),
+			MetricNamespace: pointer.FromString(v["metric_namespace"].(string)),
+			MetricName:      pointer.FromString(v["metric_name"].(string)),
+			TimeAggregation: v["aggregation"].(string),
+			Operator:        insights.Operator(v["operator"].(string)),
+			Threshold:       pointer.FromFloat64(v["threshold"].(float64)),
+			Dimensions:      &dimensions,
+		})
+	}
+
+	if multipleResources {
+		allOf := make([]insights.BasicMultiMetricCriteria, 0)
+		for _, v := range criteria {
+			allOf = append(allOf, v)
+		}
+		return insights.MetricAlertMultipleResourceMultipleMetricCriteria{
+			AllOf: &allOf,
+		}
+	}
+
+	return insights.MetricAlertSingleResourceMultipleMetricCriteria{
+		AllOf: &criteria,
+	}
+}
+
+func flattenMonitorMetricAlertCriteria(input insights.BasicMetricAlertCriteria) []interface{} {
+	criteria := make([]insights.MetricCriteria, 0)
+	if input == nil {
+		return []interface{}{}
+	}
+
+	if single, ok := input.AsMetricAlertSingleResourceMultipleMetricCriteria(); ok && single != nil && single.AllOf != nil {
+		criteria = append(criteria, *single.AllOf...)
+	}
+
+	if multiple, ok := input.AsMetricAlertMultipleResourceMultipleMetricCriteria(); ok && multiple != nil && multiple.AllOf != nil {
+		for _, item := range *multiple.AllOf {
+			if v, ok := item.AsMetricCriteria(); ok && v != nil {
+				criteria = append(criteria, *v)
+			}
+		}
+	}
+
+	results := make([]interface{}, 0)
+	for _, criterion := range criteria {
+		metricNamespace := ""
+		if criterion.MetricNamespace != nil {
+			metricNamespace = *criterion.MetricNamespace
+		}
+
+		metricName := ""
+		if criterion.MetricName != nil {
+			metricName = *criterion.MetricName
+		}
+
+		aggregation := ""
+		if v, ok := criterion.TimeAggregation.(string); ok {
+			aggregation = v
+		}
+
+		threshold := 0.0
+		if criterion.Threshold != nil {
+			threshold = *criterion.Threshold
+		}
+
+		dimensions := make([]interface{}, 0)
+		if criterion.Dimensions != nil {
+			for _, dimension := range *criterion.Dimensions {
+				name := ""
+				if dimension.Name != nil {
+					name = *dimension.Name
+				}
+
+				operator := ""
+				if dimension.Operator != nil {
+					operator = *dimension.Operator
+				}
+
+				dimensions = append(dimensions, map[string]interface{}{
+					"name":     name,
+					"operator": operator,
+					"values":   utils.FlattenStringSlice(dimension.Values),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"metric_namespace": metricNamespace,
+			"metric_name":      metricName,
+			"aggregation":      aggregation,
+			"operator":         string(criterion.Operator),
+			"threshold":        threshold,
+			"dimension":        dimensions,
+		})
+	}
+
+	return results
+}
+
+func expandMonitorMetricAlertActions(input []interface{}) *[]insights.MetricAlertAction {
+	actions := make([]insights.MetricAlertAction, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		actions = append(actions, insights.MetricAlertAction{
+			ActionGroupID:     pointer.FromString(v["action_group_id"].(string)),
+			WebHookProperties: utils.ExpandMapStringPtrString(v["webhook_properties"].(map[string]interface{})),
+		})
+	}
+	return &actions
+}
+
+func flattenMonitorMetricAlertActions(input *[]insights.MetricAlertAction) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, action := range *input {
+		actionGroupId := ""
+		if action.ActionGroupID != nil {
+			actionGroupId = *action.ActionGroupID
+		}
+
+		results = append(results, map[string]interface{}{
+			"action_group_id":    actionGroupId,
+			"webhook_properties": utils.FlattenMapStringPtrString(action.WebHookProperties),
+		})
+	}
+	return results
+}
@@ -0,0 +1,266 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type MonitorMetricAlertResource struct{}
+
+func TestAccMonitorMetricAlert_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_monitor_metric_alert", "test")
+	r := MonitorMetricAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("severity").HasValue("3"),
+				check.That(data.ResourceName).Key("frequency").HasValue("PT1M"),
+				check.That(data.ResourceName).Key("window_size").HasValue("PT5M"),
+				check.That(data.ResourceName).Key("criteria.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorMetricAlert_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_monitor_metric_alert", "test")
+	r := MonitorMetricAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorMetricAlert_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_monitor_metric_alert", "test")
+	r := MonitorMetricAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("severity").HasValue("1"),
+				check.That(data.ResourceName).Key("criteria.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.1.dimension.#").HasValue("1"),
+				check.That(data.ResourceName).Key("action.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.#").HasValue("1"),
+				check.That(data.ResourceName).Key("action.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorMetricAlert_multipleResources(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_monitor_metric_alert", "test")
+	r := MonitorMetricAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleResources(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scopes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("target_resource_type").HasValue("Microsoft.Storage/storageAccounts"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MonitorMetricAlertResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.MetricAlertID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Monitor.MetricAlertsClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (MonitorMetricAlertResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-monitor-%d"
+  location = "%s"
+}
+
+resource "azurestack_storage_account" "test" {
+  name                     = "acctestsa1%s"
+  resource_group_name      = azurestack_resource_group.test.name
+  location                 = azurestack_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r MonitorMetricAlertResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_monitor_metric_alert" "test" {
+  name                = "acctestMetricAlert-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  scopes              = [azurestack_storage_account.test.id]
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "UsedCapacity"
+    aggregation      = "Average"
+    operator         = "GreaterThan"
+    threshold        = 55.5
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorMetricAlertResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_monitor_metric_alert" "import" {
+  name                = azurestack_monitor_metric_alert.test.name
+  resource_group_name = azurestack_monitor_metric_alert.test.resource_group_name
+  scopes              = azurestack_monitor_metric_alert.test.scopes
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "UsedCapacity"
+    aggregation      = "Average"
+    operator         = "GreaterThan"
+    threshold        = 55.5
+  }
+}
+`, r.basic(data))
+}
+
+func (r MonitorMetricAlertResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurestack_monitor_metric_alert" "test" {
+  name                = "acctestMetricAlert-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  scopes              = [azurestack_storage_account.test.id]
+  enabled             = false
+  auto_mitigate       = false
+  severity            = 1
+  description         = "This is a test metric alert."
+  frequency           = "PT5M"
+  window_size         = "PT15M"
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "UsedCapacity"
+    aggregation      = "Average"
+    operator         = "GreaterThan"
+    threshold        = 55.5
+  }
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "Transactions"
+    aggregation      = "Total"
+    operator         = "GreaterThan"
+    threshold        = 1000
+
+    dimension {
+      name     = "ApiName"
+      operator = "Include"
+      values   = ["GetBlob"]
+    }
+  }
+
+  action {
+    action_group_id = azurestack_monitor_action_group.test.id
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r MonitorMetricAlertResource) multipleResources(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_account" "second" {
+  name                     = "acctestsa2%s"
+  resource_group_name      = azurestack_resource_group.test.name
+  location                 = azurestack_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurestack_monitor_metric_alert" "test" {
+  name                     = "acctestMetricAlert-%d"
+  resource_group_name      = azurestack_resource_group.test.name
+  scopes                   = [azurestack_storage_account.test.id, azurestack_storage_account.second.id]
+  target_resource_type     = "Microsoft.Storage/storageAccounts"
+  target_resource_location = azurestack_resource_group.test.location
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "Transactions"
+    aggregation      = "Total"
+    operator         = "GreaterThan"
+    threshold        = 1000
+  }
+}
+`, r.template(data), data.RandomString, data.RandomInteger)
+}
@@ -0,0 +1,5 @@
+package monitor
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ActionGroup -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/actionGroups/actionGroup1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ActivityLogAlert -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/activityLogAlerts/alert1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=MetricAlert -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Insights/metricAlerts/alert1
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"time"
+
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
 )
@@ -15,10 +17,16 @@ type Client struct {
 	SecurityGroupClient             *network.SecurityGroupsClient
 	SecurityRuleClient              *network.SecurityRulesClient
 	SubnetsClient                   *network.SubnetsClient
+	UsagesClient                    *network.UsagesClient
 	VnetGatewayConnectionsClient    *network.VirtualNetworkGatewayConnectionsClient
 	VnetGatewayClient               *network.VirtualNetworkGatewaysClient
 	VnetClient                      *network.VirtualNetworksClient
 	VnetPeeringsClient              *network.VirtualNetworkPeeringsClient
+
+	// conflictRetryMaxAttempts and conflictRetryBaseDelay configure how operations failing due to a conflicting operation
+	// are retried, see RetryOnConflict
+	conflictRetryMaxAttempts int
+	conflictRetryBaseDelay   time.Duration
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -76,6 +84,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	SubnetsClient := network.NewSubnetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SubnetsClient.Client, o.ResourceManagerAuthorizer)
 
+	UsagesClient := network.NewUsagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&UsagesClient.Client, o.ResourceManagerAuthorizer)
+
 	VnetGatewayClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VnetGatewayClient.Client, o.ResourceManagerAuthorizer)
 
@@ -95,9 +106,12 @@ func NewClient(o *common.ClientOptions) *Client {
 		SecurityGroupClient:             &SecurityGroupClient,
 		SecurityRuleClient:              &SecurityRuleClient,
 		SubnetsClient:                   &SubnetsClient,
+		UsagesClient:                    &UsagesClient,
 		VnetGatewayConnectionsClient:    &VnetGatewayConnectionsClient,
 		VnetGatewayClient:               &VnetGatewayClient,
 		VnetClient:                      &VnetClient,
 		VnetPeeringsClient:              &VnetPeeringsClient,
+		conflictRetryMaxAttempts:        o.Features.NetworkConflictRetry.MaxAttempts,
+		conflictRetryBaseDelay:          o.Features.NetworkConflictRetry.BaseDelay,
 	}
 }
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// maximumConflictRetryDelay caps the exponential backoff between two attempts
+const maximumConflictRetryDelay = 2 * time.Minute
+
+// retryableConflicts are the error codes (and status codes) returned by the Network API when the Resource, or a Resource
+// it references, is being modified by another operation - which happens frequently on Azure Stack Hub when Network
+// Interfaces, Load Balancers and Subnets are updated concurrently, and which succeed when retried once it's completed.
+//
+// NOTE: the errors returned from the SDK are matched by their message, since they're wrapped using `%+v` rather than `%w`
+var retryableConflicts = []string{
+	`Code="AnotherOperationInProgress"`,
+	`Code="CanceledAndSupersededDueToAnotherOperation"`,
+	`Code="RetryableError"`,
+	`Code="PreconditionFailed"`,
+	"StatusCode=412",
+}
+
+// RetryOnConflict calls `createOrUpdate` - which is expected to both send the request and wait for the Long Running
+// Operation to complete - retrying it with an exponential backoff (and jitter) when it fails because of a conflicting
+// operation, up to the number of attempts configured using the `network_conflict_retry` feature.
+//
+// When the request is built from an existing Resource (for example a child Resource being added to its parent), that
+// Resource must be retrieved within `createOrUpdate` - since retrying with the body (and ETag) from before the
+// conflicting operation either fails again with a `PreconditionFailed`, or overwrites the change it made.
+func (c *Client) RetryOnConflict(ctx context.Context, createOrUpdate func() error) error {
+	return retryOnConflict(ctx, c.conflictRetryMaxAttempts, c.conflictRetryBaseDelay, createOrUpdate)
+}
+
+func retryOnConflict(ctx context.Context, maxAttempts int, baseDelay time.Duration, createOrUpdate func() error) error {
+	attempt := 1
+	for {
+		err := createOrUpdate()
+		if err == nil || attempt >= maxAttempts || !isRetryableConflict(err) {
+			return err
+		}
+
+		delay := conflictRetryDelay(attempt, baseDelay)
+		log.Printf("[DEBUG] Attempt %d of %d failed due to a conflicting operation - retrying in %s: %+v", attempt, maxAttempts, delay, err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+
+		attempt++
+	}
+}
+
+// conflictRetryDelay returns the delay before the next attempt, which doubles with each attempt and is jittered by up to
+// half of the delay, so that the Resources which conflicted with each other don't retry at the same time
+func conflictRetryDelay(attempt int, baseDelay time.Duration) time.Duration {
+	delay := baseDelay
+	for i := 1; i < attempt && delay < maximumConflictRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maximumConflictRetryDelay {
+		delay = maximumConflictRetryDelay
+	}
+
+	if jitter := int64(delay / 2); jitter > 0 {
+		delay += time.Duration(rand.Int63n(jitter)) // nolint:gosec
+	}
+
+	return delay
+}
+
+func isRetryableConflict(err error) bool {
+	for _, v := range retryableConflicts {
+		if strings.Contains(err.Error(), v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableConflict(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    `network.InterfacesClient#CreateOrUpdate: Failure sending request: StatusCode=409 -- Original Error: Code="AnotherOperationInProgress" Message="Another operation on this or dependent resource is in progress."`,
+			Expected: true,
+		},
+		{
+			Input:    `waiting for update of Network Interface: Code="CanceledAndSupersededDueToAnotherOperation" Message="Operation was canceled."`,
+			Expected: true,
+		},
+		{
+			Input:    `network.SubnetsClient#CreateOrUpdate: Failure responding to request: StatusCode=412 -- Original Error: autorest/azure: Service returned an error.`,
+			Expected: true,
+		},
+		{
+			Input:    `network.SubnetsClient#CreateOrUpdate: Failure sending request: StatusCode=400 -- Original Error: Code="InvalidRequestFormat"`,
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := isRetryableConflict(fmt.Errorf("%s", v.Input)); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestRetryOnConflict(t *testing.T) {
+	conflict := fmt.Errorf(`Code="AnotherOperationInProgress"`)
+
+	testData := []struct {
+		Name             string
+		Errors           []error
+		MaxAttempts      int
+		ExpectedAttempts int
+		ExpectError      bool
+	}{
+		{
+			Name:             "succeeds immediately",
+			Errors:           []error{nil},
+			MaxAttempts:      3,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "succeeds after a conflict",
+			Errors:           []error{conflict, nil},
+			MaxAttempts:      3,
+			ExpectedAttempts: 2,
+		},
+		{
+			Name:             "attempts exhausted",
+			Errors:           []error{conflict, conflict, conflict},
+			MaxAttempts:      3,
+			ExpectedAttempts: 3,
+			ExpectError:      true,
+		},
+		{
+			Name:             "not a conflict",
+			Errors:           []error{fmt.Errorf("bad request"), nil},
+			MaxAttempts:      3,
+			ExpectedAttempts: 1,
+			ExpectError:      true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		attempts := 0
+		err := retryOnConflict(context.TODO(), v.MaxAttempts, time.Millisecond, func() error {
+			err := v.Errors[attempts]
+			attempts++
+			return err
+		})
+
+		if attempts != v.ExpectedAttempts {
+			t.Fatalf("Expected %d attempts but got %d", v.ExpectedAttempts, attempts)
+		}
+		if (err != nil) != v.ExpectError {
+			t.Fatalf("Expected an error to be %t but got: %+v", v.ExpectError, err)
+		}
+	}
+}
+
+func TestConflictRetryDelay(t *testing.T) {
+	for attempt := 1; attempt <= 10; attempt++ {
+		minimum := time.Second << (attempt - 1)
+		if minimum > maximumConflictRetryDelay {
+			minimum = maximumConflictRetryDelay
+		}
+
+		delay := conflictRetryDelay(attempt, time.Second)
+		if delay < minimum || delay >= minimum+minimum/2 {
+			t.Fatalf("Expected the delay for attempt %d to be between %s and %s but got %s", attempt, minimum, minimum+minimum/2, delay)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// ValidatePublicIPLocation confirms that the Public IP Address referenced in `field` exists in the same location as
+// the Resource referencing it - which the Azure Stack Hub otherwise rejects with a 400 error when the Resource is
+// provisioned. Public IP Addresses which can't be found are skipped, since the API returns a clearer error for these.
+func (c *Client) ValidatePublicIPLocation(ctx context.Context, field, publicIPAddressId, expectedLocation string) error {
+	id, err := parse.PublicIpAddressID(publicIPAddressId)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.PublicIPsClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s to validate `%s`: %+v", *id, field, err)
+	}
+
+	return validateSameLocation(field, id.String(), location.NormalizeNilable(resp.Location), expectedLocation)
+}
+
+// ValidateSubnetLocation confirms that the Subnet referenced in `field` exists in the same location as the Resource
+// referencing it, where the location of the Subnet is the location of the Virtual Network containing it. Subnets
+// within Virtual Networks which can't be found are skipped, since the API returns a clearer error for these.
+func (c *Client) ValidateSubnetLocation(ctx context.Context, field, subnetId, expectedLocation string) error {
+	id, err := parse.SubnetID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.VnetClient.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving the Virtual Network for %s to validate `%s`: %+v", *id, field, err)
+	}
+
+	return validateSameLocation(field, id.String(), location.NormalizeNilable(resp.Location), expectedLocation)
+}
+
+func validateSameLocation(field, resourceId, actualLocation, expectedLocation string) error {
+	if actualLocation == "" || actualLocation == location.Normalize(expectedLocation) {
+		return nil
+	}
+
+	return fmt.Errorf("`%s`: %s is in the location %q but must be in the same location as this Resource (%q)", field, resourceId, actualLocation, location.Normalize(expectedLocation))
+}
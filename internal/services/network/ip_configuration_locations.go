@@ -0,0 +1,47 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/client"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+// validateIPConfigurationLocations confirms that the Public IP Addresses and Subnets referenced within the IP Configuration
+// block `block` are in the same location as the Resource, so that this surfaces at plan time rather than as a 400 error
+// from the Azure Stack Hub during the apply
+func validateIPConfigurationLocations(ctx context.Context, client *client.Client, d *pluginsdk.ResourceDiff, block string) error {
+	if !d.NewValueKnown("location") {
+		return nil
+	}
+
+	// the references are only validated when they (or the location) change, to avoid retrieving them during every plan
+	if !d.HasChange("location") && !d.HasChange(block) {
+		return nil
+	}
+	resourceLocation := d.Get("location").(string)
+
+	for index, raw := range d.Get(block).([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		config := raw.(map[string]interface{})
+
+		publicIPField := fmt.Sprintf("%s.%d.public_ip_address_id", block, index)
+		if v, ok := config["public_ip_address_id"].(string); ok && v != "" && d.NewValueKnown(publicIPField) {
+			if err := client.ValidatePublicIPLocation(ctx, publicIPField, v, resourceLocation); err != nil {
+				return err
+			}
+		}
+
+		subnetField := fmt.Sprintf("%s.%d.subnet_id", block, index)
+		if v, ok := config["subnet_id"].(string); ok && v != "" && d.NewValueKnown(subnetField) {
+			if err := client.ValidateSubnetLocation(ctx, subnetField, v, resourceLocation); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
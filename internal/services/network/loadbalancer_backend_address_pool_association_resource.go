@@ -25,6 +25,7 @@ func loadBalancerBackendAddressPoolAssociation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: loadBalancerBackendAddressPoolAssociationCreateUpdate,
 		Read:   loadBalancerBackendAddressPoolAssociationRead,
+		Update: loadBalancerBackendAddressPoolAssociationUpdate,
 		Delete: loadBalancerBackendAddressPoolAssociationDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -66,6 +67,16 @@ func loadBalancerBackendAddressPoolAssociation() *pluginsdk.Resource {
 				ForceNew:     true,
 				ValidateFunc: loadbalancer.LoadBalancerBackendAddressPoolID,
 			},
+
+			// this is only used when the Association is removed, as a plain delay before the Network Interface is
+			// removed from the Backend Address Pool - the Load Balancer continues to send traffic to the Network
+			// Interface during this time, so this doesn't drain connections
+			"pre_delete_delay_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
 		},
 	}
 }
@@ -86,70 +97,85 @@ func loadBalancerBackendAddressPoolAssociationCreateUpdate(d *pluginsdk.Resource
 		return err
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
-	read, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(read.Response) {
-			return fmt.Errorf("%s was not found!", *id)
+	resourceId := fmt.Sprintf("%s/ipConfigurations/%s|%s", networkInterfaceId, ipConfigurationName, backendAddressPoolId)
+	attempted := false
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		// the Network Interface is retrieved for each attempt, so that a retry is built from its latest version (rather
+		// than overwriting the change made by the conflicting operation)
+		read, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(read.Response) {
+				return fmt.Errorf("%s was not found!", *id)
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
 		}
 
-		return fmt.Errorf("retrieving %s: %+v", *id, err)
-	}
+		props := read.InterfacePropertiesFormat
+		if props == nil {
+			return fmt.Errorf("Error: `properties` was nil for %s", *id)
+		}
 
-	props := read.InterfacePropertiesFormat
-	if props == nil {
-		return fmt.Errorf("Error: `properties` was nil for %s", *id)
-	}
+		ipConfigs := props.IPConfigurations
+		if ipConfigs == nil {
+			return fmt.Errorf("Error: `properties.IPConfigurations` was nil for %s", *id)
+		}
 
-	ipConfigs := props.IPConfigurations
-	if ipConfigs == nil {
-		return fmt.Errorf("Error: `properties.IPConfigurations` was nil for %s", *id)
-	}
+		c := FindNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+		if c == nil {
+			return fmt.Errorf("Error: IP Configuration %q was not found on %s", ipConfigurationName, *id)
+		}
 
-	c := FindNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
-	if c == nil {
-		return fmt.Errorf("Error: IP Configuration %q was not found on %s", ipConfigurationName, *id)
-	}
+		config := *c
+		p := config.InterfaceIPConfigurationPropertiesFormat
+		if p == nil {
+			return fmt.Errorf("Error: `IPConfiguration.properties` was nil for %s", *id)
+		}
 
-	config := *c
-	p := config.InterfaceIPConfigurationPropertiesFormat
-	if p == nil {
-		return fmt.Errorf("Error: `IPConfiguration.properties` was nil for %s", *id)
-	}
+		pools := make([]network.BackendAddressPool, 0)
 
-	pools := make([]network.BackendAddressPool, 0)
+		// first double-check it doesn't exist
+		if p.LoadBalancerBackendAddressPools != nil {
+			for _, existingPool := range *p.LoadBalancerBackendAddressPools {
+				if id := existingPool.ID; id != nil {
+					if *id == backendAddressPoolId {
+						// a previous attempt may have been applied, despite failing due to a conflicting operation
+						if attempted {
+							return nil
+						}
 
-	// first double-check it doesn't exist
-	resourceId := fmt.Sprintf("%s/ipConfigurations/%s|%s", networkInterfaceId, ipConfigurationName, backendAddressPoolId)
-	if p.LoadBalancerBackendAddressPools != nil {
-		for _, existingPool := range *p.LoadBalancerBackendAddressPools {
-			if id := existingPool.ID; id != nil {
-				if *id == backendAddressPoolId {
-					return tf.ImportAsExistsError("azurerm_network_interface_backend_address_pool_association", resourceId)
-				}
+						return tf.ImportAsExistsError("azurerm_network_interface_backend_address_pool_association", resourceId)
+					}
 
-				pools = append(pools, existingPool)
+					pools = append(pools, existingPool)
+				}
 			}
 		}
-	}
 
-	pool := network.BackendAddressPool{
-		ID: pointer.FromString(backendAddressPoolId),
-	}
-	pools = append(pools, pool)
-	p.LoadBalancerBackendAddressPools = &pools
+		pool := network.BackendAddressPool{
+			ID: pointer.FromString(backendAddressPoolId),
+		}
+		pools = append(pools, pool)
+		p.LoadBalancerBackendAddressPools = &pools
 
-	props.IPConfigurations = updateNetworkInterfaceIPConfiguration(config, props.IPConfigurations)
+		props.IPConfigurations = updateNetworkInterfaceIPConfiguration(config, props.IPConfigurations)
+		attempted = true
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, read)
-	if err != nil {
-		return fmt.Errorf("updating Backend Address Pool Association for %s: %+v", *id, err)
-	}
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, read)
+		if err != nil {
+			return fmt.Errorf("updating Backend Address Pool Association for %s: %+v", *id, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of Backend Address Pool Association for %s: %+v", *id, err)
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for completion of Backend Address Pool Association for %s: %+v", *id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	d.SetId(resourceId)
@@ -229,9 +255,18 @@ func loadBalancerBackendAddressPoolAssociationRead(d *pluginsdk.ResourceData, me
 	d.Set("ip_configuration_name", nicID.IpConfigurationName)
 	d.Set("network_interface_id", read.ID)
 
+	// not returned by the API, so is defaulted when importing
+	d.Set("pre_delete_delay_in_seconds", d.Get("pre_delete_delay_in_seconds").(int))
+
 	return nil
 }
 
+func loadBalancerBackendAddressPoolAssociationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	// `pre_delete_delay_in_seconds` is the only field which can be updated and is only used during
+	// deletion - so there's nothing to update in Azure
+	return loadBalancerBackendAddressPoolAssociationRead(d, meta)
+}
+
 func loadBalancerBackendAddressPoolAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.InterfacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -249,61 +284,79 @@ func loadBalancerBackendAddressPoolAssociationDelete(d *pluginsdk.ResourceData,
 
 	backendAddressPoolId := splitId[1]
 
-	locks.ByName(nicID.NetworkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(nicID.NetworkInterfaceName, networkInterfaceResourceName)
+	if delay := d.Get("pre_delete_delay_in_seconds").(int); delay > 0 {
+		log.Printf("[DEBUG] Waiting %d seconds before removing Network Interface %q (Resource Group %q) from Backend Address Pool %q..", delay, nicID.NetworkInterfaceName, nicID.ResourceGroup, backendAddressPoolId)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting before removing Network Interface %q (Resource Group %q) from the Backend Address Pool: %+v", nicID.NetworkInterfaceName, nicID.ResourceGroup, ctx.Err())
+		case <-time.After(time.Duration(delay) * time.Second):
+		}
+	}
+
+	networkInterfaceId := parse.NewNetworkInterfaceID(nicID.SubscriptionId, nicID.ResourceGroup, nicID.NetworkInterfaceName)
+	locks.ByID(networkInterfaceId.ID())
+	defer locks.UnlockByID(networkInterfaceId.ID())
+
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		// the Network Interface is retrieved for each attempt, so that a retry is built from its latest version (rather
+		// than overwriting the change made by the conflicting operation)
+		read, err := client.Get(ctx, nicID.ResourceGroup, nicID.NetworkInterfaceName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(read.Response) {
+				return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", nicID.NetworkInterfaceName, nicID.ResourceGroup)
+			}
 
-	read, err := client.Get(ctx, nicID.ResourceGroup, nicID.NetworkInterfaceName, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(read.Response) {
-			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", nicID.NetworkInterfaceName, nicID.ResourceGroup)
+			return fmt.Errorf("retrieving Network Interface %q (Resource Group %q): %+v", nicID.NetworkInterfaceName, nicID.ResourceGroup, err)
 		}
 
-		return fmt.Errorf("retrieving Network Interface %q (Resource Group %q): %+v", nicID.NetworkInterfaceName, nicID.ResourceGroup, err)
-	}
+		nicProps := read.InterfacePropertiesFormat
+		if nicProps == nil {
+			return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", nicID.NetworkInterfaceName, nicID.ResourceGroup)
+		}
 
-	nicProps := read.InterfacePropertiesFormat
-	if nicProps == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", nicID.NetworkInterfaceName, nicID.ResourceGroup)
-	}
+		ipConfigs := nicProps.IPConfigurations
+		if ipConfigs == nil {
+			return fmt.Errorf("Error: `properties.IPConfigurations` was nil for Network Interface %q (Resource Group %q)", nicID.NetworkInterfaceName, nicID.ResourceGroup)
+		}
 
-	ipConfigs := nicProps.IPConfigurations
-	if ipConfigs == nil {
-		return fmt.Errorf("Error: `properties.IPConfigurations` was nil for Network Interface %q (Resource Group %q)", nicID.NetworkInterfaceName, nicID.ResourceGroup)
-	}
+		c := FindNetworkInterfaceIPConfiguration(nicProps.IPConfigurations, nicID.IpConfigurationName)
+		if c == nil {
+			return fmt.Errorf("Error: IP Configuration %q was not found on Network Interface %q (Resource Group %q)", nicID.IpConfigurationName, nicID.NetworkInterfaceName, nicID.ResourceGroup)
+		}
+		config := *c
 
-	c := FindNetworkInterfaceIPConfiguration(nicProps.IPConfigurations, nicID.IpConfigurationName)
-	if c == nil {
-		return fmt.Errorf("Error: IP Configuration %q was not found on Network Interface %q (Resource Group %q)", nicID.IpConfigurationName, nicID.NetworkInterfaceName, nicID.ResourceGroup)
-	}
-	config := *c
+		props := config.InterfaceIPConfigurationPropertiesFormat
+		if props == nil {
+			return fmt.Errorf("Error: Properties for IPConfiguration %q was nil for Network Interface %q (Resource Group %q)", nicID.IpConfigurationName, nicID.NetworkInterfaceName, nicID.ResourceGroup)
+		}
 
-	props := config.InterfaceIPConfigurationPropertiesFormat
-	if props == nil {
-		return fmt.Errorf("Error: Properties for IPConfiguration %q was nil for Network Interface %q (Resource Group %q)", nicID.IpConfigurationName, nicID.NetworkInterfaceName, nicID.ResourceGroup)
-	}
+		backendAddressPools := make([]network.BackendAddressPool, 0)
+		if backendPools := props.LoadBalancerBackendAddressPools; backendPools != nil {
+			for _, pool := range *backendPools {
+				if pool.ID == nil {
+					continue
+				}
 
-	backendAddressPools := make([]network.BackendAddressPool, 0)
-	if backendPools := props.LoadBalancerBackendAddressPools; backendPools != nil {
-		for _, pool := range *backendPools {
-			if pool.ID == nil {
-				continue
+				if *pool.ID != backendAddressPoolId {
+					backendAddressPools = append(backendAddressPools, pool)
+				}
 			}
+		}
+		props.LoadBalancerBackendAddressPools = &backendAddressPools
+		nicProps.IPConfigurations = updateNetworkInterfaceIPConfiguration(config, nicProps.IPConfigurations)
 
-			if *pool.ID != backendAddressPoolId {
-				backendAddressPools = append(backendAddressPools, pool)
-			}
+		future, err := client.CreateOrUpdate(ctx, nicID.ResourceGroup, nicID.NetworkInterfaceName, read)
+		if err != nil {
+			return fmt.Errorf("removing Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", nicID.NetworkInterfaceName, nicID.ResourceGroup, err)
 		}
-	}
-	props.LoadBalancerBackendAddressPools = &backendAddressPools
-	nicProps.IPConfigurations = updateNetworkInterfaceIPConfiguration(config, nicProps.IPConfigurations)
 
-	future, err := client.CreateOrUpdate(ctx, nicID.ResourceGroup, nicID.NetworkInterfaceName, read)
-	if err != nil {
-		return fmt.Errorf("removing Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", nicID.NetworkInterfaceName, nicID.ResourceGroup, err)
-	}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for removal of Backend Address Pool Association for NIC %q (Resource Group %q): %+v", nicID.NetworkInterfaceName, nicID.ResourceGroup, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for removal of Backend Address Pool Association for NIC %q (Resource Group %q): %+v", nicID.NetworkInterfaceName, nicID.ResourceGroup, err)
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	return nil
@@ -29,7 +29,28 @@ func TestAccNetworkInterfaceBackendAddressPoolAssociation_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccNetworkInterfaceBackendAddressPoolAssociation_preDeleteDelay(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_interface_backend_address_pool_association", "test")
+	r := NetworkInterfaceBackendAddressPoolResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.preDeleteDelay(data, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pre_delete_delay_in_seconds").HasValue("30"),
+			),
+		},
+		{
+			Config: r.preDeleteDelay(data, 60),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pre_delete_delay_in_seconds").HasValue("60"),
+			),
+		},
 	})
 }
 
@@ -79,14 +100,14 @@ func TestAccNetworkInterfaceBackendAddressPoolAssociation_updateNIC(t *testing.T
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.updateNIC(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -200,6 +221,31 @@ resource "azurestack_network_interface_backend_address_pool_association" "test"
 `, r.template(data), data.RandomInteger)
 }
 
+func (r NetworkInterfaceBackendAddressPoolResource) preDeleteDelay(data acceptance.TestData, seconds int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurestack_network_interface_backend_address_pool_association" "test" {
+  network_interface_id        = azurestack_network_interface.test.id
+  ip_configuration_name       = "testconfiguration1"
+  backend_address_pool_id     = azurestack_lb_backend_address_pool.test.id
+  pre_delete_delay_in_seconds = %d
+}
+`, r.template(data), data.RandomInteger, seconds)
+}
+
 func (r NetworkInterfaceBackendAddressPoolResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
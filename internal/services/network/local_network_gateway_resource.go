@@ -129,24 +129,36 @@ func localNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	// There is a bug in the provider where the address space ordering doesn't change as expected.
 	// In the UI we have to remove the current list of addresses in the address space and re-add them in the new order and we'll copy that here.
 	if !d.IsNewResource() && d.HasChange("address_space") {
+		if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, gateway)
+			if err != nil {
+				return fmt.Errorf("removing %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for completion of %s: %+v", id, err)
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	gateway.LocalNetworkGatewayPropertiesFormat.LocalNetworkAddressSpace = expandLocalNetworkGatewayAddressSpaces(d)
+
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
 		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, gateway)
 		if err != nil {
-			return fmt.Errorf("removing %s: %+v", id, err)
+			return fmt.Errorf("creating %s: %+v", id, err)
 		}
 
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
 			return fmt.Errorf("waiting for completion of %s: %+v", id, err)
 		}
-	}
-	gateway.LocalNetworkGatewayPropertiesFormat.LocalNetworkAddressSpace = expandLocalNetworkGatewayAddressSpaces(d)
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, gateway)
-	if err != nil {
-		return fmt.Errorf("creating %s: %+v", id, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of %s: %+v", id, err)
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
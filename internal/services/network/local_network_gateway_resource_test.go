@@ -28,7 +28,7 @@ func TestAccLocalNetworkGateway_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("address_space.0").HasValue("127.0.0.0/8"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -75,7 +75,7 @@ func TestAccLocalNetworkGateway_tags(t *testing.T) {
 				check.That(data.ResourceName).Key("tags.environment").HasValue("acctest"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -93,7 +93,7 @@ func TestAccLocalNetworkGateway_bgpSettings(t *testing.T) {
 				check.That(data.ResourceName).Key("bgp_settings.#").HasValue("1"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -170,7 +170,7 @@ func TestAccLocalNetworkGateway_bgpSettingsComplete(t *testing.T) {
 				check.That(data.ResourceName).Key("bgp_settings.0.peer_weight").HasValue("15"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -185,21 +185,21 @@ func TestAccLocalNetworkGateway_updateAddressSpace(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.multipleAddressSpace(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.multipleAddressSpaceUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
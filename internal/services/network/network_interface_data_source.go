@@ -2,8 +2,10 @@ package network
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
@@ -107,6 +109,16 @@ func networkInterfaceDataSource() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeBool,
 							Computed: true,
 						},
+
+						"gateway_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"subnet_prefix_length": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -155,6 +167,7 @@ func networkInterfaceDataSource() *pluginsdk.Resource {
 
 func networkInterfaceDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.InterfacesClient
+	subnetsClient := meta.(*clients.Client).Network.SubnetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -201,7 +214,12 @@ func networkInterfaceDataSourceRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `private_ip_addresses`: %+v", err)
 		}
 
-		if err := d.Set("ip_configuration", flattenNetworkInterfaceIPConfigurations(props.IPConfigurations)); err != nil {
+		subnets, err := retrieveNetworkInterfaceSubnetInformation(ctx, subnetsClient, props.IPConfigurations)
+		if err != nil {
+			return fmt.Errorf("retrieving the Subnets used by %s: %+v", id, err)
+		}
+
+		if err := d.Set("ip_configuration", flattenNetworkInterfaceIPConfigurations(props.IPConfigurations, subnets)); err != nil {
 			return fmt.Errorf("setting `ip_configuration`: %+v", err)
 		}
 
@@ -238,3 +256,64 @@ func networkInterfaceDataSourceRead(d *pluginsdk.ResourceData, meta interface{})
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
+
+func flattenNetworkInterfaceIPConfigurations(input *[]network.InterfaceIPConfiguration, subnets map[string]networkInterfaceSubnetInformation) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make([]interface{}, 0)
+	for _, ipConfig := range *input {
+		props := ipConfig.InterfaceIPConfigurationPropertiesFormat
+
+		name := ""
+		if ipConfig.Name != nil {
+			name = *ipConfig.Name
+		}
+
+		subnetId := ""
+		gatewayIPAddress := ""
+		subnetPrefixLength := 0
+		if props.Subnet != nil && props.Subnet.ID != nil {
+			subnetId = *props.Subnet.ID
+
+			if subnet, ok := subnets[strings.ToLower(subnetId)]; ok {
+				gatewayIPAddress = subnet.gatewayIPAddress
+				subnetPrefixLength = subnet.prefixLength
+			}
+		}
+
+		privateIPAddress := ""
+		if props.PrivateIPAddress != nil {
+			privateIPAddress = *props.PrivateIPAddress
+		}
+
+		privateIPAddressVersion := ""
+		if props.PrivateIPAddressVersion != "" {
+			privateIPAddressVersion = string(props.PrivateIPAddressVersion)
+		}
+
+		publicIPAddressId := ""
+		if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
+			publicIPAddressId = *props.PublicIPAddress.ID
+		}
+
+		primary := false
+		if props.Primary != nil {
+			primary = *props.Primary
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":                          name,
+			"gateway_ip_address":            gatewayIPAddress,
+			"primary":                       primary,
+			"private_ip_address":            privateIPAddress,
+			"private_ip_address_allocation": string(props.PrivateIPAllocationMethod),
+			"private_ip_address_version":    privateIPAddressVersion,
+			"public_ip_address_id":          publicIPAddressId,
+			"subnet_id":                     subnetId,
+			"subnet_prefix_length":          subnetPrefixLength,
+		})
+	}
+	return result
+}
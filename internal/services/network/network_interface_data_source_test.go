@@ -22,6 +22,8 @@ func TestAccDataSourceArmNetworkInterface_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("private_ip_address").HasValue("10.0.2.15"),
+				check.That(data.ResourceName).Key("ip_configuration.0.gateway_ip_address").HasValue("10.0.2.1"),
+				check.That(data.ResourceName).Key("ip_configuration.0.subnet_prefix_length").HasValue("24"),
 			),
 		},
 	})
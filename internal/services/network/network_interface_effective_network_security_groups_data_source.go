@@ -0,0 +1,237 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func networkInterfaceEffectiveNetworkSecurityGroupsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: networkInterfaceEffectiveNetworkSecurityGroupsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"network_security_group": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"network_security_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"associated_subnet_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"associated_network_interface_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"security_rule": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"priority": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"direction": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"access": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"source_port_ranges": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"destination_port_ranges": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"source_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"destination_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"expanded_source_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+
+									"expanded_destination_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func networkInterfaceEffectiveNetworkSecurityGroupsDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	future, err := client.ListEffectiveNetworkSecurityGroups(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving the Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+		return fmt.Errorf("waiting for the Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the Effective Network Security Groups for %s - check that the Network Interface is attached to a running Virtual Machine: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("network_interface_id", id.ID())
+
+	if err := d.Set("network_security_group", flattenNetworkInterfaceEffectiveNetworkSecurityGroups(resp.Value)); err != nil {
+		return fmt.Errorf("setting `network_security_group`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkInterfaceEffectiveNetworkSecurityGroups(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, group := range *input {
+		networkSecurityGroupId := ""
+		if group.NetworkSecurityGroup != nil && group.NetworkSecurityGroup.ID != nil {
+			networkSecurityGroupId = *group.NetworkSecurityGroup.ID
+		}
+
+		subnetId := ""
+		networkInterfaceId := ""
+		if association := group.Association; association != nil {
+			if association.Subnet != nil && association.Subnet.ID != nil {
+				subnetId = *association.Subnet.ID
+			}
+			if association.NetworkInterface != nil && association.NetworkInterface.ID != nil {
+				networkInterfaceId = *association.NetworkInterface.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"network_security_group_id":       networkSecurityGroupId,
+			"associated_subnet_id":            subnetId,
+			"associated_network_interface_id": networkInterfaceId,
+			"security_rule":                   flattenNetworkInterfaceEffectiveSecurityRules(group.EffectiveSecurityRules),
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkInterfaceEffectiveSecurityRules(input *[]network.EffectiveNetworkSecurityRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	// the API returns either the singular or the plural form of each field, so these are combined
+	combine := func(single *string, multiple *[]string) []interface{} {
+		output := utils.FlattenStringSlice(multiple)
+		if single != nil && *single != "" {
+			output = append([]interface{}{*single}, output...)
+		}
+		return output
+	}
+
+	for _, rule := range *input {
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		priority := 0
+		if rule.Priority != nil {
+			priority = int(*rule.Priority)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                                  name,
+			"priority":                              priority,
+			"direction":                             string(rule.Direction),
+			"access":                                string(rule.Access),
+			"protocol":                              string(rule.Protocol),
+			"source_port_ranges":                    combine(rule.SourcePortRange, rule.SourcePortRanges),
+			"destination_port_ranges":               combine(rule.DestinationPortRange, rule.DestinationPortRanges),
+			"source_address_prefixes":               combine(rule.SourceAddressPrefix, rule.SourceAddressPrefixes),
+			"destination_address_prefixes":          combine(rule.DestinationAddressPrefix, rule.DestinationAddressPrefixes),
+			"expanded_source_address_prefixes":      utils.FlattenStringSlice(rule.ExpandedSourceAddressPrefix),
+			"expanded_destination_address_prefixes": utils.FlattenStringSlice(rule.ExpandedDestinationAddressPrefix),
+		})
+	}
+
+	return results
+}
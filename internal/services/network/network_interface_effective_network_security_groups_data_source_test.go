@@ -0,0 +1,38 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type NetworkInterfaceEffectiveNetworkSecurityGroupsDataSource struct{}
+
+func TestAccDataSourceNetworkInterfaceEffectiveNetworkSecurityGroups_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_network_interface_effective_network_security_groups", "test")
+	r := NetworkInterfaceEffectiveNetworkSecurityGroupsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("network_interface_id").Exists(),
+				check.That(data.ResourceName).Key("network_security_group.#").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkInterfaceEffectiveNetworkSecurityGroupsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_network_interface_effective_network_security_groups" "test" {
+  network_interface_id = azurestack_network_interface.test.id
+
+  depends_on = [azurestack_virtual_machine.test]
+}
+`, NetworkInterfaceEffectiveRoutesDataSource{}.template(data))
+}
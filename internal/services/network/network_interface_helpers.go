@@ -1,8 +1,15 @@
 package network
 
 import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
 )
 
 type networkInterfaceUpdateInformation struct {
@@ -171,3 +178,115 @@ func updateNetworkInterfaceIPConfiguration(config network.InterfaceIPConfigurati
 
 	return &output
 }
+
+// removeLoadBalancerAssociationsFromIPConfigurations removes the Load Balancer Backend Address Pool and Inbound NAT Rule
+// associations from each IP Configuration, since these can be defined outside of the Network Interface (for example
+// using the `azurestack_network_interface_backend_address_pool_association` resource in a different module)
+func removeLoadBalancerAssociationsFromIPConfigurations(input *[]network.InterfaceIPConfiguration) *[]network.InterfaceIPConfiguration {
+	if input == nil {
+		return input
+	}
+
+	for _, config := range *input {
+		if config.InterfaceIPConfigurationPropertiesFormat == nil {
+			continue
+		}
+
+		config.LoadBalancerBackendAddressPools = &[]network.BackendAddressPool{}
+		config.LoadBalancerInboundNatRules = &[]network.InboundNatRule{}
+	}
+
+	return input
+}
+
+// loadBalancerAssociationsDeletionHint returns additional context for an error raised when deleting a Network Interface
+// which is still associated with a Load Balancer, since the association can otherwise be difficult to track down
+func loadBalancerAssociationsDeletionHint(ids []string) string {
+	if len(ids) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+
+The Network Interface is still associated with the following Load Balancer Backend Address Pools
+and/or Inbound NAT Rules, which may be preventing it from being deleted:
+
+* %s
+
+These associations can be removed automatically before the Network Interface is deleted by setting
+'remove_load_balancer_associations_during_deletion' to 'true' within the 'network_interface' block
+of the 'features' block in the Provider.`, strings.Join(ids, "\n* "))
+}
+
+type networkInterfaceSubnetInformation struct {
+	gatewayIPAddress string
+	prefixLength     int
+}
+
+// retrieveNetworkInterfaceSubnetInformation looks up the Subnet used by each IP Configuration and returns the
+// gateway and prefix length of each, keyed by the (lower-cased) Subnet ID, since these aren't returned by the
+// Network Interface API but are needed to configure static networking within a Virtual Machine
+func retrieveNetworkInterfaceSubnetInformation(ctx context.Context, client *network.SubnetsClient, input *[]network.InterfaceIPConfiguration) (map[string]networkInterfaceSubnetInformation, error) {
+	output := make(map[string]networkInterfaceSubnetInformation)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, config := range *input {
+		props := config.InterfaceIPConfigurationPropertiesFormat
+		if props == nil || props.Subnet == nil || props.Subnet.ID == nil {
+			continue
+		}
+
+		key := strings.ToLower(*props.Subnet.ID)
+		if _, ok := output[key]; ok {
+			continue
+		}
+
+		id, err := parse.SubnetIDInsensitively(*props.Subnet.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		subnet, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		if subnet.SubnetPropertiesFormat == nil || subnet.SubnetPropertiesFormat.AddressPrefix == nil {
+			return nil, fmt.Errorf("retrieving %s: `properties.addressPrefix` was nil", *id)
+		}
+
+		gatewayIPAddress, prefixLength, err := subnetGatewayAndPrefixLength(*subnet.SubnetPropertiesFormat.AddressPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the address prefix for %s: %+v", *id, err)
+		}
+
+		output[key] = networkInterfaceSubnetInformation{
+			gatewayIPAddress: gatewayIPAddress,
+			prefixLength:     prefixLength,
+		}
+	}
+
+	return output, nil
+}
+
+// subnetGatewayAndPrefixLength returns the default gateway (the first usable address, which is reserved by
+// the platform) and the prefix length of the specified address prefix
+func subnetGatewayAndPrefixLength(addressPrefix string) (string, int, error) {
+	_, ipNet, err := net.ParseCIDR(addressPrefix)
+	if err != nil {
+		return "", 0, err
+	}
+
+	gateway := make(net.IP, len(ipNet.IP))
+	copy(gateway, ipNet.IP)
+	for i := len(gateway) - 1; i >= 0; i-- {
+		gateway[i]++
+		if gateway[i] != 0 {
+			break
+		}
+	}
+
+	prefixLength, _ := ipNet.Mask.Size()
+	return gateway.String(), prefixLength, nil
+}
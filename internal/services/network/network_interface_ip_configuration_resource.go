@@ -0,0 +1,387 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
+	networkClient "github.com/hashicorp/terraform-provider-azurestack/internal/services/network/client"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/state"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// networkInterfaceIPConfiguration manages a single (secondary) IP Configuration on a Network Interface, allowing
+// the IP Configurations of a shared Network Interface (for example a Network Virtual Appliance) to be managed
+// independently - each change is merged into the existing Network Interface rather than replacing it.
+func networkInterfaceIPConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: networkInterfaceIPConfigurationCreate,
+		Read:   networkInterfaceIPConfigurationRead,
+		Update: networkInterfaceIPConfigurationUpdate,
+		Delete: networkInterfaceIPConfigurationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkInterfaceIpConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"subnet_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.SubnetID,
+			},
+
+			"private_ip_address_allocation": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Dynamic),
+					string(network.Static),
+				}, true),
+				StateFunc:        state.IgnoreCase,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"private_ip_address": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"private_ip_address_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(network.IPv4),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPv4),
+				}, false),
+			},
+
+			"public_ip_address_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.Any(validation.StringIsEmpty, validate.PublicIpAddressID),
+			},
+
+			"primary": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func networkInterfaceIPConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	nicId, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkInterfaceIpConfigurationID(nicId.SubscriptionId, nicId.ResourceGroup, nicId.Name, d.Get("name").(string))
+
+	locks.ByID(nicId.ID())
+	defer locks.UnlockByID(nicId.ID())
+
+	attempted := false
+	err = updateNetworkInterfaceIPConfigurations(ctx, meta.(*clients.Client).Network, *nicId, func(props *network.InterfacePropertiesFormat) (bool, error) {
+		if FindNetworkInterfaceIPConfiguration(props.IPConfigurations, id.IpConfigurationName) != nil {
+			// a previous attempt may have been applied, despite failing due to a conflicting operation
+			if attempted {
+				return false, nil
+			}
+
+			return false, tf.ImportAsExistsError("azurestack_network_interface_ip_configuration", id.ID())
+		}
+		attempted = true
+
+		config := network.InterfaceIPConfiguration{
+			Name: pointer.FromString(id.IpConfigurationName),
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				Primary: pointer.FromBool(false),
+			},
+		}
+		expandNetworkInterfaceIPConfigurationProperties(d, config.InterfaceIPConfigurationPropertiesFormat)
+
+		ipConfigs := make([]network.InterfaceIPConfiguration, 0)
+		if props.IPConfigurations != nil {
+			ipConfigs = append(ipConfigs, *props.IPConfigurations...)
+		}
+		ipConfigs = append(ipConfigs, config)
+		props.IPConfigurations = &ipConfigs
+
+		return true, nil
+	})
+	if err != nil {
+		if errors.Is(err, errNetworkInterfaceNotFound) {
+			return fmt.Errorf("%s was not found!", *nicId)
+		}
+
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return networkInterfaceIPConfigurationRead(d, meta)
+}
+
+func networkInterfaceIPConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceIpConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	nic, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(nic.Response) {
+			log.Printf("[DEBUG] Network Interface %q (Resource Group %q) was not found - removing from state!", id.NetworkInterfaceName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Network Interface %q (Resource Group %q): %+v", id.NetworkInterfaceName, id.ResourceGroup, err)
+	}
+
+	if nic.InterfacePropertiesFormat == nil {
+		return fmt.Errorf("retrieving Network Interface %q (Resource Group %q): `properties` was nil", id.NetworkInterfaceName, id.ResourceGroup)
+	}
+
+	config := FindNetworkInterfaceIPConfiguration(nic.InterfacePropertiesFormat.IPConfigurations, id.IpConfigurationName)
+	if config == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	nicId := parse.NewNetworkInterfaceID(id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName)
+	d.Set("name", id.IpConfigurationName)
+	d.Set("network_interface_id", nicId.ID())
+
+	if props := config.InterfaceIPConfigurationPropertiesFormat; props != nil {
+		subnetId := ""
+		if props.Subnet != nil && props.Subnet.ID != nil {
+			subnetId = *props.Subnet.ID
+		}
+		d.Set("subnet_id", subnetId)
+
+		publicIPAddressId := ""
+		if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
+			publicIPAddressId = *props.PublicIPAddress.ID
+		}
+		d.Set("public_ip_address_id", publicIPAddressId)
+
+		d.Set("private_ip_address", props.PrivateIPAddress)
+		d.Set("private_ip_address_allocation", string(props.PrivateIPAllocationMethod))
+		d.Set("private_ip_address_version", string(props.PrivateIPAddressVersion))
+
+		primary := false
+		if props.Primary != nil {
+			primary = *props.Primary
+		}
+		d.Set("primary", primary)
+	}
+
+	return nil
+}
+
+func networkInterfaceIPConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceIpConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	nicId := parse.NewNetworkInterfaceID(id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName)
+	locks.ByID(nicId.ID())
+	defer locks.UnlockByID(nicId.ID())
+
+	err = updateNetworkInterfaceIPConfigurations(ctx, meta.(*clients.Client).Network, nicId, func(props *network.InterfacePropertiesFormat) (bool, error) {
+		c := FindNetworkInterfaceIPConfiguration(props.IPConfigurations, id.IpConfigurationName)
+		if c == nil {
+			return false, fmt.Errorf("%s was not found", *id)
+		}
+
+		// the existing IP Configuration is updated in-place so that fields managed in other resources (such as the
+		// Load Balancer Backend Address Pool associations) are retained
+		config := *c
+		if config.InterfaceIPConfigurationPropertiesFormat == nil {
+			config.InterfaceIPConfigurationPropertiesFormat = &network.InterfaceIPConfigurationPropertiesFormat{}
+		}
+		expandNetworkInterfaceIPConfigurationProperties(d, config.InterfaceIPConfigurationPropertiesFormat)
+		props.IPConfigurations = updateNetworkInterfaceIPConfiguration(config, props.IPConfigurations)
+
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return networkInterfaceIPConfigurationRead(d, meta)
+}
+
+func networkInterfaceIPConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceIpConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	nicId := parse.NewNetworkInterfaceID(id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName)
+	locks.ByID(nicId.ID())
+	defer locks.UnlockByID(nicId.ID())
+
+	err = updateNetworkInterfaceIPConfigurations(ctx, meta.(*clients.Client).Network, nicId, func(props *network.InterfacePropertiesFormat) (bool, error) {
+		if props.IPConfigurations == nil {
+			return false, nil
+		}
+
+		ipConfigs := make([]network.InterfaceIPConfiguration, 0)
+		for _, config := range *props.IPConfigurations {
+			if config.Name == nil || *config.Name != id.IpConfigurationName {
+				ipConfigs = append(ipConfigs, config)
+				continue
+			}
+
+			if config.InterfaceIPConfigurationPropertiesFormat != nil && config.Primary != nil && *config.Primary {
+				return false, fmt.Errorf("the Primary IP Configuration of a Network Interface can't be removed")
+			}
+		}
+
+		if len(ipConfigs) == len(*props.IPConfigurations) {
+			return false, nil
+		}
+		props.IPConfigurations = &ipConfigs
+
+		return true, nil
+	})
+	if err != nil && !errors.Is(err, errNetworkInterfaceNotFound) {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetworkInterfaceIPConfigurationProperties(d *pluginsdk.ResourceData, props *network.InterfaceIPConfigurationPropertiesFormat) {
+	props.PrivateIPAllocationMethod = network.IPAllocationMethod(d.Get("private_ip_address_allocation").(string))
+	props.PrivateIPAddressVersion = network.IPVersion(d.Get("private_ip_address_version").(string))
+	props.Subnet = &network.Subnet{
+		ID: pointer.FromString(d.Get("subnet_id").(string)),
+	}
+
+	props.PrivateIPAddress = nil
+	if strings.EqualFold(string(props.PrivateIPAllocationMethod), string(network.Static)) {
+		if v := d.Get("private_ip_address").(string); v != "" {
+			props.PrivateIPAddress = pointer.FromString(v)
+		}
+	}
+
+	props.PublicIPAddress = nil
+	if v := d.Get("public_ip_address_id").(string); v != "" {
+		props.PublicIPAddress = &network.PublicIPAddress{
+			ID: pointer.FromString(v),
+		}
+	}
+}
+
+// errNetworkInterfaceNotFound is returned from updateNetworkInterfaceIPConfigurations when the Network Interface doesn't exist
+var errNetworkInterfaceNotFound = errors.New("the Network Interface was not found")
+
+// updateNetworkInterfaceIPConfigurations retrieves the Network Interface, applies the change to its IP Configurations
+// using `update` and then submits it, holding the locks on the Subnets and Virtual Networks referenced by any of them.
+// Each attempt made when this fails due to a conflicting operation retrieves the Network Interface again, so that a
+// retry is built from its latest version rather than overwriting the conflicting change. The caller is expected to
+// hold the lock on the Network Interface itself.
+//
+// `update` returns false when the Network Interface doesn't need to be updated.
+func updateNetworkInterfaceIPConfigurations(ctx context.Context, networkClient *networkClient.Client, id parse.NetworkInterfaceId, update func(props *network.InterfacePropertiesFormat) (bool, error)) error {
+	client := networkClient.InterfacesClient
+	return networkClient.RetryOnConflict(ctx, func() error {
+		nic, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(nic.Response) {
+				return errNetworkInterfaceNotFound
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if nic.InterfacePropertiesFormat == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", id)
+		}
+
+		changed, err := update(nic.InterfacePropertiesFormat)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+
+		lockingDetails, err := determineResourcesToLockFromIPConfiguration(nic.InterfacePropertiesFormat.IPConfigurations)
+		if err != nil {
+			return fmt.Errorf("determining locking details: %+v", err)
+		}
+
+		lockingDetails.lock()
+		defer lockingDetails.unlock()
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, nic)
+		if err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+
+		if err := lro.WaitForCompletion(ctx, future, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of %s: %+v", id, err)
+		}
+
+		return nil
+	})
+}
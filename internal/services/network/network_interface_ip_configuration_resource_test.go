@@ -0,0 +1,225 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type NetworkInterfaceIPConfigurationResource struct{}
+
+func TestAccNetworkInterfaceIPConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_interface_ip_configuration", "test")
+	r := NetworkInterfaceIPConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary").HasValue("false"),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccNetworkInterfaceIPConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_interface_ip_configuration", "test")
+	r := NetworkInterfaceIPConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkInterfaceIPConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_interface_ip_configuration", "test")
+	r := NetworkInterfaceIPConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+		{
+			Config: r.static(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_ip_address").HasValue("10.0.2.10"),
+			),
+		},
+		data.ImportStepStrict(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccNetworkInterfaceIPConfiguration_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_interface_ip_configuration", "test")
+	r := NetworkInterfaceIPConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurestack_network_interface_ip_configuration.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func (NetworkInterfaceIPConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkInterfaceIpConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.InterfacesClient.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Network Interface %q (Resource Group %q): %+v", id.NetworkInterfaceName, id.ResourceGroup, err)
+	}
+
+	if resp.InterfacePropertiesFormat == nil {
+		return nil, fmt.Errorf("`properties` was nil for Network Interface %q (Resource Group %q)", id.NetworkInterfaceName, id.ResourceGroup)
+	}
+
+	config := network.FindNetworkInterfaceIPConfiguration(resp.InterfacePropertiesFormat.IPConfigurations, id.IpConfigurationName)
+	return pointer.FromBool(config != nil), nil
+}
+
+func (r NetworkInterfaceIPConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_interface_ip_configuration" "test" {
+  name                          = "secondary"
+  network_interface_id          = azurestack_network_interface.test.id
+  subnet_id                     = azurestack_subnet.test.id
+  private_ip_address_allocation = "Dynamic"
+}
+`, r.template(data))
+}
+
+func (r NetworkInterfaceIPConfigurationResource) static(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_interface_ip_configuration" "test" {
+  name                          = "secondary"
+  network_interface_id          = azurestack_network_interface.test.id
+  subnet_id                     = azurestack_subnet.test.id
+  private_ip_address_allocation = "Static"
+  private_ip_address            = "10.0.2.10"
+  public_ip_address_id          = azurestack_public_ip.test.id
+}
+`, r.template(data))
+}
+
+func (r NetworkInterfaceIPConfigurationResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_interface_ip_configuration" "test" {
+  name                          = "secondary"
+  network_interface_id          = azurestack_network_interface.test.id
+  subnet_id                     = azurestack_subnet.test.id
+  private_ip_address_allocation = "Dynamic"
+}
+
+resource "azurestack_network_interface_ip_configuration" "second" {
+  name                          = "tertiary"
+  network_interface_id          = azurestack_network_interface.test.id
+  subnet_id                     = azurestack_subnet.test.id
+  private_ip_address_allocation = "Dynamic"
+}
+`, r.template(data))
+}
+
+func (r NetworkInterfaceIPConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_interface_ip_configuration" "import" {
+  name                          = azurestack_network_interface_ip_configuration.test.name
+  network_interface_id          = azurestack_network_interface_ip_configuration.test.network_interface_id
+  subnet_id                     = azurestack_network_interface_ip_configuration.test.subnet_id
+  private_ip_address_allocation = azurestack_network_interface_ip_configuration.test.private_ip_address_allocation
+}
+`, r.basic(data))
+}
+
+func (NetworkInterfaceIPConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "testsubnet"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "acctestpip-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Static"
+}
+
+resource "azurestack_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+    primary                       = true
+  }
+
+  lifecycle {
+    ignore_changes = [ip_configuration]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
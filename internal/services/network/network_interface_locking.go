@@ -8,30 +8,33 @@ import (
 )
 
 type networkInterfaceIPConfigurationLockingDetails struct {
-	subnetNamesToLock         []string
-	virtualNetworkNamesToLock []string
+	subnetIDsToLock         []string
+	virtualNetworkIDsToLock []string
 }
 
+// lock acquires a shared lock on each Subnet and Virtual Network referenced by the IP Configurations,
+// since these are referenced (rather than modified) by the Network Interface - meaning multiple
+// Network Interfaces within the same Subnet can be provisioned in parallel
 func (details networkInterfaceIPConfigurationLockingDetails) lock() {
-	locks.MultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.MultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	locks.ReadMultipleByID(&details.virtualNetworkIDsToLock)
+	locks.ReadMultipleByID(&details.subnetIDsToLock)
 }
 
 func (details networkInterfaceIPConfigurationLockingDetails) unlock() {
-	locks.UnlockMultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.UnlockMultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	locks.ReadUnlockMultipleByID(&details.subnetIDsToLock)
+	locks.ReadUnlockMultipleByID(&details.virtualNetworkIDsToLock)
 }
 
 func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPConfiguration) (*networkInterfaceIPConfigurationLockingDetails, error) {
 	if input == nil {
 		return &networkInterfaceIPConfigurationLockingDetails{
-			subnetNamesToLock:         []string{},
-			virtualNetworkNamesToLock: []string{},
+			subnetIDsToLock:         []string{},
+			virtualNetworkIDsToLock: []string{},
 		}, nil
 	}
 
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
+	subnetIDsToLock := make([]string, 0)
+	virtualNetworkIDsToLock := make([]string, 0)
 
 	for _, config := range *input {
 		if config.Subnet == nil || config.Subnet.ID == nil {
@@ -43,20 +46,20 @@ func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPCon
 			return nil, err
 		}
 
-		virtualNetworkName := id.VirtualNetworkName
-		subnetName := id.Name
+		virtualNetworkID := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName).ID()
+		subnetID := id.ID()
 
-		if !utils.SliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
+		if !utils.SliceContainsValue(virtualNetworkIDsToLock, virtualNetworkID) {
+			virtualNetworkIDsToLock = append(virtualNetworkIDsToLock, virtualNetworkID)
 		}
 
-		if !utils.SliceContainsValue(subnetNamesToLock, subnetName) {
-			subnetNamesToLock = append(subnetNamesToLock, subnetName)
+		if !utils.SliceContainsValue(subnetIDsToLock, subnetID) {
+			subnetIDsToLock = append(subnetIDsToLock, subnetID)
 		}
 	}
 
 	return &networkInterfaceIPConfigurationLockingDetails{
-		subnetNamesToLock:         subnetNamesToLock,
-		virtualNetworkNamesToLock: virtualNetworkNamesToLock,
+		subnetIDsToLock:         subnetIDsToLock,
+		virtualNetworkIDsToLock: virtualNetworkIDsToLock,
 	}, nil
 }
@@ -1,8 +1,10 @@
 package network
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
@@ -11,518 +13,638 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
-	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
-	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/sdk"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/state"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/suppress"
-	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
 )
 
-func networkInterface() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
-		Create: networkInterfaceCreate,
-		Read:   networkInterfaceRead,
-		Update: networkInterfaceUpdate,
-		Delete: networkInterfaceDelete,
-
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.NetworkInterfaceID(id)
-			return err
-		}),
-
-		Timeouts: &pluginsdk.ResourceTimeout{
-			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
-			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
-			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
-			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+type NetworkInterfaceModel struct {
+	Name                     string                                 `tfschema:"name"`
+	Location                 string                                 `tfschema:"location"`
+	ResourceGroupName        string                                 `tfschema:"resource_group_name"`
+	IPConfigurations         []NetworkInterfaceIPConfigurationModel `tfschema:"ip_configuration"`
+	DNSServers               []string                               `tfschema:"dns_servers"`
+	EnableIPForwarding       bool                                   `tfschema:"enable_ip_forwarding"`
+	InternalDNSNameLabel     string                                 `tfschema:"internal_dns_name_label"`
+	InternalDomainNameSuffix string                                 `tfschema:"internal_domain_name_suffix"`
+	Tags                     map[string]string                      `tfschema:"tags"`
+
+	AppliedDNSServers  []string `tfschema:"applied_dns_servers"`
+	MacAddress         string   `tfschema:"mac_address"`
+	PrivateIPAddress   string   `tfschema:"private_ip_address"`
+	PrivateIPAddresses []string `tfschema:"private_ip_addresses"`
+	VirtualMachineID   string   `tfschema:"virtual_machine_id"`
+}
+
+type NetworkInterfaceIPConfigurationModel struct {
+	Name                       string `tfschema:"name"`
+	SubnetID                   string `tfschema:"subnet_id"`
+	PrivateIPAddress           string `tfschema:"private_ip_address"`
+	PrivateIPAddressVersion    string `tfschema:"private_ip_address_version"`
+	PrivateIPAddressAllocation string `tfschema:"private_ip_address_allocation"`
+	PublicIPAddressID          string `tfschema:"public_ip_address_id"`
+	Primary                    bool   `tfschema:"primary"`
+
+	GatewayIPAddress   string `tfschema:"gateway_ip_address"`
+	SubnetPrefixLength int    `tfschema:"subnet_prefix_length"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = NetworkInterfaceResource{}
+	_ sdk.ResourceWithCustomizeDiff = NetworkInterfaceResource{}
+)
+
+type NetworkInterfaceResource struct{}
+
+func (r NetworkInterfaceResource) ResourceType() string {
+	return "azurestack_network_interface"
+}
+
+func (r NetworkInterfaceResource) ModelObject() interface{} {
+	return &NetworkInterfaceModel{}
+}
+
+func (r NetworkInterfaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkInterfaceID
+}
+
+func (r NetworkInterfaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
 		},
 
-		Schema: map[string]*pluginsdk.Schema{
-			"name": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
+		"location": commonschema.Location(),
+
+		"resource_group_name": commonschema.ResourceGroupName(),
 
-			"location": commonschema.Location(),
-
-			"resource_group_name": commonschema.ResourceGroupName(),
-
-			"ip_configuration": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"subnet_id": {
-							Type:             pluginsdk.TypeString,
-							Optional:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc:     resourceid.ValidateResourceID,
-						},
-
-						"private_ip_address": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-							Computed: true,
-						},
-
-						"private_ip_address_version": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-							Default:  string(network.IPv4),
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IPv4),
-							}, false),
-						},
-
-						"private_ip_address_allocation": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.Dynamic),
-								string(network.Static),
-							}, true),
-							StateFunc:        state.IgnoreCase,
-							DiffSuppressFunc: suppress.CaseDifference,
-						},
-
-						"public_ip_address_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: resourceid.ValidateResourceIDOrEmpty,
-						},
-
-						"primary": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Computed: true,
-						},
+		"ip_configuration": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
 					},
-				},
-			},
 
-			"dns_servers": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				Computed: true,
-				Elem: &pluginsdk.Schema{
-					Type:         pluginsdk.TypeString,
-					ValidateFunc: validation.StringIsNotEmpty,
-				},
-			},
+					"subnet_id": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: suppress.CaseDifference,
+						ValidateFunc:     validate.SubnetID,
+					},
 
-			"enable_ip_forwarding": {
-				Type:     pluginsdk.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
+					"private_ip_address": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Computed: true,
+					},
 
-			"internal_domain_name_suffix": {
-				Type:     pluginsdk.TypeString,
-				Computed: true,
-			},
+					"private_ip_address_version": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(network.IPv4),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.IPv4),
+						}, false),
+					},
+
+					"private_ip_address_allocation": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.Dynamic),
+							string(network.Static),
+						}, true),
+						StateFunc:        state.IgnoreCase,
+						DiffSuppressFunc: suppress.CaseDifference,
+					},
+
+					"public_ip_address_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.Any(validation.StringIsEmpty, validate.PublicIpAddressID),
+					},
 
-			"tags": tags.Schema(),
+					"primary": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
 
-			// Computed
-			"applied_dns_servers": {
-				Type:     pluginsdk.TypeList,
-				Computed: true,
-				Elem: &pluginsdk.Schema{
-					Type: pluginsdk.TypeString,
+					"gateway_ip_address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"subnet_prefix_length": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
 				},
 			},
+		},
 
-			"mac_address": {
-				Type:     pluginsdk.TypeString,
-				Computed: true,
+		"dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			// the order of the DNS Servers is significant (the first is the primary DNS Server), as such reordering
+			// them updates the Network Interface
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
 			},
+		},
 
-			"private_ip_address": {
-				Type:     pluginsdk.TypeString,
-				Computed: true,
-			},
+		"enable_ip_forwarding": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 
-			"private_ip_addresses": {
-				Type:     pluginsdk.TypeList,
-				Computed: true,
-				Elem: &pluginsdk.Schema{
-					Type: pluginsdk.TypeString,
-				},
+		"internal_dns_name_label": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r NetworkInterfaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"applied_dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
 			},
+		},
+
+		"internal_domain_name_suffix": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"mac_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 
-			"virtual_machine_id": {
-				Type:     pluginsdk.TypeString,
-				Computed: true,
+		"private_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"private_ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
 			},
 		},
+
+		"virtual_machine_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
-func networkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
-	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+func (r NetworkInterfaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.InterfacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model NetworkInterfaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
 
-	id := parse.NewNetworkInterfaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
-	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
-		if err != nil {
+			id := parse.NewNetworkInterfaceID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
-		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return tf.ImportAsExistsError("azurestack_network_interface", id.ID())
-		}
-	}
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
 
-	location := location.Normalize(d.Get("location").(string))
-	enableIpForwarding := d.Get("enable_ip_forwarding").(bool)
-	t := d.Get("tags").(map[string]interface{})
+			properties := network.InterfacePropertiesFormat{
+				EnableIPForwarding: pointer.FromBool(model.EnableIPForwarding),
+			}
 
-	properties := network.InterfacePropertiesFormat{
-		EnableIPForwarding: &enableIpForwarding,
-	}
+			if len(model.DNSServers) > 0 || model.InternalDNSNameLabel != "" {
+				properties.DNSSettings = &network.InterfaceDNSSettings{}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+				if len(model.DNSServers) > 0 {
+					properties.DNSSettings.DNSServers = &model.DNSServers
+				}
 
-	dns, hasDns := d.GetOk("dns_servers")
-	if hasDns {
-		dnsSettings := network.InterfaceDNSSettings{}
+				if model.InternalDNSNameLabel != "" {
+					properties.DNSSettings.InternalDNSNameLabel = pointer.FromString(model.InternalDNSNameLabel)
+				}
+			}
 
-		if hasDns {
-			dnsRaw := dns.([]interface{})
-			dns := expandNetworkInterfaceDnsServers(dnsRaw)
-			dnsSettings.DNSServers = &dns
-		}
+			ipConfigs, err := expandNetworkInterfaceIPConfigurations(model.IPConfigurations)
+			if err != nil {
+				return fmt.Errorf("expanding `ip_configuration`: %+v", err)
+			}
+			lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
+			if err != nil {
+				return fmt.Errorf("determining locking details: %+v", err)
+			}
 
-		properties.DNSSettings = &dnsSettings
-	}
+			lockingDetails.lock()
+			defer lockingDetails.unlock()
 
-	ipConfigsRaw := d.Get("ip_configuration").([]interface{})
-	ipConfigs, err := expandNetworkInterfaceIPConfigurations(ipConfigsRaw)
-	if err != nil {
-		return fmt.Errorf("expanding `ip_configuration`: %+v", err)
-	}
-	lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
-	if err != nil {
-		return fmt.Errorf("determining locking details: %+v", err)
-	}
+			if len(*ipConfigs) > 0 {
+				properties.IPConfigurations = ipConfigs
+			}
 
-	lockingDetails.lock()
-	defer lockingDetails.unlock()
+			iface := network.Interface{
+				Name:                      pointer.FromString(id.Name),
+				Location:                  pointer.FromString(location.Normalize(model.Location)),
+				InterfacePropertiesFormat: &properties,
+				Tags:                      tags.FromTypedObject(model.Tags),
+			}
 
-	if len(*ipConfigs) > 0 {
-		properties.IPConfigurations = ipConfigs
-	}
+			if err := metadata.Client.Network.RetryOnConflict(ctx, func() error {
+				future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iface)
+				if err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
 
-	iface := network.Interface{
-		Name:                      pointer.FromString(id.Name),
-		Location:                  pointer.FromString(location),
-		InterfacePropertiesFormat: &properties,
-		Tags:                      tags.Expand(t),
-	}
+				if err := lro.WaitForCompletion(ctx, future, client.Client); err != nil {
+					return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+				}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iface)
-	if err != nil {
-		return fmt.Errorf("creating %s: %+v", id, err)
-	}
+				return nil
+			}); err != nil {
+				return err
+			}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			metadata.SetID(id)
+			return nil
+		},
 	}
-
-	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
-	return networkInterfaceRead(d, meta)
 }
 
-func networkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
-	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+func (r NetworkInterfaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.InterfacesClient
+			subnetsClient := metadata.Client.Network.SubnetsClient
 
-	id, err := parse.NetworkInterfaceID(d.Id())
-	if err != nil {
-		return err
-	}
+			id, err := parse.NetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
 
-	// first get the existing one so that we can pull things as needed
-	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
-	if err != nil {
-		return fmt.Errorf("retrieving %s: %+v", *id, err)
-	}
+			model := NetworkInterfaceModel{
+				Name:               id.Name,
+				ResourceGroupName:  id.ResourceGroup,
+				Location:           location.NormalizeNilable(resp.Location),
+				IPConfigurations:   make([]NetworkInterfaceIPConfigurationModel, 0),
+				DNSServers:         make([]string, 0),
+				AppliedDNSServers:  make([]string, 0),
+				PrivateIPAddresses: make([]string, 0),
+				Tags:               tags.ToTypedObject(resp.Tags),
+			}
 
-	if existing.InterfacePropertiesFormat == nil {
-		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
-	}
+			if props := resp.InterfacePropertiesFormat; props != nil {
+				if configs := props.IPConfigurations; configs != nil {
+					for i, config := range *configs {
+						if ipProps := config.InterfaceIPConfigurationPropertiesFormat; ipProps != nil {
+							v := ipProps.PrivateIPAddress
+							if v == nil {
+								continue
+							}
+
+							if i == 0 {
+								model.PrivateIPAddress = *v
+							}
+
+							model.PrivateIPAddresses = append(model.PrivateIPAddresses, *v)
+						}
+					}
+				}
 
-	// then pull out things we need to lock on
-	info := parseFieldsFromNetworkInterface(*existing.InterfacePropertiesFormat)
+				if dnsSettings := props.DNSSettings; dnsSettings != nil {
+					model.AppliedDNSServers = flattenNetworkInterfaceDnsServers(dnsSettings.AppliedDNSServers)
+					model.DNSServers = flattenNetworkInterfaceDnsServers(dnsSettings.DNSServers)
 
-	location := location.Normalize(d.Get("location").(string))
-	update := network.Interface{
-		Name:     pointer.FromString(id.Name),
-		Location: pointer.FromString(location),
-		InterfacePropertiesFormat: &network.InterfacePropertiesFormat{
-			DNSSettings: &network.InterfaceDNSSettings{},
-		},
-	}
+					if dnsSettings.InternalDNSNameLabel != nil {
+						model.InternalDNSNameLabel = *dnsSettings.InternalDNSNameLabel
+					}
 
-	if d.HasChange("dns_servers") {
-		dnsServersRaw := d.Get("dns_servers").([]interface{})
-		dnsServers := expandNetworkInterfaceDnsServers(dnsServersRaw)
+					if dnsSettings.InternalDomainNameSuffix != nil {
+						model.InternalDomainNameSuffix = *dnsSettings.InternalDomainNameSuffix
+					}
+				}
 
-		update.InterfacePropertiesFormat.DNSSettings.DNSServers = &dnsServers
-	} else {
-		update.InterfacePropertiesFormat.DNSSettings.DNSServers = existing.InterfacePropertiesFormat.DNSSettings.DNSServers
-	}
+				if props.EnableIPForwarding != nil {
+					model.EnableIPForwarding = *props.EnableIPForwarding
+				}
 
-	if d.HasChange("enable_ip_forwarding") {
-		update.InterfacePropertiesFormat.EnableIPForwarding = pointer.FromBool(d.Get("enable_ip_forwarding").(bool))
-	} else {
-		update.InterfacePropertiesFormat.EnableIPForwarding = existing.InterfacePropertiesFormat.EnableIPForwarding
-	}
+				if props.MacAddress != nil {
+					model.MacAddress = *props.MacAddress
+				}
 
-	if d.HasChange("ip_configuration") {
-		ipConfigsRaw := d.Get("ip_configuration").([]interface{})
-		ipConfigs, err := expandNetworkInterfaceIPConfigurations(ipConfigsRaw)
-		if err != nil {
-			return fmt.Errorf("expanding `ip_configuration`: %+v", err)
-		}
-		lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
-		if err != nil {
-			return fmt.Errorf("determining locking details: %+v", err)
-		}
+				if props.VirtualMachine != nil && props.VirtualMachine.ID != nil {
+					model.VirtualMachineID = *props.VirtualMachine.ID
+				}
 
-		lockingDetails.lock()
-		defer lockingDetails.unlock()
+				subnets, err := retrieveNetworkInterfaceSubnetInformation(ctx, subnetsClient, props.IPConfigurations)
+				if err != nil {
+					return fmt.Errorf("retrieving the Subnets used by %s: %+v", *id, err)
+				}
 
-		// then map the fields managed in other resources back
-		ipConfigs = mapFieldsToNetworkInterface(ipConfigs, info)
+				model.IPConfigurations = flattenNetworkInterfaceIPConfigurationsToModel(props.IPConfigurations, subnets)
+			}
 
-		update.InterfacePropertiesFormat.IPConfigurations = ipConfigs
-	} else {
-		update.InterfacePropertiesFormat.IPConfigurations = existing.InterfacePropertiesFormat.IPConfigurations
+			return metadata.Encode(&model)
+		},
 	}
+}
 
-	if d.HasChange("tags") {
-		tagsRaw := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(tagsRaw)
-	} else {
-		update.Tags = existing.Tags
+func (r NetworkInterfaceResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return validateIPConfigurationLocations(ctx, metadata.Client.Network, metadata.ResourceDiff, "ip_configuration")
+		},
 	}
+}
 
-	// this can be managed in another resource, so just port it over
-	update.InterfacePropertiesFormat.NetworkSecurityGroup = existing.InterfacePropertiesFormat.NetworkSecurityGroup
+func (r NetworkInterfaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.InterfacesClient
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, update)
-	if err != nil {
-		return fmt.Errorf("updating %s: %+v", *id, err)
-	}
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
-	}
+			id, err := parse.NetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
 
-	return nil
-}
+			var model NetworkInterfaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
 
-func networkInterfaceRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
-	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
 
-	id, err := parse.NetworkInterfaceID(d.Id())
-	if err != nil {
-		return err
-	}
+			d := metadata.ResourceData
+			if tags.OnlyTagsChanged(d) {
+				future, err := client.UpdateTags(ctx, id.ResourceGroup, id.Name, network.TagsObject{Tags: tags.FromTypedObject(model.Tags)})
+				if err != nil {
+					return fmt.Errorf("updating tags for %s: %+v", *id, err)
+				}
+				if err := lro.WaitForCompletion(ctx, future, client.Client); err != nil {
+					return fmt.Errorf("waiting for update of tags for %s: %+v", *id, err)
+				}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			d.SetId("")
-			return nil
-		}
-		return fmt.Errorf("retrieving %s: %+v", *id, err)
-	}
+				return nil
+			}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
-
-	if props := resp.InterfacePropertiesFormat; props != nil {
-		primaryPrivateIPAddress := ""
-		privateIPAddresses := make([]interface{}, 0)
-		if configs := props.IPConfigurations; configs != nil {
-			for i, config := range *props.IPConfigurations {
-				if ipProps := config.InterfaceIPConfigurationPropertiesFormat; ipProps != nil {
-					v := ipProps.PrivateIPAddress
-					if v == nil {
-						continue
-					}
+			if err := metadata.Client.Network.RetryOnConflict(ctx, func() error {
+				// first get the existing one so that we can pull things as needed - this is retrieved for each attempt, so
+				// that a retry is built from its latest version rather than overwriting the conflicting change
+				existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				if existing.InterfacePropertiesFormat == nil {
+					return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+				}
+
+				// then pull out things we need to lock on
+				info := parseFieldsFromNetworkInterface(*existing.InterfacePropertiesFormat)
+
+				update := network.Interface{
+					Name:     pointer.FromString(id.Name),
+					Location: pointer.FromString(location.Normalize(model.Location)),
+					InterfacePropertiesFormat: &network.InterfacePropertiesFormat{
+						DNSSettings: &network.InterfaceDNSSettings{},
+					},
+				}
+
+				if d.HasChange("dns_servers") {
+					update.InterfacePropertiesFormat.DNSSettings.DNSServers = &model.DNSServers
+				} else if existing.InterfacePropertiesFormat.DNSSettings != nil {
+					update.InterfacePropertiesFormat.DNSSettings.DNSServers = existing.InterfacePropertiesFormat.DNSSettings.DNSServers
+				}
 
-					if i == 0 {
-						primaryPrivateIPAddress = *v
+				if d.HasChange("internal_dns_name_label") {
+					update.InterfacePropertiesFormat.DNSSettings.InternalDNSNameLabel = pointer.FromString(model.InternalDNSNameLabel)
+				} else if existing.InterfacePropertiesFormat.DNSSettings != nil {
+					update.InterfacePropertiesFormat.DNSSettings.InternalDNSNameLabel = existing.InterfacePropertiesFormat.DNSSettings.InternalDNSNameLabel
+				}
+
+				if d.HasChange("enable_ip_forwarding") {
+					update.InterfacePropertiesFormat.EnableIPForwarding = pointer.FromBool(model.EnableIPForwarding)
+				} else {
+					update.InterfacePropertiesFormat.EnableIPForwarding = existing.InterfacePropertiesFormat.EnableIPForwarding
+				}
+
+				if d.HasChange("ip_configuration") {
+					ipConfigs, err := expandNetworkInterfaceIPConfigurations(model.IPConfigurations)
+					if err != nil {
+						return fmt.Errorf("expanding `ip_configuration`: %+v", err)
+					}
+					lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
+					if err != nil {
+						return fmt.Errorf("determining locking details: %+v", err)
 					}
 
-					privateIPAddresses = append(privateIPAddresses, *v)
+					lockingDetails.lock()
+					defer lockingDetails.unlock()
+
+					// then map the fields managed in other resources back
+					ipConfigs = mapFieldsToNetworkInterface(ipConfigs, info)
+
+					update.InterfacePropertiesFormat.IPConfigurations = ipConfigs
+				} else {
+					update.InterfacePropertiesFormat.IPConfigurations = existing.InterfacePropertiesFormat.IPConfigurations
 				}
-			}
-		}
 
-		appliedDNSServers := make([]string, 0)
-		dnsServers := make([]string, 0)
-		internalDomainNameSuffix := ""
-		if dnsSettings := props.DNSSettings; dnsSettings != nil {
-			appliedDNSServers = flattenNetworkInterfaceDnsServers(dnsSettings.AppliedDNSServers)
-			dnsServers = flattenNetworkInterfaceDnsServers(dnsSettings.DNSServers)
+				if d.HasChange("tags") {
+					update.Tags = tags.FromTypedObject(model.Tags)
+				} else {
+					update.Tags = existing.Tags
+				}
+
+				// this can be managed in another resource, so just port it over
+				update.InterfacePropertiesFormat.NetworkSecurityGroup = existing.InterfacePropertiesFormat.NetworkSecurityGroup
+
+				future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, update)
+				if err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+
+				if err := lro.WaitForCompletion(ctx, future, client.Client); err != nil {
+					return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+				}
 
-			if dnsSettings.InternalDomainNameSuffix != nil {
-				internalDomainNameSuffix = *dnsSettings.InternalDomainNameSuffix
+				return nil
+			}); err != nil {
+				return err
 			}
-		}
 
-		virtualMachineId := ""
-		if props.VirtualMachine != nil && props.VirtualMachine.ID != nil {
-			virtualMachineId = *props.VirtualMachine.ID
-		}
+			return nil
+		},
+	}
+}
 
-		if err := d.Set("applied_dns_servers", appliedDNSServers); err != nil {
-			return fmt.Errorf("setting `applied_dns_servers`: %+v", err)
-		}
+func (r NetworkInterfaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.InterfacesClient
 
-		if err := d.Set("dns_servers", dnsServers); err != nil {
-			return fmt.Errorf("setting `applied_dns_servers`: %+v", err)
-		}
+			id, err := parse.NetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
 
-		d.Set("enable_ip_forwarding", resp.EnableIPForwarding)
-		d.Set("internal_domain_name_suffix", internalDomainNameSuffix)
-		d.Set("mac_address", props.MacAddress)
-		d.Set("private_ip_address", primaryPrivateIPAddress)
-		d.Set("virtual_machine_id", virtualMachineId)
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
 
-		if err := d.Set("ip_configuration", flattenNetworkInterfaceIPConfigurations(props.IPConfigurations)); err != nil {
-			return fmt.Errorf("setting `ip_configuration`: %+v", err)
-		}
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
 
-		if err := d.Set("private_ip_addresses", privateIPAddresses); err != nil {
-			return fmt.Errorf("setting `private_ip_addresses`: %+v", err)
-		}
-	}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
 
-	return tags.FlattenAndSet(d, resp.Tags)
-}
+			if existing.InterfacePropertiesFormat == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			props := *existing.InterfacePropertiesFormat
 
-func networkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.InterfacesClient
-	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+			lockingDetails, err := determineResourcesToLockFromIPConfiguration(props.IPConfigurations)
+			if err != nil {
+				return fmt.Errorf("determining locking details: %+v", err)
+			}
 
-	id, err := parse.NetworkInterfaceID(d.Id())
-	if err != nil {
-		return err
-	}
+			lockingDetails.lock()
+			defer lockingDetails.unlock()
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+			info := parseFieldsFromNetworkInterface(props)
+			loadBalancerAssociationIds := append(info.loadBalancerBackendAddressPoolIDs, info.loadBalancerInboundNatRuleIDs...)
+			sort.Strings(loadBalancerAssociationIds)
 
-	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(existing.Response) {
-			log.Printf("[DEBUG] %q was not found - removing from state", *id)
-			d.SetId("")
-			return nil
-		}
+			if len(loadBalancerAssociationIds) > 0 && metadata.Client.Features.NetworkInterface.RemoveLoadBalancerAssociationsDuringDeletion {
+				metadata.Logger.Infof("[DEBUG] Removing the Load Balancer associations from %s prior to deletion..", *id)
+				if err := metadata.Client.Network.RetryOnConflict(ctx, func() error {
+					// the Network Interface is retrieved again for each attempt, so that a retry is built from its latest version
+					existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+					if err != nil {
+						return fmt.Errorf("retrieving %s: %+v", *id, err)
+					}
+					if existing.InterfacePropertiesFormat == nil {
+						return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+					}
+					existing.InterfacePropertiesFormat.IPConfigurations = removeLoadBalancerAssociationsFromIPConfigurations(existing.InterfacePropertiesFormat.IPConfigurations)
 
-		return fmt.Errorf("retrieving %s: %+v", *id, err)
-	}
+					future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing)
+					if err != nil {
+						return fmt.Errorf("removing the Load Balancer associations from %s: %+v", *id, err)
+					}
 
-	if existing.InterfacePropertiesFormat == nil {
-		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
-	}
-	props := *existing.InterfacePropertiesFormat
+					if err := lro.WaitForCompletion(ctx, future, client.Client); err != nil {
+						return fmt.Errorf("waiting for the Load Balancer associations to be removed from %s: %+v", *id, err)
+					}
 
-	lockingDetails, err := determineResourcesToLockFromIPConfiguration(props.IPConfigurations)
-	if err != nil {
-		return fmt.Errorf("determining locking details: %+v", err)
-	}
+					return nil
+				}); err != nil {
+					return err
+				}
 
-	lockingDetails.lock()
-	defer lockingDetails.unlock()
+				loadBalancerAssociationIds = []string{}
+			}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		return fmt.Errorf("deleting %s: %+v", *id, err)
-	}
+			future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v%s", *id, err, loadBalancerAssociationsDeletionHint(loadBalancerAssociationIds))
+			}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
+			if err = lro.WaitForCompletion(ctx, future, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v%s", *id, err, loadBalancerAssociationsDeletionHint(loadBalancerAssociationIds))
+			}
 
-	return nil
+			return nil
+		},
+	}
 }
 
-func expandNetworkInterfaceIPConfigurations(input []interface{}) (*[]network.InterfaceIPConfiguration, error) {
+func expandNetworkInterfaceIPConfigurations(input []NetworkInterfaceIPConfigurationModel) (*[]network.InterfaceIPConfiguration, error) {
 	ipConfigs := make([]network.InterfaceIPConfiguration, 0)
 
-	for _, configRaw := range input {
-		data := configRaw.(map[string]interface{})
-
-		subnetId := data["subnet_id"].(string)
-		privateIpAllocationMethod := data["private_ip_address_allocation"].(string)
-		privateIpAddressVersion := network.IPVersion(data["private_ip_address_version"].(string))
-
-		allocationMethod := network.IPAllocationMethod(privateIpAllocationMethod)
+	for _, v := range input {
+		privateIpAddressVersion := network.IPVersion(v.PrivateIPAddressVersion)
 		properties := network.InterfaceIPConfigurationPropertiesFormat{
-			PrivateIPAllocationMethod: allocationMethod,
+			PrivateIPAllocationMethod: network.IPAllocationMethod(v.PrivateIPAddressAllocation),
 			PrivateIPAddressVersion:   privateIpAddressVersion,
+			Primary:                   pointer.FromBool(v.Primary),
 		}
 
-		if privateIpAddressVersion == network.IPv4 && subnetId == "" {
+		if privateIpAddressVersion == network.IPv4 && v.SubnetID == "" {
 			return nil, fmt.Errorf("A Subnet ID must be specified for an IPv4 Network Interface.")
 		}
 
-		if subnetId != "" {
+		if v.SubnetID != "" {
 			properties.Subnet = &network.Subnet{
-				ID: &subnetId,
+				ID: pointer.FromString(v.SubnetID),
 			}
 		}
 
-		if v := data["private_ip_address"].(string); v != "" {
-			properties.PrivateIPAddress = &v
+		if v.PrivateIPAddress != "" {
+			properties.PrivateIPAddress = pointer.FromString(v.PrivateIPAddress)
 		}
 
-		if v := data["public_ip_address_id"].(string); v != "" {
+		if v.PublicIPAddressID != "" {
 			properties.PublicIPAddress = &network.PublicIPAddress{
-				ID: &v,
+				ID: pointer.FromString(v.PublicIPAddressID),
 			}
 		}
 
-		if v, ok := data["primary"]; ok {
-			properties.Primary = pointer.FromBool(v.(bool))
-		}
-
-		name := data["name"].(string)
 		ipConfigs = append(ipConfigs, network.InterfaceIPConfiguration{
-			Name:                                     &name,
+			Name:                                     pointer.FromString(v.Name),
 			InterfaceIPConfigurationPropertiesFormat: &properties,
 		})
 	}
@@ -545,64 +667,48 @@ func expandNetworkInterfaceIPConfigurations(input []interface{}) (*[]network.Int
 	return &ipConfigs, nil
 }
 
-func flattenNetworkInterfaceIPConfigurations(input *[]network.InterfaceIPConfiguration) []interface{} {
+func flattenNetworkInterfaceIPConfigurationsToModel(input *[]network.InterfaceIPConfiguration, subnets map[string]networkInterfaceSubnetInformation) []NetworkInterfaceIPConfigurationModel {
+	output := make([]NetworkInterfaceIPConfigurationModel, 0)
 	if input == nil {
-		return []interface{}{}
+		return output
 	}
 
-	result := make([]interface{}, 0)
 	for _, ipConfig := range *input {
-		props := ipConfig.InterfaceIPConfigurationPropertiesFormat
-
-		name := ""
+		config := NetworkInterfaceIPConfigurationModel{}
 		if ipConfig.Name != nil {
-			name = *ipConfig.Name
+			config.Name = *ipConfig.Name
 		}
 
-		subnetId := ""
-		if props.Subnet != nil && props.Subnet.ID != nil {
-			subnetId = *props.Subnet.ID
-		}
+		if props := ipConfig.InterfaceIPConfigurationPropertiesFormat; props != nil {
+			config.PrivateIPAddressAllocation = string(props.PrivateIPAllocationMethod)
+			config.PrivateIPAddressVersion = string(props.PrivateIPAddressVersion)
 
-		privateIPAddress := ""
-		if props.PrivateIPAddress != nil {
-			privateIPAddress = *props.PrivateIPAddress
-		}
+			if props.Subnet != nil && props.Subnet.ID != nil {
+				config.SubnetID = *props.Subnet.ID
 
-		privateIPAddressVersion := ""
-		if props.PrivateIPAddressVersion != "" {
-			privateIPAddressVersion = string(props.PrivateIPAddressVersion)
-		}
+				if subnet, ok := subnets[strings.ToLower(*props.Subnet.ID)]; ok {
+					config.GatewayIPAddress = subnet.gatewayIPAddress
+					config.SubnetPrefixLength = subnet.prefixLength
+				}
+			}
 
-		publicIPAddressId := ""
-		if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
-			publicIPAddressId = *props.PublicIPAddress.ID
-		}
+			if props.PrivateIPAddress != nil {
+				config.PrivateIPAddress = *props.PrivateIPAddress
+			}
+
+			if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
+				config.PublicIPAddressID = *props.PublicIPAddress.ID
+			}
 
-		primary := false
-		if props.Primary != nil {
-			primary = *props.Primary
+			if props.Primary != nil {
+				config.Primary = *props.Primary
+			}
 		}
 
-		result = append(result, map[string]interface{}{
-			"name":                          name,
-			"primary":                       primary,
-			"private_ip_address":            privateIPAddress,
-			"private_ip_address_allocation": string(props.PrivateIPAllocationMethod),
-			"private_ip_address_version":    privateIPAddressVersion,
-			"public_ip_address_id":          publicIPAddressId,
-			"subnet_id":                     subnetId,
-		})
+		output = append(output, config)
 	}
-	return result
-}
 
-func expandNetworkInterfaceDnsServers(input []interface{}) []string {
-	dnsServers := make([]string, 0)
-	for _, v := range input {
-		dnsServers = append(dnsServers, v.(string))
-	}
-	return dnsServers
+	return output
 }
 
 func flattenNetworkInterfaceDnsServers(input *[]string) []string {
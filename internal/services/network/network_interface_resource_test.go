@@ -3,8 +3,10 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
@@ -24,9 +26,11 @@ func TestAccNetworkInterface_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_configuration.0.gateway_ip_address").HasValue("10.0.2.1"),
+				check.That(data.ResourceName).Key("ip_configuration.0.subnet_prefix_length").HasValue("24"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -51,14 +55,55 @@ func TestAccNetworkInterface_dnsServers(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
+			// reordering the DNS Servers changes the primary DNS Server, so updates the Network Interface
 			Config: r.dnsServersUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dns_servers.0").HasValue("10.0.0.6"),
+				check.That(data.ResourceName).Key("dns_servers.1").HasValue("10.0.0.5"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccNetworkInterface_subnetInDifferentLocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_interface", "test")
+	r := NetworkInterfaceResource{}
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			// the Subnet needs to exist for its location to be validated at plan time
+			Config: r.template(data),
+		},
+		{
+			Config:      r.subnetInDifferentLocation(data),
+			ExpectError: regexp.MustCompile("`ip_configuration.0.subnet_id`: .* must be in the same location as this Resource"),
+		},
+	})
+}
+
+func TestAccNetworkInterface_internalDNSNameLabel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_interface", "test")
+	r := NetworkInterfaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.internalDNSNameLabel(data, "acctestlabel1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("internal_dns_name_label").HasValue(fmt.Sprintf("acctestlabel1-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStepStrict(),
+		{
+			Config: r.internalDNSNameLabel(data, "acctestlabel2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("internal_dns_name_label").HasValue(fmt.Sprintf("acctestlabel2-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStepStrict(),
 	})
 }
 
@@ -73,7 +118,7 @@ func TestAccNetworkInterface_enableIPForwarding(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			// Disabled
 			Config: r.enableIPForwarding(data, false),
@@ -81,7 +126,7 @@ func TestAccNetworkInterface_enableIPForwarding(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			// Enabled
 			Config: r.enableIPForwarding(data, true),
@@ -89,7 +134,7 @@ func TestAccNetworkInterface_enableIPForwarding(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -103,7 +148,7 @@ func TestAccNetworkInterface_multipleIPConfigurations(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -117,7 +162,7 @@ func TestAccNetworkInterface_multipleIPConfigurationsSecondaryAsPrimary(t *testi
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -131,21 +176,21 @@ func TestAccNetworkInterface_publicIP(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.publicIPRemoved(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.publicIP(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -176,7 +221,7 @@ func TestAccNetworkInterface_static(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -190,14 +235,14 @@ func TestAccNetworkInterface_tags(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.tagsUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -211,14 +256,14 @@ func TestAccNetworkInterface_update(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.multipleIPConfigurations(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -232,14 +277,32 @@ func TestAccNetworkInterface_updateMultipleParameters(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.updateMultipleParameters(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccNetworkInterface_removeLoadBalancerAssociationsDuringDeletion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_interface", "test")
+	r := NetworkInterfaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withLoadBalancer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// the association is made outside of this configuration, as it would be when defined in a different module
+				data.CheckWithClient(r.associateWithBackendAddressPool(data)),
+			),
+		},
+		{
+			Config: r.loadBalancerOnly(data),
+		},
 	})
 }
 
@@ -275,6 +338,47 @@ func (NetworkInterfaceResource) Destroy(ctx context.Context, client *clients.Cli
 	return pointer.FromBool(true), nil
 }
 
+func (NetworkInterfaceResource) associateWithBackendAddressPool(data acceptance.TestData) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := parse.NetworkInterfaceID(state.ID)
+		if err != nil {
+			return err
+		}
+
+		read, err := clients.Network.InterfacesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		if read.InterfacePropertiesFormat == nil || read.InterfacePropertiesFormat.IPConfigurations == nil {
+			return fmt.Errorf("retrieving %s: `properties.ipConfigurations` was nil", *id)
+		}
+
+		backendAddressPoolId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/acctestlb-%d/backendAddressPools/acctestpool", id.SubscriptionId, id.ResourceGroup, data.RandomInteger)
+		for _, config := range *read.InterfacePropertiesFormat.IPConfigurations {
+			if config.InterfaceIPConfigurationPropertiesFormat == nil {
+				continue
+			}
+
+			config.LoadBalancerBackendAddressPools = &[]network.BackendAddressPool{
+				{
+					ID: pointer.FromString(backendAddressPoolId),
+				},
+			}
+		}
+
+		future, err := clients.Network.InterfacesClient.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, read)
+		if err != nil {
+			return fmt.Errorf("associating %s with Backend Address Pool %q: %+v", *id, backendAddressPoolId, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, clients.Network.InterfacesClient.Client); err != nil {
+			return fmt.Errorf("waiting for %s to be associated with Backend Address Pool %q: %+v", *id, backendAddressPoolId, err)
+		}
+
+		return nil
+	}
+}
+
 func (r NetworkInterfaceResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -395,6 +499,43 @@ resource "azurestack_network_interface" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r NetworkInterfaceResource) subnetInDifferentLocation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = "%s"
+  resource_group_name = azurestack_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r NetworkInterfaceResource) internalDNSNameLabel(data acceptance.TestData, label string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_interface" "test" {
+  name                    = "acctestni-%d"
+  location                = azurestack_resource_group.test.location
+  resource_group_name     = azurestack_resource_group.test.name
+  internal_dns_name_label = "%s-%d"
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, r.template(data), data.RandomInteger, label, data.RandomInteger)
+}
+
 func (r NetworkInterfaceResource) enableIPForwarding(data acceptance.TestData, enabled bool) string {
 	return fmt.Sprintf(`
 %s
@@ -622,3 +763,76 @@ resource "azurestack_subnet" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (r NetworkInterfaceResource) withLoadBalancer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurestack_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, r.loadBalancerOnly(data), data.RandomInteger)
+}
+
+func (NetworkInterfaceResource) loadBalancerOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {
+    network_interface {
+      remove_load_balancer_associations_during_deletion = true
+    }
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  location            = azurestack_resource_group.test.location
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "acctestpip-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Static"
+}
+
+resource "azurestack_lb" "test" {
+  name                = "acctestlb-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  frontend_ip_configuration {
+    name                 = "primary"
+    public_ip_address_id = azurestack_public_ip.test.id
+  }
+}
+
+resource "azurestack_lb_backend_address_pool" "test" {
+  resource_group_name = azurestack_resource_group.test.name
+  loadbalancer_id     = azurestack_lb.test.id
+  name                = "acctestpool"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -36,88 +36,32 @@ func networkSecurityGroupDataSource() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"description": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"protocol": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"source_port_range": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"source_port_ranges": {
-							Type:     pluginsdk.TypeSet,
-							Computed: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"destination_port_range": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"destination_port_ranges": {
-							Type:     pluginsdk.TypeSet,
-							Computed: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"source_address_prefix": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"source_address_prefixes": {
-							Type:     pluginsdk.TypeSet,
-							Computed: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"destination_address_prefix": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"destination_address_prefixes": {
-							Type:     pluginsdk.TypeSet,
-							Computed: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"access": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"priority": {
-							Type:     pluginsdk.TypeInt,
-							Computed: true,
-						},
-
-						"direction": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: networkSecurityGroupDataSourceRuleSchema(),
 				},
 			},
 
+			// the default rules are created by the platform on every Network Security Group and can't be modified,
+			// however they're evaluated after the custom rules, so are needed to audit the effective rule set
+			"default_security_rule": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: networkSecurityGroupDataSourceRuleSchema(),
+				},
+			},
+
+			"network_interface_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+
+			"subnet_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -153,7 +97,118 @@ func networkSecurityGroupDataSourceRead(d *pluginsdk.ResourceData, meta interfac
 		if err := d.Set("security_rule", flattenedRules); err != nil {
 			return fmt.Errorf("setting `security_rule`: %+v", err)
 		}
+
+		flattenedDefaultRules := flattenNetworkSecurityRules(props.DefaultSecurityRules)
+		if err := d.Set("default_security_rule", flattenedDefaultRules); err != nil {
+			return fmt.Errorf("setting `default_security_rule`: %+v", err)
+		}
+
+		networkInterfaceIds := make([]interface{}, 0)
+		if props.NetworkInterfaces != nil {
+			for _, nic := range *props.NetworkInterfaces {
+				if nic.ID != nil {
+					networkInterfaceIds = append(networkInterfaceIds, *nic.ID)
+				}
+			}
+		}
+		if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+			return fmt.Errorf("setting `network_interface_ids`: %+v", err)
+		}
+
+		subnetIds := make([]interface{}, 0)
+		if props.Subnets != nil {
+			for _, subnet := range *props.Subnets {
+				if subnet.ID != nil {
+					subnetIds = append(subnetIds, *subnet.ID)
+				}
+			}
+		}
+		if err := d.Set("subnet_ids", subnetIds); err != nil {
+			return fmt.Errorf("setting `subnet_ids`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
+
+func networkSecurityGroupDataSourceRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"source_port_range": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"source_port_ranges": {
+			Type:     pluginsdk.TypeSet,
+			Computed: true,
+			Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			Set:      pluginsdk.HashString,
+		},
+
+		"destination_port_range": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"destination_port_ranges": {
+			Type:     pluginsdk.TypeSet,
+			Computed: true,
+			Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			Set:      pluginsdk.HashString,
+		},
+
+		"source_address_prefix": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"source_address_prefixes": {
+			Type:     pluginsdk.TypeSet,
+			Computed: true,
+			Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			Set:      pluginsdk.HashString,
+		},
+
+		"destination_address_prefix": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"destination_address_prefixes": {
+			Type:     pluginsdk.TypeSet,
+			Computed: true,
+			Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			Set:      pluginsdk.HashString,
+		},
+
+		"access": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"priority": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"direction": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
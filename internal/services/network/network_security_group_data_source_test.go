@@ -20,6 +20,9 @@ func TestAccDataSourceNetworkSecurityGroup_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("location").Exists(),
 				check.That(data.ResourceName).Key("security_rule.#").HasValue("0"),
+				check.That(data.ResourceName).Key("default_security_rule.#").HasValue("6"),
+				check.That(data.ResourceName).Key("network_interface_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("subnet_ids.#").HasValue("0"),
 				check.That(data.ResourceName).Key("tags.%").HasValue("0"),
 			),
 		},
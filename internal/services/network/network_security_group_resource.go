@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
@@ -66,7 +68,7 @@ func networkSecurityGroup() *pluginsdk.Resource {
 						"description": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(0, 140),
+							ValidateFunc: capabilities.NetworkSecurityRuleDescriptionLength.ValidateStringLength(),
 						},
 
 						"protocol": {
@@ -162,7 +164,36 @@ func networkSecurityGroup() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(networkSecurityGroupCustomizeDiff),
+	}
+}
+
+func networkSecurityGroupCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	rules := d.Get("security_rule").(*pluginsdk.Set).List()
+	if err := capabilities.NetworkSecurityGroupRules.Validate("security_rule", len(rules)); err != nil {
+		return err
+	}
+
+	for _, raw := range rules {
+		rule, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, field := range []string{"source_port_ranges", "destination_port_ranges"} {
+			ranges, ok := rule[field].(*pluginsdk.Set)
+			if !ok {
+				continue
+			}
+
+			if err := capabilities.NetworkSecurityRulePortRanges.Validate(field, ranges.Len()); err != nil {
+				return fmt.Errorf("security rule %q: %+v", rule["name"], err)
+			}
+		}
 	}
+
+	return nil
 }
 
 func networkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -194,8 +225,8 @@ func networkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("Building list of Network Security Group Rules: %+v", sgErr)
 	}
 
-	locks.ByName(id.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(id.Name, networkSecurityGroupResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	sg := network.SecurityGroup{
 		Name:     &id.Name,
@@ -206,13 +237,19 @@ func networkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		Tags: tags.Expand(t),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, sg)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, sg)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the completion of %s: %+v", id, err)
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the completion of %s: %+v", id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
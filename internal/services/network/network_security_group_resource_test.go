@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -25,7 +26,7 @@ func TestAccNetworkSecurityGroup_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -56,7 +57,7 @@ func TestAccNetworkSecurityGroup_singleRule(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -123,7 +124,7 @@ func TestAccNetworkSecurityGroup_withTags(t *testing.T) {
 				check.That(data.ResourceName).Key("tags.cost_center").HasValue("MSFT"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.withTagsUpdate(data),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -132,7 +133,7 @@ func TestAccNetworkSecurityGroup_withTags(t *testing.T) {
 				check.That(data.ResourceName).Key("tags.environment").HasValue("staging"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -169,7 +170,29 @@ func TestAccNetworkSecurityGroup_augmented(t *testing.T) {
 				check.That(data.ResourceName).Key("security_rule.#").HasValue("1"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccNetworkSecurityGroup_tooManyRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_security_group", "test")
+	r := NetworkSecurityGroupResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.tooManyRules(data),
+			ExpectError: regexp.MustCompile("`security_rule`: 201 exceeds the Azure Stack Hub limit of 200 security rules per Network Security Group"),
+		},
+	})
+}
+
+func TestAccNetworkSecurityGroup_tooManyPortRanges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_network_security_group", "test")
+	r := NetworkSecurityGroupResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.tooManyPortRanges(data),
+			ExpectError: regexp.MustCompile("`destination_port_ranges`: 16 exceeds the Azure Stack Hub limit of 15 port ranges per security rule"),
+		},
 	})
 }
 
@@ -183,7 +206,7 @@ func TestAccNetworkSecurityGroup_deleteRule(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.deleteRule(data),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -191,7 +214,7 @@ func TestAccNetworkSecurityGroup_deleteRule(t *testing.T) {
 				check.That(data.ResourceName).Key("security_rule.#").HasValue("0"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -455,6 +478,71 @@ resource "azurestack_network_security_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (NetworkSecurityGroupResource) tooManyRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  dynamic "security_rule" {
+    for_each = range(201)
+    content {
+      name                       = "rule${security_rule.value}"
+      priority                   = 100 + security_rule.value
+      direction                  = "Inbound"
+      access                     = "Allow"
+      protocol                   = "Tcp"
+      source_port_range          = "*"
+      destination_port_range     = "${1000 + security_rule.value}"
+      source_address_prefix      = "*"
+      destination_address_prefix = "*"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (NetworkSecurityGroupResource) tooManyPortRanges(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  security_rule {
+    name                       = "test123"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_ranges    = [for i in range(16) : tostring(8000 + i)]
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (NetworkSecurityGroupResource) deleteRule(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -1,12 +1,14 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
@@ -52,7 +54,7 @@ func networkSecurityRule() *pluginsdk.Resource {
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 140),
+				ValidateFunc: capabilities.NetworkSecurityRuleDescriptionLength.ValidateStringLength(),
 			},
 
 			"protocol": {
@@ -151,9 +153,21 @@ func networkSecurityRule() *pluginsdk.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(networkSecurityRuleCustomizeDiff),
 	}
 }
 
+func networkSecurityRuleCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	for _, field := range []string{"source_port_ranges", "destination_port_ranges"} {
+		if err := capabilities.NetworkSecurityRulePortRanges.Validate(field, d.Get(field).(*pluginsdk.Set).Len()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func networkSecurityRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.SecurityRuleClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -173,6 +187,19 @@ func networkSecurityRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		if existing.ID != nil && *existing.ID != "" {
 			return tf.ImportAsExistsError("azurestack_network_security_rule", *existing.ID)
 		}
+
+		// the number of rules can only be checked against the Network Security Group prior to creation, since
+		// the rules within the Network Security Group aren't known at plan time
+		nsg, err := meta.(*clients.Client).Network.SecurityGroupClient.Get(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, "")
+		if err != nil {
+			return fmt.Errorf("retrieving Network Security Group %q (Resource Group %q): %+v", id.NetworkSecurityGroupName, id.ResourceGroup, err)
+		}
+
+		if props := nsg.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+			if err := capabilities.NetworkSecurityGroupRules.Validate("network_security_group_name", len(*props.SecurityRules)+1); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+		}
 	}
 
 	sourcePortRange := d.Get("source_port_range").(string)
@@ -186,8 +213,9 @@ func networkSecurityRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	// TODO should we put this into stack?
 	/* if !meta.(*clients.Client).Features.Network.RelaxedLocking {
-		locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+		networkSecurityGroupId := parse.NewNetworkSecurityGroupID(id.SubscriptionId, id.ResourceGroup, id.NetworkSecurityGroupName)
+		locks.ByID(networkSecurityGroupId.ID())
+		defer locks.UnlockByID(networkSecurityGroupId.ID())
 	}*/
 
 	rule := network.SecurityRule{
@@ -249,13 +277,19 @@ func networkSecurityRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		rule.SecurityRulePropertiesFormat.DestinationAddressPrefixes = &destinationAddressPrefixes
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name, rule)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name, rule)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of %s: %+v", id, err)
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for completion of %s: %+v", id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
@@ -317,8 +351,9 @@ func networkSecurityRuleDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	// TODO should we put this into stack?
 	/* if !meta.(*clients.Client).Features.Network.RelaxedLocking {
-		locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+		networkSecurityGroupId := parse.NewNetworkSecurityGroupID(id.SubscriptionId, id.ResourceGroup, id.NetworkSecurityGroupName)
+		locks.ByID(networkSecurityGroupId.ID())
+		defer locks.UnlockByID(networkSecurityGroupId.ID())
 	}*/
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name)
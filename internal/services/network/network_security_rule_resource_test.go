@@ -26,7 +26,7 @@ func TestAccNetworkSecurityRule_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -91,7 +91,7 @@ func TestAccNetworkSecurityRule_augmented(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -24,6 +24,7 @@ func TestBackendAddressPoolAddressID(t *testing.T) {
 		Error    bool
 		Expected *BackendAddressPoolAddressId
 	}{
+
 		{
 			// empty
 			Input: "",
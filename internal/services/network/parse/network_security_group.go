@@ -67,3 +67,47 @@ func NetworkSecurityGroupID(input string) (*NetworkSecurityGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// NetworkSecurityGroupIDInsensitively parses an NetworkSecurityGroup ID into an NetworkSecurityGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting, the NetworkSecurityGroupID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func NetworkSecurityGroupIDInsensitively(input string) (*NetworkSecurityGroupId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NetworkSecurityGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'networkSecurityGroups' segment
+	networkSecurityGroupsKey := "networkSecurityGroups"
+	for key := range id.Path {
+		if strings.EqualFold(key, networkSecurityGroupsKey) {
+			networkSecurityGroupsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(networkSecurityGroupsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
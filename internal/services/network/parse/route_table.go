@@ -67,3 +67,47 @@ func RouteTableID(input string) (*RouteTableId, error) {
 
 	return &resourceId, nil
 }
+
+// RouteTableIDInsensitively parses an RouteTable ID into an RouteTableId struct, insensitively
+// This should only be used to parse an ID for rewriting, the RouteTableID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func RouteTableIDInsensitively(input string) (*RouteTableId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RouteTableId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'routeTables' segment
+	routeTablesKey := "routeTables"
+	for key := range id.Path {
+		if strings.EqualFold(key, routeTablesKey) {
+			routeTablesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(routeTablesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
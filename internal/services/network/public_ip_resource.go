@@ -159,6 +159,19 @@ func publicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	sku := d.Get("sku").(string)
 	t := d.Get("tags").(map[string]interface{})
 
+	if tags.OnlyTagsChanged(d) {
+		future, err := client.UpdateTags(ctx, id.ResourceGroup, id.Name, network.TagsObject{Tags: tags.Expand(t)})
+		if err != nil {
+			return fmt.Errorf("updating tags for %s: %+v", id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of tags for %s: %+v", id, err)
+		}
+
+		return publicIpRead(d, meta)
+	}
+
 	idleTimeout := d.Get("idle_timeout_in_minutes").(int)
 	ipVersion := network.IPVersion(d.Get("ip_version").(string))
 	ipAllocationMethod := d.Get("allocation_method").(string)
@@ -203,13 +216,19 @@ func publicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		publicIp.PublicIPAddressPropertiesFormat.DNSSettings = &dnsSettings
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, publicIp)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, publicIp)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
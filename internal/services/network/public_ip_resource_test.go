@@ -31,7 +31,7 @@ func TestAccPublicIpStatic_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("ip_version").HasValue("IPv4"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -71,7 +71,7 @@ func TestAccPublicIpStatic_basic_withDNSLabel(t *testing.T) {
 				check.That(data.ResourceName).Key("domain_name_label").HasValue(dnl),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -87,7 +87,7 @@ func TestAccPublicIpStatic_basic_defaultsToIPv4(t *testing.T) {
 				check.That(data.ResourceName).Key("ip_version").HasValue("IPv4"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -104,7 +104,7 @@ func TestAccPublicIpStatic_basic_withIPv4(t *testing.T) {
 				check.That(data.ResourceName).Key("ip_version").HasValue("IPv4"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -132,7 +132,7 @@ func TestAccPublicIpStatic_idleTimeout(t *testing.T) {
 				check.That(data.ResourceName).Key("idle_timeout_in_minutes").HasValue("30"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -179,7 +179,7 @@ func TestAccPublicIpStatic_update(t *testing.T) {
 				check.That(data.ResourceName).Key("domain_name_label").HasValue(fmt.Sprintf("acctest-%d", data.RandomInteger)),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -194,7 +194,7 @@ func TestAccPublicIpDynamic_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -229,7 +229,7 @@ func TestAccPublicIpStatic_canLabelBe63(t *testing.T) {
 				check.That(data.ResourceName).Key("allocation_method").HasValue("Static"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
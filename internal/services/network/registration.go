@@ -2,6 +2,12 @@ package network
 
 import (
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/sdk"
+)
+
+var (
+	_ sdk.TypedServiceRegistration   = Registration{}
+	_ sdk.UntypedServiceRegistration = Registration{}
 )
 
 type Registration struct{}
@@ -18,26 +24,40 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		NetworkInterfaceResource{},
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_network_interface":                  networkInterfaceDataSource(),
-		"azurestack_public_ip":                          publicIPDataSource(),
-		"azurestack_public_ips":                         publicIPsDataSource(),
-		"azurestack_route_table":                        routeTableDataSource(),
-		"azurestack_subnet":                             subnetDataSource(),
-		"azurestack_virtual_network":                    virtualNetworkDataSource(),
-		"azurestack_network_security_group":             networkSecurityGroupDataSource(),
-		"azurestack_virtual_network_gateway":            virtualNetworkGatewayDataSource(),
-		"azurestack_virtual_network_gateway_connection": virtualNetworkGatewayConnectionDataSource(),
-		"azurestack_local_network_gateway":              localNetworkGatewayDataSource(),
+		"azurestack_network_interface":                                   networkInterfaceDataSource(),
+		"azurestack_network_interface_effective_network_security_groups": networkInterfaceEffectiveNetworkSecurityGroupsDataSource(),
+		"azurestack_network_interface_effective_routes":                  networkInterfaceEffectiveRoutesDataSource(),
+		"azurestack_public_ip":                                           publicIPDataSource(),
+		"azurestack_public_ips":                                          publicIPsDataSource(),
+		"azurestack_route_table":                                         routeTableDataSource(),
+		"azurestack_subnet":                                              subnetDataSource(),
+		"azurestack_virtual_network":                                     virtualNetworkDataSource(),
+		"azurestack_network_security_group":                              networkSecurityGroupDataSource(),
+		"azurestack_virtual_network_gateway":                             virtualNetworkGatewayDataSource(),
+		"azurestack_virtual_network_gateway_connection":                  virtualNetworkGatewayConnectionDataSource(),
+		"azurestack_virtual_network_gateway_vpn_client_package":          virtualNetworkGatewayVpnClientPackageDataSource(),
+		"azurestack_local_network_gateway":                               localNetworkGatewayDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_network_interface":                                  networkInterface(),
 		"azurestack_public_ip":                                          publicIp(),
 		"azurestack_route_table":                                        routeTable(),
 		"azurestack_route":                                              resourceRoute(),
@@ -50,5 +70,19 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurestack_local_network_gateway":                              localNetworkGateway(),
 		"azurestack_virtual_network_peering":                            virtualNetworkPeering(),
 		"azurestack_network_interface_backend_address_pool_association": loadBalancerBackendAddressPoolAssociation(),
+		"azurestack_network_interface_ip_configuration":                 networkInterfaceIPConfiguration(),
+		"azurestack_subnet_network_security_group_association":          subnetNetworkSecurityGroupAssociation(),
+		"azurestack_subnet_route_table_association":                     subnetRouteTableAssociation(),
+	}
+}
+
+// AttributesNotReturnedByAPI returns the attributes of the Resources supported by this Service which
+// aren't returned by the API, and as such aren't populated when the Resource is imported
+func (r Registration) AttributesNotReturnedByAPI() map[string][]string {
+	return map[string][]string{
+		// the Shared Key isn't always returned when retrieving the Connection
+		"azurestack_virtual_network_gateway_connection": {
+			"shared_key",
+		},
 	}
 }
@@ -1,9 +0,0 @@
-package network
-
-var (
-	networkInterfaceResourceName     = "azurestack_network_interface"
-	networkSecurityGroupResourceName = "azurestack_network_security_group"
-	routeTableResourceName           = "azurestack_route_table"
-	SubnetResourceName               = "azurestack_subnet"
-	VirtualNetworkResourceName       = "azurestack_virtual_network"
-)
@@ -106,8 +106,9 @@ func routeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.ByName(id.RouteTableName, routeTableResourceName)
-	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
+	routeTableId := parse.NewRouteTableID(id.SubscriptionId, id.ResourceGroup, id.RouteTableName)
+	locks.ByID(routeTableId.ID())
+	defer locks.UnlockByID(routeTableId.ID())
 
 	route := network.Route{
 		Name: pointer.FromString(id.Name),
@@ -121,13 +122,19 @@ func routeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		route.RoutePropertiesFormat.NextHopIPAddress = pointer.FromString(v.(string))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.RouteTableName, id.Name, route)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.RouteTableName, id.Name, route)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for create/update of %s: %+v", id, err)
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for create/update of %s: %+v", id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
@@ -176,8 +183,9 @@ func routeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.RouteTableName, routeTableResourceName)
-	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
+	routeTableId := parse.NewRouteTableID(id.SubscriptionId, id.ResourceGroup, id.RouteTableName)
+	locks.ByID(routeTableId.ID())
+	defer locks.UnlockByID(routeTableId.ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RouteTableName, id.Name)
 	if err != nil {
@@ -63,6 +63,11 @@ func routeTableDataSource() *pluginsdk.Resource {
 				},
 			},
 
+			"disable_bgp_route_propagation": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"subnets": {
 				Type:     pluginsdk.TypeSet,
 				Computed: true,
@@ -105,6 +110,8 @@ func routeTableDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error
 		if err := d.Set("subnets", flattenRouteTableDataSourceSubnets(props.Subnets)); err != nil {
 			return err
 		}
+
+		d.Set("disable_bgp_route_propagation", props.DisableBgpRoutePropagation)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -19,6 +19,7 @@ func TestAccDataSourceRouteTable_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("route.#").HasValue("0"),
+				check.That(data.ResourceName).Key("disable_bgp_route_propagation").HasValue("false"),
 			),
 		},
 	})
@@ -28,7 +28,7 @@ func TestAccRouteTable_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("route.#").HasValue("0"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -65,7 +65,7 @@ func TestAccRouteTable_complete(t *testing.T) {
 				check.That(data.ResourceName).Key("route.#").HasValue("1"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -112,7 +112,7 @@ func TestAccRouteTable_singleRoute(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -218,7 +218,7 @@ func TestAccRouteTable_multipleRoutes(t *testing.T) {
 				check.That(data.ResourceName).Key("route.1.next_hop_type").HasValue("VnetLocal"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -51,6 +52,44 @@ func subnetDataSource() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"service_endpoints": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+			},
+
+			"delegation": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"service_delegation": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"actions": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -89,7 +128,64 @@ func subnetDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			routeTableId = *props.RouteTable.ID
 		}
 		d.Set("route_table_id", routeTableId)
+
+		if err := d.Set("service_endpoints", flattenSubnetDataSourceServiceEndpoints(props.ServiceEndpoints)); err != nil {
+			return fmt.Errorf("setting `service_endpoints`: %+v", err)
+		}
+
+		if err := d.Set("delegation", flattenSubnetDataSourceDelegations(props.Delegations)); err != nil {
+			return fmt.Errorf("setting `delegation`: %+v", err)
+		}
 	}
 
 	return nil
 }
+
+func flattenSubnetDataSourceServiceEndpoints(input *[]network.ServiceEndpointPropertiesFormat) []interface{} {
+	endpoints := make([]interface{}, 0)
+	if input == nil {
+		return endpoints
+	}
+
+	for _, endpoint := range *input {
+		if endpoint.Service != nil {
+			endpoints = append(endpoints, *endpoint.Service)
+		}
+	}
+
+	return endpoints
+}
+
+func flattenSubnetDataSourceDelegations(input *[]network.Delegation) []interface{} {
+	delegations := make([]interface{}, 0)
+	if input == nil {
+		return delegations
+	}
+
+	for _, delegation := range *input {
+		name := ""
+		if delegation.Name != nil {
+			name = *delegation.Name
+		}
+
+		serviceDelegations := make([]interface{}, 0)
+		if props := delegation.ServiceDelegationPropertiesFormat; props != nil {
+			serviceName := ""
+			if props.ServiceName != nil {
+				serviceName = *props.ServiceName
+			}
+
+			serviceDelegations = append(serviceDelegations, map[string]interface{}{
+				"name":    serviceName,
+				"actions": utils.FlattenStringSlice(props.Actions),
+			})
+		}
+
+		delegations = append(delegations, map[string]interface{}{
+			"name":               name,
+			"service_delegation": serviceDelegations,
+		})
+	}
+
+	return delegations
+}
@@ -24,6 +24,8 @@ func TestAccSubnetDataSource_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("address_prefix").Exists(),
 				check.That(data.ResourceName).Key("network_security_group_id").HasValue(""),
 				check.That(data.ResourceName).Key("route_table_id").HasValue(""),
+				check.That(data.ResourceName).Key("service_endpoints.#").HasValue("0"),
+				check.That(data.ResourceName).Key("delegation.#").HasValue("0"),
 			),
 		},
 	})
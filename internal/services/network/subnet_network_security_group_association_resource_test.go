@@ -0,0 +1,173 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type SubnetNetworkSecurityGroupAssociationResource struct{}
+
+func TestAccSubnetNetworkSecurityGroupAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subnet_network_security_group_association", "test")
+	r := SubnetNetworkSecurityGroupAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccSubnetNetworkSecurityGroupAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subnet_network_security_group_association", "test")
+	r := SubnetNetworkSecurityGroupAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSubnetNetworkSecurityGroupAssociation_updateSubnet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subnet_network_security_group_association", "test")
+	r := SubnetNetworkSecurityGroupAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+		{
+			// changing the Subnet mustn't remove the association
+			Config: r.updateSubnet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func (SubnetNetworkSecurityGroupAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SubnetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.SubnetsClient.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := resp.SubnetPropertiesFormat
+	return pointer.FromBool(props != nil && props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil), nil
+}
+
+func (r SubnetNetworkSecurityGroupAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_subnet_network_security_group_association" "test" {
+  subnet_id                 = azurestack_subnet.test.id
+  network_security_group_id = azurestack_network_security_group.test.id
+}
+`, r.template(data))
+}
+
+func (r SubnetNetworkSecurityGroupAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subnet_network_security_group_association" "import" {
+  subnet_id                 = azurestack_subnet_network_security_group_association.test.subnet_id
+  network_security_group_id = azurestack_subnet_network_security_group_association.test.network_security_group_id
+}
+`, r.basic(data))
+}
+
+func (r SubnetNetworkSecurityGroupAssociationResource) updateSubnet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.3.0/24"
+}
+
+resource "azurestack_subnet_network_security_group_association" "test" {
+  subnet_id                 = azurestack_subnet.test.id
+  network_security_group_id = azurestack_network_security_group.test.id
+}
+`, r.template(data))
+}
+
+func (SubnetNetworkSecurityGroupAssociationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvirtnet%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_network_security_group" "test" {
+  name                = "acctestnsg%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  security_rule {
+    name                       = "test123"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -96,8 +96,9 @@ func subnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurestack_subnet", id.ID())
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	locks.ByID(virtualNetworkId.ID())
+	defer locks.UnlockByID(virtualNetworkId.ID())
 
 	properties := network.SubnetPropertiesFormat{}
 	if value, ok := d.GetOk("address_prefix"); ok {
@@ -110,13 +111,19 @@ func subnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		SubnetPropertiesFormat: &properties,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, subnet)
-	if err != nil {
-		return fmt.Errorf("creating %s: %+v", id, err)
-	}
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, subnet)
+		if err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	timeout, _ := ctx.Deadline()
@@ -159,41 +166,70 @@ func subnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	locks.ByID(virtualNetworkId.ID())
+	defer locks.UnlockByID(virtualNetworkId.ID())
 
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
-	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
-	if err != nil {
-		return fmt.Errorf("retrieving %s: %+v", *id, err)
-	}
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		// the Subnet is retrieved for each attempt, so that a retry is built from its latest version (rather than
+		// overwriting the change made by the conflicting operation)
+		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
 
-	if existing.SubnetPropertiesFormat == nil {
-		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
-	}
+		if existing.SubnetPropertiesFormat == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+		}
 
-	// TODO: locking on the NSG/Route Table if applicable
+		props := *existing.SubnetPropertiesFormat
 
-	props := *existing.SubnetPropertiesFormat
+		// the Network Security Group and Route Table are managed by the association resources, so are locked
+		// to ensure they're not changed whilst the Subnet is being updated
+		if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
+			networkSecurityGroupId, err := parse.NetworkSecurityGroupIDInsensitively(*props.NetworkSecurityGroup.ID)
+			if err != nil {
+				return err
+			}
 
-	if d.HasChange("address_prefix") {
-		props.AddressPrefix = pointer.FromString(d.Get("address_prefix").(string))
-	}
+			locks.ByID(networkSecurityGroupId.ID())
+			defer locks.UnlockByID(networkSecurityGroupId.ID())
+		}
 
-	subnet := network.Subnet{
-		Name:                   pointer.FromString(id.Name),
-		SubnetPropertiesFormat: &props,
-	}
+		if props.RouteTable != nil && props.RouteTable.ID != nil {
+			routeTableId, err := parse.RouteTableIDInsensitively(*props.RouteTable.ID)
+			if err != nil {
+				return err
+			}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, subnet)
-	if err != nil {
-		return fmt.Errorf("updating %s: %+v", *id, err)
-	}
+			locks.ByID(routeTableId.ID())
+			defer locks.UnlockByID(routeTableId.ID())
+		}
+
+		if d.HasChange("address_prefix") {
+			props.AddressPrefix = pointer.FromString(d.Get("address_prefix").(string))
+		}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+		subnet := network.Subnet{
+			Name:                   pointer.FromString(id.Name),
+			SubnetPropertiesFormat: &props,
+		}
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, subnet)
+		if err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	timeout, _ := ctx.Deadline()
@@ -264,11 +300,12 @@ func subnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	locks.ByID(virtualNetworkId.ID())
+	defer locks.UnlockByID(virtualNetworkId.ID())
 
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 	if err != nil {
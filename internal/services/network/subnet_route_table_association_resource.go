@@ -0,0 +1,243 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func subnetRouteTableAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: subnetRouteTableAssociationCreate,
+		Read:   subnetRouteTableAssociationRead,
+		Delete: subnetRouteTableAssociationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SubnetID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SubnetID,
+			},
+
+			"route_table_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RouteTableID,
+			},
+		},
+	}
+}
+
+func subnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Subnet <-> Route Table Association creation.")
+
+	subnetId, err := parse.SubnetID(d.Get("subnet_id").(string))
+	if err != nil {
+		return err
+	}
+
+	routeTableId, err := parse.RouteTableIDInsensitively(d.Get("route_table_id").(string))
+	if err != nil {
+		return err
+	}
+
+	virtualNetworkId := parse.NewVirtualNetworkID(subnetId.SubscriptionId, subnetId.ResourceGroup, subnetId.VirtualNetworkName)
+	locks.ByID(virtualNetworkId.ID())
+	defer locks.UnlockByID(virtualNetworkId.ID())
+
+	locks.ByID(subnetId.ID())
+	defer locks.UnlockByID(subnetId.ID())
+
+	locks.ByID(routeTableId.ID())
+	defer locks.UnlockByID(routeTableId.ID())
+
+	attempted := false
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		// the Subnet is retrieved for each attempt, so that a retry is built from its latest version (rather than
+		// overwriting the change made by the conflicting operation)
+		subnet, err := client.Get(ctx, subnetId.ResourceGroup, subnetId.VirtualNetworkName, subnetId.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(subnet.Response) {
+				return fmt.Errorf("%s was not found", *subnetId)
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", *subnetId, err)
+		}
+
+		props := subnet.SubnetPropertiesFormat
+		if props == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", *subnetId)
+		}
+
+		if props.RouteTable != nil && props.RouteTable.ID != nil && *props.RouteTable.ID != "" {
+			// a previous attempt may have been applied, despite failing due to a conflicting operation
+			if attempted && strings.EqualFold(*props.RouteTable.ID, routeTableId.ID()) {
+				return nil
+			}
+
+			return tf.ImportAsExistsError("azurestack_subnet_route_table_association", subnetId.ID())
+		}
+		attempted = true
+
+		props.RouteTable = &network.RouteTable{
+			ID: pointer.FromString(routeTableId.ID()),
+		}
+
+		future, err := client.CreateOrUpdate(ctx, subnetId.ResourceGroup, subnetId.VirtualNetworkName, subnetId.Name, subnet)
+		if err != nil {
+			return fmt.Errorf("associating %s with %s: %+v", *routeTableId, *subnetId, err)
+		}
+
+		if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+			return fmt.Errorf("waiting for the association of %s with %s: %+v", *routeTableId, *subnetId, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(subnetId.ID())
+
+	return subnetRouteTableAssociationRead(d, meta)
+}
+
+func subnetRouteTableAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SubnetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s could not be found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := resp.SubnetPropertiesFormat
+	if props == nil || props.RouteTable == nil || props.RouteTable.ID == nil {
+		log.Printf("[DEBUG] %s doesn't have a Route Table - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", id.ID())
+	d.Set("route_table_id", props.RouteTable.ID)
+
+	return nil
+}
+
+func subnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SubnetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// retrieve the Subnet first, since the Route Table may have been changed outside of Terraform
+	read, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] %s could not be found - removing from state", *id)
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := read.SubnetPropertiesFormat
+	if props == nil || props.RouteTable == nil || props.RouteTable.ID == nil {
+		log.Printf("[DEBUG] %s doesn't have a Route Table - removing from state", *id)
+		return nil
+	}
+
+	routeTableId, err := parse.RouteTableIDInsensitively(*props.RouteTable.ID)
+	if err != nil {
+		return err
+	}
+
+	virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	locks.ByID(virtualNetworkId.ID())
+	defer locks.UnlockByID(virtualNetworkId.ID())
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	locks.ByID(routeTableId.ID())
+	defer locks.UnlockByID(routeTableId.ID())
+
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		// then re-retrieve it whilst holding the locks (and for each attempt), to ensure nothing has changed in the interim
+		read, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(read.Response) {
+				log.Printf("[DEBUG] %s could not be found - removing from state", *id)
+				return nil
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if read.SubnetPropertiesFormat == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+		}
+		read.SubnetPropertiesFormat.RouteTable = nil
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, read)
+		if err != nil {
+			return fmt.Errorf("removing the Route Table from %s: %+v", *id, err)
+		}
+
+		if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+			return fmt.Errorf("waiting for the Route Table to be removed from %s: %+v", *id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type SubnetRouteTableAssociationResource struct{}
+
+func TestAccSubnetRouteTableAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subnet_route_table_association", "test")
+	r := SubnetRouteTableAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccSubnetRouteTableAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subnet_route_table_association", "test")
+	r := SubnetRouteTableAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSubnetRouteTableAssociation_updateSubnet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subnet_route_table_association", "test")
+	r := SubnetRouteTableAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+		{
+			// changing the Subnet mustn't remove the association
+			Config: r.updateSubnet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func (SubnetRouteTableAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SubnetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.SubnetsClient.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := resp.SubnetPropertiesFormat
+	return pointer.FromBool(props != nil && props.RouteTable != nil && props.RouteTable.ID != nil), nil
+}
+
+func (r SubnetRouteTableAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurestack_subnet_route_table_association" "test" {
+  subnet_id      = azurestack_subnet.test.id
+  route_table_id = azurestack_route_table.test.id
+}
+`, r.template(data))
+}
+
+func (r SubnetRouteTableAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subnet_route_table_association" "import" {
+  subnet_id      = azurestack_subnet_route_table_association.test.subnet_id
+  route_table_id = azurestack_subnet_route_table_association.test.route_table_id
+}
+`, r.basic(data))
+}
+
+func (r SubnetRouteTableAssociationResource) updateSubnet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.3.0/24"
+}
+
+resource "azurestack_subnet_route_table_association" "test" {
+  subnet_id      = azurestack_subnet.test.id
+  route_table_id = azurestack_route_table.test.id
+}
+`, r.template(data))
+}
+
+func (SubnetRouteTableAssociationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvirtnet%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_route_table" "test" {
+  name                = "acctestrt%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  route {
+    name                   = "route1"
+    address_prefix         = "10.100.0.0/14"
+    next_hop_type          = "VirtualAppliance"
+    next_hop_in_ip_address = "10.10.1.1"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -9,6 +9,7 @@ func TestBackendAddressPoolAddressID(t *testing.T) {
 		Input string
 		Valid bool
 	}{
+
 		{
 			// empty
 			Input: "",
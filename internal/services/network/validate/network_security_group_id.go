@@ -15,7 +15,7 @@ func NetworkSecurityGroupID(input interface{}, key string) (warnings []string, e
 		return
 	}
 
-	if _, err := parse.NetworkSecurityGroupID(v); err != nil {
+	if _, err := parse.NetworkSecurityGroupIDInsensitively(v); err != nil {
 		errors = append(errors, err)
 	}
 
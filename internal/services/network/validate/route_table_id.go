@@ -15,7 +15,7 @@ func RouteTableID(input interface{}, key string) (warnings []string, errors []er
 		return
 	}
 
-	if _, err := parse.RouteTableID(v); err != nil {
+	if _, err := parse.RouteTableIDInsensitively(v); err != nil {
 		errors = append(errors, err)
 	}
 
@@ -15,7 +15,7 @@ func SubnetID(input interface{}, key string) (warnings []string, errors []error)
 		return
 	}
 
-	if _, err := parse.SubnetID(v); err != nil {
+	if _, err := parse.SubnetIDInsensitively(v); err != nil {
 		errors = append(errors, err)
 	}
 
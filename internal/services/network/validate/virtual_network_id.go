@@ -15,7 +15,7 @@ func VirtualNetworkID(input interface{}, key string) (warnings []string, errors
 		return
 	}
 
-	if _, err := parse.VirtualNetworkID(v); err != nil {
+	if _, err := parse.VirtualNetworkIDInsensitively(v); err != nil {
 		errors = append(errors, err)
 	}
 
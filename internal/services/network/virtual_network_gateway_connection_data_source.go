@@ -75,6 +75,11 @@ func virtualNetworkGatewayConnectionDataSource() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"connection_protocol": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"virtual_network_gateway_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -148,6 +153,7 @@ func virtualNetworkGatewayConnectionDataSource() *pluginsdk.Resource {
 
 func virtualNetworkGatewayConnectionDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	stateEncryption := meta.(*clients.Client).StateEncryption
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -172,7 +178,9 @@ func virtualNetworkGatewayConnectionDataSourceRead(d *pluginsdk.ResourceData, me
 	if resp.VirtualNetworkGatewayConnectionPropertiesFormat != nil {
 		gwc := *resp.VirtualNetworkGatewayConnectionPropertiesFormat
 
-		d.Set("shared_key", gwc.SharedKey)
+		if err := stateEncryption.Set(d, "shared_key", gwc.SharedKey); err != nil {
+			return err
+		}
 		d.Set("authorization_key", gwc.AuthorizationKey)
 		d.Set("enable_bgp", gwc.EnableBgp)
 		d.Set("ingress_bytes_transferred", gwc.IngressBytesTransferred)
@@ -180,6 +188,7 @@ func virtualNetworkGatewayConnectionDataSourceRead(d *pluginsdk.ResourceData, me
 		d.Set("use_policy_based_traffic_selectors", gwc.UsePolicyBasedTrafficSelectors)
 		d.Set("type", string(gwc.ConnectionType))
 		d.Set("routing_weight", gwc.RoutingWeight)
+		d.Set("connection_protocol", string(gwc.ConnectionProtocol))
 
 		if gwc.VirtualNetworkGateway1 != nil {
 			d.Set("virtual_network_gateway_id", gwc.VirtualNetworkGateway1.ID)
@@ -14,8 +14,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/stateencryption"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
@@ -68,7 +70,7 @@ func virtualNetworkGatewayConnection() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: resourceid.ValidateResourceID,
+				ValidateFunc: validate.VirtualNetworkGatewayID,
 			},
 
 			"authorization_key": {
@@ -89,13 +91,13 @@ func virtualNetworkGatewayConnection() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: resourceid.ValidateResourceIDOrEmpty,
+				ValidateFunc: validation.Any(validation.StringIsEmpty, validate.VirtualNetworkGatewayID),
 			},
 
 			"local_network_gateway_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ValidateFunc: resourceid.ValidateResourceIDOrEmpty,
+				ValidateFunc: validation.Any(validation.StringIsEmpty, validate.LocalNetworkGatewayID),
 			},
 
 			"enable_bgp": {
@@ -110,6 +112,17 @@ func virtualNetworkGatewayConnection() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"connection_protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IKEv1),
+					string(network.IKEv2),
+				}, false),
+			},
+
 			"routing_weight": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -117,10 +130,13 @@ func virtualNetworkGatewayConnection() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(0, 32000),
 			},
 
+			// when State Encryption is enabled the value in the state is encrypted, so the diff is
+			// suppressed when it's an encrypted copy of the configured value
 			"shared_key": {
-				Type:      pluginsdk.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: stateencryption.DiffSuppressFunc,
 			},
 
 			"ipsec_policy": {
@@ -264,7 +280,7 @@ func virtualNetworkGatewayConnectionCreateUpdate(d *pluginsdk.ResourceData, meta
 	location := location.Normalize(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	properties, err := getVirtualNetworkGatewayConnectionProperties(d)
+	properties, err := getVirtualNetworkGatewayConnectionProperties(d, meta.(*clients.Client).StateEncryption)
 	if err != nil {
 		return err
 	}
@@ -276,16 +292,23 @@ func virtualNetworkGatewayConnectionCreateUpdate(d *pluginsdk.ResourceData, meta
 		VirtualNetworkGatewayConnectionPropertiesFormat: properties,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ConnectionName, connection)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ConnectionName, connection)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of %s: %+v", id, err)
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for completion of %s: %+v", id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
-	if properties.SharedKey != nil && !d.IsNewResource() {
+	// setting the Shared Key resets the connection, as such this is only done when it's actually changed
+	if properties.SharedKey != nil && !d.IsNewResource() && d.HasChange("shared_key") {
 		future, err := client.SetSharedKey(ctx, id.ResourceGroup, id.ConnectionName, network.ConnectionSharedKey{
 			Value: properties.SharedKey,
 		})
@@ -304,6 +327,7 @@ func virtualNetworkGatewayConnectionCreateUpdate(d *pluginsdk.ResourceData, meta
 
 func virtualNetworkGatewayConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.VnetGatewayConnectionsClient
+	stateEncryption := meta.(*clients.Client).StateEncryption
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -363,8 +387,14 @@ func virtualNetworkGatewayConnectionRead(d *pluginsdk.ResourceData, meta interfa
 		d.Set("routing_weight", conn.RoutingWeight)
 	}
 
+	if conn.ConnectionProtocol != "" {
+		d.Set("connection_protocol", string(conn.ConnectionProtocol))
+	}
+
 	if conn.SharedKey != nil {
-		d.Set("shared_key", conn.SharedKey)
+		if err := stateEncryption.Set(d, "shared_key", conn.SharedKey); err != nil {
+			return err
+		}
 	}
 
 	if conn.IpsecPolicies != nil {
@@ -400,7 +430,7 @@ func virtualNetworkGatewayConnectionDelete(d *pluginsdk.ResourceData, meta inter
 	return nil
 }
 
-func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*network.VirtualNetworkGatewayConnectionPropertiesFormat, error) {
+func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData, stateEncryption *stateencryption.Encrypter) (*network.VirtualNetworkGatewayConnectionPropertiesFormat, error) {
 	connectionType := network.VirtualNetworkGatewayConnectionType(d.Get("type").(string))
 
 	props := &network.VirtualNetworkGatewayConnectionPropertiesFormat{
@@ -468,16 +498,26 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 		}
 	}
 
-	if v, ok := d.GetOk("routing_weight"); ok {
+	if v, ok := d.GetOk("connection_protocol"); ok {
+		props.ConnectionProtocol = network.VirtualNetworkGatewayConnectionProtocol(v.(string))
+	}
+
+	// the routing weight can be updated to `0`, which GetOk would otherwise omit
+	if v, ok := d.GetOk("routing_weight"); ok || d.HasChange("routing_weight") {
 		routingWeight := int32(v.(int))
 		props.RoutingWeight = &routingWeight
 	}
 
-	if v, ok := d.GetOk("shared_key"); ok {
-		props.SharedKey = pointer.FromString(v.(string))
+	if _, ok := d.GetOk("shared_key"); ok {
+		sharedKey, err := stateEncryption.Get(d, "shared_key")
+		if err != nil {
+			return nil, err
+		}
+		props.SharedKey = pointer.FromString(sharedKey)
 	}
 
-	if v, ok := d.GetOk("ipsec_policy"); ok {
+	// when the `ipsec_policy` block is removed an empty list must be sent to reset the connection to the default policy
+	if v, ok := d.GetOk("ipsec_policy"); ok || d.HasChange("ipsec_policy") {
 		props.IpsecPolicies = expandVirtualNetworkGatewayConnectionIpsecPolicies(v.([]interface{}))
 	}
 
@@ -493,6 +533,10 @@ func getVirtualNetworkGatewayConnectionProperties(d *pluginsdk.ResourceData) (*n
 		}
 	}
 
+	if props.ConnectionProtocol != "" && props.ConnectionType == network.ExpressRoute {
+		return nil, fmt.Errorf("`connection_protocol` cannot be specified when `type` is set to `ExpressRoute`")
+	}
+
 	if props.ConnectionType == network.Vnet2Vnet {
 		if props.VirtualNetworkGateway2 == nil || props.VirtualNetworkGateway2.ID == nil {
 			return nil, fmt.Errorf("`peer_virtual_network_gateway_id` must be specified when `type` is set to `Vnet2Vnet`")
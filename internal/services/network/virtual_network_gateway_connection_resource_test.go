@@ -25,7 +25,31 @@ func TestAccVirtualNetworkGatewayConnection_sitetosite(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccVirtualNetworkGatewayConnection_connectionProtocol(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_network_gateway_connection", "test")
+	r := VirtualNetworkGatewayConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.connectionProtocol(data, "IKEv1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connection_protocol").HasValue("IKEv1"),
+			),
+		},
+		data.ImportStepStrict(),
+		{
+			Config: r.connectionProtocol(data, "IKEv2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connection_protocol").HasValue("IKEv2"),
+			),
+		},
+		data.ImportStepStrict(),
 	})
 }
 
@@ -58,7 +82,7 @@ func TestAccVirtualNetworkGatewayConnection_sitetositeWithoutSharedKey(t *testin
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -125,6 +149,46 @@ func TestAccVirtualNetworkGatewayConnection_updatingSharedKey(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetworkGatewayConnection_updatedInPlace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_network_gateway_connection", "test")
+	r := VirtualNetworkGatewayConnectionResource{}
+
+	// the Resource GUID changes when the connection is torn down and recreated, which would drop the tunnel
+	var resourceGuid string
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.updatedInPlace(data, 10, false, 27000),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recordResourceGuid(&resourceGuid)),
+			),
+		},
+		data.ImportStepStrict("shared_key"),
+		{
+			Config: r.updatedInPlace(data, 20, true, 28000),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("routing_weight").HasValue("20"),
+				check.That(data.ResourceName).Key("use_policy_based_traffic_selectors").HasValue("true"),
+				check.That(data.ResourceName).Key("ipsec_policy.0.sa_lifetime").HasValue("28000"),
+				data.CheckWithClient(r.resourceGuidUnchanged(&resourceGuid)),
+			),
+		},
+		data.ImportStepStrict("shared_key"),
+		{
+			Config: r.updatedInPlace(data, 0, false, 27000),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("routing_weight").HasValue("0"),
+				check.That(data.ResourceName).Key("use_policy_based_traffic_selectors").HasValue("false"),
+				data.CheckWithClient(r.resourceGuidUnchanged(&resourceGuid)),
+			),
+		},
+		data.ImportStepStrict("shared_key"),
+	})
+}
+
 func (t VirtualNetworkGatewayConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	gatewayName := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
@@ -137,6 +201,49 @@ func (t VirtualNetworkGatewayConnectionResource) Exists(ctx context.Context, cli
 	return pointer.FromBool(resp.ID != nil), nil
 }
 
+func (VirtualNetworkGatewayConnectionResource) recordResourceGuid(resourceGuid *string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		guid, err := virtualNetworkGatewayConnectionResourceGuid(ctx, clients, state)
+		if err != nil {
+			return err
+		}
+
+		*resourceGuid = guid
+		return nil
+	}
+}
+
+func (VirtualNetworkGatewayConnectionResource) resourceGuidUnchanged(resourceGuid *string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		guid, err := virtualNetworkGatewayConnectionResourceGuid(ctx, clients, state)
+		if err != nil {
+			return err
+		}
+
+		if guid != *resourceGuid {
+			return fmt.Errorf("expected the Resource GUID to remain %q but got %q - the connection was recreated", *resourceGuid, guid)
+		}
+
+		return nil
+	}
+}
+
+func virtualNetworkGatewayConnectionResourceGuid(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (string, error) {
+	name := state.Attributes["name"]
+	resourceGroup := state.Attributes["resource_group_name"]
+
+	resp, err := clients.Network.VnetGatewayConnectionsClient.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return "", fmt.Errorf("reading Virtual Network Gateway Connection (%s): %+v", state.ID, err)
+	}
+
+	if resp.VirtualNetworkGatewayConnectionPropertiesFormat == nil || resp.VirtualNetworkGatewayConnectionPropertiesFormat.ResourceGUID == nil {
+		return "", fmt.Errorf("reading Virtual Network Gateway Connection (%s): `resourceGuid` was nil", state.ID)
+	}
+
+	return *resp.VirtualNetworkGatewayConnectionPropertiesFormat.ResourceGUID, nil
+}
+
 func (VirtualNetworkGatewayConnectionResource) sitetosite(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 variable "random" {
@@ -209,6 +316,79 @@ resource "azurestack_virtual_network_gateway_connection" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (VirtualNetworkGatewayConnectionResource) connectionProtocol(data acceptance.TestData, protocol string) string {
+	return fmt.Sprintf(`
+variable "random" {
+  default = "%d"
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-${var.random}"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvn-${var.random}"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "acctest-${var.random}"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Dynamic"
+}
+
+resource "azurestack_virtual_network_gateway" "test" {
+  name                = "acctest-${var.random}"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  type     = "Vpn"
+  vpn_type = "RouteBased"
+  sku      = "Standard"
+
+  ip_configuration {
+    name                          = "vnetGatewayConfig"
+    public_ip_address_id          = azurestack_public_ip.test.id
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = azurestack_subnet.test.id
+  }
+}
+
+resource "azurestack_local_network_gateway" "test" {
+  name                = "acctest-${var.random}"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  gateway_address = "168.62.225.23"
+  address_space   = ["10.1.1.0/24"]
+}
+
+resource "azurestack_virtual_network_gateway_connection" "test" {
+  name                = "acctest-${var.random}"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  type                       = "IPsec"
+  virtual_network_gateway_id = azurestack_virtual_network_gateway.test.id
+  local_network_gateway_id   = azurestack_local_network_gateway.test.id
+  connection_protocol        = "%s"
+
+  shared_key = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+}
+`, data.RandomInteger, data.Locations.Primary, protocol)
+}
+
 func (VirtualNetworkGatewayConnectionResource) sitetositeWithoutSharedKey(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 variable "random" {
@@ -421,7 +601,7 @@ resource "azurestack_virtual_network_gateway_connection" "test_2" {
 `, data.RandomInteger, rInt2, sharedKey, data.Locations.Primary, data.Locations.Secondary)
 }
 
-func (VirtualNetworkGatewayConnectionResource) ipsecpolicy(data acceptance.TestData) string {
+func (VirtualNetworkGatewayConnectionResource) ipsecTemplate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 variable "random" {
   default = "%d"
@@ -478,6 +658,12 @@ resource "azurestack_local_network_gateway" "test" {
   gateway_address = "168.62.225.23"
   address_space   = ["10.1.1.0/24"]
 }
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r VirtualNetworkGatewayConnectionResource) ipsecpolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
 
 resource "azurestack_virtual_network_gateway_connection" "test" {
   name                = "acctest-${var.random}"
@@ -504,5 +690,37 @@ resource "azurestack_virtual_network_gateway_connection" "test" {
 
   shared_key = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
 }
-`, data.RandomInteger, data.Locations.Primary)
+`, r.ipsecTemplate(data))
+}
+
+func (r VirtualNetworkGatewayConnectionResource) updatedInPlace(data acceptance.TestData, routingWeight int, usePolicyBasedTrafficSelectors bool, saLifetime int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_virtual_network_gateway_connection" "test" {
+  name                = "acctest-${var.random}"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  type                       = "IPsec"
+  virtual_network_gateway_id = azurestack_virtual_network_gateway.test.id
+  local_network_gateway_id   = azurestack_local_network_gateway.test.id
+
+  use_policy_based_traffic_selectors = %t
+  routing_weight                     = %d
+
+  ipsec_policy {
+    dh_group         = "DHGroup14"
+    ike_encryption   = "AES256"
+    ike_integrity    = "SHA256"
+    ipsec_encryption = "AES256"
+    ipsec_integrity  = "SHA256"
+    pfs_group        = "PFS2048"
+    sa_datasize      = 102400000
+    sa_lifetime      = %d
+  }
+
+  shared_key = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+}
+`, r.ipsecTemplate(data), usePolicyBasedTrafficSelectors, routingWeight, saLifetime)
 }
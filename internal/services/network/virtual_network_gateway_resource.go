@@ -2,6 +2,7 @@ package network
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,7 +12,6 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/terraform-provider-azurestack/internal/az/resourceid"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
@@ -134,7 +134,7 @@ func virtualNetworkGateway() *pluginsdk.Resource {
 						"public_ip_address_id": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: resourceid.ValidateResourceIDOrEmpty,
+							ValidateFunc: validation.Any(validation.StringIsEmpty, validate.PublicIpAddressID),
 						},
 					},
 				},
@@ -253,16 +253,23 @@ func virtualNetworkGateway() *pluginsdk.Resource {
 			},
 
 			"default_local_network_gateway_id": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: resourceid.ValidateResourceIDOrEmpty,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.LocalNetworkGatewayID,
 			},
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualNetworkGatewayCustomizeDiff),
 	}
 }
 
+func virtualNetworkGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	return validateIPConfigurationLocations(ctx, meta.(*clients.Client).Network, d, "ip_configuration")
+}
+
 func virtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.VnetGatewayClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -294,6 +301,12 @@ func virtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
+	if properties.GatewayDefaultSite != nil {
+		if err := validateVirtualNetworkGatewayDefaultSite(ctx, meta.(*clients.Client), *properties.GatewayDefaultSite.ID, properties.IPConfigurations); err != nil {
+			return fmt.Errorf("validating the default site for %s: %+v", id, err)
+		}
+	}
+
 	gateway := network.VirtualNetworkGateway{
 		Name:                                  &id.Name,
 		Location:                              &location,
@@ -301,13 +314,19 @@ func virtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		VirtualNetworkGatewayPropertiesFormat: properties,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, gateway)
-	if err != nil {
-		return fmt.Errorf("Creating/Updating %s: %+v", id, err)
-	}
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, gateway)
+		if err != nil {
+			return fmt.Errorf("Creating/Updating %s: %+v", id, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of %s: %+v", id, err)
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for completion of %s: %+v", id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID()) // TODO before release confirm no state migration is required for this
@@ -347,9 +366,11 @@ func virtualNetworkGatewayRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			d.Set("vpn_type", string(gw.VpnType))
 		}
 
-		if gw.GatewayDefaultSite != nil {
-			d.Set("default_local_network_gateway_id", gw.GatewayDefaultSite.ID)
+		defaultSiteId := ""
+		if gw.GatewayDefaultSite != nil && gw.GatewayDefaultSite.ID != nil {
+			defaultSiteId = *gw.GatewayDefaultSite.ID
 		}
+		d.Set("default_local_network_gateway_id", defaultSiteId)
 
 		if gw.Sku != nil {
 			d.Set("sku", string(gw.Sku.Name))
@@ -394,6 +415,73 @@ func virtualNetworkGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	return nil
 }
 
+// validateVirtualNetworkGatewayDefaultSite checks that the Local Network Gateway used as the default site (for
+// forced tunneling) exists, and that the Route Table associated with the Gateway Subnet (if any) doesn't send
+// the default route anywhere other than the Virtual Network Gateway - since otherwise traffic would never reach
+// the default site.
+func validateVirtualNetworkGatewayDefaultSite(ctx context.Context, client *clients.Client, defaultSiteId string, ipConfigurations *[]network.VirtualNetworkGatewayIPConfiguration) error {
+	localNetworkGatewayId, err := parse.LocalNetworkGatewayID(defaultSiteId)
+	if err != nil {
+		return err
+	}
+
+	localNetworkGateway, err := client.Network.LocalNetworkGatewaysClient.Get(ctx, localNetworkGatewayId.ResourceGroup, localNetworkGatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(localNetworkGateway.Response) {
+			return fmt.Errorf("the default site %s was not found", localNetworkGatewayId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", localNetworkGatewayId, err)
+	}
+
+	if ipConfigurations == nil {
+		return nil
+	}
+
+	for _, config := range *ipConfigurations {
+		if config.VirtualNetworkGatewayIPConfigurationPropertiesFormat == nil || config.Subnet == nil || config.Subnet.ID == nil {
+			continue
+		}
+
+		subnetId, err := parse.SubnetIDInsensitively(*config.Subnet.ID)
+		if err != nil {
+			return err
+		}
+
+		subnet, err := client.Network.SubnetsClient.Get(ctx, subnetId.ResourceGroup, subnetId.VirtualNetworkName, subnetId.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", subnetId, err)
+		}
+		if subnet.SubnetPropertiesFormat == nil || subnet.RouteTable == nil || subnet.RouteTable.ID == nil {
+			continue
+		}
+
+		routeTableId, err := parse.RouteTableID(*subnet.RouteTable.ID)
+		if err != nil {
+			return err
+		}
+
+		routeTable, err := client.Network.RouteTablesClient.Get(ctx, routeTableId.ResourceGroup, routeTableId.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", routeTableId, err)
+		}
+		if routeTable.RouteTablePropertiesFormat == nil || routeTable.Routes == nil {
+			continue
+		}
+
+		for _, route := range *routeTable.Routes {
+			if route.RoutePropertiesFormat == nil || route.AddressPrefix == nil || *route.AddressPrefix != "0.0.0.0/0" {
+				continue
+			}
+
+			if route.NextHopType != network.RouteNextHopTypeVirtualNetworkGateway {
+				return fmt.Errorf("%s associated with the Gateway %s sends the default route (`0.0.0.0/0`) to %q rather than the Virtual Network Gateway, so traffic can't reach the default site %s", routeTableId, subnetId, string(route.NextHopType), localNetworkGatewayId)
+			}
+		}
+	}
+
+	return nil
+}
+
 // NOTE: these methods are deprecated, but provided to ease compatibility for open PR's
 // TODO remove this function
 func evaluateSchemaValidateFunc(i interface{}, k string, validateFunc pluginsdk.SchemaValidateFunc) (bool, error) {
@@ -26,7 +26,7 @@ func TestAccVirtualNetworkGateway_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("sku").HasValue("Basic"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -60,7 +60,7 @@ func TestAccVirtualNetworkGateway_lowerCaseSubnetName(t *testing.T) {
 				check.That(data.ResourceName).Key("sku").HasValue("Basic"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -109,6 +109,22 @@ func TestAccVirtualNetworkGateway_vpnClientConfig(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetworkGateway_vpnClientConfigRootCertificates(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_network_gateway", "test")
+	r := VirtualNetworkGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.vpnClientConfigRootCertificates(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vpn_client_configuration.0.root_certificate.#").HasValue("1"),
+				check.That(data.ResourceName).Key("vpn_client_configuration.0.revoked_certificate.#").HasValue("1"),
+			),
+		},
+	})
+}
+
 func TestAccVirtualNetworkGateway_vpnClientConfigOpenVPN(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_virtual_network_gateway", "test")
 	r := VirtualNetworkGatewayResource{}
@@ -124,6 +140,29 @@ func TestAccVirtualNetworkGateway_vpnClientConfigOpenVPN(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetworkGateway_defaultSite(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_virtual_network_gateway", "test")
+	r := VirtualNetworkGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultSite(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_local_network_gateway_id").Exists(),
+			),
+		},
+		data.ImportStepStrict(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_local_network_gateway_id").HasValue(""),
+			),
+		},
+	})
+}
+
 func (t VirtualNetworkGatewayResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	gatewayName := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
@@ -186,6 +225,66 @@ resource "azurestack_virtual_network_gateway" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (VirtualNetworkGatewayResource) defaultSite(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "acctestpip-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Dynamic"
+}
+
+resource "azurestack_local_network_gateway" "test" {
+  name                = "acctestlng-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  gateway_address     = "168.62.225.23"
+  address_space       = ["10.1.1.0/24"]
+}
+
+resource "azurestack_virtual_network_gateway" "test" {
+  name                = "acctestvng-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  type     = "Vpn"
+  vpn_type = "RouteBased"
+  sku      = "Basic"
+
+  ip_configuration {
+    public_ip_address_id          = azurestack_public_ip.test.id
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = azurestack_subnet.test.id
+  }
+
+  default_local_network_gateway_id = azurestack_local_network_gateway.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (r VirtualNetworkGatewayResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -394,6 +493,72 @@ resource "azurestack_virtual_network_gateway" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (VirtualNetworkGatewayResource) vpnClientConfigRootCertificates(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurestack_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = azurestack_resource_group.test.name
+  virtual_network_name = azurestack_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "acctestpip-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Dynamic"
+}
+
+resource "azurestack_virtual_network_gateway" "test" {
+  depends_on          = [azurestack_public_ip.test]
+  name                = "acctestvng-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+
+  type     = "Vpn"
+  vpn_type = "RouteBased"
+  sku      = "Standard"
+
+  ip_configuration {
+    public_ip_address_id          = azurestack_public_ip.test.id
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = azurestack_subnet.test.id
+  }
+
+  vpn_client_configuration {
+    address_space        = ["10.2.0.0/24"]
+    vpn_client_protocols = ["SSTP", "IkeV2"]
+
+    root_certificate {
+      name             = "P2SRootCert"
+      public_cert_data = "MIIDDTCCAfWgAwIBAgIUDCJOgC2ohlwy+5TbJweJA9TPwfkwDQYJKoZIhvcNAQELBQAwFjEUMBIGA1UEAwwLUDJTUm9vdENlcnQwHhcNMjYxMDE3MDAyODEzWhcNMzYxMDE0MDAyODEzWjAWMRQwEgYDVQQDDAtQMlNSb290Q2VydDCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBAN+ZTMRyYaeBfV1CJHqSKoouGE7frlDCD4AxIDfbvQjowjeuMBZbRxp9KENTEK2jr90CMStzYmmZORN335nubM0i5fAjB+u/sfBvqTCiDZj3xcPgjuJsgASPplt6wgP5JBc1ySuZ4JPOuDzdTuyjZNDTvA6fNTLtJw8ee0y98vImfI6l7UMgY6tzdJgRYALfJOAqXh2gUTXEoJBienVdamQzM13zHiP99wPm6Dc5nVrih0IC18dNxtSbAQHIcv+MwSKwVBWz8CDbfqv3Rme6NpWzehi3f5EkKtsP/ErM13zx4A0ynJyJL/hDygXtW/Mz1TIGHIj2w4Xwwye/avNuyq8CAwEAAaNTMFEwHQYDVR0OBBYEFKhZ4ofeHuQbbNOw2tQBKPjmWiGVMB8GA1UdIwQYMBaAFKhZ4ofeHuQbbNOw2tQBKPjmWiGVMA8GA1UdEwEB/wQFMAMBAf8wDQYJKoZIhvcNAQELBQADggEBAEn1wR+Qh8fr13X9aTTbJCbnNntznUt3SfhOtxpBXB270ws0bdCElRtbeVcqdo1Xq+fvBj+JcOI1PZEiCgzdat6Xw1Gr12Yqgg/ppNN3SPiEiwSX047vISI5DGgHg15VE59koihQEwdizxfUoMaq1oHqSQqQ+ew6jPB3NqYZQ358OctkUG1OnJvvm3ZPIfZGdxE1dmX2B45864hDsk00KziXQBv8KCgRgiodgGlL0sE3XHmK+rOFksHzeU0u9tCnE6a2cq8W36sXqDEbjqtdYiImrz6BoxIuIP7DMIetmfrwQd7kQYgHAYP+9d+l2BWbaAVx6mqLHYu7uSZ1cedhlTU="
+    }
+
+    revoked_certificate {
+      name       = "RevokedClientCert"
+      thumbprint = "F7D3D7ACE3AA2F0C8D25E55B7A5BD33E42BEDD3E"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (VirtualNetworkGatewayResource) vpnClientConfigOpenVPN(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -0,0 +1,124 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/lro"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func virtualNetworkGatewayVpnClientPackageDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: virtualNetworkGatewayVpnClientPackageDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualNetworkGatewayID,
+			},
+
+			"processor_architecture": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(network.Amd64),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Amd64),
+					string(network.X86),
+				}, false),
+			},
+
+			"authentication_method": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(network.EAPTLS),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.EAPTLS),
+					string(network.EAPMSCHAPv2),
+				}, false),
+			},
+
+			"radius_server_auth_certificate": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"client_root_certificates": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			// the URL contains a SAS Token which grants access to the package
+			"package_url": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func virtualNetworkGatewayVpnClientPackageDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkGatewayID(d.Get("virtual_network_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.VpnClientParameters{
+		ProcessorArchitecture: network.ProcessorArchitecture(d.Get("processor_architecture").(string)),
+		AuthenticationMethod:  network.AuthenticationMethod(d.Get("authentication_method").(string)),
+	}
+
+	if v, ok := d.GetOk("radius_server_auth_certificate"); ok {
+		certificate := v.(string)
+		parameters.RadiusServerAuthCertificate = &certificate
+	}
+
+	if v, ok := d.GetOk("client_root_certificates"); ok {
+		parameters.ClientRootCertificates = utils.ExpandStringSlice(v.([]interface{}))
+	}
+
+	future, err := client.Generatevpnclientpackage(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("generating the VPN Client Package for %s: %+v", *id, err)
+	}
+
+	if err := lro.WaitForCompletion(ctx, &future, client.Client); err != nil {
+		return fmt.Errorf("waiting for the VPN Client Package for %s to be generated - check that `vpn_client_configuration` is configured on the Virtual Network Gateway: %+v", *id, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the VPN Client Package for %s: %+v", *id, err)
+	}
+	if resp.Value == nil || *resp.Value == "" {
+		return fmt.Errorf("retrieving the VPN Client Package for %s: the URL was empty", *id)
+	}
+
+	d.SetId(id.ID())
+	d.Set("virtual_network_gateway_id", id.ID())
+	d.Set("package_url", resp.Value)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package network_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type VirtualNetworkGatewayVpnClientPackageDataSource struct{}
+
+func TestAccDataSourceVirtualNetworkGatewayVpnClientPackage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_virtual_network_gateway_vpn_client_package", "test")
+	r := VirtualNetworkGatewayVpnClientPackageDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("package_url").MatchesRegex(regexp.MustCompile("^https://")),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkGatewayVpnClientPackageDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_virtual_network_gateway_vpn_client_package" "test" {
+  virtual_network_gateway_id = azurestack_virtual_network_gateway.test.id
+}
+`, VirtualNetworkGatewayResource{}.vpnClientConfigRootCertificates(data))
+}
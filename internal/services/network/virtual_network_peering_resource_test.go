@@ -30,7 +30,7 @@ func TestAccVirtualNetworkPeering_basic(t *testing.T) {
 				acceptance.TestCheckResourceAttr(secondResourceName, "allow_virtual_network_access", "true"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -80,7 +80,7 @@ func TestAccVirtualNetworkPeering_update(t *testing.T) {
 				acceptance.TestCheckResourceAttr(secondResourceName, "allow_forwarded_traffic", "false"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.basicUpdate(data),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -92,7 +92,7 @@ func TestAccVirtualNetworkPeering_update(t *testing.T) {
 				acceptance.TestCheckResourceAttr(secondResourceName, "allow_forwarded_traffic", "true"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -139,6 +139,22 @@ func virtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 	location := location.Normalize(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
+	if tags.OnlyTagsChanged(d) {
+		locks.ByID(id.ID())
+		defer locks.UnlockByID(id.ID())
+
+		future, err := client.UpdateTags(ctx, id.ResourceGroup, id.Name, network.TagsObject{Tags: tags.Expand(t)})
+		if err != nil {
+			return fmt.Errorf("updating tags for %s: %+v", id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of tags for %s: %+v", id, err)
+		}
+
+		return virtualNetworkRead(d, meta)
+	}
+
 	vnetProperties, err := expandVirtualNetworkProperties(ctx, d, meta)
 	if err != nil {
 		return err
@@ -151,7 +167,7 @@ func virtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		Tags:                           tags.Expand(t),
 	}
 
-	networkSecurityGroupNames := make([]string, 0)
+	networkSecurityGroupIds := make([]string, 0)
 	for _, subnet := range *vnet.VirtualNetworkPropertiesFormat.Subnets {
 		if subnet.NetworkSecurityGroup != nil {
 			parsedNsgID, err := parse.NetworkSecurityGroupID(*subnet.NetworkSecurityGroup.ID)
@@ -159,23 +175,32 @@ func virtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 				return err
 			}
 
-			networkSecurityGroupName := parsedNsgID.Name
-			if !utils.SliceContainsValue(networkSecurityGroupNames, networkSecurityGroupName) {
-				networkSecurityGroupNames = append(networkSecurityGroupNames, networkSecurityGroupName)
+			networkSecurityGroupId := parsedNsgID.ID()
+			if !utils.SliceContainsValue(networkSecurityGroupIds, networkSecurityGroupId) {
+				networkSecurityGroupIds = append(networkSecurityGroupIds, networkSecurityGroupId)
 			}
 		}
 	}
 
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
-	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, vnet)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
+	locks.ReadMultipleByID(&networkSecurityGroupIds)
+	defer locks.ReadUnlockMultipleByID(&networkSecurityGroupIds)
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	if err := meta.(*clients.Client).Network.RetryOnConflict(ctx, func() error {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, vnet)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	timeout, _ := ctx.Deadline()
@@ -246,13 +271,16 @@ func virtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	nsgNames, err := expandazurestackVirtualNetworkVirtualNetworkSecurityGroupNames(d)
+	nsgIds, err := expandazurestackVirtualNetworkVirtualNetworkSecurityGroupIDs(d)
 	if err != nil {
 		return fmt.Errorf("parsing Network Security Group ID's: %+v", err)
 	}
 
-	locks.MultipleByName(&nsgNames, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&nsgNames, VirtualNetworkResourceName)
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	locks.ReadMultipleByID(&nsgIds)
+	defer locks.ReadUnlockMultipleByID(&nsgIds)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -399,8 +427,8 @@ func getExistingSubnet(ctx context.Context, resGroup string, vnetName string, su
 	return &resp, nil
 }
 
-func expandazurestackVirtualNetworkVirtualNetworkSecurityGroupNames(d *pluginsdk.ResourceData) ([]string, error) {
-	nsgNames := make([]string, 0)
+func expandazurestackVirtualNetworkVirtualNetworkSecurityGroupIDs(d *pluginsdk.ResourceData) ([]string, error) {
+	nsgIds := make([]string, 0)
 
 	if v, ok := d.GetOk("subnet"); ok {
 		subnets := v.(*pluginsdk.Set).List()
@@ -417,15 +445,15 @@ func expandazurestackVirtualNetworkVirtualNetworkSecurityGroupNames(d *pluginsdk
 					return nil, err
 				}
 
-				networkSecurityGroupName := parsedNsgID.Name
-				if !utils.SliceContainsValue(nsgNames, networkSecurityGroupName) {
-					nsgNames = append(nsgNames, networkSecurityGroupName)
+				nsgId := parsedNsgID.ID()
+				if !utils.SliceContainsValue(nsgIds, nsgId) {
+					nsgIds = append(nsgIds, nsgId)
 				}
 			}
 		}
 	}
 
-	return nsgNames, nil
+	return nsgIds, nil
 }
 
 func VirtualNetworkProvisioningStateRefreshFunc(ctx context.Context, client *network.VirtualNetworksClient, id parse.VirtualNetworkId) pluginsdk.StateRefreshFunc {
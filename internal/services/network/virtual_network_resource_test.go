@@ -28,7 +28,7 @@ func TestAccVirtualNetwork_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("subnet.0.id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -43,7 +43,7 @@ func TestAccVirtualNetwork_complete(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -60,7 +60,7 @@ func TestAccVirtualNetwork_basicUpdated(t *testing.T) {
 				check.That(data.ResourceName).Key("subnet.0.id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.complete(data),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -69,7 +69,7 @@ func TestAccVirtualNetwork_basicUpdated(t *testing.T) {
 				check.That(data.ResourceName).Key("subnet.0.id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -143,7 +143,7 @@ func TestAccVirtualNetwork_deleteSubnet(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.noSubnet(data),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -151,7 +151,7 @@ func TestAccVirtualNetwork_deleteSubnet(t *testing.T) {
 				check.That(data.ResourceName).Key("subnet.#").HasValue("0"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/policy"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+type Client struct {
+	AssignmentsClient *policy.AssignmentsClient
+	DefinitionsClient *policy.DefinitionsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	assignmentsClient := policy.NewAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&assignmentsClient.Client, o.ResourceManagerAuthorizer)
+
+	definitionsClient := policy.NewDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&definitionsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AssignmentsClient: &assignmentsClient,
+		DefinitionsClient: &definitionsClient,
+	}
+}
@@ -0,0 +1,58 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NOTE: this is manually maintained since Policy Assignments can exist at any Scope, which the generator doesn't support
+
+const policyAssignmentsSegment = "/providers/Microsoft.Authorization/policyAssignments/"
+
+type PolicyAssignmentId struct {
+	Scope string
+	Name  string
+}
+
+func NewPolicyAssignmentID(scope, name string) PolicyAssignmentId {
+	return PolicyAssignmentId{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+func (id PolicyAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Policy Assignment", segmentsStr)
+}
+
+func (id PolicyAssignmentId) ID() string {
+	return fmt.Sprintf("%s%s%s", id.Scope, policyAssignmentsSegment, id.Name)
+}
+
+// PolicyAssignmentID parses a PolicyAssignment ID into an PolicyAssignmentId struct
+func PolicyAssignmentID(input string) (*PolicyAssignmentId, error) {
+	index := strings.LastIndex(strings.ToLower(input), strings.ToLower(policyAssignmentsSegment))
+	if index == -1 {
+		return nil, fmt.Errorf("expected the ID %q to be in the format `{scope}%s{name}`", input, policyAssignmentsSegment)
+	}
+
+	id := PolicyAssignmentId{
+		Scope: input[:index],
+		Name:  input[index+len(policyAssignmentsSegment):],
+	}
+
+	if id.Scope == "" || !strings.HasPrefix(id.Scope, "/") {
+		return nil, fmt.Errorf("ID was missing the `scope` element")
+	}
+
+	if id.Name == "" || strings.Contains(id.Name, "/") {
+		return nil, fmt.Errorf("ID was missing the `policyAssignments` element")
+	}
+
+	return &id, nil
+}
@@ -0,0 +1,105 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestPolicyAssignmentIDFormatter(t *testing.T) {
+	actual := NewPolicyAssignmentID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1", "assignment1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestPolicyAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PolicyAssignmentId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Scope
+			Input: "/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/policyAssignments/",
+			Error: true,
+		},
+
+		{
+			// missing policyAssignments
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Error: true,
+		},
+
+		{
+			// subscription scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Expected: &PolicyAssignmentId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012",
+				Name:  "assignment1",
+			},
+		},
+
+		{
+			// resource group scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Expected: &PolicyAssignmentId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				Name:  "assignment1",
+			},
+		},
+
+		{
+			// resource scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Expected: &PolicyAssignmentId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				Name:  "assignment1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/PROVIDERS/MICROSOFT.AUTHORIZATION/POLICYASSIGNMENTS/assignment1",
+			Expected: &PolicyAssignmentId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				Name:  "assignment1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PolicyAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PolicyDefinitionId struct {
+	SubscriptionId string
+	Name           string
+}
+
+func NewPolicyDefinitionID(subscriptionId, name string) PolicyDefinitionId {
+	return PolicyDefinitionId{
+		SubscriptionId: subscriptionId,
+		Name:           name,
+	}
+}
+
+func (id PolicyDefinitionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Policy Definition", segmentsStr)
+}
+
+func (id PolicyDefinitionId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Authorization/policyDefinitions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
+}
+
+// PolicyDefinitionID parses a PolicyDefinition ID into an PolicyDefinitionId struct
+func PolicyDefinitionID(input string) (*PolicyDefinitionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PolicyDefinitionId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("policyDefinitions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,19 @@
+package policy
+
+import (
+	"encoding/json"
+)
+
+// flattenPolicyJson flattens the (untyped) JSON properties of Policy Definitions and Assignments into a string
+func flattenPolicyJson(input interface{}) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	output, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,208 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/policy"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/policy/parse"
+	policyValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func policyAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: policyAssignmentCreateUpdate,
+		Read:   policyAssignmentRead,
+		Update: policyAssignmentCreateUpdate,
+		Delete: policyAssignmentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.PolicyAssignmentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"scope": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: policyValidate.PolicyAssignmentScope,
+			},
+
+			"policy_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: policyValidate.AssignablePolicyDefinitionID,
+			},
+
+			"display_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"parameters": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func policyAssignmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.AssignmentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewPolicyAssignmentID(d.Get("scope").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.Scope, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_policy_assignment", id.ID())
+		}
+	}
+
+	properties := policy.AssignmentProperties{
+		Scope:              pointer.FromString(id.Scope),
+		PolicyDefinitionID: pointer.FromString(d.Get("policy_definition_id").(string)),
+		DisplayName:        pointer.FromString(d.Get("display_name").(string)),
+		Description:        pointer.FromString(d.Get("description").(string)),
+	}
+
+	if v := d.Get("parameters").(string); v != "" {
+		parameters, err := pluginsdk.ExpandJsonFromString(v)
+		if err != nil {
+			return fmt.Errorf("expanding `parameters`: %+v", err)
+		}
+		properties.Parameters = &parameters
+	}
+
+	assignment := policy.Assignment{
+		AssignmentProperties: &properties,
+	}
+
+	// a Policy Definition (or Scope) which has just been provisioned can take a while to be available to assign
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	if err := pluginsdk.Retry(time.Until(deadline), retryPolicyAssignmentsClient(ctx, client, id, assignment)); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return policyAssignmentRead(d, meta)
+}
+
+func policyAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.AssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PolicyAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("scope", id.Scope)
+
+	if props := resp.AssignmentProperties; props != nil {
+		d.Set("policy_definition_id", props.PolicyDefinitionID)
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+
+		parameters, err := flattenPolicyJson(props.Parameters)
+		if err != nil {
+			return fmt.Errorf("flattening `parameters`: %+v", err)
+		}
+		d.Set("parameters", parameters)
+	}
+
+	return nil
+}
+
+func policyAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.AssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PolicyAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.Scope, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func retryPolicyAssignmentsClient(ctx context.Context, client *policy.AssignmentsClient, id parse.PolicyAssignmentId, assignment policy.Assignment) func() *pluginsdk.RetryError {
+	return func() *pluginsdk.RetryError {
+		resp, err := client.Create(ctx, id.Scope, id.Name, assignment)
+		if err != nil {
+			if utils.ResponseErrorIsRetryable(err) || utils.ResponseWasNotFound(resp.Response) {
+				// the Policy Definition (or Scope) hasn't replicated yet
+				return pluginsdk.RetryableError(err)
+			}
+
+			return pluginsdk.NonRetryableError(err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,210 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type PolicyAssignmentResource struct{}
+
+func TestAccPolicyAssignment_resourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_policy_assignment", "test")
+	r := PolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPolicyAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_policy_assignment", "test")
+	r := PolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPolicyAssignment_subscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_policy_assignment", "test")
+	r := PolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.subscription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPolicyAssignment_parameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_policy_assignment", "test")
+	r := PolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.parameters(data, "Created by the Acceptance Tests"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Created by the Acceptance Tests"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.parameters(data, "Updated by the Acceptance Tests"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Updated by the Acceptance Tests"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Policy.AssignmentsClient.Get(ctx, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(true), nil
+}
+
+func (PolicyAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_policy_definition" "test" {
+  name         = "acctestpol-%d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%d"
+
+  policy_rule = <<POLICY_RULE
+{
+  "if": {
+    "not": {
+      "field": "location",
+      "in": "[parameters('allowedLocations')]"
+    }
+  },
+  "then": {
+    "effect": "audit"
+  }
+}
+POLICY_RULE
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "type": "Array",
+    "metadata": {
+      "description": "The list of allowed locations for resources.",
+      "displayName": "Allowed locations"
+    },
+    "defaultValue": ["%s"]
+  }
+}
+PARAMETERS
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r PolicyAssignmentResource) resourceGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_policy_assignment" "test" {
+  name                 = "acctestpa-%d"
+  scope                = azurestack_resource_group.test.id
+  policy_definition_id = azurestack_policy_definition.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PolicyAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_policy_assignment" "import" {
+  name                 = azurestack_policy_assignment.test.name
+  scope                = azurestack_policy_assignment.test.scope
+  policy_definition_id = azurestack_policy_assignment.test.policy_definition_id
+}
+`, r.resourceGroup(data))
+}
+
+func (r PolicyAssignmentResource) subscription(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_subscription" "current" {}
+
+resource "azurestack_policy_assignment" "test" {
+  name                 = "acctestpa-%d"
+  scope                = data.azurestack_subscription.current.id
+  policy_definition_id = azurestack_policy_definition.test.id
+  display_name         = "acctestpa-%d"
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r PolicyAssignmentResource) parameters(data acceptance.TestData, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_policy_assignment" "test" {
+  name                 = "acctestpa-%d"
+  scope                = azurestack_resource_group.test.id
+  policy_definition_id = azurestack_policy_definition.test.id
+  description          = "%s"
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "value": ["%s"]
+  }
+}
+PARAMETERS
+}
+`, r.template(data), data.RandomInteger, description, data.Locations.Primary)
+}
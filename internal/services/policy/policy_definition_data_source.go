@@ -0,0 +1,192 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/policy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func policyDefinitionDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: policyDefinitionDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "display_name"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "display_name"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"policy_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"mode": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"policy_rule": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"parameters": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func policyDefinitionDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.DefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	var definition *policy.Definition
+	var err error
+	if name := d.Get("name").(string); name != "" {
+		definition, err = findPolicyDefinitionByName(ctx, client, name)
+	} else {
+		definition, err = findPolicyDefinitionByDisplayName(ctx, client, d.Get("display_name").(string))
+	}
+	if err != nil {
+		return err
+	}
+
+	if definition.ID == nil {
+		return fmt.Errorf("retrieving Policy Definition: `id` was nil")
+	}
+
+	d.SetId(*definition.ID)
+	d.Set("name", definition.Name)
+
+	if props := definition.DefinitionProperties; props != nil {
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+		d.Set("policy_type", string(props.PolicyType))
+		d.Set("mode", string(props.Mode))
+
+		policyRule, err := flattenPolicyJson(props.PolicyRule)
+		if err != nil {
+			return fmt.Errorf("flattening `policy_rule`: %+v", err)
+		}
+		d.Set("policy_rule", policyRule)
+
+		metadata, err := flattenPolicyJson(props.Metadata)
+		if err != nil {
+			return fmt.Errorf("flattening `metadata`: %+v", err)
+		}
+		d.Set("metadata", metadata)
+
+		parameters, err := flattenPolicyJson(props.Parameters)
+		if err != nil {
+			return fmt.Errorf("flattening `parameters`: %+v", err)
+		}
+		d.Set("parameters", parameters)
+	}
+
+	return nil
+}
+
+// findPolicyDefinitionByName looks up a Custom Policy Definition within the Subscription, falling back to the
+// Built-In Policy Definitions when one isn't found
+func findPolicyDefinitionByName(ctx context.Context, client *policy.DefinitionsClient, name string) (*policy.Definition, error) {
+	resp, err := client.Get(ctx, name)
+	if err == nil {
+		return &resp, nil
+	}
+	if !utils.ResponseWasNotFound(resp.Response) {
+		return nil, fmt.Errorf("retrieving Policy Definition %q: %+v", name, err)
+	}
+
+	resp, err = client.GetBuiltIn(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("a Policy Definition named %q was not found", name)
+		}
+		return nil, fmt.Errorf("retrieving Built-In Policy Definition %q: %+v", name, err)
+	}
+
+	return &resp, nil
+}
+
+// findPolicyDefinitionByDisplayName looks up a Custom or Built-In Policy Definition by its Display Name, which
+// (unlike the Name) isn't required to be unique - as such an error is returned when this is ambiguous
+func findPolicyDefinitionByDisplayName(ctx context.Context, client *policy.DefinitionsClient, displayName string) (*policy.Definition, error) {
+	matches := make([]policy.Definition, 0)
+	seen := make(map[string]struct{})
+
+	appendMatches := func(iterator policy.DefinitionListResultIterator, err error) error {
+		if err != nil {
+			return err
+		}
+
+		for iterator.NotDone() {
+			v := iterator.Value()
+			if props := v.DefinitionProperties; props != nil && props.DisplayName != nil && strings.EqualFold(*props.DisplayName, displayName) && v.ID != nil {
+				// the Built-In Policy Definitions can also be returned when listing those within the Subscription
+				if _, ok := seen[strings.ToLower(*v.ID)]; !ok {
+					seen[strings.ToLower(*v.ID)] = struct{}{}
+					matches = append(matches, v)
+				}
+			}
+
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := appendMatches(client.ListComplete(ctx)); err != nil {
+		return nil, fmt.Errorf("listing Policy Definitions: %+v", err)
+	}
+	if err := appendMatches(client.ListBuiltInComplete(ctx)); err != nil {
+		return nil, fmt.Errorf("listing Built-In Policy Definitions: %+v", err)
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("a Policy Definition with the Display Name %q was not found", displayName)
+	}
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("found %d Policy Definitions with the Display Name %q - please specify the `name` instead", len(matches), displayName)
+	}
+
+	return &matches[0], nil
+}
@@ -0,0 +1,91 @@
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type PolicyDefinitionDataSource struct{}
+
+func TestAccPolicyDefinitionDataSource_builtInByDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_policy_definition", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: PolicyDefinitionDataSource{}.builtInByDisplayName("Allowed locations"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").HasValue("/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c"),
+				check.That(data.ResourceName).Key("name").HasValue("e56962a6-4747-49cd-b67b-bf8b01975c4c"),
+				check.That(data.ResourceName).Key("policy_type").HasValue("BuiltIn"),
+				check.That(data.ResourceName).Key("policy_rule").Exists(),
+				check.That(data.ResourceName).Key("parameters").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccPolicyDefinitionDataSource_builtInByName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_policy_definition", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: PolicyDefinitionDataSource{}.builtInByName("e56962a6-4747-49cd-b67b-bf8b01975c4c"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").HasValue("Allowed locations"),
+				check.That(data.ResourceName).Key("policy_type").HasValue("BuiltIn"),
+			),
+		},
+	})
+}
+
+func TestAccPolicyDefinitionDataSource_custom(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_policy_definition", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: PolicyDefinitionDataSource{}.custom(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestpol-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("policy_type").HasValue("Custom"),
+				check.That(data.ResourceName).Key("mode").HasValue("All"),
+			),
+		},
+	})
+}
+
+func (PolicyDefinitionDataSource) builtInByDisplayName(displayName string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_policy_definition" "test" {
+  display_name = "%s"
+}
+`, displayName)
+}
+
+func (PolicyDefinitionDataSource) builtInByName(name string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_policy_definition" "test" {
+  name = "%s"
+}
+`, name)
+}
+
+func (PolicyDefinitionDataSource) custom(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_policy_definition" "test" {
+  display_name = azurestack_policy_definition.test.display_name
+}
+`, PolicyDefinitionResource{}.basic(data))
+}
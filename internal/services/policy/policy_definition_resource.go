@@ -0,0 +1,275 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/policy"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func policyDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: policyDefinitionCreateUpdate,
+		Read:   policyDefinitionRead,
+		Update: policyDefinitionCreateUpdate,
+		Delete: policyDefinitionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.PolicyDefinitionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"policy_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(policy.TypeBuiltIn),
+					string(policy.TypeCustom),
+					string(policy.TypeNotSpecified),
+				}, false),
+			},
+
+			"mode": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(policy.All),
+					string(policy.Indexed),
+					string(policy.NotSpecified),
+				}, false),
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"policy_rule": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			},
+
+			"metadata": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			},
+
+			"parameters": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func policyDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.DefinitionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewPolicyDefinitionID(subscriptionId, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_policy_definition", id.ID())
+		}
+	}
+
+	properties := policy.DefinitionProperties{
+		PolicyType:  policy.Type(d.Get("policy_type").(string)),
+		Mode:        policy.Mode(d.Get("mode").(string)),
+		DisplayName: pointer.FromString(d.Get("display_name").(string)),
+		Description: pointer.FromString(d.Get("description").(string)),
+	}
+
+	if v := d.Get("policy_rule").(string); v != "" {
+		policyRule, err := pluginsdk.ExpandJsonFromString(v)
+		if err != nil {
+			return fmt.Errorf("expanding `policy_rule`: %+v", err)
+		}
+		properties.PolicyRule = &policyRule
+	}
+
+	if v := d.Get("metadata").(string); v != "" {
+		metadata, err := pluginsdk.ExpandJsonFromString(v)
+		if err != nil {
+			return fmt.Errorf("expanding `metadata`: %+v", err)
+		}
+		properties.Metadata = &metadata
+	}
+
+	if v := d.Get("parameters").(string); v != "" {
+		parameters, err := pluginsdk.ExpandJsonFromString(v)
+		if err != nil {
+			return fmt.Errorf("expanding `parameters`: %+v", err)
+		}
+		properties.Parameters = &parameters
+	}
+
+	definition := policy.Definition{
+		DefinitionProperties: &properties,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.Name, definition); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if d.IsNewResource() {
+		// the Policy Definition can take a while to replicate, as such we wait for it to be consistently returned -
+		// otherwise it can't be assigned immediately afterwards
+		log.Printf("[DEBUG] Waiting for %s to replicate..", id)
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return fmt.Errorf("context had no deadline")
+		}
+		stateConf := &resource.StateChangeConf{
+			Pending:                   []string{"NotFound"},
+			Target:                    []string{"Created"},
+			Refresh:                   policyDefinitionRefreshFunc(ctx, client, id),
+			MinTimeout:                5 * time.Second,
+			ContinuousTargetOccurence: 5,
+			Timeout:                   time.Until(deadline),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for %s to replicate: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return policyDefinitionRead(d, meta)
+}
+
+func policyDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.DefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PolicyDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+
+	if props := resp.DefinitionProperties; props != nil {
+		d.Set("policy_type", string(props.PolicyType))
+		d.Set("mode", string(props.Mode))
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+
+		policyRule, err := flattenPolicyJson(props.PolicyRule)
+		if err != nil {
+			return fmt.Errorf("flattening `policy_rule`: %+v", err)
+		}
+		d.Set("policy_rule", policyRule)
+
+		metadata, err := flattenPolicyJson(props.Metadata)
+		if err != nil {
+			return fmt.Errorf("flattening `metadata`: %+v", err)
+		}
+		d.Set("metadata", metadata)
+
+		parameters, err := flattenPolicyJson(props.Parameters)
+		if err != nil {
+			return fmt.Errorf("flattening `parameters`: %+v", err)
+		}
+		d.Set("parameters", parameters)
+	}
+
+	return nil
+}
+
+func policyDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Policy.DefinitionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PolicyDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+// policyDefinitionRefreshFunc returns whether the Policy Definition has been `Created` or is `NotFound`
+func policyDefinitionRefreshFunc(ctx context.Context, client *policy.DefinitionsClient, id parse.PolicyDefinitionId) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "NotFound", nil
+			}
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		return resp, "Created", nil
+	}
+}
@@ -0,0 +1,3 @@
+package policy
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PolicyDefinition -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyDefinitions/definition1
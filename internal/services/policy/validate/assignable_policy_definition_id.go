@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// AssignablePolicyDefinitionID validates that the value is the ID of either a Built-In Policy Definition, or of a
+// Custom Policy Definition within a Subscription
+func AssignablePolicyDefinitionID(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`(?i)^(/subscriptions/[^/]+)?/providers/Microsoft\.Authorization/policyDefinitions/[^/]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Built-In Policy Definition or of a Policy Definition within a Subscription, got %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,54 @@
+package validate
+
+import "testing"
+
+func TestAssignablePolicyDefinitionID(t *testing.T) {
+	testData := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			// built-in
+			Input: "/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+			Valid: true,
+		},
+		{
+			// custom
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyDefinitions/definition1",
+			Valid: true,
+		},
+		{
+			// missing name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyDefinitions/",
+			Valid: false,
+		},
+		{
+			// resource group
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/policyDefinitions/definition1",
+			Valid: false,
+		},
+		{
+			// policy assignment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Valid: false,
+		},
+		{
+			// upper-cased
+			Input: "/PROVIDERS/MICROSOFT.AUTHORIZATION/POLICYDEFINITIONS/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+			Valid: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		_, errors := AssignablePolicyDefinitionID(v.Input, "policy_definition_id")
+		if actual := len(errors) == 0; actual != v.Valid {
+			t.Fatalf("Expected %t but got %t", v.Valid, actual)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// PolicyAssignmentScope validates that the Scope of a Policy Assignment is either a Subscription, a Resource Group,
+// or a Resource within a Resource Group
+func PolicyAssignmentScope(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`(?i)^/subscriptions/[^/]+(/resourceGroups/[^/]+(/providers/[^/]+(/[^/]+/[^/]+)+)?)?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Subscription, a Resource Group or a Resource within a Resource Group, got %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,64 @@
+package validate
+
+import "testing"
+
+func TestPolicyAssignmentScope(t *testing.T) {
+	testData := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			// subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Valid: true,
+		},
+		{
+			// subscription with a trailing slash
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Valid: false,
+		},
+		{
+			// resource group
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Valid: true,
+		},
+		{
+			// resource group with a trailing slash
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/",
+			Valid: false,
+		},
+		{
+			// resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Valid: true,
+		},
+		{
+			// nested resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Valid: true,
+		},
+		{
+			// missing resource name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks",
+			Valid: false,
+		},
+		{
+			// lower-cased
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+			Valid: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		_, errors := PolicyAssignmentScope(v.Input, "scope")
+		if actual := len(errors) == 0; actual != v.Valid {
+			t.Fatalf("Expected %t but got %t", v.Valid, actual)
+		}
+	}
+}
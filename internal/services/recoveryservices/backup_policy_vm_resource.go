@@ -0,0 +1,423 @@
+package recoveryservices
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func backupPolicyVM() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: backupPolicyVMCreateUpdate,
+		Read:   backupPolicyVMRead,
+		Update: backupPolicyVMCreateUpdate,
+		Delete: backupPolicyVMDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackupPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-_!a-zA-Z0-9]{2,149}$"),
+					"Backup Policy name must be 3 - 150 characters long, start with a letter, contain only letters and numbers.",
+				),
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"timezone": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "UTC",
+			},
+
+			"instant_restore_retention_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+
+			"backup": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"frequency": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(backup.ScheduleRunTypeDaily),
+								string(backup.ScheduleRunTypeWeekly),
+							}, false),
+						},
+
+						"time": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile("^([01][0-9]|[2][0-3]):([03][0])$"),
+								"Time of day must match the format HH:mm where HH is 00-23 and mm is 00 or 30",
+							),
+						},
+
+						"weekdays": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice(backupPolicyVMDaysOfTheWeek(), false),
+							},
+						},
+					},
+				},
+			},
+
+			"retention_daily": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"count": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 9999),
+						},
+					},
+				},
+			},
+
+			"retention_weekly": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"count": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 5163),
+						},
+
+						"weekdays": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice(backupPolicyVMDaysOfTheWeek(), false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func backupPolicyVMCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewBackupPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("recovery_vault_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_backup_policy_vm", id.ID())
+		}
+	}
+
+	schedule, err := expandBackupPolicyVMSchedule(d.Get("backup").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if schedule.ScheduleRunFrequency == backup.ScheduleRunTypeDaily && len(d.Get("retention_daily").([]interface{})) == 0 {
+		return fmt.Errorf("`retention_daily` must be specified when the `backup` `frequency` is `Daily`")
+	}
+	if schedule.ScheduleRunFrequency == backup.ScheduleRunTypeWeekly && len(d.Get("retention_weekly").([]interface{})) == 0 {
+		return fmt.Errorf("`retention_weekly` must be specified when the `backup` `frequency` is `Weekly`")
+	}
+
+	policy := &backup.AzureIaaSVMProtectionPolicy{
+		TimeZone:        pointer.FromString(d.Get("timezone").(string)),
+		SchedulePolicy:  schedule,
+		RetentionPolicy: expandBackupPolicyVMRetention(d, *schedule.ScheduleRunTimes),
+	}
+
+	if v, ok := d.GetOk("instant_restore_retention_days"); ok {
+		policy.InstantRpRetentionRangeInDays = utils.Int32(int32(v.(int)))
+	}
+
+	parameters := backup.ProtectionPolicyResource{
+		Properties: policy,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.VaultName, id.ResourceGroup, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return backupPolicyVMRead(d, meta)
+}
+
+func backupPolicyVMRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackupPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("recovery_vault_name", id.VaultName)
+
+	if resp.Properties == nil {
+		return nil
+	}
+
+	props, ok := resp.Properties.AsAzureIaaSVMProtectionPolicy()
+	if !ok {
+		return fmt.Errorf("%s was not an Azure IaaS VM Protection Policy", *id)
+	}
+
+	d.Set("timezone", props.TimeZone)
+	d.Set("instant_restore_retention_days", props.InstantRpRetentionRangeInDays)
+
+	if schedule, ok := props.SchedulePolicy.AsSimpleSchedulePolicy(); ok && schedule != nil {
+		if err := d.Set("backup", flattenBackupPolicyVMSchedule(schedule)); err != nil {
+			return fmt.Errorf("setting `backup`: %+v", err)
+		}
+	}
+
+	retentionDaily := make([]interface{}, 0)
+	retentionWeekly := make([]interface{}, 0)
+	if retention, ok := props.RetentionPolicy.AsLongTermRetentionPolicy(); ok && retention != nil {
+		retentionDaily = flattenBackupPolicyVMRetentionDaily(retention.DailySchedule)
+		retentionWeekly = flattenBackupPolicyVMRetentionWeekly(retention.WeeklySchedule)
+	}
+	if err := d.Set("retention_daily", retentionDaily); err != nil {
+		return fmt.Errorf("setting `retention_daily`: %+v", err)
+	}
+	if err := d.Set("retention_weekly", retentionWeekly); err != nil {
+		return fmt.Errorf("setting `retention_weekly`: %+v", err)
+	}
+
+	return nil
+}
+
+func backupPolicyVMDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectionPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackupPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.VaultName, id.ResourceGroup, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func backupPolicyVMDaysOfTheWeek() []string {
+	days := make([]string, 0)
+	for _, day := range backup.PossibleDayOfWeekValues() {
+		days = append(days, string(day))
+	}
+	return days
+}
+
+func expandBackupPolicyVMSchedule(input []interface{}) (*backup.SimpleSchedulePolicy, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, fmt.Errorf("`backup` must be specified")
+	}
+	raw := input[0].(map[string]interface{})
+
+	// the API only cares about the time of day, so the date portion is arbitrary
+	runTime, err := time.Parse(time.RFC3339, fmt.Sprintf("2018-07-30T%s:00Z", raw["time"].(string)))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `time` %q: %+v", raw["time"].(string), err)
+	}
+
+	schedule := &backup.SimpleSchedulePolicy{
+		ScheduleRunFrequency: backup.ScheduleRunType(raw["frequency"].(string)),
+		ScheduleRunTimes:     &[]date.Time{{Time: runTime}},
+	}
+
+	weekdays := expandBackupPolicyVMDaysOfTheWeek(raw["weekdays"].(*pluginsdk.Set).List())
+	if schedule.ScheduleRunFrequency == backup.ScheduleRunTypeWeekly {
+		if len(weekdays) == 0 {
+			return nil, fmt.Errorf("`weekdays` must be specified when the `backup` `frequency` is `Weekly`")
+		}
+		schedule.ScheduleRunDays = &weekdays
+	} else if len(weekdays) > 0 {
+		return nil, fmt.Errorf("`weekdays` can only be specified when the `backup` `frequency` is `Weekly`")
+	}
+
+	return schedule, nil
+}
+
+func expandBackupPolicyVMRetention(d *pluginsdk.ResourceData, runTimes []date.Time) *backup.LongTermRetentionPolicy {
+	retention := &backup.LongTermRetentionPolicy{}
+
+	if v := d.Get("retention_daily").([]interface{}); len(v) > 0 && v[0] != nil {
+		raw := v[0].(map[string]interface{})
+		retention.DailySchedule = &backup.DailyRetentionSchedule{
+			RetentionTimes: &runTimes,
+			RetentionDuration: &backup.RetentionDuration{
+				Count:        utils.Int32(int32(raw["count"].(int))),
+				DurationType: backup.RetentionDurationTypeDays,
+			},
+		}
+	}
+
+	if v := d.Get("retention_weekly").([]interface{}); len(v) > 0 && v[0] != nil {
+		raw := v[0].(map[string]interface{})
+		weekdays := expandBackupPolicyVMDaysOfTheWeek(raw["weekdays"].(*pluginsdk.Set).List())
+		retention.WeeklySchedule = &backup.WeeklyRetentionSchedule{
+			DaysOfTheWeek:  &weekdays,
+			RetentionTimes: &runTimes,
+			RetentionDuration: &backup.RetentionDuration{
+				Count:        utils.Int32(int32(raw["count"].(int))),
+				DurationType: backup.RetentionDurationTypeWeeks,
+			},
+		}
+	}
+
+	return retention
+}
+
+func expandBackupPolicyVMDaysOfTheWeek(input []interface{}) []backup.DayOfWeek {
+	days := make([]backup.DayOfWeek, 0)
+	for _, day := range input {
+		days = append(days, backup.DayOfWeek(day.(string)))
+	}
+	return days
+}
+
+func flattenBackupPolicyVMSchedule(input *backup.SimpleSchedulePolicy) []interface{} {
+	runTime := ""
+	if times := input.ScheduleRunTimes; times != nil && len(*times) > 0 {
+		runTime = (*times)[0].Format("15:04")
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"frequency": string(input.ScheduleRunFrequency),
+			"time":      runTime,
+			"weekdays":  flattenBackupPolicyVMDaysOfTheWeek(input.ScheduleRunDays),
+		},
+	}
+}
+
+func flattenBackupPolicyVMRetentionDaily(input *backup.DailyRetentionSchedule) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	count := 0
+	if duration := input.RetentionDuration; duration != nil && duration.Count != nil {
+		count = int(*duration.Count)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"count": count,
+		},
+	}
+}
+
+func flattenBackupPolicyVMRetentionWeekly(input *backup.WeeklyRetentionSchedule) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	count := 0
+	if duration := input.RetentionDuration; duration != nil && duration.Count != nil {
+		count = int(*duration.Count)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"count":    count,
+			"weekdays": flattenBackupPolicyVMDaysOfTheWeek(input.DaysOfTheWeek),
+		},
+	}
+}
+
+func flattenBackupPolicyVMDaysOfTheWeek(input *[]backup.DayOfWeek) []interface{} {
+	days := make([]interface{}, 0)
+	if input == nil {
+		return days
+	}
+
+	for _, day := range *input {
+		days = append(days, string(day))
+	}
+	return days
+}
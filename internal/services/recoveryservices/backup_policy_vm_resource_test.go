@@ -0,0 +1,179 @@
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type BackupPolicyVMResource struct{}
+
+func TestAccBackupPolicyVM_basicDaily(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_backup_policy_vm", "test")
+	r := BackupPolicyVMResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicDaily(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("backup.0.frequency").HasValue("Daily"),
+				check.That(data.ResourceName).Key("backup.0.time").HasValue("23:00"),
+				check.That(data.ResourceName).Key("retention_daily.0.count").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBackupPolicyVM_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_backup_policy_vm", "test")
+	r := BackupPolicyVMResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicDaily(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccBackupPolicyVM_updateDailyToWeekly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_backup_policy_vm", "test")
+	r := BackupPolicyVMResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicDaily(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basicWeekly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("backup.0.frequency").HasValue("Weekly"),
+				check.That(data.ResourceName).Key("backup.0.weekdays.#").HasValue("2"),
+				check.That(data.ResourceName).Key("retention_weekly.0.count").HasValue("42"),
+				check.That(data.ResourceName).Key("retention_weekly.0.weekdays.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (BackupPolicyVMResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackupPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.ProtectionPoliciesClient.Get(ctx, id.VaultName, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (BackupPolicyVMResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-backup-%d"
+  location = "%s"
+}
+
+resource "azurestack_recovery_services_vault" "test" {
+  name                = "acctest-RSV-%d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  sku                 = "Standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r BackupPolicyVMResource) basicDaily(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_backup_policy_vm" "test" {
+  name                = "acctest-BPVM-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  recovery_vault_name = azurestack_recovery_services_vault.test.name
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r BackupPolicyVMResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_backup_policy_vm" "import" {
+  name                = azurestack_backup_policy_vm.test.name
+  resource_group_name = azurestack_backup_policy_vm.test.resource_group_name
+  recovery_vault_name = azurestack_backup_policy_vm.test.recovery_vault_name
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+`, r.basicDaily(data))
+}
+
+func (r BackupPolicyVMResource) basicWeekly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_backup_policy_vm" "test" {
+  name                = "acctest-BPVM-%d"
+  resource_group_name = azurestack_resource_group.test.name
+  recovery_vault_name = azurestack_recovery_services_vault.test.name
+  timezone            = "UTC"
+
+  backup {
+    frequency = "Weekly"
+    time      = "23:00"
+    weekdays  = ["Sunday", "Wednesday"]
+  }
+
+  retention_weekly {
+    count    = 42
+    weekdays = ["Sunday", "Wednesday"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
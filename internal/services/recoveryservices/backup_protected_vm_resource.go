@@ -0,0 +1,223 @@
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	computeParse "github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/parse"
+	computeValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/recoveryservices/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// backupProtectedVMFabricName is the name of the Backup Fabric which contains the Protection Containers for Virtual Machines
+const backupProtectedVMFabricName = "Azure"
+
+func backupProtectedVM() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: backupProtectedVMCreateUpdate,
+		Read:   backupProtectedVMRead,
+		Update: backupProtectedVMCreateUpdate,
+		Delete: backupProtectedVMDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ProtectedItemID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(80 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(80 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(80 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"source_vm_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     computeValidate.VirtualMachineID,
+				DiffSuppressFunc: backupProtectedVMSourceIdDiffSuppress,
+			},
+
+			"backup_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.BackupPolicyID,
+			},
+		},
+	}
+}
+
+func backupProtectedVMCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vmId, err := computeParse.VirtualMachineID(d.Get("source_vm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the Protection Container and Protected Item names are derived from the Virtual Machine being protected
+	containerName := fmt.Sprintf("iaasvmcontainer;iaasvmcontainerv2;%s;%s", vmId.ResourceGroup, vmId.Name)
+	protectedItemName := fmt.Sprintf("vm;iaasvmcontainerv2;%s;%s", vmId.ResourceGroup, vmId.Name)
+	id := parse.NewProtectedItemID(subscriptionId, d.Get("resource_group_name").(string), d.Get("recovery_vault_name").(string), backupProtectedVMFabricName, containerName, protectedItemName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_backup_protected_vm", id.ID())
+		}
+	}
+
+	parameters := backup.ProtectedItemResource{
+		Properties: &backup.AzureIaaSComputeVMProtectedItem{
+			PolicyID:          pointer.FromString(d.Get("backup_policy_id").(string)),
+			ProtectedItemType: backup.ProtectedItemTypeMicrosoftComputevirtualMachines,
+			WorkloadType:      backup.DataSourceTypeVM,
+			SourceResourceID:  pointer.FromString(vmId.ID()),
+			FriendlyName:      pointer.FromString(vmId.Name),
+			VirtualMachineID:  pointer.FromString(vmId.ID()),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	// the Protected Item is provisioned asynchronously, however the API doesn't return a Future we can poll on
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"NotFound"},
+		Target:     []string{"Found"},
+		Refresh:    backupProtectedVMStateRefreshFunc(ctx, client, id),
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be provisioned: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return backupProtectedVMRead(d, meta)
+}
+
+func backupProtectedVMRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProtectedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("recovery_vault_name", id.VaultName)
+
+	if resp.Properties != nil {
+		if props, ok := resp.Properties.AsAzureIaaSComputeVMProtectedItem(); ok && props != nil {
+			d.Set("source_vm_id", props.SourceResourceID)
+			d.Set("backup_policy_id", props.PolicyID)
+		}
+	}
+
+	return nil
+}
+
+func backupProtectedVMDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProtectedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	// the Protected Item (and the Recovery Points within it) are removed asynchronously
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Found"},
+		Target:     []string{"NotFound"},
+		Refresh:    backupProtectedVMStateRefreshFunc(ctx, client, *id),
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func backupProtectedVMStateRefreshFunc(ctx context.Context, client *backup.ProtectedItemsClient, id parse.ProtectedItemId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.VaultName, id.ResourceGroup, id.BackupFabricName, id.ProtectionContainerName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "NotFound", nil
+			}
+
+			return nil, "", fmt.Errorf("polling for %s: %+v", id, err)
+		}
+
+		return resp, "Found", nil
+	}
+}
+
+// backupProtectedVMSourceIdDiffSuppress suppresses differences in casing within the Virtual Machine ID, since the
+// Recovery Services API returns the ID with a different casing to the Compute API
+func backupProtectedVMSourceIdDiffSuppress(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
+)
+
+type Client struct {
+	ProtectedItemsClient     *backup.ProtectedItemsClient
+	ProtectionPoliciesClient *backup.ProtectionPoliciesClient
+	VaultsClient             *recoveryservices.VaultsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	protectedItemsClient := backup.NewProtectedItemsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&protectedItemsClient.Client, o.ResourceManagerAuthorizer)
+
+	protectionPoliciesClient := backup.NewProtectionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&protectionPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	vaultsClient := recoveryservices.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ProtectedItemsClient:     &protectedItemsClient,
+		ProtectionPoliciesClient: &protectionPoliciesClient,
+		VaultsClient:             &vaultsClient,
+	}
+}
@@ -0,0 +1,5 @@
+package recoveryservices
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Vault -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BackupPolicy -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupPolicies/policy1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ProtectedItem -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/iaasvmcontainer;iaasvmcontainerv2;resGroup1;vm1/protectedItems/vm;iaasvmcontainerv2;resGroup1;vm1
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func RecoveryServicesVaultName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]{1,49}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 50 characters, must start with a letter and can only contain alphanumeric characters and hyphens", k))
+	}
+
+	return warnings, errors
+}
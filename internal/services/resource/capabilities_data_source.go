@@ -0,0 +1,162 @@
+package resource
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+var capabilitiesResourceTypeRegex = regexp.MustCompile(`^[^/]+/[^/]+(/[^/]+)*$`)
+
+func capabilitiesDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: capabilitiesDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_types": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringMatch(capabilitiesResourceTypeRegex, "must be in the format `{namespace}/{type}`, for example `Microsoft.Compute/virtualMachines`"),
+				},
+			},
+
+			"location": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"capabilities": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"supported": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"api_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"api_versions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"locations": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func capabilitiesDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.ProvidersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	filterLocation := location.Normalize(d.Get("location").(string))
+
+	// the Resource Providers are cached, since it's common to check multiple Resource Types within a namespace
+	providers := make(map[string]*resources.Provider)
+
+	output := make([]interface{}, 0)
+	for _, raw := range d.Get("resource_types").([]interface{}) {
+		resourceType := raw.(string)
+		segments := strings.SplitN(resourceType, "/", 2)
+		namespace := strings.ToLower(segments[0])
+
+		provider, ok := providers[namespace]
+		if !ok {
+			resp, err := client.Get(ctx, segments[0], "")
+			if err != nil {
+				if !utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("retrieving Resource Provider %q: %+v", segments[0], err)
+				}
+			} else {
+				provider = &resp
+			}
+			providers[namespace] = provider
+		}
+
+		apiVersions := make([]string, 0)
+		locations := make([]string, 0)
+		found := false
+		if provider != nil && provider.ResourceTypes != nil {
+			for _, rt := range *provider.ResourceTypes {
+				if rt.ResourceType == nil || !strings.EqualFold(*rt.ResourceType, segments[1]) {
+					continue
+				}
+
+				found = true
+				if rt.APIVersions != nil {
+					apiVersions = *rt.APIVersions
+				}
+				if rt.Locations != nil {
+					for _, v := range *rt.Locations {
+						locations = append(locations, location.Normalize(v))
+					}
+				}
+				break
+			}
+		}
+
+		// Resource Types which aren't tied to a location (for example those within `Microsoft.Authorization`)
+		// don't list any locations, so are available in every location
+		supported := found && len(apiVersions) > 0
+		if supported && filterLocation != "" && len(locations) > 0 {
+			supported = utils.SliceContainsValue(locations, filterLocation)
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_type": resourceType,
+			"supported":     supported,
+			"api_version":   capabilities.LatestAPIVersion(apiVersions),
+			"api_versions":  apiVersions,
+			"locations":     locations,
+		})
+	}
+
+	d.SetId("capabilities-" + subscriptionId)
+
+	if err := d.Set("capabilities", output); err != nil {
+		return fmt.Errorf("setting `capabilities`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type CapabilitiesDataSource struct{}
+
+func TestAccDataSourceCapabilities_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_capabilities", "test")
+	r := CapabilitiesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("capabilities.#").HasValue("3"),
+				check.That(data.ResourceName).Key("capabilities.0.resource_type").HasValue("Microsoft.Compute/virtualMachines"),
+				check.That(data.ResourceName).Key("capabilities.0.supported").HasValue("true"),
+				check.That(data.ResourceName).Key("capabilities.0.api_version").Exists(),
+				check.That(data.ResourceName).Key("capabilities.1.supported").HasValue("true"),
+				check.That(data.ResourceName).Key("capabilities.2.supported").HasValue("false"),
+				check.That(data.ResourceName).Key("capabilities.2.api_version").HasValue(""),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceCapabilities_location(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_capabilities", "test")
+	r := CapabilitiesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.location(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("capabilities.#").HasValue("1"),
+				check.That(data.ResourceName).Key("capabilities.0.supported").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (CapabilitiesDataSource) basic() string {
+	return `
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_capabilities" "test" {
+  resource_types = [
+    "Microsoft.Compute/virtualMachines",
+    "Microsoft.Network/virtualNetworks/subnets",
+    "Microsoft.Unsupported/widgets",
+  ]
+}
+`
+}
+
+func (CapabilitiesDataSource) location(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_capabilities" "test" {
+  resource_types = ["Microsoft.Network/virtualNetworks"]
+  location       = "%s"
+}
+`, data.Locations.Primary)
+}
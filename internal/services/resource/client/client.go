@@ -1,26 +1,37 @@
 package client
 
 import (
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/locks"
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/subscriptions"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/common"
 )
 
 type Client struct {
-	DeploymentsClient *resources.DeploymentsClient
-	GroupsClient      *resources.GroupsClient
-	ProvidersClient   *resources.ProvidersClient
-	ResourcesClient   *resources.Client
+	DeploymentOperationsClient *resources.DeploymentOperationsClient
+	DeploymentsClient          *resources.DeploymentsClient
+	GroupsClient               *resources.GroupsClient
+	LocksClient                *locks.ManagementLocksClient
+	ProvidersClient            *resources.ProvidersClient
+	ResourcesClient            *resources.Client
+	SubscriptionsClient        *subscriptions.Client
 
 	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	deploymentOperationsClient := resources.NewDeploymentOperationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&deploymentOperationsClient.Client, o.ResourceManagerAuthorizer)
+
 	deploymentsClient := resources.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
 
 	groupsClient := resources.NewGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
+	locksClient := locks.NewManagementLocksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&locksClient.Client, o.ResourceManagerAuthorizer)
+
 	// this has to come from the Profile since this is shared with Stack
 	providersClient := resources.NewProvidersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&providersClient.Client, o.ResourceManagerAuthorizer)
@@ -28,11 +39,17 @@ func NewClient(o *common.ClientOptions) *Client {
 	resourcesClient := resources.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourcesClient.Client, o.ResourceManagerAuthorizer)
 
+	subscriptionsClient := subscriptions.NewClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&subscriptionsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		DeploymentsClient: &deploymentsClient,
-		GroupsClient:      &groupsClient,
-		ProvidersClient:   &providersClient,
-		ResourcesClient:   &resourcesClient,
+		DeploymentOperationsClient: &deploymentOperationsClient,
+		DeploymentsClient:          &deploymentsClient,
+		GroupsClient:               &groupsClient,
+		LocksClient:                &locksClient,
+		ProvidersClient:            &providersClient,
+		ResourcesClient:            &resourcesClient,
+		SubscriptionsClient:        &subscriptionsClient,
 
 		options: o,
 	}
@@ -0,0 +1,228 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/locks"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/parse"
+	resourceValidate "github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func managementLock() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: managementLockCreate,
+		Read:   managementLockRead,
+		Delete: managementLockDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagementLockID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceValidate.ManagementLockName,
+			},
+
+			"scope": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceValidate.ManagementLockScope,
+			},
+
+			"lock_level": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(locks.CanNotDelete),
+					string(locks.ReadOnly),
+				}, false),
+			},
+
+			"notes": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+		},
+	}
+}
+
+func managementLockCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.LocksClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewManagementLockID(d.Get("scope").(string), d.Get("name").(string))
+
+	existing, err := client.GetByScope(ctx, id.Scope, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurestack_management_lock", id.ID())
+	}
+
+	lock := locks.ManagementLockObject{
+		ManagementLockProperties: &locks.ManagementLockProperties{
+			Level: locks.LockLevel(d.Get("lock_level").(string)),
+			Notes: pointer.FromString(d.Get("notes").(string)),
+		},
+	}
+
+	// a Resource which has just been provisioned can take a while to be available to lock
+	if err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), retryManagementLocksClient(ctx, client, id, lock)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	// the Lock can take a while to replicate, as such we wait for it to be consistently returned - otherwise
+	// it may not be enforced for Resources which are changed shortly afterwards
+	log.Printf("[DEBUG] Waiting for %s to replicate..", id)
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"NotFound"},
+		Target:                    []string{"Created"},
+		Refresh:                   managementLockRefreshFunc(ctx, client, id),
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to replicate: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return managementLockRead(d, meta)
+}
+
+func managementLockRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.LocksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagementLockID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetByScope(ctx, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("scope", id.Scope)
+
+	if props := resp.ManagementLockProperties; props != nil {
+		d.Set("lock_level", string(props.Level))
+		d.Set("notes", props.Notes)
+	}
+
+	return nil
+}
+
+func managementLockDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.LocksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagementLockID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DeleteByScope(ctx, id.Scope, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	// the deletion can take a while to replicate, during which the Lock continues to be enforced - as such we wait
+	// for it to be consistently not found, so that the locked Resource can be changed (or deleted) afterwards
+	log.Printf("[DEBUG] Waiting for %s to be deleted..", *id)
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"Created"},
+		Target:                    []string{"NotFound"},
+		Refresh:                   managementLockRefreshFunc(ctx, client, *id),
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be deleted: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func retryManagementLocksClient(ctx context.Context, client *locks.ManagementLocksClient, id parse.ManagementLockId, lock locks.ManagementLockObject) func() *pluginsdk.RetryError {
+	return func() *pluginsdk.RetryError {
+		resp, err := client.CreateOrUpdateByScope(ctx, id.Scope, id.Name, lock)
+		if err != nil {
+			if utils.ResponseErrorIsRetryable(err) || utils.ResponseWasNotFound(resp.Response) {
+				// the Scope hasn't replicated yet
+				return pluginsdk.RetryableError(err)
+			}
+
+			return pluginsdk.NonRetryableError(err)
+		}
+
+		return nil
+	}
+}
+
+// managementLockRefreshFunc returns whether the Lock has been `Created` or is `NotFound`
+func managementLockRefreshFunc(ctx context.Context, client *locks.ManagementLocksClient, id parse.ManagementLockId) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetByScope(ctx, id.Scope, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "NotFound", nil
+			}
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		return resp, "Created", nil
+	}
+}
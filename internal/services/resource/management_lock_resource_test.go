@@ -0,0 +1,190 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type ManagementLockResource struct{}
+
+func TestAccManagementLock_resourceGroupCanNotDelete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_management_lock", "test")
+	r := ManagementLockResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceGroup(data, "CanNotDelete"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lock_level").HasValue("CanNotDelete"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementLock_resourceGroupReadOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_management_lock", "test")
+	r := ManagementLockResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceGroup(data, "ReadOnly"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lock_level").HasValue("ReadOnly"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementLock_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_management_lock", "test")
+	r := ManagementLockResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceGroup(data, "CanNotDelete"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccManagementLock_publicIPCanNotDelete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_management_lock", "test")
+	r := ManagementLockResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.publicIP(data, "CanNotDelete"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementLock_notes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_management_lock", "test")
+	r := ManagementLockResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.notes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("notes").HasValue("Hello, World!"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ManagementLockResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagementLockID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.LocksClient.GetByScope(ctx, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(true), nil
+}
+
+func (ManagementLockResource) resourceGroup(data acceptance.TestData, lockLevel string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_management_lock" "test" {
+  name       = "acctestlock-%d"
+  scope      = azurestack_resource_group.test.id
+  lock_level = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, lockLevel)
+}
+
+func (r ManagementLockResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_management_lock" "import" {
+  name       = azurestack_management_lock.test.name
+  scope      = azurestack_management_lock.test.scope
+  lock_level = azurestack_management_lock.test.lock_level
+}
+`, r.resourceGroup(data, "CanNotDelete"))
+}
+
+func (ManagementLockResource) publicIP(data acceptance.TestData, lockLevel string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_public_ip" "test" {
+  name                    = "acctestpublicip-%d"
+  location                = azurestack_resource_group.test.location
+  resource_group_name     = azurestack_resource_group.test.name
+  allocation_method       = "Static"
+  idle_timeout_in_minutes = 30
+}
+
+resource "azurestack_management_lock" "test" {
+  name       = "acctestlock-%d"
+  scope      = azurestack_public_ip.test.id
+  lock_level = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, lockLevel)
+}
+
+func (ManagementLockResource) notes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_management_lock" "test" {
+  name       = "acctestlock-%d"
+  scope      = azurestack_resource_group.test.id
+  lock_level = "CanNotDelete"
+  notes      = "Hello, World!"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,58 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NOTE: this is manually maintained since Management Locks can exist at any Scope, which the generator doesn't support
+
+const managementLocksSegment = "/providers/Microsoft.Authorization/locks/"
+
+type ManagementLockId struct {
+	Scope string
+	Name  string
+}
+
+func NewManagementLockID(scope, name string) ManagementLockId {
+	return ManagementLockId{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+func (id ManagementLockId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Management Lock", segmentsStr)
+}
+
+func (id ManagementLockId) ID() string {
+	return fmt.Sprintf("%s%s%s", id.Scope, managementLocksSegment, id.Name)
+}
+
+// ManagementLockID parses a ManagementLock ID into an ManagementLockId struct
+func ManagementLockID(input string) (*ManagementLockId, error) {
+	index := strings.LastIndex(strings.ToLower(input), strings.ToLower(managementLocksSegment))
+	if index == -1 {
+		return nil, fmt.Errorf("expected the ID %q to be in the format `{scope}%s{name}`", input, managementLocksSegment)
+	}
+
+	id := ManagementLockId{
+		Scope: input[:index],
+		Name:  input[index+len(managementLocksSegment):],
+	}
+
+	if id.Scope == "" || !strings.HasPrefix(id.Scope, "/") {
+		return nil, fmt.Errorf("ID was missing the `scope` element")
+	}
+
+	if id.Name == "" || strings.Contains(id.Name, "/") {
+		return nil, fmt.Errorf("ID was missing the `locks` element")
+	}
+
+	return &id, nil
+}
@@ -0,0 +1,96 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestManagementLockIDFormatter(t *testing.T) {
+	actual := NewManagementLockID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1", "lock1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/locks/lock1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestManagementLockID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagementLockId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Scope
+			Input: "/providers/Microsoft.Authorization/locks/lock1",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/locks/",
+			Error: true,
+		},
+
+		{
+			// missing locks
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Error: true,
+		},
+
+		{
+			// resource group scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ManagementLockId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				Name:  "lock1",
+			},
+		},
+
+		{
+			// resource scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ManagementLockId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				Name:  "lock1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/PROVIDERS/MICROSOFT.AUTHORIZATION/LOCKS/lock1",
+			Expected: &ManagementLockId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				Name:  "lock1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagementLockID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -6,8 +6,9 @@ import (
 )
 
 var (
-	_ sdk.TypedServiceRegistration   = Registration{}
-	_ sdk.UntypedServiceRegistration = Registration{}
+	_ sdk.TypedServiceRegistration                     = Registration{}
+	_ sdk.UntypedServiceRegistration                   = Registration{}
+	_ sdk.UntypedServiceRegistrationWithImportMetadata = Registration{}
 )
 
 type Registration struct{}
@@ -29,16 +30,21 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurestack_capabilities":   capabilitiesDataSource(),
 		"azurestack_resource_group": resourceGroupDataSource(),
 		"azurestack_resources":      resourcesDataSource(),
+		"azurestack_subscription":   subscriptionDataSource(),
+		"azurestack_subscriptions":  subscriptionsDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_resource_group":      resourceGroup(),
-		"azurestack_template_deployment": templateDeployment(),
+		"azurestack_management_lock":                  managementLock(),
+		"azurestack_resource_group":                   resourceGroup(),
+		"azurestack_subscription_template_deployment": subscriptionTemplateDeployment(),
+		"azurestack_template_deployment":              templateDeployment(),
 	}
 }
 
@@ -51,3 +57,14 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{}
 }
+
+// AttributesNotReturnedByAPI returns the attributes of the Resources supported by this Service which
+// aren't returned by the API, and as such aren't populated when the Resource is imported
+func (r Registration) AttributesNotReturnedByAPI() map[string][]string {
+	return map[string][]string{
+		// the values of `securestring` and `secureObject` parameters aren't returned
+		"azurestack_subscription_template_deployment": {
+			"parameters_content",
+		},
+	}
+}
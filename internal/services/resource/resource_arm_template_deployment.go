@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
@@ -16,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
@@ -29,6 +30,11 @@ func templateDeployment() *schema.Resource {
 		Update: templateDeploymentCreate,
 		Delete: templateDeploymentDelete,
 
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.TemplateDeploymentID(id)
+			return err
+		}),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -49,10 +55,11 @@ func templateDeployment() *schema.Resource {
 			"resource_group_name": commonschema.ResourceGroupName(),
 
 			"template_body": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				StateFunc: normalizeJson,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				StateFunc:        normalizeJson,
+				DiffSuppressFunc: suppress.JsonDiff,
 			},
 
 			"parameters": {
@@ -65,11 +72,12 @@ func templateDeployment() *schema.Resource {
 			},
 
 			"parameters_body": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				StateFunc:     normalizeJson,
-				ConflictsWith: []string{"parameters"},
-				ValidateFunc:  validation.NoZeroValues,
+				Type:             schema.TypeString,
+				Optional:         true,
+				StateFunc:        normalizeJson,
+				DiffSuppressFunc: suppress.JsonDiff,
+				ConflictsWith:    []string{"parameters"},
+				ValidateFunc:     validation.StringIsJSON,
 			},
 
 			"deployment_mode": {
@@ -89,6 +97,11 @@ func templateDeployment() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+
+			"output_content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -102,6 +115,19 @@ func templateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
 	resourceGroup := d.Get("resource_group_name").(string)
 	deploymentMode := d.Get("deployment_mode").(string)
 
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_template_deployment", *existing.ID)
+		}
+	}
+
 	log.Printf("[INFO] preparing arguments for AzureStack Template Deployment creation.")
 	properties := resources.DeploymentProperties{
 		Mode: resources.DeploymentMode(deploymentMode),
@@ -187,46 +213,42 @@ func templateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("making Read request on Azure RM Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	outputs := make(map[string]string)
-	if outs := resp.Properties.Outputs; outs != nil {
-		outsVal := outs.(map[string]interface{})
-		if len(outsVal) > 0 {
-			for key, output := range outsVal {
-				log.Printf("[DEBUG] Processing deployment output %s", key)
-				outputMap := output.(map[string]interface{})
-				outputValue, ok := outputMap["value"]
-				if !ok {
-					log.Printf("[DEBUG] No value - skipping")
-					continue
-				}
-				outputType, ok := outputMap["type"]
-				if !ok {
-					log.Printf("[DEBUG] No type - skipping")
-					continue
-				}
+	templateContents, err := client.ExportTemplate(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("exporting the template for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
 
-				var outputValueString string
-				switch strings.ToLower(outputType.(string)) {
-				case "bool":
-					outputValueString = strconv.FormatBool(outputValue.(bool))
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
 
-				case "string":
-					outputValueString = outputValue.(string)
+	templateBody, err := flattenTemplateDeploymentBody(templateContents.Template)
+	if err != nil {
+		return fmt.Errorf("flattening `template_body`: %+v", err)
+	}
+	d.Set("template_body", templateBody)
 
-				case "int":
-					outputValueString = fmt.Sprint(outputValue)
+	var outputsRaw, parametersRaw interface{}
+	if props := resp.Properties; props != nil {
+		d.Set("deployment_mode", string(props.Mode))
+		outputsRaw = props.Outputs
+		parametersRaw = props.Parameters
+	}
 
-				default:
-					log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported in azurestack_template_deployment.",
-						key, outputType)
-					continue
-				}
-				outputs[key] = outputValueString
-			}
-		}
+	if err := setTemplateDeploymentParameters(d, parametersRaw, templateContents.Template); err != nil {
+		return err
 	}
 
-	return d.Set("outputs", outputs)
+	outputs, outputContent, err := flattenTemplateDeploymentOutputs(outputsRaw)
+	if err != nil {
+		return fmt.Errorf("flattening the outputs of Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := d.Set("outputs", outputs); err != nil {
+		return fmt.Errorf("setting `outputs`: %+v", err)
+	}
+	d.Set("output_content", outputContent)
+
+	return nil
 }
 
 func templateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
@@ -241,6 +263,18 @@ func templateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	resourceGroup := id.ResourceGroup
 	name := id.Path["deployments"]
 
+	if meta.(*clients.Client).Features.TemplateDeployment.DeleteNestedItemsDuringDeletion {
+		log.Printf("[DEBUG] Deleting the resources provisioned by Template Deployment %q (Resource Group %q)", name, resourceGroup)
+		operations, err := meta.(*clients.Client).Resource.DeploymentOperationsClient.ListComplete(ctx, resourceGroup, name, nil)
+		if err != nil {
+			return fmt.Errorf("listing the operations for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := deleteItemsProvisionedByTemplate(ctx, meta.(*clients.Client).Resource, operations, fmt.Sprintf("Template Deployment %q (Resource Group %q)", name, resourceGroup)); err != nil {
+			return fmt.Errorf("deleting the resources provisioned by Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	if _, err = client.Delete(ctx, resourceGroup, name); err != nil {
 		return fmt.Errorf("deleting Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -249,6 +283,73 @@ func templateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 // TODO: move this out into the new `helpers` structure
+// setTemplateDeploymentParameters sets the parameters of the Template Deployment into either `parameters_body`
+// (when that's used, or any of the values is an array or object) or otherwise `parameters`
+func setTemplateDeploymentParameters(d *schema.ResourceData, input interface{}, template interface{}) error {
+	existing := make(map[string]interface{})
+	usesParametersBody := false
+	if v := d.Get("parameters_body").(string); v != "" {
+		body, err := expandParametersBody(v)
+		if err != nil {
+			return err
+		}
+		for key, param := range body {
+			if paramMap, ok := param.(map[string]interface{}); ok {
+				existing[key] = paramMap["value"]
+			}
+		}
+		usesParametersBody = true
+	} else {
+		for key, val := range d.Get("parameters").(map[string]interface{}) {
+			existing[key] = val
+		}
+	}
+
+	parameters, err := flattenTemplateDeploymentParameters(input, template, existing)
+	if err != nil {
+		return fmt.Errorf("flattening the parameters: %+v", err)
+	}
+
+	if !usesParametersBody {
+		flattened := make(map[string]interface{}, len(parameters))
+		for key, val := range parameters {
+			switch v := val.(type) {
+			case string:
+				flattened[key] = v
+			case bool, float64, nil:
+				encoded, err := json.Marshal(v)
+				if err != nil {
+					return fmt.Errorf("encoding the parameter %q: %+v", key, err)
+				}
+				flattened[key] = string(encoded)
+			default:
+				usesParametersBody = true
+			}
+		}
+
+		if !usesParametersBody {
+			if err := d.Set("parameters", flattened); err != nil {
+				return fmt.Errorf("setting `parameters`: %+v", err)
+			}
+			return nil
+		}
+	}
+
+	body := make(map[string]interface{}, len(parameters))
+	for key, val := range parameters {
+		body[key] = map[string]interface{}{
+			"value": val,
+		}
+	}
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encoding `parameters_body`: %+v", err)
+	}
+	d.Set("parameters_body", string(encoded))
+
+	return nil
+}
+
 func expandParametersBody(body string) (map[string]interface{}, error) {
 	var parametersBody map[string]interface{}
 	err := json.Unmarshal([]byte(body), &parametersBody)
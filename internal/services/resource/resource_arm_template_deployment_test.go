@@ -124,6 +124,45 @@ func TestAccTemplateDeployment_withOutputs(t *testing.T) {
 				acceptance.TestCheckOutput("tfFalseOutput", "false"),
 				acceptance.TestCheckOutput("tfTrueOutput", "true"),
 				check.That(data.ResourceName).Key("outputs.stringOutput").HasValue("Standard_LRS"),
+				check.That(data.ResourceName).Key("outputs.arrayOutput").HasValue(`["first","second"]`),
+				check.That(data.ResourceName).Key("outputs.objectOutput").HasValue(`{"enabled":true}`),
+				check.That(data.ResourceName).Key("output_content").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccTemplateDeployment_import(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_template_deployment", "test")
+	r := TemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicSingle(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTemplateDeployment_deleteNestedItems(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_template_deployment", "test")
+	r := TemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deleteNestedItems(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// removing the Template Deployment should also remove the Public IP it provisioned
+			Config: r.deleteNestedItems(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientWithoutResource(r.publicIPHasBeenDeleted(data)),
 			),
 		},
 	})
@@ -254,6 +293,72 @@ DEPLOY
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (TemplateDeploymentResource) deleteNestedItems(data acceptance.TestData, includeDeployment bool) string {
+	deployment := ""
+	if includeDeployment {
+		deployment = fmt.Sprintf(`
+resource "azurestack_template_deployment" "test" {
+  name                = "acctesttemplate-%[1]d"
+  resource_group_name = azurestack_resource_group.test.name
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2015-06-15",
+      "name": "acctestpip-%[1]d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      }
+    }
+  ]
+}
+DEPLOY
+
+  deployment_mode = "Incremental"
+}
+`, data.RandomInteger)
+	}
+
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {
+    template_deployment {
+      delete_nested_items_during_deletion = true
+    }
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+%s
+`, data.RandomInteger, data.Locations.Primary, deployment)
+}
+
+func (TemplateDeploymentResource) publicIPHasBeenDeleted(data acceptance.TestData) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, _ *pluginsdk.InstanceState) error {
+		resourceGroup := fmt.Sprintf("acctestRG-%d", data.RandomInteger)
+		name := fmt.Sprintf("acctestpip-%d", data.RandomInteger)
+
+		resp, err := clients.Network.PublicIPsClient.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return fmt.Errorf("retrieving Public IP %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		return fmt.Errorf("expected Public IP %q (Resource Group %q) to have been deleted along with the Template Deployment", name, resourceGroup)
+	}
+}
+
 func (TemplateDeploymentResource) basicMultiple(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -774,6 +879,16 @@ resource "azurestack_template_deployment" "test" {
     "trueOutput": {
       "type": "bool",
       "value": "[parameters('trueParameter')]"
+    },
+    "arrayOutput": {
+      "type": "array",
+      "value": ["first", "second"]
+    },
+    "objectOutput": {
+      "type": "object",
+      "value": {
+        "enabled": true
+      }
     }
   }
 }
@@ -2,10 +2,14 @@ package resource
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
@@ -25,6 +29,34 @@ func resourceGroupDataSource() *pluginsdk.Resource {
 			"location": commonschema.LocationComputed(),
 
 			"tags": commonschema.TagsDataSource(),
+
+			"managed_by": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"include_resources": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"resource_types": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"resource_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -48,5 +80,51 @@ func resourceGroupDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) er
 	// but needs to be fixed (resourcegroups -> resourceGroups)
 	d.SetId(*resp.ID)
 
-	return resourceGroupRead(d, meta)
+	if err := resourceGroupRead(d, meta); err != nil {
+		return err
+	}
+
+	resourceTypes := d.Get("resource_types").(*pluginsdk.Set).List()
+	if len(resourceTypes) > 0 && !d.Get("include_resources").(bool) {
+		return fmt.Errorf("`resource_types` can only be specified when `include_resources` is enabled")
+	}
+
+	resourceIds := make([]string, 0)
+	if d.Get("include_resources").(bool) {
+		id := parse.NewResourceGroupID(meta.(*clients.Client).Account.SubscriptionId, name)
+		nestedResources, err := listResourceGroupNestedResources(ctx, meta.(*clients.Client).Resource.ResourcesClient, id)
+		if err != nil {
+			return err
+		}
+
+		resourceIds = filterResourceGroupNestedResources(nestedResources, *utils.ExpandStringSlice(resourceTypes))
+	}
+
+	if err := d.Set("resource_ids", resourceIds); err != nil {
+		return fmt.Errorf("setting `resource_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+// filterResourceGroupNestedResources returns the sorted IDs of the Resources matching one of the (case-insensitive)
+// Resource Types, or of all of the Resources when no Resource Types are specified
+func filterResourceGroupNestedResources(input []resourceGroupNestedResource, resourceTypes []string) []string {
+	output := make([]string, 0)
+	for _, nestedResource := range input {
+		matches := len(resourceTypes) == 0
+		for _, resourceType := range resourceTypes {
+			if strings.EqualFold(nestedResource.resourceType, resourceType) {
+				matches = true
+				break
+			}
+		}
+
+		if matches {
+			output = append(output, nestedResource.id)
+		}
+	}
+
+	sort.Strings(output)
+	return output
 }
@@ -23,6 +23,25 @@ func TestAccDataSourceAzurestackResourceGroup_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("location").HasValue(location.Normalize(data.Locations.Primary)),
 				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
 				check.That(data.ResourceName).Key("tags.env").HasValue("test"),
+				check.That(data.ResourceName).Key("managed_by").HasValue(""),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceAzurestackResourceGroup_includeResources(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_resource_group", "test")
+	r := ResourceGroupDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.includeResources(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_ids.#").HasValue("2"),
+				check.That("data.azurestack_resource_group.filtered").Key("resource_ids.#").HasValue("1"),
+				check.That("data.azurestack_resource_group.filtered").Key("resource_ids.0").MatchesOtherKey(
+					check.That("azurestack_virtual_network.test").Key("id"),
+				),
 			),
 		},
 	})
@@ -48,3 +67,45 @@ data "azurestack_resource_group" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (ResourceGroupDataSource) includeResources(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurestack_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+}
+
+resource "azurestack_public_ip" "test" {
+  name                = "acctestpip-%[1]d"
+  location            = azurestack_resource_group.test.location
+  resource_group_name = azurestack_resource_group.test.name
+  allocation_method   = "Static"
+}
+
+data "azurestack_resource_group" "test" {
+  name              = azurestack_resource_group.test.name
+  include_resources = true
+
+  depends_on = [azurestack_virtual_network.test, azurestack_public_ip.test]
+}
+
+data "azurestack_resource_group" "filtered" {
+  name              = azurestack_resource_group.test.name
+  include_resources = true
+  resource_types    = ["microsoft.network/virtualnetworks"]
+
+  depends_on = [azurestack_virtual_network.test, azurestack_public_ip.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
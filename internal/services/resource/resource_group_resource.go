@@ -1,8 +1,12 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
@@ -11,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	resourceClient "github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/client"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
@@ -20,9 +25,9 @@ import (
 
 func resourceGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceGroupCreateUpdate,
+		Create: resourceGroupCreate,
 		Read:   resourceGroupRead,
-		Update: resourceGroupCreateUpdate,
+		Update: resourceGroupUpdate,
 		Delete: resourceGroupDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -43,30 +48,33 @@ func resourceGroup() *pluginsdk.Resource {
 			"location": commonschema.Location(),
 
 			"tags": commonschema.Tags(),
+
+			"managed_by": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.GroupsClient
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
 	location := location.Normalize(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	if d.IsNewResource() {
-		existing, err := client.Get(ctx, name)
-		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing resource group: %+v", err)
-			}
+	existing, err := client.Get(ctx, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing resource group: %+v", err)
 		}
+	}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurestack_resource_group", *existing.ID)
-		}
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurestack_resource_group", *existing.ID)
 	}
 
 	parameters := resources.Group{
@@ -91,6 +99,31 @@ func resourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	return resourceGroupRead(d, meta)
 }
 
+func resourceGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.GroupsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ResourceGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// only the tags can be updated - these are patched rather than the Resource Group being re-created, so that
+	// properties set outside of Terraform (such as `managedBy`, which is set by operator tooling) are retained
+	if d.HasChange("tags") {
+		parameters := resources.GroupPatchable{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, parameters); err != nil {
+			return fmt.Errorf("updating Resource Group %q: %+v", id.ResourceGroup, err)
+		}
+	}
+
+	return resourceGroupRead(d, meta)
+}
+
 func resourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.GroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -114,6 +147,7 @@ func resourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	d.Set("name", resp.Name)
 	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("managed_by", resp.ManagedBy)
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -127,30 +161,38 @@ func resourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	// conditionally check for nested resources and error if they exist
-	// TODO upgrade and enable
-	/*if meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources {
-		resourceClient := meta.(*clients.Client).Resource.ResourcesClient
-		results, err := resourceClient.ListByResourceGroupComplete(ctx, id.ResourceGroup, "", "", utils.Int32(500))
+	// conditionally check for nested resources and either error or delete them when they exist
+	resourceGroupFeatures := meta.(*clients.Client).Features.ResourceGroup
+	if resourceGroupFeatures.PreventDeletionIfContainsResources || resourceGroupFeatures.DeleteNestedItemsDuringDeletion || resourceGroupFeatures.DeleteComputeResourcesFirst {
+		resourcesClient := meta.(*clients.Client).Resource.ResourcesClient
+		nestedResources, err := listResourceGroupNestedResources(ctx, resourcesClient, *id)
 		if err != nil {
-			return fmt.Errorf("listing resources in %s: %v", *id, err)
+			return err
 		}
-		nestedResourceIds := make([]string, 0)
-		for results.NotDone() {
-			val := results.Value()
-			if val.ID != nil {
-				nestedResourceIds = append(nestedResourceIds, *val.ID)
+
+		if len(nestedResources) > 0 {
+			if resourceGroupFeatures.PreventDeletionIfContainsResources {
+				nestedResourceIds := make([]string, 0)
+				for _, v := range nestedResources {
+					nestedResourceIds = append(nestedResourceIds, v.id)
+				}
+				return resourceGroupContainsItemsError(id.ResourceGroup, nestedResourceIds)
 			}
 
-			if err := results.NextWithContext(ctx); err != nil {
-				return fmt.Errorf("retrieving next page of nested items for %s: %+v", id, err)
+			if resourceGroupFeatures.DeleteComputeResourcesFirst {
+				nestedResources, err = deleteResourceGroupComputeResources(ctx, meta.(*clients.Client).Resource, *id, nestedResources)
+				if err != nil {
+					return err
+				}
 			}
-		}
 
-		if len(nestedResourceIds) > 0 {
-			return resourceGroupContainsItemsError(id.ResourceGroup, nestedResourceIds)
+			if resourceGroupFeatures.DeleteNestedItemsDuringDeletion && len(nestedResources) > 0 {
+				if err := deleteResourceGroupNestedResources(ctx, meta.(*clients.Client).Resource, *id, nestedResources); err != nil {
+					return err
+				}
+			}
 		}
-	}*/
+	}
 
 	deleteFuture, err := client.Delete(ctx, id.ResourceGroup)
 	if err != nil {
@@ -164,3 +206,188 @@ func resourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+type resourceGroupNestedResource struct {
+	id           string
+	resourceType string
+}
+
+func listResourceGroupNestedResources(ctx context.Context, client *resources.Client, id parse.ResourceGroupId) ([]resourceGroupNestedResource, error) {
+	results, err := client.ListByResourceGroupComplete(ctx, id.ResourceGroup, "", "", utils.Int32(500))
+	if err != nil {
+		return nil, fmt.Errorf("listing resources in %s: %+v", id, err)
+	}
+
+	nestedResources := make([]resourceGroupNestedResource, 0)
+	for results.NotDone() {
+		val := results.Value()
+		if val.ID != nil && val.Type != nil {
+			nestedResources = append(nestedResources, resourceGroupNestedResource{
+				id:           *val.ID,
+				resourceType: *val.Type,
+			})
+		}
+
+		if err := results.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("retrieving next page of nested items for %s: %+v", id, err)
+		}
+	}
+
+	return nestedResources, nil
+}
+
+// deleteResourceGroupNestedResources deletes each of the Resources within the Resource Group - since the order in
+// which these need to be deleted isn't known (for example a Network Interface must be deleted before the Subnet
+// it's connected to) any Resources which fail to delete are retried until no further progress can be made
+func deleteResourceGroupNestedResources(ctx context.Context, client *resourceClient.Client, id parse.ResourceGroupId, nestedResources []resourceGroupNestedResource) error {
+	apiVersions := make(map[string]string)
+
+	remaining := nestedResources
+	for len(remaining) > 0 {
+		failed := make([]resourceGroupNestedResource, 0)
+		failures := make([]string, 0)
+
+		for _, nestedResource := range remaining {
+			apiVersion, ok := apiVersions[strings.ToLower(nestedResource.resourceType)]
+			if !ok {
+				var err error
+				apiVersion, err = apiVersionForResourceType(ctx, client.ProvidersClient, nestedResource.resourceType)
+				if err != nil {
+					return err
+				}
+				apiVersions[strings.ToLower(nestedResource.resourceType)] = apiVersion
+			}
+
+			log.Printf("[DEBUG] Deleting %q (API Version %q) within %s", nestedResource.id, apiVersion, id)
+			if err := deleteResourceGroupNestedResource(ctx, client.ResourcesClient, nestedResource.id, apiVersion); err != nil {
+				log.Printf("[DEBUG] Unable to delete %q within %s, will retry: %+v", nestedResource.id, id, err)
+				failed = append(failed, nestedResource)
+				failures = append(failures, fmt.Sprintf("%s: %+v", nestedResource.id, err))
+			}
+		}
+
+		if len(failed) == len(remaining) {
+			return fmt.Errorf("deleting the nested resources within %s:\n\n%s", id, strings.Join(failures, "\n"))
+		}
+
+		remaining = failed
+	}
+
+	return nil
+}
+
+// resourceGroupComputeResourceTypes are the Resource Types which are deleted (in this order) prior to the Resource Group
+// when the `delete_compute_resources_first` feature is enabled - since otherwise the Resource Provider frequently attempts
+// to delete the Network Interfaces and Disks whilst they're still attached to a Virtual Machine, which is retried until
+// the Virtual Machine has been deleted and regularly causes the deletion of the Resource Group to time out
+var resourceGroupComputeResourceTypes = []string{
+	"Microsoft.Compute/virtualMachines",
+	"Microsoft.Network/networkInterfaces",
+	"Microsoft.Compute/disks",
+}
+
+// deleteResourceGroupComputeResources deletes the Virtual Machines, then the Network Interfaces and then the Disks within
+// the Resource Group - where the Resources of each type are deleted concurrently - returning the other nested Resources
+func deleteResourceGroupComputeResources(ctx context.Context, client *resourceClient.Client, id parse.ResourceGroupId, nestedResources []resourceGroupNestedResource) ([]resourceGroupNestedResource, error) {
+	remaining := nestedResources
+	for _, resourceType := range resourceGroupComputeResourceTypes {
+		toDelete := make([]resourceGroupNestedResource, 0)
+		others := make([]resourceGroupNestedResource, 0)
+		for _, nestedResource := range remaining {
+			if strings.EqualFold(nestedResource.resourceType, resourceType) {
+				toDelete = append(toDelete, nestedResource)
+			} else {
+				others = append(others, nestedResource)
+			}
+		}
+		remaining = others
+
+		if len(toDelete) == 0 {
+			continue
+		}
+
+		apiVersion, err := apiVersionForResourceType(ctx, client.ProvidersClient, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Printf("[DEBUG] Deleting %d %q Resources (API Version %q) within %s", len(toDelete), resourceType, apiVersion, id)
+		errors := make(chan string, len(toDelete))
+		wg := &sync.WaitGroup{}
+		for _, nestedResource := range toDelete {
+			wg.Add(1)
+			go func(resourceId string) {
+				defer wg.Done()
+				if err := deleteResourceGroupNestedResource(ctx, client.ResourcesClient, resourceId, apiVersion); err != nil {
+					errors <- fmt.Sprintf("%s: %+v", resourceId, err)
+				}
+			}(nestedResource.id)
+		}
+		wg.Wait()
+		close(errors)
+
+		failures := make([]string, 0)
+		for failure := range errors {
+			failures = append(failures, failure)
+		}
+		if len(failures) > 0 {
+			sort.Strings(failures)
+			return nil, fmt.Errorf("deleting the %q Resources within %s:\n\n%s", resourceType, id, strings.Join(failures, "\n"))
+		}
+	}
+
+	return remaining, nil
+}
+
+func deleteResourceGroupNestedResource(ctx context.Context, client *resources.Client, resourceId, apiVersion string) error {
+	future, err := client.DeleteByID(ctx, resourceId, apiVersion)
+	if err != nil {
+		if utils.WasNotFound(future.Response()) {
+			return nil
+		}
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if utils.WasNotFound(future.Response()) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func resourceGroupContainsItemsError(name string, nestedResourceIds []string) error {
+	formattedResourceUris := make([]string, 0)
+	for _, id := range nestedResourceIds {
+		formattedResourceUris = append(formattedResourceUris, fmt.Sprintf("* `%s`", id))
+	}
+	sort.Strings(formattedResourceUris)
+
+	return fmt.Errorf(`deleting Resource Group %[1]q: the Resource Group still contains Resources.
+
+Terraform is configured to check for Resources within the Resource Group when deleting the Resource Group - and
+raise an error if nested Resources still exist to avoid unintentionally deleting these Resources.
+
+Terraform has detected that the following Resources still exist within the Resource Group:
+
+%[2]s
+
+This feature is intended to avoid the unintentional destruction of nested Resources provisioned through some
+other means (for example, an ARM Template Deployment) - as such you must either remove these Resources, or
+disable this behaviour using the feature flag 'prevent_deletion_if_contains_resources' within the 'features'
+block when configuring the Provider, for example:
+
+provider "azurestack" {
+  features {
+    resource_group {
+      prevent_deletion_if_contains_resources = false
+    }
+  }
+}
+
+When that feature flag is set, Terraform will skip checking for any Resources within the Resource Group and
+delete this using the Azure API directly (which will clear up any nested resources).
+`, name, strings.Join(formattedResourceUris, "\n"))
+}
@@ -3,13 +3,18 @@ package resource_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/compute/mgmt/compute"
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/network/mgmt/network"
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
 )
 
 type ResourceGroupResource struct{}
@@ -70,14 +75,100 @@ func TestAccResourceGroup_withTags(t *testing.T) {
 	})
 }
 
-/*
-// todo put back in when we add vnets back in
+func TestAccResourceGroup_updateTagsRetainsManagedBy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_resource_group", "test")
+	testResource := ResourceGroupResource{}
+	assert := check.That(data.ResourceName)
+	data.ResourceTest(t, testResource, []acceptance.TestStep{
+		{
+			Config: testResource.withTagsConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("managed_by").HasValue(""),
+				data.CheckWithClient(testResource.setManagedByOutsideTerraform()),
+			),
+		},
+		{
+			Config: testResource.withTagsUpdatedConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("managed_by").Exists(),
+				assert.Key("tags.%").HasValue("1"),
+				assert.Key("tags.environment").HasValue("staging"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroup_withProvenanceTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_resource_group", "test")
+	testResource := ResourceGroupResource{}
+	assert := check.That(data.ResourceName)
+	data.ResourceTest(t, testResource, []acceptance.TestStep{
+		{
+			Config: testResource.withProvenanceTagsConfig(data, "Production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("tags.%").HasValue("1"),
+				assert.Key("tags.environment").HasValue("Production"),
+				data.CheckWithClient(testResource.hasProvenanceTags("acctest")),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: testResource.withProvenanceTagsConfig(data, "staging"),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("tags.%").HasValue("1"),
+				assert.Key("tags.environment").HasValue("staging"),
+				data.CheckWithClient(testResource.hasProvenanceTags("acctest")),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroup_withDefaultTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_resource_group", "test")
+	testResource := ResourceGroupResource{}
+	assert := check.That(data.ResourceName)
+	data.ResourceTest(t, testResource, []acceptance.TestStep{
+		{
+			Config: testResource.withDefaultTagsConfig(data, "Production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("tags.%").HasValue("1"),
+				assert.Key("tags.environment").HasValue("Production"),
+				data.CheckWithClient(testResource.hasTags(map[string]string{
+					"environment": "Production",
+					"owner":       "platform",
+				})),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: testResource.withDefaultTagsConfig(data, "staging"),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("tags.%").HasValue("1"),
+				assert.Key("tags.environment").HasValue("staging"),
+				data.CheckWithClient(testResource.hasTags(map[string]string{
+					"environment": "staging",
+					"owner":       "platform",
+				})),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccResourceGroup_withNestedItemsAndFeatureFlag(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurestack_resource_group", "test")
 	r := ResourceGroupResource{}
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.withFeatureFlag(data, true),
+			Config: r.withFeatureFlag(data, true, false),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				// since we don't want to track/destroy this resource for test purposes, we can create this here
@@ -88,17 +179,55 @@ func TestAccResourceGroup_withNestedItemsAndFeatureFlag(t *testing.T) {
 		data.ImportStep(),
 		{
 			// attempting to delete this with the vnet should error
-			Config:      r.withFeatureFlag(data, true),
+			Config:      r.withFeatureFlag(data, true, false),
 			Destroy:     true,
 			ExpectError: regexp.MustCompile("This feature is intended to avoid the unintentional destruction"),
 		},
 		{
 			// with the feature disabled we should delete the RG and the Network
-			Config:  r.withFeatureFlag(data, false),
+			Config:  r.withFeatureFlag(data, false, false),
+			Destroy: true,
+		},
+	})
+}
+
+func TestAccResourceGroup_withNestedItemsDeletedDuringDeletion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_resource_group", "test")
+	r := ResourceGroupResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withFeatureFlag(data, false, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.createNetworkOutsideTerraform(fmt.Sprintf("acctestvnet-%d", data.RandomInteger))),
+			),
+		},
+		{
+			// the Network is deleted prior to the Resource Group
+			Config:  r.withFeatureFlag(data, false, true),
 			Destroy: true,
 		},
 	})
-}*/
+}
+
+func TestAccResourceGroup_withComputeResourcesDeletedFirst(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_resource_group", "test")
+	r := ResourceGroupResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withDeleteComputeResourcesFirst(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.createManagedDiskOutsideTerraform(fmt.Sprintf("acctestdisk-%d", data.RandomInteger))),
+			),
+		},
+		{
+			// the Managed Disk is deleted prior to the Resource Group
+			Config:  r.withDeleteComputeResourcesFirst(data),
+			Destroy: true,
+		},
+	})
+}
 
 func (t ResourceGroupResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	resourceGroup := state.Attributes["name"]
@@ -128,6 +257,132 @@ func (t ResourceGroupResource) Exists(ctx context.Context, client *clients.Clien
 	return pointer.FromBool(resp.Properties != nil), nil
 }
 
+func (t ResourceGroupResource) createNetworkOutsideTerraform(name string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+		resourceGroup := state.Attributes["name"]
+		location := state.Attributes["location"]
+
+		vnetsClient := client.Network.VnetClient
+		params := network.VirtualNetwork{
+			Location: pointer.FromString(location),
+			VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+				AddressSpace: &network.AddressSpace{
+					AddressPrefixes: &[]string{
+						"10.0.0.0/16",
+					},
+				},
+			},
+		}
+		future, err := vnetsClient.CreateOrUpdate(ctx, resourceGroup, name, params)
+		if err != nil {
+			return fmt.Errorf("creating nested Virtual Network %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, vnetsClient.Client); err != nil {
+			return fmt.Errorf("waiting for creation of nested Virtual Network %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		return nil
+	}
+}
+
+func (t ResourceGroupResource) createManagedDiskOutsideTerraform(name string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+		resourceGroup := state.Attributes["name"]
+		location := state.Attributes["location"]
+
+		disksClient := client.Compute.DisksClient
+		params := compute.Disk{
+			Location: pointer.FromString(location),
+			Sku: &compute.DiskSku{
+				Name: compute.StandardLRS,
+			},
+			DiskProperties: &compute.DiskProperties{
+				CreationData: &compute.CreationData{
+					CreateOption: compute.Empty,
+				},
+				DiskSizeGB: utils.Int32(1),
+			},
+		}
+		future, err := disksClient.CreateOrUpdate(ctx, resourceGroup, name, params)
+		if err != nil {
+			return fmt.Errorf("creating nested Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, disksClient.Client); err != nil {
+			return fmt.Errorf("waiting for creation of nested Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		return nil
+	}
+}
+
+// setManagedByOutsideTerraform sets `managedBy` on the Resource Group (as operator tooling would) to the ID of the
+// Resource Group itself, since the value has to be a Resource ID
+func (t ResourceGroupResource) setManagedByOutsideTerraform() acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+		name := state.Attributes["name"]
+
+		existing, err := client.Resource.GroupsClient.Get(ctx, name)
+		if err != nil {
+			return fmt.Errorf("retrieving Resource Group %q: %+v", name, err)
+		}
+
+		params := resources.Group{
+			Location:  existing.Location,
+			ManagedBy: existing.ID,
+			Tags:      existing.Tags,
+		}
+		if _, err := client.Resource.GroupsClient.CreateOrUpdate(ctx, name, params); err != nil {
+			return fmt.Errorf("setting `managedBy` on Resource Group %q: %+v", name, err)
+		}
+
+		return nil
+	}
+}
+
+func (t ResourceGroupResource) hasTags(expected map[string]string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+		name := state.Attributes["name"]
+
+		resp, err := client.Resource.GroupsClient.Get(ctx, name)
+		if err != nil {
+			return fmt.Errorf("retrieving Resource Group %q: %+v", name, err)
+		}
+
+		for key, value := range expected {
+			if v, ok := resp.Tags[key]; !ok || v == nil || *v != value {
+				return fmt.Errorf("expected the tag %q to be %q on Resource Group %q", key, value, name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func (t ResourceGroupResource) hasProvenanceTags(workspace string) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+		name := state.Attributes["name"]
+
+		resp, err := client.Resource.GroupsClient.Get(ctx, name)
+		if err != nil {
+			return fmt.Errorf("retrieving Resource Group %q: %+v", name, err)
+		}
+
+		for _, key := range []string{"terraform-workspace", "terraform-module-hash", "terraform-last-apply"} {
+			if v, ok := resp.Tags[key]; !ok || v == nil || *v == "" {
+				return fmt.Errorf("expected the tag %q to be set on Resource Group %q", key, name)
+			}
+		}
+
+		if v := *resp.Tags["terraform-workspace"]; v != workspace {
+			return fmt.Errorf("expected the tag %q to be %q but got %q", "terraform-workspace", workspace, v)
+		}
+
+		return nil
+	}
+}
+
 func (t ResourceGroupResource) basicConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -141,6 +396,41 @@ resource "azurestack_resource_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (t ResourceGroupResource) withFeatureFlag(data acceptance.TestData, preventDeletion, deleteNestedItems bool) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {
+    resource_group {
+      delete_nested_items_during_deletion    = %t
+      prevent_deletion_if_contains_resources = %t
+    }
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, deleteNestedItems, preventDeletion, data.RandomInteger, data.Locations.Primary)
+}
+
+func (t ResourceGroupResource) withDeleteComputeResourcesFirst(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {
+    resource_group {
+      delete_compute_resources_first = true
+    }
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (t ResourceGroupResource) requiresImportConfig(data acceptance.TestData) string {
 	template := t.basicConfig(data)
 	return fmt.Sprintf(`
@@ -187,3 +477,47 @@ resource "azurestack_resource_group" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (t ResourceGroupResource) withProvenanceTagsConfig(data acceptance.TestData, environment string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {
+    provenance_tags {
+      enabled   = true
+      workspace = "acctest"
+    }
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags = {
+    environment = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, environment)
+}
+
+func (t ResourceGroupResource) withDefaultTagsConfig(data acceptance.TestData, environment string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+
+  default_tags = {
+    environment = "Production"
+    owner       = "platform"
+  }
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags = {
+    environment = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, environment)
+}
@@ -3,11 +3,13 @@ package resource
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-uuid"
 
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
@@ -62,7 +64,7 @@ func resourcesDataSource() *pluginsdk.Resource {
 							Computed: true,
 						},
 
-						"location": commonschema.Location(),
+						"location": commonschema.LocationComputed(),
 
 						"tags": tags.SchemaDataSource(),
 					},
@@ -82,32 +84,11 @@ func resourcesDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error
 	resourceType := d.Get("type").(string)
 	requiredTags := d.Get("required_tags").(map[string]interface{})
 
-	if resourceGroupName == "" && resourceName == "" && resourceType == "" {
-		return fmt.Errorf("At least one of `name`, `resource_group_name` or `type` must be specified")
+	if resourceGroupName == "" && resourceName == "" && resourceType == "" && len(requiredTags) == 0 {
+		return fmt.Errorf("At least one of `name`, `resource_group_name`, `type` or `required_tags` must be specified")
 	}
 
-	var filter string
-
-	if resourceGroupName != "" {
-		v := fmt.Sprintf("resourceGroup eq '%s'", resourceGroupName)
-		filter += v
-	}
-
-	if resourceName != "" {
-		if strings.Contains(filter, "eq") {
-			filter += " and "
-		}
-		v := fmt.Sprintf("name eq '%s'", resourceName)
-		filter += v
-	}
-
-	if resourceType != "" {
-		if strings.Contains(filter, "eq") {
-			filter += " and "
-		}
-		v := fmt.Sprintf("resourceType eq '%s'", resourceType)
-		filter += v
-	}
+	filter := buildResourcesFilter(resourceGroupName, resourceName, resourceType, requiredTags)
 
 	// Use List instead of listComplete because of bug in SDK: https://github.com/Azure/azure-sdk-for-go/issues/9510
 	resources := make([]map[string]interface{}, 0)
@@ -132,6 +113,38 @@ func resourcesDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error
 	return nil
 }
 
+// buildResourcesFilter returns the OData filter used to list the Resources. The API doesn't support filtering on
+// tags alongside other fields (or on more than one tag) - so when only tags are specified the Resources are filtered
+// on one of the tags by the API, and otherwise the tags are filtered client-side (see filterResource)
+func buildResourcesFilter(resourceGroupName, resourceName, resourceType string, requiredTags map[string]interface{}) string {
+	filters := make([]string, 0)
+
+	if resourceGroupName != "" {
+		filters = append(filters, fmt.Sprintf("resourceGroup eq '%s'", resourceGroupName))
+	}
+
+	if resourceName != "" {
+		filters = append(filters, fmt.Sprintf("name eq '%s'", resourceName))
+	}
+
+	if resourceType != "" {
+		filters = append(filters, fmt.Sprintf("resourceType eq '%s'", resourceType))
+	}
+
+	if len(filters) == 0 && len(requiredTags) > 0 {
+		tagNames := make([]string, 0)
+		for tagName := range requiredTags {
+			tagNames = append(tagNames, tagName)
+		}
+		sort.Strings(tagNames)
+
+		tagName := tagNames[0]
+		filters = append(filters, fmt.Sprintf("tagName eq '%s' and tagValue eq '%s'", tagName, requiredTags[tagName]))
+	}
+
+	return strings.Join(filters, " and ")
+}
+
 func filterResource(inputs []resources.GenericResourceExpanded, requiredTags map[string]interface{}) []map[string]interface{} {
 	var result []map[string]interface{}
 	for _, res := range inputs {
@@ -168,10 +181,7 @@ func filterResource(inputs []resources.GenericResourceExpanded, requiredTags map
 				resType = *res.Type
 			}
 
-			resLocation := ""
-			if res.Location != nil {
-				resLocation = *res.Location
-			}
+			resLocation := location.NormalizeNilable(res.Location)
 
 			resTags := make(map[string]interface{})
 			if res.Tags != nil {
@@ -191,7 +201,7 @@ func filterResource(inputs []resources.GenericResourceExpanded, requiredTags map
 				"tags":     resTags,
 			})
 		} else {
-			log.Printf("[DEBUG] azurestack_resources - resources %q (id: %q) skipped as a required tag is not set or has the wrong value.", *res.Name, *res.ID)
+			log.Printf("[DEBUG] azurestack_resources - resource %q skipped as a required tag is not set or has the wrong value.", *res.ID)
 		}
 	}
 	return result
@@ -78,6 +78,24 @@ func TestAccResourcesDataSource_FilteredByTags(t *testing.T) {
 	})
 }
 
+func TestAccResourcesDataSource_ByTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_resources", "test")
+	r := ResourcesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.ByTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resources.#").HasValue("1"),
+				check.That(data.ResourceName).Key("resources.0.type").HasValue("Microsoft.Storage/storageAccounts"),
+			),
+		},
+	})
+}
+
 func (r ResourcesDataSource) ByName(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -124,6 +142,19 @@ data "azurestack_resources" "test" {
 `, r.template(data))
 }
 
+func (r ResourcesDataSource) ByTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurestack_resources" "test" {
+  required_tags = {
+    environment = "production"
+    test        = "acctest%s"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
 func (ResourcesDataSource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurestack" {
@@ -131,12 +162,12 @@ provider "azurestack" {
 }
 
 resource "azurestack_resource_group" "test" {
-  name     = "acctestRG-storage-%d"
-  location = "%s"
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
 }
 
 resource "azurestack_storage_account" "test" {
-  name                = "acctestsads%s"
+  name                = "acctestsads%[3]s"
   resource_group_name = azurestack_resource_group.test.name
 
   location                 = azurestack_resource_group.test.location
@@ -145,6 +176,7 @@ resource "azurestack_storage_account" "test" {
 
   tags = {
     environment = "production"
+    test        = "acctest%[3]s"
   }
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
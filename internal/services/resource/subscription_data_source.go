@@ -0,0 +1,136 @@
+package resource
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func subscriptionDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: subscriptionDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: subscriptionDataSourceSchema(),
+	}
+}
+
+func subscriptionDataSourceSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"subscription_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tenant_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"location_placement_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"quota_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"spending_limit": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func subscriptionDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.SubscriptionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	subscriptionId := d.Get("subscription_id").(string)
+	if subscriptionId == "" {
+		subscriptionId = meta.(*clients.Client).Account.SubscriptionId
+	}
+
+	resp, err := client.Get(ctx, subscriptionId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Subscription %q was not found", subscriptionId)
+		}
+		return fmt.Errorf("retrieving Subscription %q: %+v", subscriptionId, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("retrieving Subscription %q: `id` was nil", subscriptionId)
+	}
+
+	d.SetId(*resp.ID)
+	for k, v := range flattenSubscription(resp) {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func flattenSubscription(input subscriptions.Subscription) map[string]interface{} {
+	subscriptionId := ""
+	if input.SubscriptionID != nil {
+		subscriptionId = *input.SubscriptionID
+	}
+
+	displayName := ""
+	if input.DisplayName != nil {
+		displayName = *input.DisplayName
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	locationPlacementId := ""
+	quotaId := ""
+	spendingLimit := ""
+	if policies := input.SubscriptionPolicies; policies != nil {
+		if policies.LocationPlacementID != nil {
+			locationPlacementId = *policies.LocationPlacementID
+		}
+		if policies.QuotaID != nil {
+			quotaId = *policies.QuotaID
+		}
+		spendingLimit = string(policies.SpendingLimit)
+	}
+
+	return map[string]interface{}{
+		"subscription_id":       subscriptionId,
+		"display_name":          displayName,
+		"tenant_id":             tenantId,
+		"state":                 string(input.State),
+		"location_placement_id": locationPlacementId,
+		"quota_id":              quotaId,
+		"spending_limit":        spendingLimit,
+	}
+}
@@ -0,0 +1,68 @@
+package resource_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type SubscriptionDataSource struct{}
+
+func TestAccDataSourceSubscription_current(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_subscription", "current")
+	r := SubscriptionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.current(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("subscription_id").HasValue(os.Getenv("ARM_SUBSCRIPTION_ID")),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("tenant_id").Exists(),
+				check.That(data.ResourceName).Key("state").HasValue("Enabled"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceSubscription_specific(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_subscription", "specific")
+	r := SubscriptionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.specific(os.Getenv("ARM_SUBSCRIPTION_ID")),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("subscription_id").HasValue(os.Getenv("ARM_SUBSCRIPTION_ID")),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("tenant_id").Exists(),
+				check.That(data.ResourceName).Key("state").HasValue("Enabled"),
+			),
+		},
+	})
+}
+
+func (SubscriptionDataSource) current() string {
+	return `
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_subscription" "current" {}
+`
+}
+
+func (SubscriptionDataSource) specific(subscriptionId string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_subscription" "specific" {
+  subscription_id = "%s"
+}
+`, subscriptionId)
+}
@@ -0,0 +1,273 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func subscriptionTemplateDeployment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: subscriptionTemplateDeploymentCreateUpdate,
+		Read:   subscriptionTemplateDeploymentRead,
+		Update: subscriptionTemplateDeploymentCreateUpdate,
+		Delete: subscriptionTemplateDeploymentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SubscriptionTemplateDeploymentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(180 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+
+			"location": commonschema.Location(),
+
+			"template_content": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				StateFunc:        normalizeJson,
+				DiffSuppressFunc: suppress.JsonDiff,
+				ValidateFunc:     validation.StringIsJSON,
+			},
+
+			"parameters_content": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				StateFunc:        normalizeJson,
+				DiffSuppressFunc: suppress.JsonDiff,
+				ValidateFunc:     validation.StringIsJSON,
+			},
+
+			"outputs": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"output_content": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func subscriptionTemplateDeploymentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewSubscriptionTemplateDeploymentID(subscriptionId, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.GetAtSubscriptionScope(ctx, id.DeploymentName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurestack_subscription_template_deployment", id.ID())
+		}
+	}
+
+	template, err := expandTemplateBody(d.Get("template_content").(string))
+	if err != nil {
+		return err
+	}
+
+	// deployments at the Subscription scope only support the Incremental mode
+	properties := resources.DeploymentProperties{
+		Mode:     resources.Incremental,
+		Template: &template,
+	}
+
+	if v, ok := d.GetOk("parameters_content"); ok {
+		params, err := expandParametersBody(v.(string))
+		if err != nil {
+			return err
+		}
+
+		properties.Parameters = &params
+	}
+
+	deployment := resources.Deployment{
+		Location:   pointer.FromString(location.Normalize(d.Get("location").(string))),
+		Properties: &properties,
+	}
+
+	log.Printf("[DEBUG] Validating %s..", id)
+	validationResult, err := client.ValidateAtSubscriptionScope(ctx, id.DeploymentName, deployment)
+	if err != nil {
+		return fmt.Errorf("validating %s: %+v", id, err)
+	}
+	if validationResult.Error != nil {
+		if validationResult.Error.Message != nil {
+			return fmt.Errorf("validating %s: %s", id, *validationResult.Error.Message)
+		}
+		return fmt.Errorf("validating %s: %+v", id, *validationResult.Error)
+	}
+
+	future, err := client.CreateOrUpdateAtSubscriptionScope(ctx, id.DeploymentName, deployment)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return subscriptionTemplateDeploymentRead(d, meta)
+}
+
+func subscriptionTemplateDeploymentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SubscriptionTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAtSubscriptionScope(ctx, id.DeploymentName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	templateContents, err := client.ExportTemplateAtSubscriptionScope(ctx, id.DeploymentName)
+	if err != nil {
+		return fmt.Errorf("exporting the template for %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DeploymentName)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	templateContent, err := flattenTemplateDeploymentBody(templateContents.Template)
+	if err != nil {
+		return fmt.Errorf("flattening `template_content`: %+v", err)
+	}
+	d.Set("template_content", templateContent)
+
+	var outputsRaw interface{}
+	if props := resp.Properties; props != nil {
+		outputsRaw = props.Outputs
+	}
+
+	outputs, outputContent, err := flattenTemplateDeploymentOutputs(outputsRaw)
+	if err != nil {
+		return fmt.Errorf("flattening the outputs of %s: %+v", *id, err)
+	}
+
+	if err := d.Set("outputs", outputs); err != nil {
+		return fmt.Errorf("setting `outputs`: %+v", err)
+	}
+	d.Set("output_content", outputContent)
+
+	return nil
+}
+
+func subscriptionTemplateDeploymentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SubscriptionTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if meta.(*clients.Client).Features.TemplateDeployment.DeleteNestedItemsDuringDeletion {
+		log.Printf("[DEBUG] Deleting the resources provisioned by %s", *id)
+		operations, err := meta.(*clients.Client).Resource.DeploymentOperationsClient.ListAtSubscriptionScopeComplete(ctx, id.DeploymentName, nil)
+		if err != nil {
+			return fmt.Errorf("listing the operations for %s: %+v", *id, err)
+		}
+
+		if err := deleteItemsProvisionedByTemplate(ctx, meta.(*clients.Client).Resource, operations, id.String()); err != nil {
+			return fmt.Errorf("deleting the resources provisioned by %s: %+v", *id, err)
+		}
+	}
+
+	future, err := client.DeleteAtSubscriptionScope(ctx, id.DeploymentName)
+	if err != nil {
+		if utils.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return waitForSubscriptionTemplateDeploymentToBeDeleted(ctx, client, *id)
+}
+
+func waitForSubscriptionTemplateDeploymentToBeDeleted(ctx context.Context, client *resources.DeploymentsClient, id parse.SubscriptionTemplateDeploymentId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
+	log.Printf("[DEBUG] Waiting for %s to be deleted", id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"200"},
+		Target:  []string{"404"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetAtSubscriptionScope(ctx, id.DeploymentName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, strconv.Itoa(resp.StatusCode), nil
+				}
+				return nil, "", fmt.Errorf("polling for the status of %s: %+v", id, err)
+			}
+
+			return resp, strconv.Itoa(resp.StatusCode), nil
+		},
+		Timeout: time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be deleted: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type SubscriptionTemplateDeploymentResource struct{}
+
+func TestAccSubscriptionTemplateDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subscription_template_deployment", "test")
+	r := SubscriptionTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSubscriptionTemplateDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subscription_template_deployment", "test")
+	r := SubscriptionTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSubscriptionTemplateDeployment_withParameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subscription_template_deployment", "test")
+	r := SubscriptionTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withParameters(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("outputs.resourceGroupName").HasValue(fmt.Sprintf("acctestRG-sub-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("outputs.tagValue").HasValue("first"),
+				check.That(data.ResourceName).Key("output_content").Exists(),
+			),
+		},
+		data.ImportStep("parameters_content"),
+		{
+			Config: r.withParameters(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("outputs.tagValue").HasValue("second"),
+			),
+		},
+		data.ImportStep("parameters_content"),
+	})
+}
+
+func TestAccSubscriptionTemplateDeployment_deleteNestedItems(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_subscription_template_deployment", "test")
+	r := SubscriptionTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// removing the Template Deployment should also remove the Resource Group it provisioned
+			Config: r.providerOnly(),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientWithoutResource(r.resourceGroupHasBeenDeleted(data)),
+			),
+		},
+	})
+}
+
+func (SubscriptionTemplateDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SubscriptionTemplateDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.DeploymentsClient.GetAtSubscriptionScope(ctx, id.DeploymentName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.FromBool(resp.ID != nil), nil
+}
+
+func (SubscriptionTemplateDeploymentResource) resourceGroupHasBeenDeleted(data acceptance.TestData) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		name := fmt.Sprintf("acctestRG-sub-%d", data.RandomInteger)
+
+		resp, err := clients.Resource.GroupsClient.Get(ctx, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return fmt.Errorf("retrieving Resource Group %q: %+v", name, err)
+		}
+
+		return fmt.Errorf("expected Resource Group %q to have been deleted with the Template Deployment", name)
+	}
+}
+
+func (SubscriptionTemplateDeploymentResource) providerOnly() string {
+	return `
+provider "azurestack" {
+  features {
+    template_deployment {
+      delete_nested_items_during_deletion = true
+    }
+  }
+}
+`
+}
+
+func (r SubscriptionTemplateDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subscription_template_deployment" "test" {
+  name     = "acctestsubdeploy-%d"
+  location = %q
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [
+    {
+      "type": "Microsoft.Resources/resourceGroups",
+      "apiVersion": "2018-05-01",
+      "name": "acctestRG-sub-%d",
+      "location": "%s",
+      "properties": {}
+    }
+  ]
+}
+TEMPLATE
+}
+`, r.providerOnly(), data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r SubscriptionTemplateDeploymentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subscription_template_deployment" "import" {
+  name             = azurestack_subscription_template_deployment.test.name
+  location         = azurestack_subscription_template_deployment.test.location
+  template_content = azurestack_subscription_template_deployment.test.template_content
+}
+`, r.basic(data))
+}
+
+func (r SubscriptionTemplateDeploymentResource) withParameters(data acceptance.TestData, tagValue string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_subscription_template_deployment" "test" {
+  name     = "acctestsubdeploy-%d"
+  location = %q
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "resourceGroupName": {
+      "type": "string"
+    },
+    "tagValue": {
+      "type": "string"
+    }
+  },
+  "variables": {},
+  "resources": [
+    {
+      "type": "Microsoft.Resources/resourceGroups",
+      "apiVersion": "2018-05-01",
+      "name": "[parameters('resourceGroupName')]",
+      "location": "%s",
+      "tags": {
+        "environment": "[parameters('tagValue')]"
+      },
+      "properties": {}
+    }
+  ],
+  "outputs": {
+    "resourceGroupName": {
+      "type": "string",
+      "value": "[parameters('resourceGroupName')]"
+    },
+    "tagValue": {
+      "type": "string",
+      "value": "[parameters('tagValue')]"
+    }
+  }
+}
+TEMPLATE
+
+  parameters_content = jsonencode({
+    resourceGroupName = {
+      value = "acctestRG-sub-%d"
+    }
+    tagValue = {
+      value = %q
+    }
+  })
+}
+`, r.providerOnly(), data.RandomInteger, data.Locations.Primary, data.Locations.Primary, data.RandomInteger, tagValue)
+}
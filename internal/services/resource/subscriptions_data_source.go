@@ -0,0 +1,98 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func subscriptionsDataSource() *pluginsdk.Resource {
+	subscriptionSchema := subscriptionDataSourceSchema()
+	subscriptionSchema["subscription_id"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+	subscriptionSchema["id"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+
+	return &pluginsdk.Resource{
+		Read: subscriptionsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name_prefix": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"display_name_contains": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"subscriptions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: subscriptionSchema,
+				},
+			},
+		},
+	}
+}
+
+func subscriptionsDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.SubscriptionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	displayNamePrefix := strings.ToLower(d.Get("display_name_prefix").(string))
+	displayNameContains := strings.ToLower(d.Get("display_name_contains").(string))
+
+	results, err := client.ListComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("listing Subscriptions: %+v", err)
+	}
+
+	subscriptions := make([]interface{}, 0)
+	for results.NotDone() {
+		subscription := results.Value()
+
+		displayName := ""
+		if subscription.DisplayName != nil {
+			displayName = strings.ToLower(*subscription.DisplayName)
+		}
+
+		if strings.HasPrefix(displayName, displayNamePrefix) && strings.Contains(displayName, displayNameContains) {
+			result := flattenSubscription(subscription)
+
+			id := ""
+			if subscription.ID != nil {
+				id = *subscription.ID
+			}
+			result["id"] = id
+
+			subscriptions = append(subscriptions, result)
+		}
+
+		if err := results.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Subscriptions: %+v", err)
+		}
+	}
+
+	d.SetId("subscriptions-" + meta.(*clients.Client).Account.TenantId)
+	if err := d.Set("subscriptions", subscriptions); err != nil {
+		return fmt.Errorf("setting `subscriptions`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type SubscriptionsDataSource struct{}
+
+func TestAccDataSourceSubscriptions_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_subscriptions", "current")
+	r := SubscriptionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("subscriptions.0.id").Exists(),
+				check.That(data.ResourceName).Key("subscriptions.0.subscription_id").Exists(),
+				check.That(data.ResourceName).Key("subscriptions.0.display_name").Exists(),
+				check.That(data.ResourceName).Key("subscriptions.0.tenant_id").Exists(),
+				check.That(data.ResourceName).Key("subscriptions.0.state").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceSubscriptions_prefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_subscriptions", "current")
+	r := SubscriptionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.noMatches(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("subscriptions.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (SubscriptionsDataSource) basic() string {
+	return `
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_subscriptions" "current" {}
+`
+}
+
+func (SubscriptionsDataSource) noMatches() string {
+	return `
+provider "azurestack" {
+  features {}
+}
+
+data "azurestack_subscriptions" "current" {
+  display_name_prefix   = "acctest"
+  display_name_contains = "a-display-name-which-does-not-exist"
+}
+`
+}
@@ -0,0 +1,249 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/az/capabilities"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// flattenTemplateDeploymentOutputs returns the outputs of a Template Deployment both as a map of strings (where
+// arrays and objects are JSON encoded) and as a JSON document which retains both the type and value of each output
+func flattenTemplateDeploymentOutputs(input interface{}) (map[string]string, string, error) {
+	outputs := make(map[string]string)
+	if input == nil {
+		return outputs, "{}", nil
+	}
+
+	outsVal, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, "", fmt.Errorf("expected the outputs to be a map but got %T", input)
+	}
+
+	for key, output := range outsVal {
+		log.Printf("[DEBUG] Processing deployment output %s", key)
+		outputMap, ok := output.(map[string]interface{})
+		if !ok {
+			log.Printf("[DEBUG] Output %s isn't a map - skipping", key)
+			continue
+		}
+
+		outputValue, ok := outputMap["value"]
+		if !ok {
+			log.Printf("[DEBUG] No value - skipping")
+			continue
+		}
+		outputType, ok := outputMap["type"].(string)
+		if !ok {
+			log.Printf("[DEBUG] No type - skipping")
+			continue
+		}
+
+		var outputValueString string
+		switch strings.ToLower(outputType) {
+		case "bool":
+			v, ok := outputValue.(bool)
+			if !ok {
+				return nil, "", fmt.Errorf("expected the output %q to be a bool but got %T", key, outputValue)
+			}
+			outputValueString = strconv.FormatBool(v)
+
+		case "string":
+			v, ok := outputValue.(string)
+			if !ok {
+				return nil, "", fmt.Errorf("expected the output %q to be a string but got %T", key, outputValue)
+			}
+			outputValueString = v
+
+		case "int":
+			outputValueString = fmt.Sprint(outputValue)
+
+		case "array", "object":
+			encoded, err := json.Marshal(outputValue)
+			if err != nil {
+				return nil, "", fmt.Errorf("encoding the output %q: %+v", key, err)
+			}
+			outputValueString = string(encoded)
+
+		default:
+			log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported in azurestack_template_deployment.",
+				key, outputType)
+			continue
+		}
+		outputs[key] = outputValueString
+	}
+
+	content, err := json.Marshal(outsVal)
+	if err != nil {
+		return nil, "", fmt.Errorf("encoding the outputs: %+v", err)
+	}
+
+	return outputs, string(content), nil
+}
+
+// flattenTemplateDeploymentBody returns the (exported) template of a Template Deployment as a JSON document
+func flattenTemplateDeploymentBody(input interface{}) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	content, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("encoding the template: %+v", err)
+	}
+
+	return string(content), nil
+}
+
+// flattenTemplateDeploymentParameters returns the values of the parameters of a Template Deployment - since the API
+// also returns the parameters which weren't specified (with their default value) these are omitted, unless they're
+// listed in `existing`. The values of `securestring` and `secureObject` parameters aren't returned, as such the
+// value within `existing` is retained for these
+func flattenTemplateDeploymentParameters(input interface{}, template interface{}, existing map[string]interface{}) (map[string]interface{}, error) {
+	parameters := make(map[string]interface{})
+	for key, val := range existing {
+		parameters[key] = val
+	}
+	if input == nil {
+		return parameters, nil
+	}
+
+	paramsVal, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected the parameters to be a map but got %T", input)
+	}
+
+	defaults := make(map[string]interface{})
+	if templateVal, ok := template.(map[string]interface{}); ok {
+		if templateParams, ok := templateVal["parameters"].(map[string]interface{}); ok {
+			for key, param := range templateParams {
+				if paramMap, ok := param.(map[string]interface{}); ok {
+					if defaultValue, ok := paramMap["defaultValue"]; ok {
+						defaults[key] = defaultValue
+					}
+				}
+			}
+		}
+	}
+
+	for key, param := range paramsVal {
+		paramMap, ok := param.(map[string]interface{})
+		if !ok {
+			log.Printf("[DEBUG] Parameter %s isn't a map - skipping", key)
+			continue
+		}
+
+		value, ok := paramMap["value"]
+		if !ok {
+			log.Printf("[DEBUG] No value for parameter %s - skipping", key)
+			continue
+		}
+
+		if _, specified := existing[key]; !specified {
+			if defaultValue, ok := defaults[key]; ok && reflect.DeepEqual(defaultValue, value) {
+				continue
+			}
+		}
+
+		parameters[key] = value
+	}
+
+	return parameters, nil
+}
+
+// deleteItemsProvisionedByTemplate deletes the resources which were provisioned by the specified Template Deployment,
+// which are otherwise left behind when the Deployment itself is deleted
+func deleteItemsProvisionedByTemplate(ctx context.Context, client *client.Client, operations resources.DeploymentOperationsListResultIterator, deployment string) error {
+	resourceTypes := make(map[string]string)
+	resourceIds := make([]string, 0)
+
+	for operations.NotDone() {
+		operation := operations.Value()
+		if props := operation.Properties; props != nil && props.TargetResource != nil {
+			target := props.TargetResource
+			if target.ID != nil && target.ResourceType != nil && !strings.EqualFold(*target.ResourceType, "Microsoft.Resources/deployments") {
+				if _, exists := resourceTypes[*target.ID]; !exists {
+					resourceTypes[*target.ID] = *target.ResourceType
+					resourceIds = append(resourceIds, *target.ID)
+				}
+			}
+		}
+
+		if err := operations.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing the next page of operations for %s: %+v", deployment, err)
+		}
+	}
+
+	apiVersions := make(map[string]string)
+
+	// the operations are listed in the order they were performed, as such we delete these in the reverse
+	// order so that any child resources are removed prior to their parents
+	for i := len(resourceIds) - 1; i >= 0; i-- {
+		resourceId := resourceIds[i]
+		resourceType := resourceTypes[resourceId]
+
+		apiVersion, ok := apiVersions[strings.ToLower(resourceType)]
+		if !ok {
+			var err error
+			apiVersion, err = apiVersionForResourceType(ctx, client.ProvidersClient, resourceType)
+			if err != nil {
+				return err
+			}
+			apiVersions[strings.ToLower(resourceType)] = apiVersion
+		}
+
+		log.Printf("[DEBUG] Deleting %q (API Version %q) provisioned by %s", resourceId, apiVersion, deployment)
+		future, err := client.ResourcesClient.DeleteByID(ctx, resourceId, apiVersion)
+		if err != nil {
+			if utils.WasNotFound(future.Response()) {
+				continue
+			}
+			return fmt.Errorf("deleting %q provisioned by %s: %+v", resourceId, deployment, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.ResourcesClient.Client); err != nil {
+			if utils.WasNotFound(future.Response()) {
+				continue
+			}
+			return fmt.Errorf("waiting for deletion of %q provisioned by %s: %+v", resourceId, deployment, err)
+		}
+	}
+
+	return nil
+}
+
+// apiVersionForResourceType returns the most recent stable API version supported by the
+// Azure Stack Hub for the specified Resource Type, falling back to the most recent preview version
+func apiVersionForResourceType(ctx context.Context, client *resources.ProvidersClient, resourceType string) (string, error) {
+	segments := strings.SplitN(resourceType, "/", 2)
+	if len(segments) != 2 {
+		return "", fmt.Errorf("expected the Resource Type %q to be in the format `{namespace}/{type}`", resourceType)
+	}
+
+	provider, err := client.Get(ctx, segments[0], "")
+	if err != nil {
+		return "", fmt.Errorf("retrieving Resource Provider %q: %+v", segments[0], err)
+	}
+
+	if provider.ResourceTypes != nil {
+		for _, rt := range *provider.ResourceTypes {
+			if rt.ResourceType == nil || !strings.EqualFold(*rt.ResourceType, segments[1]) || rt.APIVersions == nil {
+				continue
+			}
+
+			if version := capabilities.LatestAPIVersion(*rt.APIVersions); version != "" {
+				return version, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("unable to determine the API version for Resource Type %q", resourceType)
+}
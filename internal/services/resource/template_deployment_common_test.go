@@ -0,0 +1,83 @@
+package resource
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenTemplateDeploymentParameters(t *testing.T) {
+	template := map[string]interface{}{
+		"parameters": map[string]interface{}{
+			"storageAccountType": map[string]interface{}{
+				"type":         "string",
+				"defaultValue": "Standard_LRS",
+			},
+			"intParameter": map[string]interface{}{
+				"type":         "int",
+				"defaultValue": float64(-123),
+			},
+			"dnsLabelPrefix": map[string]interface{}{
+				"type": "string",
+			},
+			"password": map[string]interface{}{
+				"type": "securestring",
+			},
+		},
+	}
+	parameters := map[string]interface{}{
+		"storageAccountType": map[string]interface{}{"type": "String", "value": "Standard_LRS"},
+		"intParameter":       map[string]interface{}{"type": "Int", "value": float64(456)},
+		"dnsLabelPrefix":     map[string]interface{}{"type": "String", "value": "terraform-test"},
+		"password":           map[string]interface{}{"type": "SecureString"},
+	}
+
+	testCases := []struct {
+		Name     string
+		Existing map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "Imported",
+			Existing: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"intParameter":   float64(456),
+				"dnsLabelPrefix": "terraform-test",
+			},
+		},
+		{
+			Name: "Specified Default Value",
+			Existing: map[string]interface{}{
+				"storageAccountType": "Standard_LRS",
+			},
+			Expected: map[string]interface{}{
+				"storageAccountType": "Standard_LRS",
+				"intParameter":       float64(456),
+				"dnsLabelPrefix":     "terraform-test",
+			},
+		},
+		{
+			Name: "Secure String",
+			Existing: map[string]interface{}{
+				"password": "P@ssw0rd1234!",
+			},
+			Expected: map[string]interface{}{
+				"password":       "P@ssw0rd1234!",
+				"intParameter":   float64(456),
+				"dnsLabelPrefix": "terraform-test",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q", testCase.Name)
+
+		actual, err := flattenTemplateDeploymentParameters(parameters, template, testCase.Existing)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if !reflect.DeepEqual(actual, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, actual)
+		}
+	}
+}
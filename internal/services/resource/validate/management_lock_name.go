@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ManagementLockName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[^<>*%&:\\?/+]{1,260}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 260 characters and cannot contain the characters `<`, `>`, `*`, `%%`, `&`, `:`, `\\`, `?`, `/` or `+`", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestManagementLockName(t *testing.T) {
+	testData := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "lock1",
+			Valid: true,
+		},
+		{
+			Input: "Prevent Deletion - Network",
+			Valid: true,
+		},
+		{
+			Input: "lock/1",
+			Valid: false,
+		},
+		{
+			Input: "lock:1",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 260),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 261),
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		_, errors := ManagementLockName(v.Input, "name")
+		if actual := len(errors) == 0; actual != v.Valid {
+			t.Fatalf("Expected %t but got %t", v.Valid, actual)
+		}
+	}
+}
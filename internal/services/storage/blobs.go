@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/validate"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/blobs"
 )
 
@@ -203,6 +205,13 @@ func (sbu BlobUpload) uploadPageBlob(ctx context.Context) error {
 
 	fileSize := info.Size()
 
+	// Azure Stack Hub only rejects a VHD which can't be used as a Disk or Image once it's been uploaded
+	if isVHD(sbu.Source) {
+		if err := validate.VHD(file, fileSize); err != nil {
+			return fmt.Errorf("%q isn't a valid VHD for Azure Stack Hub: %+v", sbu.Source, err)
+		}
+	}
+
 	// first let's create a file of the specified file size
 	input := blobs.PutPageBlobInput{
 		BlobContentLengthBytes: fileSize,
@@ -220,6 +229,12 @@ func (sbu BlobUpload) uploadPageBlob(ctx context.Context) error {
 	return nil
 }
 
+// isVHD returns whether the source file is a VHD (or VHDX), based on its extension
+func isVHD(source string) bool {
+	extension := strings.ToLower(filepath.Ext(source))
+	return extension == ".vhd" || extension == ".vhdx"
+}
+
 // TODO: move below here into Giovanni
 
 type storageBlobPage struct {
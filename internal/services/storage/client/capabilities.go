@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+// minimumApiVersionForInfrastructureEncryption is the first version of the Storage API which supports
+// Infrastructure Encryption (double encryption) - which is only available on newer versions of Azure Stack Hub
+const minimumApiVersionForInfrastructureEncryption = "2019-06-01"
+
+var (
+	infrastructureEncryptionCache = map[string]bool{}
+	capabilitiesLock              = sync.RWMutex{}
+)
+
+// SupportsInfrastructureEncryption returns whether the Azure Stack Hub Stamp supports Infrastructure Encryption
+// for Storage Accounts, determined from the API versions exposed by the Storage Resource Provider.
+func (client Client) SupportsInfrastructureEncryption(ctx context.Context) (bool, error) {
+	cacheKey := client.endpoint + "/" + client.AccountsClient.SubscriptionID
+
+	capabilitiesLock.RLock()
+	supported, ok := infrastructureEncryptionCache[cacheKey]
+	capabilitiesLock.RUnlock()
+	if ok {
+		return supported, nil
+	}
+
+	capabilitiesLock.Lock()
+	defer capabilitiesLock.Unlock()
+
+	provider, err := client.ProvidersClient.Get(ctx, "Microsoft.Storage", "")
+	if err != nil {
+		return false, fmt.Errorf("retrieving Resource Provider %q: %+v", "Microsoft.Storage", err)
+	}
+
+	supported = false
+	if provider.ResourceTypes != nil {
+		for _, rt := range *provider.ResourceTypes {
+			if rt.ResourceType == nil || !strings.EqualFold(*rt.ResourceType, "storageAccounts") || rt.APIVersions == nil {
+				continue
+			}
+
+			for _, version := range *rt.APIVersions {
+				// API versions are dates in the format `YYYY-MM-DD` (optionally with a `-preview` suffix)
+				// so can be compared lexicographically
+				if strings.Contains(strings.ToLower(version), "preview") {
+					continue
+				}
+				if version >= minimumApiVersionForInfrastructureEncryption {
+					supported = true
+					break
+				}
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Azure Stack Hub supports Infrastructure Encryption for Storage Accounts: %t", supported)
+	infrastructureEncryptionCache[cacheKey] = supported
+
+	return supported, nil
+}
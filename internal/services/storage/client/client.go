@@ -3,9 +3,12 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/storage/mgmt/storage"
+	storage2019 "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -14,23 +17,50 @@ import (
 	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/blobs"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/containers"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/messages"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/queues"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/table/entities"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/table/tables"
 )
 
 type Client struct {
 	AccountsClient *storage.AccountsClient
 
+	// AccountsClientV2019 uses a newer API version than the Profile, which is required for features
+	// only available on newer versions of Azure Stack Hub (such as Infrastructure Encryption)
+	AccountsClientV2019 *storage2019.AccountsClient
+	ProvidersClient     *resources.ProvidersClient
+	UsageClient         *storage.UsageClient
+
 	Env      azure.Environment
 	endpoint string
+
+	// httpClient is used by the Data Plane clients (which aren't configured using the ClientOptions), these
+	// intentionally don't log requests since the bodies can contain large amounts of data (e.g. Page Blobs)
+	httpClient *http.Client
 }
 
 func NewClient(options *common.ClientOptions) *Client {
 	accountsClient := storage.NewAccountsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&accountsClient.Client, options.ResourceManagerAuthorizer)
 
+	accountsClientV2019 := storage2019.NewAccountsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&accountsClientV2019.Client, options.ResourceManagerAuthorizer)
+
+	providersClient := resources.NewProvidersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&providersClient.Client, options.ResourceManagerAuthorizer)
+
+	usageClient := storage.NewUsageClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&usageClient.Client, options.ResourceManagerAuthorizer)
+
 	client := Client{
-		AccountsClient: &accountsClient,
-		endpoint:       options.ResourceManagerEndpoint,
-		Env:            options.Environment,
+		AccountsClient:      &accountsClient,
+		AccountsClientV2019: &accountsClientV2019,
+		ProvidersClient:     &providersClient,
+		UsageClient:         &usageClient,
+		endpoint:            options.ResourceManagerEndpoint,
+		Env:                 options.Environment,
+		httpClient:          options.HTTPClient,
 	}
 
 	return &client
@@ -54,6 +84,9 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 
 	blobsClient := blobs.NewWithEnvironment(client.Env)
 	blobsClient.Client.Authorizer = storageAuth
+	if client.httpClient != nil {
+		blobsClient.Client.Sender = client.httpClient
+	}
 	return &blobsClient, nil
 }
 
@@ -70,11 +103,91 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 
 	containersClient := containers.NewWithEnvironment(client.Env)
 	containersClient.Client.Authorizer = storageAuth
+	if client.httpClient != nil {
+		containersClient.Client.Sender = client.httpClient
+	}
 
 	shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
 	return shim, nil
 }
 
+func (client Client) QueuesClient(ctx context.Context, account accountDetails) (*queues.Client, error) {
+	accountKey, err := account.AccountKey(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account Key: %s", err)
+	}
+
+	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKey)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer: %+v", err)
+	}
+
+	queuesClient := queues.NewWithEnvironment(client.Env)
+	queuesClient.Client.Authorizer = storageAuth
+	if client.httpClient != nil {
+		queuesClient.Client.Sender = client.httpClient
+	}
+	return &queuesClient, nil
+}
+
+func (client Client) QueueMessagesClient(ctx context.Context, account accountDetails) (*messages.Client, error) {
+	accountKey, err := account.AccountKey(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account Key: %s", err)
+	}
+
+	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKey)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer: %+v", err)
+	}
+
+	messagesClient := messages.NewWithEnvironment(client.Env)
+	messagesClient.Client.Authorizer = storageAuth
+	if client.httpClient != nil {
+		messagesClient.Client.Sender = client.httpClient
+	}
+	return &messagesClient, nil
+}
+
+func (client Client) TablesClient(ctx context.Context, account accountDetails) (*tables.Client, error) {
+	accountKey, err := account.AccountKey(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account Key: %s", err)
+	}
+
+	// the Table Service requires the SharedKeyLite scheme, rather than the SharedKey scheme used by the other services
+	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKeyLiteForTable)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer: %+v", err)
+	}
+
+	tablesClient := tables.NewWithEnvironment(client.Env)
+	tablesClient.Client.Authorizer = storageAuth
+	if client.httpClient != nil {
+		tablesClient.Client.Sender = client.httpClient
+	}
+	return &tablesClient, nil
+}
+
+func (client Client) TableEntitiesClient(ctx context.Context, account accountDetails) (*entities.Client, error) {
+	accountKey, err := account.AccountKey(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account Key: %s", err)
+	}
+
+	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKeyLiteForTable)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer: %+v", err)
+	}
+
+	entitiesClient := entities.NewWithEnvironment(client.Env)
+	entitiesClient.Client.Authorizer = storageAuth
+	if client.httpClient != nil {
+		entitiesClient.Client.Sender = client.httpClient
+	}
+	return &entitiesClient, nil
+}
+
 func (client Client) GetKeyForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (string, bool, error) {
 	cacheIndex := resourceGroupName + "/" + storageAccountName
 	storageKeyCacheMu.RLock()
@@ -134,6 +247,9 @@ func (client Client) GetBlobStorageClientForStorageAccount(ctx context.Context,
 	if err != nil {
 		return nil, true, fmt.Errorf("creating storage client for storage account %q: %s", storageAccountName, err)
 	}
+	if client.httpClient != nil {
+		storageClient.HTTPClient = client.httpClient
+	}
 
 	blobClient := storageClient.GetBlobService()
 	return &blobClient, true, nil
@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/storage/mgmt/storage"
+)
+
+// storageAccountEndpoint returns the Endpoint for the specified service (e.g. `blob`) within the Storage Account - falling
+// back to building this from the Stamp's Storage Endpoint Suffix when this isn't returned by the API
+func storageAccountEndpoint(accountName, endpointSuffix, service string, endpoint *string) string {
+	if endpoint != nil && *endpoint != "" {
+		return *endpoint
+	}
+
+	return fmt.Sprintf("https://%s.%s.%s/", accountName, service, endpointSuffix)
+}
+
+// storageAccountPrimaryFileEndpoint returns the Primary File Endpoint for the Storage Account, which isn't returned by
+// all versions of the API
+func storageAccountPrimaryFileEndpoint(accountName, endpointSuffix string, endpoints *storage.Endpoints) string {
+	var endpoint *string
+	if endpoints != nil {
+		endpoint = endpoints.File
+	}
+
+	return storageAccountEndpoint(accountName, endpointSuffix, "file", endpoint)
+}
+
+// storageAccountEndpointHost returns the Host Name component of a Storage Account Endpoint
+func storageAccountEndpointHost(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+
+	return u.Host
+}
@@ -21,16 +21,40 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_storage_account":   storageAccountDataSource(),
-		"azurestack_storage_container": storageContainerDataSource(),
+		"azurestack_storage_account":                    storageAccountDataSource(),
+		"azurestack_storage_account_connection_strings": storageAccountConnectionStringsDataSource(),
+		"azurestack_storage_container":                  storageContainerDataSource(),
+		"azurestack_storage_share_sas":                  storageShareSasDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurestack_storage_account":   storageAccount(),
-		"azurestack_storage_blob":      storageBlob(),
-		"azurestack_storage_container": storageContainer(),
+		"azurestack_storage_account":       storageAccount(),
+		"azurestack_storage_blob":          storageBlob(),
+		"azurestack_storage_container":     storageContainer(),
+		"azurestack_storage_queue":         storageQueue(),
+		"azurestack_storage_queue_message": storageQueueMessage(),
+		"azurestack_storage_table":         storageTable(),
+		"azurestack_storage_table_entity":  storageTableEntity(),
+	}
+}
+
+// AttributesNotReturnedByAPI returns the attributes of the Resources supported by this Service which
+// aren't returned by the API, and as such aren't populated when the Resource is imported
+func (r Registration) AttributesNotReturnedByAPI() map[string][]string {
+	return map[string][]string{
+		// the API doesn't return whether the Custom Domain was validated using the `asverify` subdomain
+		"azurestack_storage_account": {
+			"custom_domain.use_subdomain",
+		},
+		// these configure how the Blob is uploaded, rather than being properties of the Blob
+		"azurestack_storage_blob": {
+			"parallelism",
+			"size",
+			"source",
+			"source_content",
+		},
 	}
 }
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/storage/mgmt/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+func storageAccountConnectionStringsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: storageAccountConnectionStringsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"key": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "primary",
+				ValidateFunc: validation.StringInSlice([]string{
+					"primary",
+					"secondary",
+				}, false),
+			},
+
+			"endpoint_suffix": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"blob_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"queue_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"table_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"file_connection_string": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func storageAccountConnectionStringsDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	endpointSuffix := meta.(*clients.Client).Account.Environment.StorageEndpointSuffix
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Storage Account %q: %s", accountName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q", accountName)
+	}
+
+	keys, err := storageClient.AccountsClient.ListKeys(ctx, account.ResourceGroup, accountName)
+	if err != nil {
+		return fmt.Errorf("listing Keys for Storage Account %q (Resource Group %q): %+v", accountName, account.ResourceGroup, err)
+	}
+
+	keyIndex := 0
+	if d.Get("key").(string) == "secondary" {
+		keyIndex = 1
+	}
+	if keys.Keys == nil || len(*keys.Keys) <= keyIndex || (*keys.Keys)[keyIndex].Value == nil {
+		return fmt.Errorf("the %s Key was not returned for Storage Account %q (Resource Group %q)", d.Get("key").(string), accountName, account.ResourceGroup)
+	}
+	accountKey := *(*keys.Keys)[keyIndex].Value
+
+	var endpoints storage.Endpoints
+	if props := account.Properties; props != nil && props.PrimaryEndpoints != nil {
+		endpoints = *props.PrimaryEndpoints
+	}
+
+	d.SetId(account.ID)
+	d.Set("endpoint_suffix", endpointSuffix)
+
+	// these are written in plain text (rather than being encrypted) since the purpose of this Data Source is to
+	// pass the Connection Strings to other resources - and the fields are marked as Sensitive
+	d.Set("connection_string", fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", accountName, accountKey, endpointSuffix))
+	d.Set("blob_connection_string", storageAccountServiceConnectionString("Blob", storageAccountEndpoint(accountName, endpointSuffix, "blob", endpoints.Blob), accountName, accountKey))
+	d.Set("queue_connection_string", storageAccountServiceConnectionString("Queue", storageAccountEndpoint(accountName, endpointSuffix, "queue", endpoints.Queue), accountName, accountKey))
+	d.Set("table_connection_string", storageAccountServiceConnectionString("Table", storageAccountEndpoint(accountName, endpointSuffix, "table", endpoints.Table), accountName, accountKey))
+	d.Set("file_connection_string", storageAccountServiceConnectionString("File", storageAccountEndpoint(accountName, endpointSuffix, "file", endpoints.File), accountName, accountKey))
+
+	return nil
+}
+
+// storageAccountServiceConnectionString returns a Connection String scoped to a single service (e.g. `Blob`) within
+// the Storage Account, which uses the Endpoint for that service rather than the default Endpoint Suffix
+func storageAccountServiceConnectionString(service, endpoint, accountName, accountKey string) string {
+	return fmt.Sprintf("DefaultEndpointsProtocol=https;%sEndpoint=%s;AccountName=%s;AccountKey=%s", service, strings.TrimSuffix(endpoint, "/"), accountName, accountKey)
+}
@@ -0,0 +1,69 @@
+package storage_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type StorageAccountConnectionStringsDataSource struct{}
+
+func TestAccStorageAccountConnectionStringsDataSource_primary(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_storage_account_connection_strings", "test")
+	r := StorageAccountConnectionStringsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "primary"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("endpoint_suffix").Exists(),
+				check.That(data.ResourceName).Key("connection_string").MatchesOtherKey(check.That("azurestack_storage_account.test").Key("primary_connection_string")),
+				check.That(data.ResourceName).Key("blob_connection_string").MatchesRegex(regexp.MustCompile(`^DefaultEndpointsProtocol=https;BlobEndpoint=https://`)),
+				check.That(data.ResourceName).Key("file_connection_string").MatchesRegex(regexp.MustCompile(`^DefaultEndpointsProtocol=https;FileEndpoint=https://`)),
+			),
+		},
+	})
+}
+
+func TestAccStorageAccountConnectionStringsDataSource_secondary(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_storage_account_connection_strings", "test")
+	r := StorageAccountConnectionStringsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "secondary"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("connection_string").MatchesOtherKey(check.That("azurestack_storage_account.test").Key("secondary_connection_string")),
+			),
+		},
+	})
+}
+
+func (d StorageAccountConnectionStringsDataSource) basic(data acceptance.TestData, key string) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurestack_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurestack_resource_group.test.name
+  location                 = azurestack_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+data "azurestack_storage_account_connection_strings" "test" {
+  storage_account_name = azurestack_storage_account.test.name
+  key                  = %q
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, key)
+}
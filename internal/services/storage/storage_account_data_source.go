@@ -124,6 +124,11 @@ func storageAccountDataSource() *schema.Resource {
 				Computed: true,
 			},
 
+			"primary_file_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"primary_access_key": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -163,6 +168,7 @@ func storageAccountDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 	client := meta.(*clients.Client).Storage.AccountsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	endpointSuffix := meta.(*clients.Client).Account.Environment.StorageEndpointSuffix
+	stateEncryption := meta.(*clients.Client).StateEncryption
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -239,20 +245,27 @@ func storageAccountDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 			storageAccessKeys := *accessKeys
 			if len(storageAccessKeys) > 0 {
 				pcs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *storageAccessKeys[0].Value, endpointSuffix)
-				d.Set("primary_connection_string", pcs)
+				if err := stateEncryption.Set(d, "primary_connection_string", &pcs); err != nil {
+					return err
+				}
 			}
 
 			if len(storageAccessKeys) > 1 {
 				scs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *storageAccessKeys[1].Value, endpointSuffix)
-				d.Set("secondary_connection_string", scs)
+				if err := stateEncryption.Set(d, "secondary_connection_string", &scs); err != nil {
+					return err
+				}
 			}
 		}
 
+		primaryFileEndpoint := storageAccountPrimaryFileEndpoint(id.Name, endpointSuffix, props.PrimaryEndpoints)
+		d.Set("primary_file_endpoint", primaryFileEndpoint)
+		d.Set("primary_file_host", storageAccountEndpointHost(primaryFileEndpoint))
+
 		if endpoints := props.PrimaryEndpoints; endpoints != nil {
 			d.Set("primary_blob_endpoint", endpoints.Blob)
 			d.Set("primary_queue_endpoint", endpoints.Queue)
 			d.Set("primary_table_endpoint", endpoints.Table)
-			d.Set("primary_file_endpoint", endpoints.File)
 		}
 
 		if endpoints := props.SecondaryEndpoints; endpoints != nil {
@@ -272,8 +285,12 @@ func storageAccountDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 
 	if accessKeys := accountKeys; accessKeys != nil {
 		storageAccountKeys := *accessKeys
-		d.Set("primary_access_key", storageAccountKeys[0].Value)
-		d.Set("secondary_access_key", storageAccountKeys[1].Value)
+		if err := stateEncryption.Set(d, "primary_access_key", storageAccountKeys[0].Value); err != nil {
+			return err
+		}
+		if err := stateEncryption.Set(d, "secondary_access_key", storageAccountKeys[1].Value); err != nil {
+			return err
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
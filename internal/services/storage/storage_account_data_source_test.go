@@ -24,6 +24,8 @@ func TestAccDataSourceStorageAccount_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("account_replication_type").HasValue("LRS"),
 				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
 				check.That(data.ResourceName).Key("tags.environment").HasValue("production"),
+				check.That(data.ResourceName).Key("primary_file_endpoint").Exists(),
+				check.That(data.ResourceName).Key("primary_file_host").Exists(),
 			),
 		},
 	})
@@ -1,11 +1,14 @@
 package storage
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/storage/mgmt/storage"
+	storage2019 "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -13,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/az/tags"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/validate"
@@ -136,6 +140,15 @@ func storageAccount() *schema.Resource {
 				Computed: true,
 			},
 
+			// Infrastructure Encryption is only supported by newer versions of Azure Stack Hub, which
+			// is checked when the Storage Account is created
+			"infrastructure_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"primary_location": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -182,6 +195,11 @@ func storageAccount() *schema.Resource {
 				Computed: true,
 			},
 
+			"primary_file_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"primary_access_key": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -279,13 +297,40 @@ func storageAccountCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	future, err := client.Create(ctx, id.ResourceGroup, id.Name, parameters)
-	if err != nil {
-		return fmt.Errorf("creating Azure Storage Account %q: %+v", id.Name, err)
-	}
+	if d.Get("infrastructure_encryption_enabled").(bool) {
+		// Infrastructure Encryption isn't available in the Profile, so the Storage Account has to be
+		// created using a newer API version - providing the Azure Stack Hub Stamp supports it
+		storageClient := meta.(*clients.Client).Storage
+		supported, err := storageClient.SupportsInfrastructureEncryption(ctx)
+		if err != nil {
+			return fmt.Errorf("determining whether Infrastructure Encryption is supported: %+v", err)
+		}
+		if !supported {
+			return fmt.Errorf("`infrastructure_encryption_enabled` isn't supported by this version of Azure Stack Hub - please update Azure Stack Hub to a version which supports the Storage API `2019-06-01` or later")
+		}
+
+		parametersV2019, err := expandStorageAccountCreateParametersV2019(parameters)
+		if err != nil {
+			return err
+		}
+
+		future, err := storageClient.AccountsClientV2019.Create(ctx, id.ResourceGroup, id.Name, *parametersV2019)
+		if err != nil {
+			return fmt.Errorf("creating Azure Storage Account %q: %+v", id.Name, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, storageClient.AccountsClientV2019.Client); err != nil {
+			return fmt.Errorf("waiting for Azure Storage Account %q to be created: %+v", id.Name, err)
+		}
+	} else {
+		future, err := client.Create(ctx, id.ResourceGroup, id.Name, parameters)
+		if err != nil {
+			return fmt.Errorf("creating Azure Storage Account %q: %+v", id.Name, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for Azure Storage Account %q to be created: %+v", id.Name, err)
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for Azure Storage Account %q to be created: %+v", id.Name, err)
+		}
 	}
 
 	d.SetId(id.ID())
@@ -399,6 +444,7 @@ func storageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 func storageAccountRead(d *schema.ResourceData, meta interface{}) error {
 	endpointSuffix := meta.(*clients.Client).Account.Environment.StorageEndpointSuffix
 	client := meta.(*clients.Client).Storage.AccountsClient
+	stateEncryption := meta.(*clients.Client).StateEncryption
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -448,29 +494,44 @@ func storageAccountRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("account_encryption_source", string(encryption.KeySource))
 		}
 
+		infrastructureEncryptionEnabled, err := storageAccountInfrastructureEncryptionEnabled(ctx, meta.(*clients.Client).Storage, *id)
+		if err != nil {
+			return err
+		}
+		d.Set("infrastructure_encryption_enabled", infrastructureEncryptionEnabled)
+
 		// Computed
 		d.Set("primary_location", props.PrimaryLocation)
 		d.Set("secondary_location", props.SecondaryLocation)
 
 		if len(accessKeys) > 0 {
 			pcs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *accessKeys[0].Value, endpointSuffix)
-			d.Set("primary_connection_string", pcs)
+			if err := stateEncryption.Set(d, "primary_connection_string", &pcs); err != nil {
+				return err
+			}
 		}
 
 		if len(accessKeys) > 1 {
 			scs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *accessKeys[1].Value, endpointSuffix)
-			d.Set("secondary_connection_string", scs)
+			if err := stateEncryption.Set(d, "secondary_connection_string", &scs); err != nil {
+				return err
+			}
 		}
 
+		primaryFileEndpoint := storageAccountPrimaryFileEndpoint(id.Name, endpointSuffix, props.PrimaryEndpoints)
+		d.Set("primary_file_endpoint", primaryFileEndpoint)
+		d.Set("primary_file_host", storageAccountEndpointHost(primaryFileEndpoint))
+
 		if endpoints := props.PrimaryEndpoints; endpoints != nil {
 			d.Set("primary_blob_endpoint", endpoints.Blob)
 			d.Set("primary_queue_endpoint", endpoints.Queue)
 			d.Set("primary_table_endpoint", endpoints.Table)
-			d.Set("primary_file_endpoint", endpoints.File)
 
 			pscs := fmt.Sprintf("DefaultEndpointsProtocol=https;BlobEndpoint=%s;AccountName=%s;AccountKey=%s",
 				*endpoints.Blob, *resp.Name, *accessKeys[0].Value)
-			d.Set("primary_blob_connection_string", pscs)
+			if err := stateEncryption.Set(d, "primary_blob_connection_string", &pscs); err != nil {
+				return err
+			}
 		}
 
 		if endpoints := props.SecondaryEndpoints; endpoints != nil {
@@ -478,7 +539,9 @@ func storageAccountRead(d *schema.ResourceData, meta interface{}) error {
 				d.Set("secondary_blob_endpoint", blob)
 				sscs := fmt.Sprintf("DefaultEndpointsProtocol=https;BlobEndpoint=%s;AccountName=%s;AccountKey=%s",
 					*blob, *resp.Name, *accessKeys[1].Value)
-				d.Set("secondary_blob_connection_string", sscs)
+				if err := stateEncryption.Set(d, "secondary_blob_connection_string", &sscs); err != nil {
+					return err
+				}
 			} else {
 				d.Set("secondary_blob_endpoint", "")
 				d.Set("secondary_blob_connection_string", "")
@@ -498,8 +561,12 @@ func storageAccountRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	d.Set("primary_access_key", accessKeys[0].Value)
-	d.Set("secondary_access_key", accessKeys[1].Value)
+	if err := stateEncryption.Set(d, "primary_access_key", accessKeys[0].Value); err != nil {
+		return err
+	}
+	if err := stateEncryption.Set(d, "secondary_access_key", accessKeys[1].Value); err != nil {
+		return err
+	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
@@ -522,6 +589,63 @@ func storageAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// storageAccountInfrastructureEncryptionEnabled returns whether Infrastructure Encryption is enabled for the
+// Storage Account, which is only exposed by newer API versions than the Profile
+func storageAccountInfrastructureEncryptionEnabled(ctx context.Context, client *client.Client, id parse.StorageAccountId) (bool, error) {
+	supported, err := client.SupportsInfrastructureEncryption(ctx)
+	if err != nil {
+		return false, fmt.Errorf("determining whether Infrastructure Encryption is supported: %+v", err)
+	}
+	if !supported {
+		return false, nil
+	}
+
+	resp, err := client.AccountsClientV2019.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return false, fmt.Errorf("retrieving Infrastructure Encryption for %s: %+v", id, err)
+	}
+
+	if props := resp.AccountProperties; props != nil && props.Encryption != nil && props.Encryption.RequireInfrastructureEncryption != nil {
+		return *props.Encryption.RequireInfrastructureEncryption, nil
+	}
+
+	return false, nil
+}
+
+// expandStorageAccountCreateParametersV2019 converts the Profile's create parameters into those for the newer API
+// version, enabling Infrastructure Encryption
+func expandStorageAccountCreateParametersV2019(input storage.AccountCreateParameters) (*storage2019.AccountCreateParameters, error) {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("serializing Storage Account parameters: %+v", err)
+	}
+
+	var output storage2019.AccountCreateParameters
+	if err := json.Unmarshal(raw, &output); err != nil {
+		return nil, fmt.Errorf("deserializing Storage Account parameters: %+v", err)
+	}
+
+	if output.AccountPropertiesCreateParameters == nil {
+		output.AccountPropertiesCreateParameters = &storage2019.AccountPropertiesCreateParameters{}
+	}
+	if output.Encryption == nil {
+		output.Encryption = &storage2019.Encryption{
+			Services: &storage2019.EncryptionServices{
+				Blob: &storage2019.EncryptionService{
+					Enabled: pointer.FromBool(true),
+				},
+				File: &storage2019.EncryptionService{
+					Enabled: pointer.FromBool(true),
+				},
+			},
+			KeySource: storage2019.KeySourceMicrosoftStorage,
+		}
+	}
+	output.Encryption.RequireInfrastructureEncryption = pointer.FromBool(true)
+
+	return &output, nil
+}
+
 func expandStorageAccountCustomDomain(d *schema.ResourceData) *storage.CustomDomain {
 	domains := d.Get("custom_domain").([]interface{})
 	if len(domains) == 0 {
@@ -31,7 +31,7 @@ func TestAccStorageAccount_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("tags.environment").HasValue("production"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		// TODO this needs to be fixed
 		/*{
 			Config: r.update(data),
@@ -76,7 +76,23 @@ func TestAccStorageAccount_premium(t *testing.T) {
 				check.That(data.ResourceName).Key("tags.environment").HasValue("production"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccStorageAccount_infrastructureEncryption(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_account", "test")
+	r := StorageAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.infrastructureEncryption(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("infrastructure_encryption_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStepStrict(),
 	})
 }
 
@@ -120,7 +136,7 @@ func TestAccStorageAccount_blobStorageWithUpdate(t *testing.T) {
 				check.That(data.ResourceName).Key("account_kind").HasValue("BlobStorage"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.blobStorageUpdate(data),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -141,7 +157,7 @@ func TestAccStorageAccount_NonStandardCasing(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config:             r.nonStandardCasing(data),
 			PlanOnly:           true,
@@ -243,6 +259,29 @@ resource "azurestack_storage_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (r StorageAccountResource) infrastructureEncryption(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurestack_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurestack_resource_group.test.name
+
+  location                          = azurestack_resource_group.test.location
+  account_tier                      = "Standard"
+  account_replication_type          = "LRS"
+  infrastructure_encryption_enabled = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 /*
 func (r StorageAccountResource) blobStorage(data acceptance.TestData) string {
 	return fmt.Sprintf(`
@@ -1,8 +1,10 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"time"
 
@@ -33,6 +35,8 @@ func storageBlob() *schema.Resource {
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(storageBlobCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(120 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -139,6 +143,26 @@ func storageBlob() *schema.Resource {
 	}
 }
 
+// storageBlobCustomizeDiff validates a VHD being uploaded as a Page Blob during the plan (when the file
+// already exists), rather than once it's been uploaded - which can take several hours
+func storageBlobCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.HasChange("source") || !strings.EqualFold(d.Get("type").(string), "page") {
+		return nil
+	}
+
+	source := d.Get("source").(string)
+	if source == "" || !isVHD(source) {
+		return nil
+	}
+
+	// the file may be created during the apply, in which case it's validated prior to being uploaded
+	if _, err := os.Stat(source); err != nil {
+		return nil
+	}
+
+	return validate.VHDFile(source)
+}
+
 func storageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
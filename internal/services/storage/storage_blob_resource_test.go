@@ -3,8 +3,10 @@ package storage_test
 import (
 	"context"
 	"crypto/rand"
+	"encoding/binary"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -42,7 +44,7 @@ func TestAccStorageBlob_appendEmpty(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 	})
 }
 
@@ -57,7 +59,7 @@ func TestAccStorageBlob_appendEmptyMetaData(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 	})
 }
 
@@ -72,7 +74,7 @@ func TestAccStorageBlob_blockEmpty(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 	})
 }
 
@@ -87,7 +89,7 @@ func TestAccStorageBlob_blockEmptyMetaData(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 	})
 }
 
@@ -102,7 +104,7 @@ func TestAccStorageBlob_blockFromInlineContent(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStepStrict("parallelism", "size", "source_content", "type"),
 	})
 }
 
@@ -117,7 +119,7 @@ func TestAccStorageBlob_blockFromPublicBlob(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_uri", "type"),
+		data.ImportStepStrict("parallelism", "size", "source_uri", "type"),
 	})
 }
 
@@ -132,7 +134,7 @@ func TestAccStorageBlob_blockFromPublicFile(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_uri", "type"),
+		data.ImportStepStrict("parallelism", "size", "source_uri", "type"),
 	})
 }
 
@@ -147,7 +149,7 @@ func TestAccStorageBlob_blockFromExistingBlob(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_uri", "type"),
+		data.ImportStepStrict("parallelism", "size", "source_uri", "type"),
 	})
 }
 
@@ -171,7 +173,7 @@ func TestAccStorageBlob_blockFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStepStrict("parallelism", "size", "source", "type"),
 	})
 }
 
@@ -196,7 +198,7 @@ func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 				acceptance.TestCheckResourceAttr(data.ResourceName, "source", sourceBlob.Name()),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStepStrict("parallelism", "size", "source", "type"),
 	})
 }
 
@@ -211,14 +213,14 @@ func TestAccStorageBlob_cacheControl(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 		{
 			Config: r.cacheControl(data, "max-age=3600"),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 	})
 }
 
@@ -233,14 +235,14 @@ func TestAccStorageBlob_contentType(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 		{
 			Config: r.contentTypeUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 	})
 }
 
@@ -255,7 +257,7 @@ func TestAccStorageBlob_contentTypePremium(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 	})
 }
 
@@ -270,7 +272,7 @@ func TestAccStorageBlob_pageEmpty(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 	})
 }
 
@@ -285,7 +287,7 @@ func TestAccStorageBlob_pageEmptyPremium(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 	})
 }
 
@@ -300,7 +302,7 @@ func TestAccStorageBlob_pageEmptyMetaData(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type"),
+		data.ImportStepStrict("parallelism", "size", "type"),
 	})
 }
 
@@ -315,7 +317,7 @@ func TestAccStorageBlob_pageFromExistingBlob(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type", "source_uri"),
+		data.ImportStepStrict("parallelism", "size", "type", "source_uri"),
 	})
 }
 
@@ -339,7 +341,27 @@ func TestAccStorageBlob_pageFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.PageBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type", "source"),
+		data.ImportStepStrict("parallelism", "size", "type", "source"),
+	})
+}
+
+func TestAccStorageBlob_pageFromLocalDynamicVHD(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "*.vhd")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateDynamicVHD(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurestack_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.pageFromLocalBlob(data, sourceBlob.Name()),
+			ExpectError: regexp.MustCompile("only Fixed VHDs are supported"),
+		},
 	})
 }
 
@@ -928,6 +950,26 @@ resource "azurestack_storage_container" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, accessLevel)
 }
 
+// populateDynamicVHD writes the footer of a Dynamic VHD, which Azure Stack Hub can't use as a Disk or Image
+func populateDynamicVHD(input *os.File) error {
+	footer := make([]byte, 512)
+	copy(footer[0:8], "conectix")
+	binary.BigEndian.PutUint64(footer[48:56], 1024*1024)
+	binary.BigEndian.PutUint32(footer[60:64], 3)
+
+	var checksum uint32
+	for _, b := range footer {
+		checksum += uint32(b)
+	}
+	binary.BigEndian.PutUint32(footer[64:68], ^checksum)
+
+	if _, err := input.WriteAt(footer, 1024); err != nil {
+		return fmt.Errorf("Failed to write VHD footer to file")
+	}
+
+	return input.Close()
+}
+
 func populateTempFile(input *os.File) error {
 	if err := input.Truncate(25*1024*1024 + 512); err != nil {
 		return fmt.Errorf("Failed to truncate file to 25M")
@@ -29,7 +29,7 @@ func TestAccStorageContainer_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -44,7 +44,7 @@ func TestAccStorageContainer_deleteAndRecreate(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.template(data),
 		},
@@ -54,7 +54,7 @@ func TestAccStorageContainer_deleteAndRecreate(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -92,7 +92,7 @@ func TestAccStorageContainer_update(t *testing.T) {
 				check.That(data.ResourceName).Key("container_access_type").HasValue("container"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -107,21 +107,21 @@ func TestAccStorageContainer_metaData(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.metaDataUpdated(data, "private"),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 		{
 			Config: r.metaDataEmpty(data, "private"),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -149,7 +149,7 @@ func TestAccStorageContainer_root(t *testing.T) {
 				check.That(data.ResourceName).Key("name").HasValue("$root"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -165,7 +165,7 @@ func TestAccStorageContainer_web(t *testing.T) {
 				check.That(data.ResourceName).Key("name").HasValue("$web"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStepStrict(),
 	})
 }
 
@@ -0,0 +1,237 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/messages"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+// storageQueueMessagesPeekLimit is the maximum number of Messages which can be peeked at in a single request
+const storageQueueMessagesPeekLimit = 32
+
+func storageQueueMessage() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: storageQueueMessageCreate,
+		Read:   storageQueueMessageRead,
+		Delete: storageQueueMessageDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		// NOTE: Messages can't be imported, since the Pop Receipt (which is required to delete the Message) is
+		// only returned when the Message is put into (or retrieved from) the Queue
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"queue_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageQueueName,
+			},
+
+			"message_text": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"time_to_live_in_seconds": {
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				// -1 means that the Message never expires
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{-1}),
+					validation.IntAtLeast(1),
+				),
+			},
+
+			"message_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"pop_receipt": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expiration_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func storageQueueMessageCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	queueName := d.Get("queue_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Queue %q: %s", accountName, queueName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	client, err := storageClient.QueueMessagesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queue Messages Client: %s", err)
+	}
+
+	input := messages.PutInput{
+		Message: d.Get("message_text").(string),
+	}
+	if v, ok := d.GetOk("time_to_live_in_seconds"); ok {
+		input.MessageTtl = pointer.FromInt(v.(int))
+	}
+
+	log.Printf("[INFO] Putting Message into Queue %q in Storage Account %q", queueName, accountName)
+	result, err := client.Put(ctx, accountName, queueName, input)
+	if err != nil {
+		return fmt.Errorf("putting Message into Queue %q (Storage Account %q): %s", queueName, accountName, err)
+	}
+	if result.QueueMessages == nil || len(*result.QueueMessages) == 0 {
+		return fmt.Errorf("putting Message into Queue %q (Storage Account %q): no Message was returned", queueName, accountName)
+	}
+
+	message := (*result.QueueMessages)[0]
+	d.Set("pop_receipt", message.PopReceipt)
+
+	d.SetId(client.GetResourceID(accountName, queueName, message.MessageId))
+	return storageQueueMessageRead(d, meta)
+}
+
+func storageQueueMessageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := messages.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Queue %q: %s", id.AccountName, id.QueueName, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Unable to locate Account %q for Storage Queue %q - assuming removed & removing from state", id.AccountName, id.QueueName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.QueueMessagesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queue Messages Client: %s", err)
+	}
+
+	result, err := client.Peek(ctx, id.AccountName, id.QueueName, storageQueueMessagesPeekLimit)
+	if err != nil {
+		if utils.ResponseWasNotFound(result.Response) {
+			log.Printf("[DEBUG] Storage Queue %q was not found in Storage Account %q - assuming removed & removing from state", id.QueueName, id.AccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("peeking at Messages in Queue %q (Storage Account %q): %s", id.QueueName, id.AccountName, err)
+	}
+
+	var message *messages.QueueMessageResponse
+	peeked := make([]messages.QueueMessageResponse, 0)
+	if result.QueueMessages != nil {
+		peeked = *result.QueueMessages
+	}
+	for _, v := range peeked {
+		if v.MessageId == id.MessageID {
+			v := v
+			message = &v
+			break
+		}
+	}
+
+	if message == nil {
+		// only the Messages at the front of the Queue can be peeked at, so when the Queue contains more Messages
+		// than can be peeked at we're unable to determine whether the Message still exists
+		if len(peeked) < storageQueueMessagesPeekLimit {
+			log.Printf("[DEBUG] Message %q was not found in Queue %q (Storage Account %q) - assuming removed & removing from state", id.MessageID, id.QueueName, id.AccountName)
+			d.SetId("")
+			return nil
+		}
+
+		log.Printf("[DEBUG] Message %q is not within the first %d Messages in Queue %q (Storage Account %q) - assuming it still exists", id.MessageID, storageQueueMessagesPeekLimit, id.QueueName, id.AccountName)
+	}
+
+	d.Set("storage_account_name", id.AccountName)
+	d.Set("queue_name", id.QueueName)
+	d.Set("message_id", id.MessageID)
+
+	if message != nil {
+		d.Set("expiration_time", message.ExpirationTime)
+	}
+
+	return nil
+}
+
+func storageQueueMessageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := messages.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Queue %q: %s", id.AccountName, id.QueueName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	client, err := storageClient.QueueMessagesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queue Messages Client: %s", err)
+	}
+
+	if resp, err := client.Delete(ctx, id.AccountName, id.QueueName, id.MessageID, d.Get("pop_receipt").(string)); err != nil {
+		// the Message has already been consumed (or has expired)
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Message %q from Queue %q (Storage Account %q): %s", id.MessageID, id.QueueName, id.AccountName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/messages"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+type StorageQueueMessageResource struct{}
+
+func TestAccStorageQueueMessage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_queue_message", "test")
+	r := StorageQueueMessageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("message_id").Exists(),
+				check.That(data.ResourceName).Key("expiration_time").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccStorageQueueMessage_timeToLive(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_queue_message", "test")
+	r := StorageQueueMessageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.timeToLive(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("time_to_live_in_seconds").HasValue("3600"),
+			),
+		},
+	})
+}
+
+func (r StorageQueueMessageResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := messages.ParseResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Queue %q: %+v", id.AccountName, id.QueueName, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+	}
+
+	messagesClient, err := client.Storage.QueueMessagesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Queue Messages Client: %+v", err)
+	}
+	resp, err := messagesClient.Peek(ctx, id.AccountName, id.QueueName, 32)
+	if err != nil {
+		return nil, fmt.Errorf("peeking at Messages in Queue %q (Account %q): %+v", id.QueueName, id.AccountName, err)
+	}
+	if resp.QueueMessages != nil {
+		for _, v := range *resp.QueueMessages {
+			if v.MessageId == id.MessageID {
+				return pointer.FromBool(true), nil
+			}
+		}
+	}
+	return pointer.FromBool(false), nil
+}
+
+func (r StorageQueueMessageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_queue_message" "test" {
+  storage_account_name = azurestack_storage_account.test.name
+  queue_name           = azurestack_storage_queue.test.name
+  message_text         = "hello world"
+}
+`, StorageQueueResource{}.basic(data))
+}
+
+func (r StorageQueueMessageResource) timeToLive(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_queue_message" "test" {
+  storage_account_name    = azurestack_storage_account.test.name
+  queue_name              = azurestack_storage_queue.test.name
+  message_text            = "hello world"
+  time_to_live_in_seconds = 3600
+}
+`, StorageQueueResource{}.basic(data))
+}
@@ -0,0 +1,206 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/queues"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func storageQueue() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: storageQueueCreate,
+		Read:   storageQueueRead,
+		Update: storageQueueUpdate,
+		Delete: storageQueueDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := queues.ParseResourceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageQueueName,
+			},
+
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"metadata": MetaDataSchema(),
+		},
+	}
+}
+
+func storageQueueCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	queueName := d.Get("name").(string)
+	accountName := d.Get("storage_account_name").(string)
+	metaData := ExpandMetaData(d.Get("metadata").(map[string]interface{}))
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Queue %q: %s", accountName, queueName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	client, err := storageClient.QueuesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queues Client: %s", err)
+	}
+
+	id := client.GetResourceID(accountName, queueName)
+	existing, err := client.GetMetaData(ctx, accountName, queueName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Queue %q (Storage Account %q): %s", queueName, accountName, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurestack_storage_queue", id)
+	}
+
+	log.Printf("[INFO] Creating Queue %q in Storage Account %q", queueName, accountName)
+	if _, err := client.Create(ctx, accountName, queueName, metaData); err != nil {
+		return fmt.Errorf("creating Queue %q (Storage Account %q): %s", queueName, accountName, err)
+	}
+
+	d.SetId(id)
+	return storageQueueRead(d, meta)
+}
+
+func storageQueueRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := queues.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Queue %q: %s", id.AccountName, id.QueueName, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Unable to locate Account %q for Storage Queue %q - assuming removed & removing from state", id.AccountName, id.QueueName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.QueuesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queues Client: %s", err)
+	}
+
+	metaData, err := client.GetMetaData(ctx, id.AccountName, id.QueueName)
+	if err != nil {
+		if utils.ResponseWasNotFound(metaData.Response) {
+			log.Printf("[DEBUG] Storage Queue %q was not found in Storage Account %q - assuming removed & removing from state", id.QueueName, id.AccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving MetaData for Queue %q (Storage Account %q): %s", id.QueueName, id.AccountName, err)
+	}
+
+	d.Set("name", id.QueueName)
+	d.Set("storage_account_name", id.AccountName)
+
+	if err := d.Set("metadata", FlattenMetaData(metaData.MetaData)); err != nil {
+		return fmt.Errorf("setting `metadata`: %s", err)
+	}
+
+	return nil
+}
+
+func storageQueueUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := queues.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Queue %q: %s", id.AccountName, id.QueueName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	client, err := storageClient.QueuesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queues Client: %s", err)
+	}
+
+	if d.HasChange("metadata") {
+		metaData := ExpandMetaData(d.Get("metadata").(map[string]interface{}))
+		if _, err := client.SetMetaData(ctx, id.AccountName, id.QueueName, metaData); err != nil {
+			return fmt.Errorf("updating MetaData for Queue %q (Storage Account %q): %s", id.QueueName, id.AccountName, err)
+		}
+	}
+
+	return storageQueueRead(d, meta)
+}
+
+func storageQueueDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := queues.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Queue %q: %s", id.AccountName, id.QueueName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	client, err := storageClient.QueuesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Queues Client: %s", err)
+	}
+
+	if _, err := client.Delete(ctx, id.AccountName, id.QueueName); err != nil {
+		return fmt.Errorf("deleting Queue %q (Storage Account %q): %s", id.QueueName, id.AccountName, err)
+	}
+
+	return nil
+}
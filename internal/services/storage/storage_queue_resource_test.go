@@ -0,0 +1,136 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/queues"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type StorageQueueResource struct{}
+
+func TestAccStorageQueue_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_queue", "test")
+	r := StorageQueueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccStorageQueue_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_queue", "test")
+	r := StorageQueueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageQueue_metaData(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_queue", "test")
+	r := StorageQueueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.metaData(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+			),
+		},
+		data.ImportStepStrict(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("0"),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func (r StorageQueueResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := queues.ParseResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Queue %q: %+v", id.AccountName, id.QueueName, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+	}
+
+	queuesClient, err := client.Storage.QueuesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Queues Client: %+v", err)
+	}
+	resp, err := queuesClient.GetMetaData(ctx, id.AccountName, id.QueueName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Queue %q (Account %q): %+v", id.QueueName, id.AccountName, err)
+	}
+	return pointer.FromBool(true), nil
+}
+
+func (r StorageQueueResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_queue" "test" {
+  name                 = "acctestsq-%d"
+  storage_account_name = azurestack_storage_account.test.name
+}
+`, StorageTableResource{}.template(data), data.RandomInteger)
+}
+
+func (r StorageQueueResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_queue" "import" {
+  name                 = azurestack_storage_queue.test.name
+  storage_account_name = azurestack_storage_queue.test.storage_account_name
+}
+`, r.basic(data))
+}
+
+func (r StorageQueueResource) metaData(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_queue" "test" {
+  name                 = "acctestsq-%d"
+  storage_account_name = azurestack_storage_account.test.name
+
+  metadata = {
+    hello = "world"
+  }
+}
+`, StorageTableResource{}.template(data), data.RandomInteger)
+}
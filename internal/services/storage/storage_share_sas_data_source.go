@@ -0,0 +1,259 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/storage/mgmt/storage"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+)
+
+// storageShareSasSignedVersion is the version of the Storage API used to sign the Shared Access Signature
+const storageShareSasSignedVersion = "2018-11-09"
+
+func storageShareSasDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: storageShareSasDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"share_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageShareName,
+			},
+
+			"https_only": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"ip_address": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"start": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"expiry": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"permissions": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"read": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"create": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"write": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"delete": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"list": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"share_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"sas": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func storageShareSasDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	endpointSuffix := meta.(*clients.Client).Account.Environment.StorageEndpointSuffix
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	shareName := d.Get("share_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %s", accountName, shareName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Account %q for Storage Share %q", accountName, shareName)
+	}
+
+	accountKey, err := account.AccountKey(ctx, *storageClient)
+	if err != nil {
+		return fmt.Errorf("retrieving the Account Key for Storage Account %q (Resource Group %q): %s", accountName, account.ResourceGroup, err)
+	}
+
+	permissions := expandStorageShareSasPermissions(d.Get("permissions").([]interface{}))
+	if permissions == "" {
+		return fmt.Errorf("at least one of the `permissions` must be enabled")
+	}
+
+	protocol := "https,http"
+	if d.Get("https_only").(bool) {
+		protocol = "https"
+	}
+
+	sas, err := computeStorageShareSas(storageShareSasOptions{
+		accountName: accountName,
+		accountKey:  *accountKey,
+		shareName:   shareName,
+		permissions: permissions,
+		start:       d.Get("start").(string),
+		expiry:      d.Get("expiry").(string),
+		ipAddress:   d.Get("ip_address").(string),
+		protocol:    protocol,
+	})
+	if err != nil {
+		return fmt.Errorf("computing the Shared Access Signature for Share %q (Account %q): %+v", shareName, accountName, err)
+	}
+
+	var primaryEndpoints *storage.Endpoints
+	if props := account.Properties; props != nil {
+		primaryEndpoints = props.PrimaryEndpoints
+	}
+	primaryFileEndpoint := storageAccountPrimaryFileEndpoint(accountName, endpointSuffix, primaryEndpoints)
+
+	hash := sha256.Sum256([]byte(sas))
+	d.SetId(hex.EncodeToString(hash[:]))
+	d.Set("share_url", fmt.Sprintf("%s/%s", strings.TrimSuffix(primaryFileEndpoint, "/"), shareName))
+	d.Set("sas", sas)
+
+	return nil
+}
+
+type storageShareSasOptions struct {
+	accountName string
+	accountKey  string
+	shareName   string
+	permissions string
+	start       string
+	expiry      string
+	ipAddress   string
+	protocol    string
+}
+
+// computeStorageShareSas computes a Service Shared Access Signature scoped to a File Share, see
+// https://docs.microsoft.com/rest/api/storageservices/create-service-sas
+func computeStorageShareSas(input storageShareSasOptions) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(input.accountKey)
+	if err != nil {
+		return "", fmt.Errorf("decoding the Account Key: %+v", err)
+	}
+
+	canonicalizedResource := fmt.Sprintf("/file/%s/%s", input.accountName, input.shareName)
+
+	stringToSign := strings.Join([]string{
+		input.permissions,
+		input.start,
+		input.expiry,
+		canonicalizedResource,
+		"", // signed identifier
+		input.ipAddress,
+		input.protocol,
+		storageShareSasSignedVersion,
+		"", // cache control
+		"", // content disposition
+		"", // content encoding
+		"", // content language
+		"", // content type
+	}, "\n")
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	values := url.Values{}
+	values.Set("sv", storageShareSasSignedVersion)
+	values.Set("sr", "s")
+	values.Set("sp", input.permissions)
+	values.Set("st", input.start)
+	values.Set("se", input.expiry)
+	if input.ipAddress != "" {
+		values.Set("sip", input.ipAddress)
+	}
+	values.Set("spr", input.protocol)
+	values.Set("sig", signature)
+
+	return fmt.Sprintf("?%s", values.Encode()), nil
+}
+
+func expandStorageShareSasPermissions(input []interface{}) string {
+	if len(input) == 0 || input[0] == nil {
+		return ""
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	// the permissions must be specified in this order
+	permissions := ""
+	if raw["read"].(bool) {
+		permissions += "r"
+	}
+	if raw["create"].(bool) {
+		permissions += "c"
+	}
+	if raw["write"].(bool) {
+		permissions += "w"
+	}
+	if raw["delete"].(bool) {
+		permissions += "d"
+	}
+	if raw["list"].(bool) {
+		permissions += "l"
+	}
+
+	return permissions
+}
@@ -0,0 +1,63 @@
+package storage_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+)
+
+type StorageShareSasDataSource struct{}
+
+func TestAccStorageShareSasDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurestack_storage_share_sas", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageShareSasDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("https_only").HasValue("true"),
+				check.That(data.ResourceName).Key("sas").MatchesRegex(regexp.MustCompile(`^\?.*sp=rl.*&sr=s&st=`)),
+				check.That(data.ResourceName).Key("share_url").MatchesRegex(regexp.MustCompile(`^https://acctestsa.+\.file\..+/myshare$`)),
+			),
+		},
+	})
+}
+
+func (d StorageShareSasDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurestack_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurestack_resource_group.test.name
+  location                 = azurestack_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+data "azurestack_storage_share_sas" "test" {
+  storage_account_name = azurestack_storage_account.test.name
+  share_name           = "myshare"
+  start                = "2021-01-01T00:00:00Z"
+  expiry               = "2031-01-01T00:00:00Z"
+
+  permissions {
+    read   = true
+    create = false
+    write  = false
+    delete = false
+    list   = true
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
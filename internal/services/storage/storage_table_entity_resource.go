@@ -0,0 +1,235 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/table/entities"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func storageTableEntity() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: storageTableEntityCreateUpdate,
+		Read:   storageTableEntityRead,
+		Update: storageTableEntityCreateUpdate,
+		Delete: storageTableEntityDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := entities.ParseResourceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"table_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageTableName,
+			},
+
+			"partition_key": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"row_key": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"entity": {
+				Type:     pluginsdk.TypeMap,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func storageTableEntityCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	tableName := d.Get("table_name").(string)
+	partitionKey := d.Get("partition_key").(string)
+	rowKey := d.Get("row_key").(string)
+	entity := d.Get("entity").(map[string]interface{})
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Table %q: %s", accountName, tableName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	client, err := storageClient.TableEntitiesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Table Entities Client: %s", err)
+	}
+
+	id := client.GetResourceID(accountName, tableName, partitionKey, rowKey)
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, accountName, tableName, entities.GetEntityInput{
+			PartitionKey:  partitionKey,
+			RowKey:        rowKey,
+			MetaDataLevel: entities.NoMetaData,
+		})
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q): %s", partitionKey, rowKey, tableName, accountName, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurestack_storage_table_entity", id)
+		}
+	}
+
+	// the Entity is replaced (rather than merged) so that any properties removed from the configuration are removed
+	input := entities.InsertOrReplaceEntityInput{
+		PartitionKey: partitionKey,
+		RowKey:       rowKey,
+		Entity:       entity,
+	}
+	if _, err := client.InsertOrReplace(ctx, accountName, tableName, input); err != nil {
+		return fmt.Errorf("creating/updating Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q): %s", partitionKey, rowKey, tableName, accountName, err)
+	}
+
+	d.SetId(id)
+	return storageTableEntityRead(d, meta)
+}
+
+func storageTableEntityRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := entities.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Table %q: %s", id.AccountName, id.TableName, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Unable to locate Account %q for Storage Table %q - assuming removed & removing from state", id.AccountName, id.TableName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.TableEntitiesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Table Entities Client: %s", err)
+	}
+
+	input := entities.GetEntityInput{
+		PartitionKey:  id.PartitionKey,
+		RowKey:        id.RowKey,
+		MetaDataLevel: entities.NoMetaData,
+	}
+	result, err := client.Get(ctx, id.AccountName, id.TableName, input)
+	if err != nil {
+		if utils.ResponseWasNotFound(result.Response) {
+			log.Printf("[DEBUG] Entity (Partition Key %q / Row Key %q) was not found in Table %q (Storage Account %q) - assuming removed & removing from state", id.PartitionKey, id.RowKey, id.TableName, id.AccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q): %s", id.PartitionKey, id.RowKey, id.TableName, id.AccountName, err)
+	}
+
+	d.Set("storage_account_name", id.AccountName)
+	d.Set("table_name", id.TableName)
+	d.Set("partition_key", id.PartitionKey)
+	d.Set("row_key", id.RowKey)
+
+	if err := d.Set("entity", flattenStorageTableEntity(result.Entity)); err != nil {
+		return fmt.Errorf("setting `entity`: %s", err)
+	}
+
+	return nil
+}
+
+func storageTableEntityDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := entities.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Table %q: %s", id.AccountName, id.TableName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	client, err := storageClient.TableEntitiesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Table Entities Client: %s", err)
+	}
+
+	input := entities.DeleteEntityInput{
+		PartitionKey: id.PartitionKey,
+		RowKey:       id.RowKey,
+	}
+	if resp, err := client.Delete(ctx, id.AccountName, id.TableName, input); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Entity (Partition Key %q / Row Key %q) in Table %q (Storage Account %q): %s", id.PartitionKey, id.RowKey, id.TableName, id.AccountName, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenStorageTableEntity(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		// the system properties are returned alongside the Entity, but are exposed as top-level fields
+		if k == "PartitionKey" || k == "RowKey" || k == "Timestamp" {
+			continue
+		}
+
+		output[k] = fmt.Sprintf("%v", v)
+	}
+
+	return output
+}
@@ -0,0 +1,176 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/table/entities"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type StorageTableEntityResource struct{}
+
+func TestAccStorageTableEntity_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.%").HasValue("1"),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccStorageTableEntity_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageTableEntity_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_table_entity", "test")
+	r := StorageTableEntityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.%").HasValue("2"),
+				check.That(data.ResourceName).Key("entity.joinToken").HasValue("updated"),
+			),
+		},
+		data.ImportStepStrict(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.%").HasValue("1"),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func (r StorageTableEntityResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := entities.ParseResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Table %q: %+v", id.AccountName, id.TableName, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+	}
+
+	entitiesClient, err := client.Storage.TableEntitiesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Table Entities Client: %+v", err)
+	}
+	input := entities.GetEntityInput{
+		PartitionKey:  id.PartitionKey,
+		RowKey:        id.RowKey,
+		MetaDataLevel: entities.NoMetaData,
+	}
+	resp, err := entitiesClient.Get(ctx, id.AccountName, id.TableName, input)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Entity (Partition Key %q / Row Key %q) in Table %q (Account %q): %+v", id.PartitionKey, id.RowKey, id.TableName, id.AccountName, err)
+	}
+	return pointer.FromBool(true), nil
+}
+
+func (r StorageTableEntityResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_table_entity" "test" {
+  storage_account_name = azurestack_storage_account.test.name
+  table_name           = azurestack_storage_table.test.name
+  partition_key        = "cluster"
+  row_key              = "acctest-%d"
+
+  entity = {
+    joinToken = "initial"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageTableEntityResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_table_entity" "import" {
+  storage_account_name = azurestack_storage_table_entity.test.storage_account_name
+  table_name           = azurestack_storage_table_entity.test.table_name
+  partition_key        = azurestack_storage_table_entity.test.partition_key
+  row_key              = azurestack_storage_table_entity.test.row_key
+
+  entity = {
+    joinToken = "initial"
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageTableEntityResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_table_entity" "test" {
+  storage_account_name = azurestack_storage_account.test.name
+  table_name           = azurestack_storage_table.test.name
+  partition_key        = "cluster"
+  row_key              = "acctest-%d"
+
+  entity = {
+    joinToken = "updated"
+    endpoint  = "https://example.com"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageTableEntityResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_table" "test" {
+  name                 = "acctestst%d"
+  storage_account_name = azurestack_storage_account.test.name
+}
+`, StorageTableResource{}.template(data), data.RandomInteger)
+}
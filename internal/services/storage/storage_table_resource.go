@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/table/tables"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/timeouts"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func storageTable() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: storageTableCreate,
+		Read:   storageTableRead,
+		Delete: storageTableDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := tables.ParseResourceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageTableName,
+			},
+
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+		},
+	}
+}
+
+func storageTableCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	tableName := d.Get("name").(string)
+	accountName := d.Get("storage_account_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Table %q: %s", accountName, tableName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	client, err := storageClient.TablesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Tables Client: %s", err)
+	}
+
+	id := client.GetResourceID(accountName, tableName)
+	existing, err := client.Exists(ctx, accountName, tableName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing) {
+			return fmt.Errorf("checking for presence of existing Table %q (Storage Account %q): %s", tableName, accountName, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing) {
+		return tf.ImportAsExistsError("azurestack_storage_table", id)
+	}
+
+	log.Printf("[INFO] Creating Table %q in Storage Account %q", tableName, accountName)
+	if _, err := client.Create(ctx, accountName, tableName); err != nil {
+		return fmt.Errorf("creating Table %q (Storage Account %q): %s", tableName, accountName, err)
+	}
+
+	d.SetId(id)
+	return storageTableRead(d, meta)
+}
+
+func storageTableRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tables.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Table %q: %s", id.AccountName, id.TableName, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Unable to locate Account %q for Storage Table %q - assuming removed & removing from state", id.AccountName, id.TableName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.TablesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Tables Client: %s", err)
+	}
+
+	exists, err := client.Exists(ctx, id.AccountName, id.TableName)
+	if err != nil {
+		if utils.ResponseWasNotFound(exists) {
+			log.Printf("[DEBUG] Storage Table %q was not found in Storage Account %q - assuming removed & removing from state", id.TableName, id.AccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Table %q (Storage Account %q): %s", id.TableName, id.AccountName, err)
+	}
+
+	d.Set("name", id.TableName)
+	d.Set("storage_account_name", id.AccountName)
+
+	return nil
+}
+
+func storageTableDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tables.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Table %q: %s", id.AccountName, id.TableName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	client, err := storageClient.TablesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Tables Client: %s", err)
+	}
+
+	if _, err := client.Delete(ctx, id.AccountName, id.TableName); err != nil {
+		return fmt.Errorf("deleting Table %q (Storage Account %q): %s", id.TableName, id.AccountName, err)
+	}
+
+	return nil
+}
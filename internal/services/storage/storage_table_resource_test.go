@@ -0,0 +1,118 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/table/tables"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+type StorageTableResource struct{}
+
+func TestAccStorageTable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_table", "test")
+	r := StorageTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepStrict(),
+	})
+}
+
+func TestAccStorageTable_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurestack_storage_table", "test")
+	r := StorageTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StorageTableResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Table %q: %+v", id.AccountName, id.TableName, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+	}
+
+	tablesClient, err := client.Storage.TablesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Tables Client: %+v", err)
+	}
+	resp, err := tablesClient.Exists(ctx, id.AccountName, id.TableName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return pointer.FromBool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Table %q (Account %q): %+v", id.TableName, id.AccountName, err)
+	}
+	return pointer.FromBool(true), nil
+}
+
+func (r StorageTableResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_table" "test" {
+  name                 = "acctestst%d"
+  storage_account_name = azurestack_storage_account.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageTableResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurestack_storage_table" "import" {
+  name                 = azurestack_storage_table.test.name
+  storage_account_name = azurestack_storage_table.test.storage_account_name
+}
+`, r.basic(data))
+}
+
+func (r StorageTableResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurestack" {
+  features {}
+}
+
+resource "azurestack_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurestack_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurestack_resource_group.test.name
+  location                 = azurestack_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -15,7 +15,7 @@ func StorageAccountID(input interface{}, key string) (warnings []string, errors
 		return
 	}
 
-	if _, err := parse.StorageAccountID(v); err != nil {
+	if _, err := parse.StorageAccountIDInsensitively(v); err != nil {
 		errors = append(errors, err)
 	}
 
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func StorageQueueName(v interface{}, _ string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	if !regexp.MustCompile(`\A([a-z0-9]([a-z0-9-]{1,61})[a-z0-9])\z`).MatchString(input) {
+		errors = append(errors, fmt.Errorf("name (%q) can only consist of lowercase letters, numbers and hyphens, must start and end with a letter or number and must be between 3 and 63 characters long", input))
+	}
+
+	if strings.Contains(input, "--") {
+		errors = append(errors, fmt.Errorf("name (%q) cannot contain consecutive hyphens", input))
+	}
+
+	return warnings, errors
+}
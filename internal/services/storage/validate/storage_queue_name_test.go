@@ -0,0 +1,35 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageQueueName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"ab", true},
+		{"abc", false},
+		{"ABC", true},
+		{"abc-123", false},
+		{"-abc", true},
+		{"abc-", true},
+		{"ab--c", true},
+		{"ab_c", true},
+		{strings.Repeat("a", 63), false},
+		{strings.Repeat("a", 64), true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageQueueName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+		if !test.shouldError && len(es) != 0 {
+			t.Fatalf("Expected validating name %q to succeed but got %+v", test.input, es)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func StorageTableName(v interface{}, _ string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	if strings.EqualFold(input, "tables") {
+		errors = append(errors, fmt.Errorf("name (%q) is reserved and cannot be used as a Table Name", input))
+	}
+
+	if !regexp.MustCompile(`\A([a-zA-Z][a-zA-Z0-9]{2,62})\z`).MatchString(input) {
+		errors = append(errors, fmt.Errorf("name (%q) can only consist of letters and numbers, must start with a letter and must be between 3 and 63 characters long", input))
+	}
+
+	return warnings, errors
+}
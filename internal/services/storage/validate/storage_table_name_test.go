@@ -0,0 +1,34 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageTableName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"ab", true},
+		{"abc", false},
+		{"ABC123", false},
+		{"1abc", true},
+		{"ab-c", true},
+		{"tables", true},
+		{"Tables", true},
+		{strings.Repeat("a", 63), false},
+		{strings.Repeat("a", 64), true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageTableName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+		if !test.shouldError && len(es) != 0 {
+			t.Fatalf("Expected validating name %q to succeed but got %+v", test.input, es)
+		}
+	}
+}
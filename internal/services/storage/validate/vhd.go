@@ -0,0 +1,117 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	vhdFooterSize = 512
+
+	// vhdAlignment is the multiple of which the virtual size of a VHD must be to be used as a Disk or Image
+	vhdAlignment = 1024 * 1024
+
+	// maximumVHDSizeInGB is the largest Disk supported by Azure Stack Hub
+	maximumVHDSizeInGB = 1023
+
+	vhdDiskTypeFixed        = 2
+	vhdDiskTypeDynamic      = 3
+	vhdDiskTypeDifferencing = 4
+)
+
+var (
+	vhdCookie  = []byte("conectix")
+	vhdxCookie = []byte("vhdxfile")
+)
+
+const vhdConversionHint = "VHDs can be converted using `Convert-VHD -Path {source} -DestinationPath {destination} -VHDType Fixed` (Hyper-V) or `qemu-img convert -O vpc -o subformat=fixed,force_size {source} {destination}`"
+
+// VHDFile validates that the file at the specified path is a VHD which can be used as a Disk or Image on
+// Azure Stack Hub - which requires a Fixed VHD whose virtual size is a whole number of MiB
+func VHDFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening %q: %+v", path, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("retrieving information about %q: %+v", path, err)
+	}
+
+	if err := VHD(file, info.Size()); err != nil {
+		return fmt.Errorf("%q isn't a valid VHD for Azure Stack Hub: %+v", path, err)
+	}
+
+	return nil
+}
+
+// VHD validates the VHD contained within the reader, which is of the specified size in bytes
+func VHD(file io.ReaderAt, size int64) error {
+	header := make([]byte, len(vhdxCookie))
+	if _, err := file.ReadAt(header, 0); err != nil && err != io.EOF {
+		return fmt.Errorf("reading header: %+v", err)
+	}
+	if bytes.Equal(header, vhdxCookie) {
+		return fmt.Errorf("the file is a VHDX but only the VHD format is supported - %s", vhdConversionHint)
+	}
+
+	if size < vhdFooterSize {
+		return fmt.Errorf("the file is %d bytes which is too small to contain a VHD footer (%d bytes)", size, vhdFooterSize)
+	}
+
+	footer := make([]byte, vhdFooterSize)
+	if _, err := file.ReadAt(footer, size-vhdFooterSize); err != nil {
+		return fmt.Errorf("reading footer: %+v", err)
+	}
+
+	if !bytes.Equal(footer[0:8], vhdCookie) {
+		return fmt.Errorf("the file doesn't contain a VHD footer (expected the cookie %q in the last %d bytes but got %q)", string(vhdCookie), vhdFooterSize, string(footer[0:8]))
+	}
+
+	if expected, actual := vhdFooterChecksum(footer), binary.BigEndian.Uint32(footer[64:68]); expected != actual {
+		return fmt.Errorf("the checksum of the VHD footer is invalid (expected %d but got %d) - the file may be corrupt or truncated", expected, actual)
+	}
+
+	switch diskType := binary.BigEndian.Uint32(footer[60:64]); diskType {
+	case vhdDiskTypeFixed:
+		// supported
+	case vhdDiskTypeDynamic:
+		return fmt.Errorf("the VHD is Dynamic but only Fixed VHDs are supported - %s", vhdConversionHint)
+	case vhdDiskTypeDifferencing:
+		return fmt.Errorf("the VHD is Differencing but only Fixed VHDs are supported - %s", vhdConversionHint)
+	default:
+		return fmt.Errorf("the VHD has an unknown Disk Type %d but only Fixed VHDs are supported", diskType)
+	}
+
+	virtualSize := int64(binary.BigEndian.Uint64(footer[48:56]))
+	if expected := virtualSize + vhdFooterSize; size != expected {
+		return fmt.Errorf("the file is %d bytes but a Fixed VHD with a virtual size of %d bytes should be %d bytes - the file may be truncated", size, virtualSize, expected)
+	}
+
+	if virtualSize%vhdAlignment != 0 {
+		return fmt.Errorf("the virtual size of the VHD (%d bytes) must be a multiple of 1 MiB (%d bytes), for example %d bytes - the VHD can be resized using `Resize-VHD` (Hyper-V) or `qemu-img resize`", virtualSize, vhdAlignment, (virtualSize/vhdAlignment+1)*vhdAlignment)
+	}
+
+	if maximum := int64(maximumVHDSizeInGB) * 1024 * 1024 * 1024; virtualSize > maximum {
+		return fmt.Errorf("the virtual size of the VHD (%d bytes) exceeds the maximum size of a Disk (%d GB)", virtualSize, maximumVHDSizeInGB)
+	}
+
+	return nil
+}
+
+// vhdFooterChecksum returns the one's complement of the sum of the bytes in the footer, excluding the checksum itself
+func vhdFooterChecksum(footer []byte) uint32 {
+	var sum uint32
+	for i, b := range footer {
+		if i >= 64 && i < 68 {
+			continue
+		}
+		sum += uint32(b)
+	}
+	return ^sum
+}
@@ -0,0 +1,94 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func testVHD(virtualSize int64, diskType uint32, fileSize int64) []byte {
+	footer := make([]byte, vhdFooterSize)
+	copy(footer[0:8], vhdCookie)
+	binary.BigEndian.PutUint32(footer[12:16], 0x00010000)
+	binary.BigEndian.PutUint64(footer[16:24], 0xFFFFFFFFFFFFFFFF)
+	binary.BigEndian.PutUint64(footer[40:48], uint64(virtualSize))
+	binary.BigEndian.PutUint64(footer[48:56], uint64(virtualSize))
+	binary.BigEndian.PutUint32(footer[60:64], diskType)
+	binary.BigEndian.PutUint32(footer[64:68], vhdFooterChecksum(footer))
+
+	file := make([]byte, fileSize)
+	copy(file[fileSize-vhdFooterSize:], footer)
+	return file
+}
+
+func TestVHD(t *testing.T) {
+	corrupt := testVHD(vhdAlignment, vhdDiskTypeFixed, vhdAlignment+vhdFooterSize)
+	corrupt[len(corrupt)-vhdFooterSize+48]++
+
+	vhdx := make([]byte, 1024)
+	copy(vhdx, vhdxCookie)
+
+	testData := []struct {
+		Name     string
+		Input    []byte
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			Input:    []byte{},
+			Expected: false,
+		},
+		{
+			Name:     "not a vhd",
+			Input:    make([]byte, 2048),
+			Expected: false,
+		},
+		{
+			Name:     "vhdx",
+			Input:    vhdx,
+			Expected: false,
+		},
+		{
+			Name:     "fixed",
+			Input:    testVHD(vhdAlignment, vhdDiskTypeFixed, vhdAlignment+vhdFooterSize),
+			Expected: true,
+		},
+		{
+			Name:     "dynamic",
+			Input:    testVHD(vhdAlignment, vhdDiskTypeDynamic, 4096),
+			Expected: false,
+		},
+		{
+			Name:     "differencing",
+			Input:    testVHD(vhdAlignment, vhdDiskTypeDifferencing, 4096),
+			Expected: false,
+		},
+		{
+			Name:     "invalid checksum",
+			Input:    corrupt,
+			Expected: false,
+		},
+		{
+			Name:     "truncated",
+			Input:    testVHD(2*vhdAlignment, vhdDiskTypeFixed, vhdAlignment+vhdFooterSize),
+			Expected: false,
+		},
+		{
+			Name:     "not aligned to 1 MiB",
+			Input:    testVHD(vhdAlignment+512, vhdDiskTypeFixed, vhdAlignment+512+vhdFooterSize),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := VHD(bytes.NewReader(v.Input), int64(len(v.Input)))
+		if v.Expected && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Expected && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Package sweepers contains the Acceptance Test Sweepers, which delete the Resources left behind by Acceptance Tests
+// which failed (or were cancelled) before they could be destroyed - and are run using `make sweep`, for example:
+//
+//	ARM_SWEEP_MAX_AGE=6h go test ./internal/sweepers -v -sweep=local
+//
+// where the value of `-sweep` is the location to sweep (or `all` to sweep every location). Only Resources whose names
+// start with one of the prefixes used by the Acceptance Tests, and which are older than `ARM_SWEEP_MAX_AGE` (which
+// defaults to 6 hours) are deleted.
+package sweepers
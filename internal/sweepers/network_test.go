@@ -0,0 +1,127 @@
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/sweep"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func init() {
+	resource.AddTestSweepers("azurestack_network_interface", &resource.Sweeper{
+		Name: "azurestack_network_interface",
+		F:    sweeper("Network Interfaces", sweepNetworkInterfaces),
+	})
+
+	resource.AddTestSweepers("azurestack_public_ip", &resource.Sweeper{
+		Name: "azurestack_public_ip",
+		// a Public IP can't be deleted whilst it's associated with a Network Interface
+		Dependencies: []string{
+			"azurestack_network_interface",
+		},
+		F: sweeper("Public IPs", sweepPublicIPs),
+	})
+}
+
+func sweepNetworkInterfaces(ctx context.Context, client *clients.Client, criteria sweep.Criteria) []error {
+	interfacesClient := client.Network.InterfacesClient
+
+	iterator, err := interfacesClient.ListAllComplete(ctx)
+	if err != nil {
+		return []error{fmt.Errorf("listing Network Interfaces: %+v", err)}
+	}
+
+	errs := make([]error, 0)
+	for iterator.NotDone() {
+		nic := iterator.Value()
+		if nic.ID != nil && nic.Name != nil && nic.Location != nil && criteria.ShouldSweep(*nic.Name, *nic.Location, nil) {
+			if err := deleteNetworkInterface(ctx, client, *nic.ID); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return append(errs, fmt.Errorf("listing Network Interfaces: %+v", err))
+		}
+	}
+
+	return errs
+}
+
+func deleteNetworkInterface(ctx context.Context, client *clients.Client, resourceId string) error {
+	interfacesClient := client.Network.InterfacesClient
+
+	id, err := parse.NetworkInterfaceID(resourceId)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting %s", *id)
+	future, err := interfacesClient.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, interfacesClient.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func sweepPublicIPs(ctx context.Context, client *clients.Client, criteria sweep.Criteria) []error {
+	publicIPsClient := client.Network.PublicIPsClient
+
+	iterator, err := publicIPsClient.ListAllComplete(ctx)
+	if err != nil {
+		return []error{fmt.Errorf("listing Public IPs: %+v", err)}
+	}
+
+	errs := make([]error, 0)
+	for iterator.NotDone() {
+		publicIP := iterator.Value()
+		if publicIP.ID != nil && publicIP.Name != nil && publicIP.Location != nil && criteria.ShouldSweep(*publicIP.Name, *publicIP.Location, nil) {
+			if err := deletePublicIP(ctx, client, *publicIP.ID); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return append(errs, fmt.Errorf("listing Public IPs: %+v", err))
+		}
+	}
+
+	return errs
+}
+
+func deletePublicIP(ctx context.Context, client *clients.Client, resourceId string) error {
+	publicIPsClient := client.Network.PublicIPsClient
+
+	id, err := parse.PublicIpAddressID(resourceId)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting %s", *id)
+	future, err := publicIPsClient.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, publicIPsClient.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2020-09-01/resources/mgmt/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/sweep"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func init() {
+	resource.AddTestSweepers("azurestack_resource_group", &resource.Sweeper{
+		Name: "azurestack_resource_group",
+		// the Resources within a Resource Group are deleted along with it, however sweeping these first means the
+		// Resources left behind in a Resource Group which can't be deleted are still swept
+		Dependencies: []string{
+			"azurestack_network_interface",
+			"azurestack_public_ip",
+			"azurestack_storage_account",
+		},
+		F: sweeper("Resource Groups", sweepResourceGroups),
+	})
+}
+
+func sweepResourceGroups(ctx context.Context, client *clients.Client, criteria sweep.Criteria) []error {
+	groupsClient := client.Resource.GroupsClient
+
+	iterator, err := groupsClient.ListComplete(ctx, "", nil)
+	if err != nil {
+		return []error{fmt.Errorf("listing Resource Groups: %+v", err)}
+	}
+
+	errs := make([]error, 0)
+	futures := make(map[string]resources.GroupsDeleteFuture)
+	for iterator.NotDone() {
+		group := iterator.Value()
+		if group.Name != nil && group.Location != nil && criteria.ShouldSweep(*group.Name, *group.Location, nil) {
+			log.Printf("[DEBUG] Deleting Resource Group %q", *group.Name)
+			future, err := groupsClient.Delete(ctx, *group.Name)
+			if err != nil {
+				if !utils.WasNotFound(future.Response()) {
+					errs = append(errs, fmt.Errorf("deleting Resource Group %q: %+v", *group.Name, err))
+				}
+			} else {
+				futures[*group.Name] = future
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return append(errs, fmt.Errorf("listing Resource Groups: %+v", err))
+		}
+	}
+
+	// the deletion of each Resource Group is started before waiting for any to complete, since this can take some time
+	for name, future := range futures {
+		if err := future.WaitForCompletionRef(ctx, groupsClient.Client); err != nil {
+			errs = append(errs, fmt.Errorf("waiting for the deletion of Resource Group %q: %+v", name, err))
+		}
+	}
+
+	return errs
+}
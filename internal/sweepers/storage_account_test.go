@@ -0,0 +1,62 @@
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/sweep"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/utils"
+)
+
+func init() {
+	resource.AddTestSweepers("azurestack_storage_account", &resource.Sweeper{
+		Name: "azurestack_storage_account",
+		F:    sweeper("Storage Accounts", sweepStorageAccounts),
+	})
+}
+
+func sweepStorageAccounts(ctx context.Context, client *clients.Client, criteria sweep.Criteria) []error {
+	accountsClient := client.Storage.AccountsClient
+
+	resp, err := accountsClient.List(ctx)
+	if err != nil {
+		return []error{fmt.Errorf("listing Storage Accounts: %+v", err)}
+	}
+	if resp.Value == nil {
+		return nil
+	}
+
+	errs := make([]error, 0)
+	for _, account := range *resp.Value {
+		if account.ID == nil || account.Name == nil || account.Location == nil {
+			continue
+		}
+
+		// the names of Storage Accounts are too short to contain a timestamp, so the creation time is used instead
+		var created *time.Time
+		if props := account.AccountProperties; props != nil && props.CreationTime != nil {
+			created = &props.CreationTime.Time
+		}
+		if !criteria.ShouldSweep(*account.Name, *account.Location, created) {
+			continue
+		}
+
+		id, err := parse.StorageAccountID(*account.ID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting %s", *id)
+		if resp, err := accountsClient.Delete(ctx, id.ResourceGroup, id.Name); err != nil && !utils.ResponseWasNotFound(resp) {
+			errs = append(errs, fmt.Errorf("deleting %s: %+v", *id, err))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,65 @@
+package sweepers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/sweep"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/testclient"
+)
+
+// sweepTimeout is the maximum duration of each Sweeper
+const sweepTimeout = 60 * time.Minute
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sweepFunc deletes the Resources matching the criteria, returning an error for each Resource which couldn't be deleted
+type sweepFunc func(ctx context.Context, client *clients.Client, criteria sweep.Criteria) []error
+
+// sweeper returns a SweeperFunc which builds the client and criteria for the region (the location being swept) and
+// then runs the sweepFunc, returning the errors from any Resources which couldn't be deleted once it's completed
+func sweeper(name string, f sweepFunc) resource.SweeperFunc {
+	return func(region string) error {
+		maxAge, err := sweep.MaxAge()
+		if err != nil {
+			return err
+		}
+
+		client, err := testclient.Build()
+		if err != nil {
+			return fmt.Errorf("building client: %+v", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), sweepTimeout)
+		defer cancel()
+
+		criteria := sweep.Criteria{
+			Location: region,
+			MaxAge:   maxAge,
+			Now:      time.Now(),
+		}
+		log.Printf("[DEBUG] Sweeping %s in %q older than %s", name, region, maxAge)
+
+		errs := f(ctx, client, criteria)
+		if len(errs) == 0 {
+			return nil
+		}
+
+		messages := make([]string, 0)
+		for _, err := range errs {
+			messages = append(messages, fmt.Sprintf("  - %+v", err))
+		}
+		sort.Strings(messages)
+
+		return fmt.Errorf("sweeping %s:\n\n%s", name, strings.Join(messages, "\n"))
+	}
+}
@@ -52,6 +52,9 @@ type TestData struct {
 	// MetadataURL is the url of the endpoint where the environment is obtained
 	MetadataURL string
 
+	// Skus are the SKUs (and sizes) available on the Stamp, which are substituted into the test configurations
+	Skus *StampSkus
+
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
 }
@@ -86,6 +89,12 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		Ternary:   os.Getenv("ARM_TEST_LOCATION_ALT2"),
 	}
 
+	skus, err := LoadStampSkus()
+	if err != nil {
+		t.Fatalf("Error loading the SKUs available on the Stamp: %+v", err)
+	}
+	testData.Skus = skus
+
 	return testData
 }
 
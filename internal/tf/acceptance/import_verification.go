@@ -0,0 +1,97 @@
+package acceptance
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/provider"
+)
+
+// verifyImportedAttributes confirms that the attributes of the imported Resource match those in the state prior to
+// the import - returning an error listing each attribute which differs.
+//
+// Unlike `ImportStateVerify` the ignored attributes must match the attribute (or the nested block containing it)
+// exactly, rather than only its prefix - so ignoring `shared_key` doesn't also ignore an attribute named
+// `shared_key_id`. As with `ImportStateVerify`, empty lists/maps are treated as being unset and `timeouts` are
+// never compared.
+func verifyImportedAttributes(expected, actual map[string]string, ignore []string) error {
+	ignored := func(key string) bool {
+		if key == "timeouts" || strings.HasPrefix(key, "timeouts.") {
+			return true
+		}
+
+		for _, v := range ignore {
+			if key == v || strings.HasPrefix(key, v+".") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// empty lists/maps are only _sometimes_ written into the state, so these are treated as being unset
+	withoutEmpty := func(input map[string]string) map[string]string {
+		output := make(map[string]string)
+		for k, v := range input {
+			if (strings.HasSuffix(k, ".#") || strings.HasSuffix(k, ".%")) && v == "0" {
+				continue
+			}
+			output[k] = v
+		}
+		return output
+	}
+	expected = withoutEmpty(expected)
+	actual = withoutEmpty(actual)
+
+	keys := make(map[string]struct{})
+	for k := range expected {
+		keys[k] = struct{}{}
+	}
+	for k := range actual {
+		keys[k] = struct{}{}
+	}
+
+	differences := make([]string, 0)
+	for key := range keys {
+		if ignored(key) {
+			continue
+		}
+
+		expectedValue, inExpected := expected[key]
+		actualValue, inActual := actual[key]
+		switch {
+		case !inActual:
+			differences = append(differences, fmt.Sprintf("%s: %q was not set after importing", key, expectedValue))
+		case !inExpected:
+			differences = append(differences, fmt.Sprintf("%s: %q was set after importing but not in the configuration", key, actualValue))
+		case expectedValue != actualValue:
+			differences = append(differences, fmt.Sprintf("%s: %q was %q after importing", key, expectedValue, actualValue))
+		}
+	}
+
+	if len(differences) == 0 {
+		return nil
+	}
+
+	sort.Strings(differences)
+	return fmt.Errorf("the following attributes differ after importing the Resource - which means these aren't being set (or are being set differently) by the Read function:\n\n  - %s", strings.Join(differences, "\n  - "))
+}
+
+// validateIgnoredAttributes confirms each of the ignored attributes exists within the schema of the Resource, so that
+// attributes which have been renamed (or removed) aren't left in the list of ignored attributes
+func validateIgnoredAttributes(resourceType string, ignore []string) error {
+	resource, ok := provider.TestAzureProvider().ResourcesMap[resourceType]
+	if !ok {
+		return fmt.Errorf("the Resource %q was not found in the Provider", resourceType)
+	}
+
+	for _, v := range ignore {
+		attribute := strings.Split(v, ".")[0]
+		if _, ok := resource.Schema[attribute]; !ok {
+			return fmt.Errorf("the ignored attribute %q isn't defined in the schema for %q", v, resourceType)
+		}
+	}
+
+	return nil
+}
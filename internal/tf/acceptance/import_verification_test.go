@@ -0,0 +1,115 @@
+package acceptance
+
+import (
+	"testing"
+)
+
+func TestVerifyImportedAttributes(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected map[string]string
+		Actual   map[string]string
+		Ignore   []string
+		Error    bool
+	}{
+		{
+			Name: "identical",
+			Expected: map[string]string{
+				"name":   "example",
+				"tags.%": "1",
+				"tags.a": "b",
+			},
+			Actual: map[string]string{
+				"name":   "example",
+				"tags.%": "1",
+				"tags.a": "b",
+			},
+		},
+		{
+			Name: "optional attribute not set after import",
+			Expected: map[string]string{
+				"name":              "example",
+				"internal_dns_name": "example",
+			},
+			Actual: map[string]string{
+				"name": "example",
+			},
+			Error: true,
+		},
+		{
+			Name: "attribute differs after import",
+			Expected: map[string]string{
+				"sku": "Standard",
+			},
+			Actual: map[string]string{
+				"sku": "Basic",
+			},
+			Error: true,
+		},
+		{
+			Name: "empty containers and timeouts are ignored",
+			Expected: map[string]string{
+				"name":            "example",
+				"tags.%":          "0",
+				"timeouts.create": "1h",
+			},
+			Actual: map[string]string{
+				"name":    "example",
+				"zones.#": "0",
+			},
+		},
+		{
+			Name: "ignored attribute",
+			Expected: map[string]string{
+				"shared_key": "secret",
+			},
+			Actual: map[string]string{},
+			Ignore: []string{"shared_key"},
+		},
+		{
+			Name: "ignored nested block",
+			Expected: map[string]string{
+				"ip_configuration.#":        "1",
+				"ip_configuration.0.name":   "primary",
+				"ip_configuration.0.subnet": "example",
+			},
+			Actual: map[string]string{
+				"ip_configuration.#":      "1",
+				"ip_configuration.0.name": "primary",
+			},
+			Ignore: []string{"ip_configuration.0.subnet"},
+		},
+		{
+			Name: "ignored attribute only matches exactly",
+			Expected: map[string]string{
+				"shared_key":    "secret",
+				"shared_key_id": "abc123",
+			},
+			Actual: map[string]string{},
+			Ignore: []string{"shared_key"},
+			Error:  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := verifyImportedAttributes(v.Expected, v.Actual, v.Ignore)
+		if v.Error && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestValidateIgnoredAttributes(t *testing.T) {
+	if err := validateIgnoredAttributes("azurestack_virtual_network_gateway_connection", []string{"shared_key"}); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if err := validateIgnoredAttributes("azurestack_virtual_network_gateway_connection", []string{"shared_keys"}); err == nil {
+		t.Fatalf("Expected an error for an attribute which isn't in the schema")
+	}
+}
@@ -0,0 +1,162 @@
+package acceptance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// the SKUs (and sizes) used within the test configurations, which are substituted for those available on the
+// Stamp when they're unavailable. SKUs used to test validation (for example an invalid size) and those which are
+// ambiguous (for example `Basic`, which is used by both Public IPs and Virtual Network Gateways) are excluded.
+var (
+	testVirtualMachineSizes = []string{
+		"Standard_D1_v2",
+		"Standard_F2",
+		"Standard_D2_v2",
+		"Standard_F1",
+		"Standard_D4_v2",
+		"Standard_F4",
+		"Standard_DS1_v2",
+		"Standard_DS2_v2",
+		"STANDARD_A1",
+		"Standard_A3",
+	}
+
+	testStorageSkus = []string{
+		"Standard_LRS",
+		"Premium_LRS",
+	}
+
+	testVirtualNetworkGatewaySkus = []string{
+		"VpnGw1",
+		"VpnGw2",
+		"VpnGw3",
+		"HighPerformance",
+	}
+)
+
+// assignedString matches a string value assigned to one of the arguments containing a SKU (or size) within the
+// test configuration - including the `name` within a `sku` block. Other arguments (for example the parameters of
+// an ARM Template, such as `storageAccountType`) and values within JSON aren't substituted.
+var assignedString = regexp.MustCompile(`(\b(?:vm_size|size|storage_account_type|managed_disk_type|sku|account_type)\s*=\s*|\bsku\s*\{\s*name\s*=\s*)"([A-Za-z0-9_]+)"`)
+
+// StampSkus are the SKUs (and sizes) available on the Stamp being tested, which are read from the JSON file specified
+// in the `ARM_TEST_SKUS_FILE` Environment Variable (for example `{"virtual_machine_sizes": ["Standard_A1_v2"]}`) and
+// the `ARM_TEST_VM_SIZES`, `ARM_TEST_STORAGE_SKUS` and `ARM_TEST_GATEWAY_SKUS` Environment Variables (as a
+// comma-separated list), which take precedence. When none are specified for a category all of its SKUs are available.
+type StampSkus struct {
+	VirtualMachineSizes       []string `json:"virtual_machine_sizes"`
+	StorageSkus               []string `json:"storage_skus"`
+	VirtualNetworkGatewaySkus []string `json:"virtual_network_gateway_skus"`
+
+	// substitutions maps each SKU used within the test configurations which isn't available on the Stamp to the
+	// available SKU which is used instead
+	substitutions map[string]string
+}
+
+// LoadStampSkus returns the SKUs available on the Stamp being tested
+func LoadStampSkus() (*StampSkus, error) {
+	skus := &StampSkus{}
+
+	if path := os.Getenv("ARM_TEST_SKUS_FILE"); path != "" {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading the SKUs file %q: %+v", path, err)
+		}
+		if err := json.Unmarshal(contents, skus); err != nil {
+			return nil, fmt.Errorf("parsing the SKUs file %q: %+v", path, err)
+		}
+	}
+
+	environmentVariables := []struct {
+		name   string
+		values *[]string
+	}{
+		{name: "ARM_TEST_VM_SIZES", values: &skus.VirtualMachineSizes},
+		{name: "ARM_TEST_STORAGE_SKUS", values: &skus.StorageSkus},
+		{name: "ARM_TEST_GATEWAY_SKUS", values: &skus.VirtualNetworkGatewaySkus},
+	}
+	for _, v := range environmentVariables {
+		if raw := os.Getenv(v.name); raw != "" {
+			values := make([]string, 0)
+			for _, value := range strings.Split(raw, ",") {
+				if value = strings.TrimSpace(value); value != "" {
+					values = append(values, value)
+				}
+			}
+			*v.values = values
+		}
+	}
+
+	skus.buildSubstitutions()
+	return skus, nil
+}
+
+func (s *StampSkus) buildSubstitutions() {
+	s.substitutions = make(map[string]string)
+
+	categories := []struct {
+		used      []string
+		available []string
+	}{
+		{used: testVirtualMachineSizes, available: s.VirtualMachineSizes},
+		{used: testStorageSkus, available: s.StorageSkus},
+		{used: testVirtualNetworkGatewaySkus, available: s.VirtualNetworkGatewaySkus},
+	}
+	for _, category := range categories {
+		if len(category.available) == 0 {
+			continue
+		}
+
+		// the unavailable SKUs are substituted for distinct SKUs where possible, so that tests which change the SKU
+		// (for example resizing a Virtual Machine) continue to do so
+		next := 0
+		for _, used := range category.used {
+			if containsFold(category.available, used) {
+				continue
+			}
+
+			s.substitutions[strings.ToLower(used)] = category.available[next%len(category.available)]
+			next++
+		}
+	}
+}
+
+// Sku returns the SKU which should be used in place of the specified SKU, which is the SKU itself when it's
+// available on the Stamp (or isn't one which is substituted)
+func (s *StampSkus) Sku(input string) string {
+	if s == nil {
+		return input
+	}
+
+	if v, ok := s.substitutions[strings.ToLower(input)]; ok {
+		return v
+	}
+
+	return input
+}
+
+// Substitute replaces the SKUs within the test configuration which aren't available on the Stamp
+func (s *StampSkus) Substitute(config string) string {
+	if s == nil || len(s.substitutions) == 0 {
+		return config
+	}
+
+	return assignedString.ReplaceAllStringFunc(config, func(match string) string {
+		groups := assignedString.FindStringSubmatch(match)
+		return fmt.Sprintf("%s%q", groups[1], s.Sku(groups[2]))
+	})
+}
+
+func containsFold(input []string, value string) bool {
+	for _, v := range input {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,144 @@
+package acceptance
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStampSkusSubstitute(t *testing.T) {
+	skus := &StampSkus{
+		VirtualMachineSizes:       []string{"Standard_A1_v2", "Standard_A2_v2", "Standard_F2"},
+		VirtualNetworkGatewaySkus: []string{"Basic"},
+	}
+	skus.buildSubstitutions()
+
+	input := `
+resource "azurestack_virtual_machine" "test" {
+  vm_size = "Standard_D1_v2"
+}
+
+resource "azurestack_virtual_machine" "resized" {
+  vm_size = "Standard_D2_v2"
+}
+
+resource "azurestack_virtual_machine" "available" {
+  vm_size = "standard_f2"
+}
+
+resource "azurestack_managed_disk" "test" {
+  storage_account_type = "Premium_LRS"
+}
+
+resource "azurestack_virtual_network_gateway" "test" {
+  sku = "VpnGw1"
+}
+
+resource "azurestack_public_ip" "test" {
+  sku = "Basic"
+}
+
+resource "azurestack_virtual_machine_scale_set" "test" {
+  sku {
+    name     = "Standard_D2_v2"
+    capacity = 2
+  }
+}
+
+resource "azurestack_template_deployment" "test" {
+  template_body = <<DEPLOY
+{
+  "defaultValue": "Standard_D1_v2"
+}
+DEPLOY
+
+  parameters = {
+    vmSize = "Standard_D1_v2"
+  }
+}
+`
+	expected := `
+resource "azurestack_virtual_machine" "test" {
+  vm_size = "Standard_A1_v2"
+}
+
+resource "azurestack_virtual_machine" "resized" {
+  vm_size = "Standard_A2_v2"
+}
+
+resource "azurestack_virtual_machine" "available" {
+  vm_size = "standard_f2"
+}
+
+resource "azurestack_managed_disk" "test" {
+  storage_account_type = "Premium_LRS"
+}
+
+resource "azurestack_virtual_network_gateway" "test" {
+  sku = "Basic"
+}
+
+resource "azurestack_public_ip" "test" {
+  sku = "Basic"
+}
+
+resource "azurestack_virtual_machine_scale_set" "test" {
+  sku {
+    name     = "Standard_A2_v2"
+    capacity = 2
+  }
+}
+
+resource "azurestack_template_deployment" "test" {
+  template_body = <<DEPLOY
+{
+  "defaultValue": "Standard_D1_v2"
+}
+DEPLOY
+
+  parameters = {
+    vmSize = "Standard_D1_v2"
+  }
+}
+`
+
+	if actual := skus.Substitute(input); actual != expected {
+		t.Fatalf("Expected:\n%s\n\nbut got:\n%s", expected, actual)
+	}
+
+	if actual := skus.Sku("Standard_D1_v2"); actual != "Standard_A1_v2" {
+		t.Fatalf("Expected `Standard_A1_v2` but got %q", actual)
+	}
+
+	var unconfigured *StampSkus
+	if actual := unconfigured.Substitute(input); actual != input {
+		t.Fatalf("Expected the configuration to be unchanged when no SKUs are configured")
+	}
+}
+
+func TestLoadStampSkus(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "skus.json")
+	if err := os.WriteFile(path, []byte(`{"virtual_machine_sizes": ["Standard_A1_v2"], "storage_skus": ["Standard_LRS"]}`), 0o600); err != nil {
+		t.Fatalf("writing the SKUs file: %+v", err)
+	}
+
+	t.Setenv("ARM_TEST_SKUS_FILE", path)
+	t.Setenv("ARM_TEST_VM_SIZES", "Standard_A2_v2, Standard_A4_v2")
+	t.Setenv("ARM_TEST_STORAGE_SKUS", "")
+	t.Setenv("ARM_TEST_GATEWAY_SKUS", "")
+
+	skus, err := LoadStampSkus()
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if len(skus.VirtualMachineSizes) != 2 || skus.VirtualMachineSizes[0] != "Standard_A2_v2" || skus.VirtualMachineSizes[1] != "Standard_A4_v2" {
+		t.Fatalf("Expected the Environment Variable to take precedence over the file but got %+v", skus.VirtualMachineSizes)
+	}
+	if len(skus.StorageSkus) != 1 || skus.StorageSkus[0] != "Standard_LRS" {
+		t.Fatalf("Expected the Storage SKUs from the file but got %+v", skus.StorageSkus)
+	}
+	if actual := skus.Sku("Premium_LRS"); actual != "Standard_LRS" {
+		t.Fatalf("Expected `Premium_LRS` to be substituted for `Standard_LRS` but got %q", actual)
+	}
+}
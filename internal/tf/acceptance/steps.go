@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurestack/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/helpers"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/testclient"
@@ -127,6 +128,15 @@ func (td TestData) ImportStepFor(resourceName string, ignore ...string) resource
 		ResourceName:      resourceName,
 		ImportState:       true,
 		ImportStateVerify: true,
+		ImportStateCheck: func(states []*terraform.InstanceState) error {
+			// confirm the Required and Optional attributes are populated, so that configuration can be generated for the Resource
+			for _, state := range states {
+				if err := provider.ValidateImportedState(state.Ephemeral.Type, state.Attributes, ignore...); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
 	}
 
 	if len(ignore) > 0 {
@@ -136,6 +146,62 @@ func (td TestData) ImportStepFor(resourceName string, ignore ...string) resource
 	return step
 }
 
+// ImportStepStrict returns a Test Step which Imports the Resource and confirms that every attribute matches the
+// state prior to the import, other than the attributes which are explicitly ignored (for example, as they're not
+// returned from the API) - see ImportStepStrictFor.
+func (td TestData) ImportStepStrict(ignore ...string) resource.TestStep {
+	return td.ImportStepStrictFor(td.ResourceName, ignore...)
+}
+
+// ImportStepStrictFor returns a Test Step which Imports a given resource by name and confirms that every attribute
+// matches the state prior to the import. Unlike ImportStepFor, each ignored attribute must exist within the schema of
+// the Resource and only matches that attribute (or the nested block it refers to) rather than any attribute with the
+// same prefix - such that attributes which aren't being set by the Read function (e.g. Optional or Sensitive
+// attributes) can't be masked by an unrelated ignored attribute.
+func (td TestData) ImportStepStrictFor(resourceName string, ignore ...string) resource.TestStep {
+	if strings.HasPrefix(resourceName, "data.") {
+		return td.ImportStepFor(resourceName, ignore...)
+	}
+
+	// the state prior to the import is captured when the ID to import is determined
+	var expected *terraform.InstanceState
+	var resourceType string
+
+	return resource.TestStep{
+		ResourceName: resourceName,
+		ImportState:  true,
+		ImportStateIdFunc: func(state *terraform.State) (string, error) {
+			rs, ok := state.RootModule().Resources[resourceName]
+			if !ok || rs.Primary == nil {
+				return "", fmt.Errorf("Resource not found: %s", resourceName)
+			}
+
+			expected = rs.Primary
+			resourceType = rs.Type
+			return rs.Primary.ID, nil
+		},
+		ImportStateCheck: func(states []*terraform.InstanceState) error {
+			if err := validateIgnoredAttributes(resourceType, ignore); err != nil {
+				return err
+			}
+
+			for _, state := range states {
+				if state.Ephemeral.Type != resourceType || state.ID != expected.ID {
+					continue
+				}
+
+				if err := provider.ValidateImportedState(resourceType, state.Attributes, ignore...); err != nil {
+					return err
+				}
+
+				return verifyImportedAttributes(expected.Attributes, state.Attributes, ignore)
+			}
+
+			return fmt.Errorf("the imported state for %s (ID %q) was not found", resourceName, expected.ID)
+		},
+	}
+}
+
 // RequiresImportErrorStep returns a Test Step which expects a Requires Import
 // error to be returned when running this step
 func (td TestData) RequiresImportErrorStep(configBuilder func(data TestData) string) resource.TestStep {
@@ -0,0 +1,109 @@
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+const (
+	// DefaultMaxAge is the age after which Resources created by the Acceptance Tests are swept, which is long
+	// enough that Resources belonging to a test run which is still in progress aren't deleted
+	DefaultMaxAge = 6 * time.Hour
+
+	// AllLocations can be specified as the region (using `-sweep=all`) to sweep Resources in every location
+	AllLocations = "all"
+)
+
+// Prefixes are the prefixes of the names of the Resources created by the Acceptance Tests
+var Prefixes = []string{
+	"acctest",
+	"unlikely23exst2acct",
+}
+
+// timestampInName matches the timestamp (in the format `YYMMddHHmmss`) at the start of the random integer
+// generated by `acceptance.RandTimeInt`, which is used within the names of most test Resources
+var timestampInName = regexp.MustCompile(`(\d{12})\d{6}`)
+
+// MaxAge returns the age after which Resources are swept, which can be overridden using the `ARM_SWEEP_MAX_AGE`
+// Environment Variable (for example `2h`)
+func MaxAge() (time.Duration, error) {
+	v := os.Getenv("ARM_SWEEP_MAX_AGE")
+	if v == "" {
+		return DefaultMaxAge, nil
+	}
+
+	maxAge, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("parsing `ARM_SWEEP_MAX_AGE` %q: %+v", v, err)
+	}
+
+	return maxAge, nil
+}
+
+// Criteria determines which Resources should be swept
+type Criteria struct {
+	// Location is the location being swept, or AllLocations
+	Location string
+
+	// MaxAge is the age after which Resources are swept
+	MaxAge time.Duration
+
+	// Now is the time the sweep started
+	Now time.Time
+}
+
+// ShouldSweep returns whether the Resource should be swept - which is when its name starts with one of the
+// Prefixes, it's within the location being swept and it's older than the maximum age. The age is determined
+// using the time the Resource was created when this is known, and otherwise the timestamp within its name -
+// Resources whose age can't be determined are never swept.
+func (c Criteria) ShouldSweep(name, resourceLocation string, created *time.Time) bool {
+	if !HasPrefix(name) {
+		return false
+	}
+
+	if !strings.EqualFold(c.Location, AllLocations) && location.Normalize(c.Location) != location.Normalize(resourceLocation) {
+		return false
+	}
+
+	if created == nil {
+		timestamp, ok := TimestampFromName(name)
+		if !ok {
+			return false
+		}
+		created = &timestamp
+	}
+
+	return c.Now.Sub(*created) > c.MaxAge
+}
+
+// HasPrefix returns whether the name starts with one of the Prefixes used by the Acceptance Tests
+func HasPrefix(name string) bool {
+	for _, prefix := range Prefixes {
+		if strings.HasPrefix(strings.ToLower(name), prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TimestampFromName returns the time at which the random integer within the name was generated - which since
+// `acceptance.RandTimeInt` uses the local time, is parsed in the local time zone
+func TimestampFromName(name string) (time.Time, bool) {
+	match := timestampInName.FindStringSubmatch(name)
+	if len(match) != 2 {
+		return time.Time{}, false
+	}
+
+	timestamp, err := time.ParseInLocation("060102150405", match[1], time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return timestamp, true
+}
@@ -0,0 +1,127 @@
+package sweep
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCriteriaShouldSweep(t *testing.T) {
+	now := time.Date(2022, 3, 15, 12, 0, 0, 0, time.Local)
+	old := now.Add(-24 * time.Hour)
+	recent := now.Add(-time.Hour)
+
+	testData := []struct {
+		Name     string
+		Resource string
+		Location string
+		Created  *time.Time
+		Expected bool
+	}{
+		{
+			Name:     "old resource group",
+			Resource: "acctestRG-2203141200000000000",
+			Location: "local",
+			Expected: true,
+		},
+		{
+			Name:     "recent resource group",
+			Resource: "acctestRG-2203151100000000000",
+			Location: "local",
+			Expected: false,
+		},
+		{
+			Name:     "not created by the acceptance tests",
+			Resource: "production-2203141200000000000",
+			Location: "local",
+			Expected: false,
+		},
+		{
+			Name:     "different location",
+			Resource: "acctestRG-2203141200000000000",
+			Location: "remote",
+			Expected: false,
+		},
+		{
+			Name:     "unknown age",
+			Resource: "unlikely23exst2acctabcde",
+			Location: "local",
+			Expected: false,
+		},
+		{
+			Name:     "old creation time",
+			Resource: "unlikely23exst2acctabcde",
+			Location: "local",
+			Created:  &old,
+			Expected: true,
+		},
+		{
+			Name:     "recent creation time",
+			Resource: "acctestsa2203141200000000000",
+			Location: "local",
+			Created:  &recent,
+			Expected: false,
+		},
+	}
+
+	criteria := Criteria{
+		Location: "Local",
+		MaxAge:   DefaultMaxAge,
+		Now:      now,
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := criteria.ShouldSweep(v.Resource, v.Location, v.Created); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+
+	criteria.Location = AllLocations
+	if !criteria.ShouldSweep("acctestRG-2203141200000000000", "remote", nil) {
+		t.Fatalf("Expected Resources in every location to be swept")
+	}
+}
+
+func TestTimestampFromName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *time.Time
+	}{
+		{
+			Input: "acctestRG-220314120000001234",
+			Expected: func() *time.Time {
+				v := time.Date(2022, 3, 14, 12, 0, 0, 0, time.Local)
+				return &v
+			}(),
+		},
+		{
+			Input: "acctestRG-storage-220314120000001234",
+			Expected: func() *time.Time {
+				v := time.Date(2022, 3, 14, 12, 0, 0, 0, time.Local)
+				return &v
+			}(),
+		},
+		{
+			Input: "acctestRG-1234",
+		},
+		{
+			Input: "unlikely23exst2acctabcde",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, ok := TimestampFromName(v.Input)
+		if v.Expected == nil {
+			if ok {
+				t.Fatalf("Expected no timestamp but got %s", actual)
+			}
+			continue
+		}
+
+		if !ok || !actual.Equal(*v.Expected) {
+			t.Fatalf("Expected %s but got %s", *v.Expected, actual)
+		}
+	}
+}
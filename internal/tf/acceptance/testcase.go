@@ -1,11 +1,12 @@
 package acceptance
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/acceptance/helpers"
@@ -105,23 +106,30 @@ func RunTestsInSequence(t *testing.T, tests map[string]map[string]func(t *testin
 
 func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 	//	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProviderFactories = td.providers()
+	testCase.ProtoV5ProviderFactories = td.providers()
+	td.substituteSkus(&testCase)
 
 	resource.ParallelTest(t, testCase)
 }
 
 func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.TestCase) {
 	//	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProviderFactories = td.providers()
+	testCase.ProtoV5ProviderFactories = td.providers()
+	td.substituteSkus(&testCase)
 
 	resource.Test(t, testCase)
 }
 
-func (td TestData) providers() map[string]func() (*schema.Provider, error) {
-	return map[string]func() (*schema.Provider, error){
-		"azurestack": func() (*schema.Provider, error) { //nolint:unparam
-			azurestack := provider.TestAzureProvider()
-			return azurestack, nil
+// providers returns the muxed Provider Server (rather than the Plugin SDK provider), so that the Resources and
+// Data Sources built using the Plugin Framework are available - and that the Provider being tested is the one served
+func (td TestData) providers() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"azurestack": func() (tfprotov5.ProviderServer, error) {
+			factory, err := provider.ProtoV5ProviderServerFactory(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			return factory(), nil
 		},
 	}
 }
@@ -134,3 +142,10 @@ func (td TestData) providers() map[string]func() (*schema.Provider, error) {
 		},
 	}
 }*/
+
+// substituteSkus replaces the SKUs within the configuration of each step which aren't available on the Stamp
+func (td TestData) substituteSkus(testCase *resource.TestCase) {
+	for i := range testCase.Steps {
+		testCase.Steps[i].Config = td.Skus.Substitute(testCase.Steps[i].Config)
+	}
+}
@@ -44,8 +44,14 @@ func Build() (*clients.Client, error) {
 			return nil, fmt.Errorf("building ARM Client: %+v", err)
 		}
 
+		adminConfig, err := buildAdminAuthConfig(builder)
+		if err != nil {
+			return nil, err
+		}
+
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:               config,
+			AdminAuthConfig:          adminConfig,
 			SkipProviderRegistration: true,
 			TerraformVersion:         os.Getenv("TERRAFORM_CORE_VERSION"),
 			Features:                 features.Default(),
@@ -59,3 +65,32 @@ func Build() (*clients.Client, error) {
 
 	return _client, nil
 }
+
+// buildAdminAuthConfig returns the authentication configuration for the Admin endpoint when it's been configured,
+// the credentials default to those used for the Tenant endpoint
+func buildAdminAuthConfig(builder authentication.Builder) (*authentication.Config, error) {
+	endpoint := os.Getenv("ARM_ADMIN_ENDPOINT")
+	if endpoint == "" {
+		return nil, nil
+	}
+
+	builder.SubscriptionID = os.Getenv("ARM_ADMIN_SUBSCRIPTION_ID")
+	builder.CustomResourceManagerEndpoint = endpoint
+	builder.MetadataHost = endpoint
+	if v := os.Getenv("ARM_ADMIN_CLIENT_ID"); v != "" {
+		builder.ClientID = v
+	}
+	if v := os.Getenv("ARM_ADMIN_CLIENT_SECRET"); v != "" {
+		builder.ClientSecret = v
+	}
+	if v := os.Getenv("ARM_ADMIN_TENANT_ID"); v != "" {
+		builder.TenantID = v
+	}
+
+	config, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("building ARM Admin Client: %+v", err)
+	}
+
+	return config, nil
+}
@@ -31,6 +31,21 @@ func PreCheck(t *testing.T) {
 	}
 }
 
+// PreCheckAdmin skips the test when the Admin API hasn't been configured, since these tests
+// require Cloud Operator credentials for the Azure Stack Hub Stamp
+func PreCheckAdmin(t *testing.T) {
+	variables := []string{
+		"ARM_ADMIN_ENDPOINT",
+		"ARM_ADMIN_SUBSCRIPTION_ID",
+	}
+
+	for _, variable := range variables {
+		if os.Getenv(variable) == "" {
+			t.Skipf("`%s` must be set to run the Admin acceptance tests", variable)
+		}
+	}
+}
+
 func EnvironmentName() string {
 	envName, exists := os.LookupEnv("ARM_ENVIRONMENT")
 	if !exists {
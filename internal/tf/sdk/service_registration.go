@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
 )
 
@@ -37,3 +38,41 @@ type UntypedServiceRegistration interface {
 	// SupportedResources returns the supported Resources supported by this Service
 	SupportedResources() map[string]*pluginsdk.Resource
 }
+
+// UntypedServiceRegistrationWithImportMetadata is an optional interface which can be implemented by an
+// UntypedServiceRegistration to describe the attributes of its Resources which aren't returned by the API,
+// and as such aren't populated when the Resource is imported.
+type UntypedServiceRegistrationWithImportMetadata interface {
+	UntypedServiceRegistration
+
+	// AttributesNotReturnedByAPI returns the attributes (using the format `block.attribute` for attributes
+	// within a block) which aren't returned by the API, keyed by the Resource Type
+	AttributesNotReturnedByAPI() map[string][]string
+}
+
+// UntypedServiceRegistrationWithCapabilities is an optional interface which can be implemented by an
+// UntypedServiceRegistration to describe the Resource Types provisioned by its Resources, which are checked
+// against the Resource Types supported by the Azure Stack Hub at plan time.
+type UntypedServiceRegistrationWithCapabilities interface {
+	UntypedServiceRegistration
+
+	// ResourceProviderTypes returns the Resource Type (for example `Microsoft.EventHub/namespaces`) provisioned
+	// by each Resource, keyed by the Terraform Resource Type
+	ResourceProviderTypes() map[string]string
+}
+
+// FrameworkServiceRegistration is a Service Registration for Resources and Data Sources built using the
+// Plugin Framework, which are served alongside the Plugin SDK resources via a mux server.
+type FrameworkServiceRegistration interface {
+	// Name is the name of this Service
+	Name() string
+
+	// WebsiteCategories returns a list of categories which can be used for the sidebar
+	WebsiteCategories() []string
+
+	// FrameworkDataSources returns the Plugin Framework Data Sources supported by this Service
+	FrameworkDataSources() map[string]tfsdk.DataSourceType
+
+	// FrameworkResources returns the Plugin Framework Resources supported by this Service
+	FrameworkResources() map[string]tfsdk.ResourceType
+}
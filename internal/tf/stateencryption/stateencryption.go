@@ -0,0 +1,179 @@
+package stateencryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+// prefix identifies values within the state which have been encrypted, allowing state written
+// prior to encryption being enabled to continue to be read
+const prefix = "encrypted:v1:"
+
+// Encrypter encrypts sensitive values using a customer-provided key before they're written into
+// the state, and decrypts them when they're read back.
+//
+// A nil Encrypter is valid and means that State Encryption is disabled, in which case values are
+// written into the state as-is.
+type Encrypter struct {
+	aead cipher.AEAD
+}
+
+// New returns an Encrypter for the base64-encoded 256-bit key, or nil when no key is specified
+func New(key string) (*Encrypter, error) {
+	if key == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the State Encryption Key: expected a base64-encoded value: %+v", err)
+	}
+
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("the State Encryption Key must be 256 bits (32 bytes) but got %d bytes", len(raw))
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, fmt.Errorf("building cipher for the State Encryption Key: %+v", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("building GCM cipher for the State Encryption Key: %+v", err)
+	}
+
+	return &Encrypter{
+		aead: aead,
+	}, nil
+}
+
+// Enabled returns whether State Encryption has been configured
+func (e *Encrypter) Enabled() bool {
+	return e != nil && e.aead != nil
+}
+
+// Encrypt encrypts the plaintext value, returning it unchanged when State Encryption is disabled
+func (e *Encrypter) Encrypt(plaintext string) (string, error) {
+	if !e.Enabled() || plaintext == "" {
+		return plaintext, nil
+	}
+
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("generating nonce: %+v", err)
+	}
+
+	sealed := e.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value previously returned from Encrypt - values which aren't encrypted are
+// returned unchanged
+func (e *Encrypter) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	if !e.Enabled() {
+		return "", fmt.Errorf("the value is encrypted but `state_encryption_key` isn't configured in the Provider block")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, prefix))
+	if err != nil {
+		return "", fmt.Errorf("decoding encrypted value: %+v", err)
+	}
+
+	nonceSize := e.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", fmt.Errorf("decoding encrypted value: value is too short")
+	}
+
+	plaintext, err := e.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypting value - this can happen when the `state_encryption_key` has changed: %+v", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// IsEncrypted returns whether the value has been encrypted by an Encrypter
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Get returns the decrypted value of the specified key
+func (e *Encrypter) Get(d *pluginsdk.ResourceData, key string) (string, error) {
+	v, err := e.Decrypt(d.Get(key).(string))
+	if err != nil {
+		return "", fmt.Errorf("decrypting `%s`: %+v", key, err)
+	}
+
+	return v, nil
+}
+
+// Set encrypts the plaintext value and sets it for the specified key.
+//
+// Since each encryption produces a different value, when the value currently in the state is
+// already an encrypted copy of the plaintext value it's retained to avoid rewriting the state.
+func (e *Encrypter) Set(d *pluginsdk.ResourceData, key string, plaintext *string) error {
+	value := ""
+	if plaintext != nil {
+		value = *plaintext
+	}
+
+	if existing := d.Get(key).(string); IsEncrypted(existing) && e.Enabled() {
+		if decrypted, err := e.Decrypt(existing); err == nil && decrypted == value {
+			return nil
+		}
+	}
+
+	encrypted, err := e.Encrypt(value)
+	if err != nil {
+		return fmt.Errorf("encrypting `%s`: %+v", key, err)
+	}
+
+	return d.Set(key, encrypted)
+}
+
+// configured is the Encrypter for the `state_encryption_key` in the Provider block, which is
+// registered when the Provider is configured since a DiffSuppressFunc doesn't have access to the
+// Provider's meta
+var configured struct {
+	sync.RWMutex
+	encrypter *Encrypter
+}
+
+// Register makes the Encrypter available to DiffSuppressFunc, and is called when the Provider is configured
+func Register(e *Encrypter) {
+	configured.Lock()
+	defer configured.Unlock()
+	configured.encrypter = e
+}
+
+// DiffSuppressFunc suppresses the diff when State Encryption is enabled and the value in the state
+// is an encrypted copy of the value in the configuration. Removing the value from the configuration
+// is never suppressed.
+func DiffSuppressFunc(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	if !IsEncrypted(old) || new == "" {
+		return false
+	}
+
+	configured.RLock()
+	e := configured.encrypter
+	configured.RUnlock()
+	if !e.Enabled() {
+		return false
+	}
+
+	decrypted, err := e.Decrypt(old)
+	return err == nil && decrypted == new
+}
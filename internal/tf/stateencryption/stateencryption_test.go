@@ -0,0 +1,174 @@
+package stateencryption
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		Name    string
+		Key     string
+		Enabled bool
+		Error   bool
+	}{
+		{
+			Name:    "empty",
+			Key:     "",
+			Enabled: false,
+		},
+		{
+			Name:  "not base64",
+			Key:   "not a base64 value!",
+			Error: true,
+		},
+		{
+			Name:  "too short",
+			Key:   base64.StdEncoding.EncodeToString([]byte("too-short")),
+			Error: true,
+		},
+		{
+			Name:    "valid",
+			Key:     testKey(),
+			Enabled: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			e, err := New(tc.Key)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but didn't get one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if e.Enabled() != tc.Enabled {
+				t.Fatalf("expected Enabled to be %t but got %t", tc.Enabled, e.Enabled())
+			}
+		})
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	e, err := New(testKey())
+	if err != nil {
+		t.Fatalf("building Encrypter: %+v", err)
+	}
+
+	plaintext := "DefaultEndpointsProtocol=https;AccountName=example;AccountKey=c2VjcmV0"
+	encrypted, err := e.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("encrypting: %+v", err)
+	}
+
+	if !IsEncrypted(encrypted) {
+		t.Fatalf("expected %q to be encrypted", encrypted)
+	}
+	if strings.Contains(encrypted, plaintext) {
+		t.Fatalf("expected the encrypted value not to contain the plaintext")
+	}
+
+	again, err := e.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("encrypting: %+v", err)
+	}
+	if again == encrypted {
+		t.Fatalf("expected each encryption to use a different nonce")
+	}
+
+	decrypted, err := e.Decrypt(encrypted)
+	if err != nil {
+		t.Fatalf("decrypting: %+v", err)
+	}
+	if decrypted != plaintext {
+		t.Fatalf("expected %q but got %q", plaintext, decrypted)
+	}
+
+	// values written before encryption was enabled are returned as-is
+	unencrypted, err := e.Decrypt(plaintext)
+	if err != nil {
+		t.Fatalf("decrypting: %+v", err)
+	}
+	if unencrypted != plaintext {
+		t.Fatalf("expected %q but got %q", plaintext, unencrypted)
+	}
+
+	other, err := New(base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210")))
+	if err != nil {
+		t.Fatalf("building Encrypter: %+v", err)
+	}
+	if _, err := other.Decrypt(encrypted); err == nil {
+		t.Fatalf("expected an error decrypting with a different key but didn't get one")
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	var e *Encrypter
+
+	encrypted, err := e.Encrypt("value")
+	if err != nil {
+		t.Fatalf("encrypting: %+v", err)
+	}
+	if encrypted != "value" {
+		t.Fatalf("expected the value to be unchanged but got %q", encrypted)
+	}
+
+	enabled, err := New(testKey())
+	if err != nil {
+		t.Fatalf("building Encrypter: %+v", err)
+	}
+	value, err := enabled.Encrypt("value")
+	if err != nil {
+		t.Fatalf("encrypting: %+v", err)
+	}
+
+	if _, err := e.Decrypt(value); err == nil {
+		t.Fatalf("expected an error decrypting without a key but didn't get one")
+	}
+}
+
+func testKey() string {
+	return base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+}
+
+func TestDiffSuppressFunc(t *testing.T) {
+	e, err := New(testKey())
+	if err != nil {
+		t.Fatalf("building Encrypter: %+v", err)
+	}
+	encrypted, err := e.Encrypt("value")
+	if err != nil {
+		t.Fatalf("encrypting: %+v", err)
+	}
+
+	defer Register(nil)
+
+	testData := []struct {
+		name      string
+		encrypter *Encrypter
+		old       string
+		new       string
+		expected  bool
+	}{
+		{name: "disabled", encrypter: nil, old: encrypted, new: "value", expected: false},
+		{name: "unchanged", encrypter: e, old: encrypted, new: "value", expected: true},
+		{name: "changed", encrypter: e, old: encrypted, new: "other", expected: false},
+		{name: "removed", encrypter: e, old: encrypted, new: "", expected: false},
+		{name: "not encrypted", encrypter: e, old: "value", new: "value", expected: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		Register(v.encrypter)
+		if actual := DiffSuppressFunc("shared_key", v.old, v.new, nil); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package suppress
+
+import "testing"
+
+func TestJsonDiff(t *testing.T) {
+	cases := []struct {
+		Name     string
+		JsonA    string
+		JsonB    string
+		Suppress bool
+	}{
+		{
+			Name:     "empty",
+			JsonA:    "",
+			JsonB:    "",
+			Suppress: true,
+		},
+		{
+			Name:     "empty vs document",
+			JsonA:    `{"hello": "world"}`,
+			JsonB:    "",
+			Suppress: false,
+		},
+		{
+			Name:     "invalid json",
+			JsonA:    `{"hello": "world"}`,
+			JsonB:    `{"hello": `,
+			Suppress: false,
+		},
+		{
+			Name:     "different whitespace",
+			JsonA:    `{"hello": "world", "values": [1, 2]}`,
+			JsonB:    "{\n  \"hello\":\"world\",\n  \"values\":[1,2]\n}",
+			Suppress: true,
+		},
+		{
+			Name:     "different key ordering",
+			JsonA:    `{"a": 1, "b": {"c": true, "d": null}}`,
+			JsonB:    `{"b": {"d": null, "c": true}, "a": 1}`,
+			Suppress: true,
+		},
+		{
+			Name:     "different values",
+			JsonA:    `{"hello": "world"}`,
+			JsonB:    `{"hello": "there"}`,
+			Suppress: false,
+		},
+		{
+			Name:     "different array ordering",
+			JsonA:    `{"values": [1, 2]}`,
+			JsonB:    `{"values": [2, 1]}`,
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if JsonDiff("test", tc.JsonA, tc.JsonB, nil) != tc.Suppress {
+				t.Fatalf("Expected JsonDiff to return %t for '%q' == '%q'", tc.Suppress, tc.JsonA, tc.JsonB)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package timeouts
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// approachingTimeoutPercentage is the percentage of the timeout which an operation has to take before it's
+// considered to be approaching the timeout
+const approachingTimeoutPercentage = 80
+
+// Operation is a single Create, Update or Delete operation performed by the Provider
+type Operation struct {
+	ResourceType string
+	ResourceId   string
+	Action       string
+	Duration     time.Duration
+	Timeout      time.Duration
+	TimedOut     bool
+}
+
+// ApproachingTimeout returns whether the operation timed out, or took long enough that it's at risk of
+// timing out in the future
+func (o Operation) ApproachingTimeout() bool {
+	if o.TimedOut {
+		return true
+	}
+	if o.Timeout <= 0 {
+		return false
+	}
+
+	return o.Duration*100 >= o.Timeout*approachingTimeoutPercentage
+}
+
+// OperationTracker records the duration of the operations performed by the Provider during a Terraform run
+type OperationTracker struct {
+	mu         sync.Mutex
+	operations []Operation
+}
+
+func NewOperationTracker() *OperationTracker {
+	return &OperationTracker{
+		operations: make([]Operation, 0),
+	}
+}
+
+// Record adds the specified operation to the tracker
+func (t *OperationTracker) Record(operation Operation) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.operations = append(t.operations, operation)
+}
+
+// Slowest returns (up to) the specified number of operations, ordered from slowest to fastest
+func (t *OperationTracker) Slowest(count int) []Operation {
+	t.mu.Lock()
+	output := make([]Operation, len(t.operations))
+	copy(output, t.operations)
+	t.mu.Unlock()
+
+	sort.SliceStable(output, func(i, j int) bool {
+		return output[i].Duration > output[j].Duration
+	})
+
+	if len(output) > count {
+		output = output[:count]
+	}
+	return output
+}
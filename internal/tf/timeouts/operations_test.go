@@ -0,0 +1,80 @@
+package timeouts
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOperationApproachingTimeout(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Operation Operation
+		Expected  bool
+	}{
+		{
+			Name: "Well Within Timeout",
+			Operation: Operation{
+				Duration: 5 * time.Minute,
+				Timeout:  30 * time.Minute,
+			},
+			Expected: false,
+		},
+		{
+			Name: "Approaching Timeout",
+			Operation: Operation{
+				Duration: 24 * time.Minute,
+				Timeout:  30 * time.Minute,
+			},
+			Expected: true,
+		},
+		{
+			Name: "Timed Out",
+			Operation: Operation{
+				Duration: time.Minute,
+				Timeout:  30 * time.Minute,
+				TimedOut: true,
+			},
+			Expected: true,
+		},
+		{
+			Name: "No Timeout",
+			Operation: Operation{
+				Duration: time.Hour,
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := v.Operation.ApproachingTimeout(); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestOperationTrackerSlowest(t *testing.T) {
+	tracker := NewOperationTracker()
+	for i, duration := range []time.Duration{2 * time.Second, 5 * time.Second, time.Second, 4 * time.Second, 3 * time.Second} {
+		tracker.Record(Operation{
+			ResourceId: string(rune('a' + i)),
+			Duration:   duration,
+		})
+	}
+
+	slowest := tracker.Slowest(3)
+	if len(slowest) != 3 {
+		t.Fatalf("Expected 3 operations but got %d", len(slowest))
+	}
+
+	for i, expected := range []string{"b", "d", "e"} {
+		if slowest[i].ResourceId != expected {
+			t.Fatalf("Expected operation %d to be %q but got %q", i, expected, slowest[i].ResourceId)
+		}
+	}
+
+	if all := tracker.Slowest(10); len(all) != 5 {
+		t.Fatalf("Expected 5 operations but got %d", len(all))
+	}
+}
@@ -0,0 +1,62 @@
+package timeouts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+// Override is the set of timeouts configured for a Resource Type within the Provider block, which take
+// precedence over the default timeouts of the Resource - but not over those specified within the
+// `timeouts` block of the Resource itself
+type Override struct {
+	ResourceType string
+	Create       *time.Duration
+	Read         *time.Duration
+	Update       *time.Duration
+	Delete       *time.Duration
+
+	// PollingInterval is the interval between polls of the Long Running Operations performed by the Resource,
+	// which isn't part of the timeouts of the Resource and as such isn't applied by ApplyOverride
+	PollingInterval *time.Duration
+}
+
+// HasTimeouts returns whether the override specifies a timeout for any of the operations of the Resource
+func (o Override) HasTimeouts() bool {
+	return o.Create != nil || o.Read != nil || o.Update != nil || o.Delete != nil
+}
+
+// ApplyOverride returns a copy of the timeouts for a Resource with the default timeouts replaced by those
+// in the override. Since the operations which support a timeout form part of the Resource Schema, an error
+// is returned when the override specifies a timeout for an operation which the Resource doesn't support.
+func ApplyOverride(input *pluginsdk.ResourceTimeout, override Override) (*pluginsdk.ResourceTimeout, error) {
+	if input == nil {
+		return nil, fmt.Errorf("the Resource %q doesn't support custom timeouts", override.ResourceType)
+	}
+
+	output := *input
+	operations := []struct {
+		name     string
+		existing **time.Duration
+		override *time.Duration
+	}{
+		{name: pluginsdk.TimeoutCreate, existing: &output.Create, override: override.Create},
+		{name: pluginsdk.TimeoutRead, existing: &output.Read, override: override.Read},
+		{name: pluginsdk.TimeoutUpdate, existing: &output.Update, override: override.Update},
+		{name: pluginsdk.TimeoutDelete, existing: &output.Delete, override: override.Delete},
+	}
+	for _, operation := range operations {
+		if operation.override == nil {
+			continue
+		}
+
+		if *operation.existing == nil {
+			return nil, fmt.Errorf("the Resource %q doesn't support a custom `%s` timeout", override.ResourceType, operation.name)
+		}
+
+		*operation.existing = pluginsdk.DefaultTimeout(*operation.override)
+	}
+
+	return &output, nil
+}
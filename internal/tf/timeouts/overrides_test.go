@@ -0,0 +1,111 @@
+package timeouts
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurestack/internal/tf/pluginsdk"
+)
+
+func TestApplyOverride(t *testing.T) {
+	duration := func(input time.Duration) *time.Duration {
+		return &input
+	}
+
+	testData := []struct {
+		Name     string
+		Input    *pluginsdk.ResourceTimeout
+		Override Override
+		Expected *pluginsdk.ResourceTimeout
+		Error    bool
+	}{
+		{
+			Name:  "No Timeouts",
+			Input: nil,
+			Override: Override{
+				ResourceType: "azurestack_example",
+				Create:       duration(2 * time.Hour),
+			},
+			Error: true,
+		},
+		{
+			Name: "No Overrides",
+			Input: &pluginsdk.ResourceTimeout{
+				Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+				Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			},
+			Override: Override{
+				ResourceType: "azurestack_example",
+			},
+			Expected: &pluginsdk.ResourceTimeout{
+				Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+				Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			},
+		},
+		{
+			Name: "Overrides",
+			Input: &pluginsdk.ResourceTimeout{
+				Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+				Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+				Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+				Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+			},
+			Override: Override{
+				ResourceType: "azurestack_example",
+				Create:       duration(2 * time.Hour),
+				Delete:       duration(90 * time.Minute),
+			},
+			Expected: &pluginsdk.ResourceTimeout{
+				Create: pluginsdk.DefaultTimeout(2 * time.Hour),
+				Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+				Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+				Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+			},
+		},
+		{
+			Name: "Unsupported Operation",
+			Input: &pluginsdk.ResourceTimeout{
+				Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+				Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+				Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+			},
+			Override: Override{
+				ResourceType: "azurestack_example",
+				Update:       duration(2 * time.Hour),
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		var original *pluginsdk.ResourceTimeout
+		if v.Input != nil {
+			copied := *v.Input
+			original = &copied
+		}
+
+		actual, err := ApplyOverride(v.Input, v.Override)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+
+		// the defaults of the Resource itself shouldn't be changed
+		if !reflect.DeepEqual(v.Input, original) {
+			t.Fatalf("Expected the input to be unchanged but got %+v", v.Input)
+		}
+	}
+}
@@ -201,7 +201,7 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 
 		// the RP shouldn't be transformed
 		if key == "providers" {
-			r := regexp.MustCompile(`^Microsoft.[A-Z][A-Za-z]+$`)
+			r := regexp.MustCompile(`^Microsoft.[A-Z][A-Za-z]+(\.[A-Z][A-Za-z]+)?$`)
 			if !r.MatchString(value) {
 				return nil, fmt.Errorf("the resource provider in the id must begin with upper case got: %s", value)
 			}
@@ -900,6 +900,13 @@ func Test%[1]sIDInsensitively(t *testing.T) {
 }
 
 func (id ResourceIdGenerator) ValidatorCode() string {
+	// Resource IDs which are parsed insensitively are also validated insensitively, since the API can return
+	// these in a different casing - which users can then pass through from elsewhere (e.g. a Data Source)
+	parserName := fmt.Sprintf("%sID", id.TypeName)
+	if id.ShouldRewrite {
+		parserName = fmt.Sprintf("%sIDInsensitively", id.TypeName)
+	}
+
 	return fmt.Sprintf(`package validate
 
 // NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
@@ -917,13 +924,13 @@ func %[1]sID(input interface{}, key string) (warnings []string, errors []error)
 		return
 	}
 
-	if _, err := parse.%[1]sID(v); err != nil {
+	if _, err := parse.%[3]s(v); err != nil {
 		errors = append(errors, err)
 	}
 
 	return
 }
-`, id.TypeName, id.ServicePackageName)
+`, id.TypeName, id.ServicePackageName, parserName)
 }
 
 func (id ResourceIdGenerator) ValidatorTestCode() string {
@@ -970,6 +977,25 @@ func (id ResourceIdGenerator) ValidatorTestCode() string {
 			Valid: false,
 		},`, strings.ToUpper(id.IDRaw)))
 
+	if id.ShouldRewrite {
+		// Resource IDs parsed insensitively also accept the segment names in a different casing
+		resourceIdWithUpperCasedSegments := id.IDRaw
+		for _, segment := range id.Segments {
+			if segment.FieldName == "SubscriptionId" || segment.FieldName == "ResourceGroup" {
+				continue
+			}
+
+			resourceIdWithUpperCasedSegments = strings.Replace(resourceIdWithUpperCasedSegments, segment.SegmentKey, strings.ToUpper(segment.SegmentKey), 1)
+		}
+
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// upper-cased segment names
+			Input: %q,
+			Valid: true,
+		},`, resourceIdWithUpperCasedSegments))
+	}
+
 	testCasesStr := strings.Join(testCases, "\n")
 
 	if id.TestPackageSuffix == "" {
@@ -1,5 +1,7 @@
 package utils
 
+import "strings"
+
 func AllEquals(v ...interface{}) bool {
 	if len(v) > 1 {
 		a := v[0]
@@ -11,3 +13,45 @@ func AllEquals(v ...interface{}) bool {
 	}
 	return true
 }
+
+// NaturalLess returns whether a sorts before b, comparing runs of digits by their numeric value
+// rather than lexicographically - such that `img-v2` sorts before `img-v10`
+func NaturalLess(a, b string) bool {
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			aDigits, bDigits := leadingDigits(a), leadingDigits(b)
+			a, b = a[len(aDigits):], b[len(bDigits):]
+
+			aValue, bValue := strings.TrimLeft(aDigits, "0"), strings.TrimLeft(bDigits, "0")
+			if len(aValue) != len(bValue) {
+				return len(aValue) < len(bValue)
+			}
+			if aValue != bValue {
+				return aValue < bValue
+			}
+			if len(aDigits) != len(bDigits) {
+				return len(aDigits) < len(bDigits)
+			}
+			continue
+		}
+
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		a, b = a[1:], b[1:]
+	}
+
+	return len(a) < len(b)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func leadingDigits(input string) string {
+	i := 0
+	for i < len(input) && isDigit(input[i]) {
+		i++
+	}
+	return input[:i]
+}
@@ -0,0 +1,29 @@
+package utils
+
+import "testing"
+
+func TestNaturalLess(t *testing.T) {
+	testData := []struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		{a: "img-v2", b: "img-v10", expected: true},
+		{a: "img-v10", b: "img-v2", expected: false},
+		{a: "img-v1.2.3", b: "img-v1.10.0", expected: true},
+		{a: "img-20220101", b: "img-20220102", expected: true},
+		{a: "img-a", b: "img-b", expected: true},
+		{a: "img", b: "img-v1", expected: true},
+		{a: "img-v01", b: "img-v1", expected: false},
+		{a: "img-v1", b: "img-v01", expected: true},
+		{a: "img-v1", b: "img-v1", expected: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q < %q", v.a, v.b)
+
+		if actual := NaturalLess(v.a, v.b); actual != v.expected {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
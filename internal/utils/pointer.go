@@ -7,3 +7,7 @@ func Bool(input bool) *bool {
 func Int32(input int32) *int32 {
 	return &input
 }
+
+func Int64(input int64) *int64 {
+	return &input
+}
@@ -0,0 +1,31 @@
+#!/usr/bin/env bash
+
+# shared helpers for the scripts which validate and run the examples under ./examples
+
+EXAMPLES=${EXAMPLES:-./examples}
+TERRAFORM=${TF_ACC_TERRAFORM_PATH:-terraform}
+
+# findExamples lists each directory containing a `main.tf` - which is a standalone example
+function findExamples {
+  find "$EXAMPLES" -type f -name "main.tf" -not -path "*/.terraform/*" -exec dirname {} \; | sort
+}
+
+# configureDevOverrides builds the provider from this repository and configures Terraform
+# to use it, rather than the version published in the Registry
+function configureDevOverrides {
+  local pluginDir
+  pluginDir=$(mktemp -d)
+
+  echo "==> Building the Provider into $pluginDir.."
+  go build -o "$pluginDir/terraform-provider-azurestack" . || exit 1
+
+  export TF_CLI_CONFIG_FILE="$pluginDir/terraformrc"
+  cat > "$TF_CLI_CONFIG_FILE" <<CONFIG
+provider_installation {
+  dev_overrides {
+    "hashicorp/azurestack" = "$pluginDir"
+  }
+  direct {}
+}
+CONFIG
+}
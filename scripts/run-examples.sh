@@ -0,0 +1,122 @@
+#!/usr/bin/env bash
+
+# Applies (and then destroys) each example under ./examples against an Azure Stack Hub, recording
+# how long each took. When a baseline from a previous run is available, failures and slowdowns
+# are reported as regressions.
+
+source "$(dirname "$0")/examples-common.sh"
+
+RESULTS_FILE=${EXAMPLES_RESULTS_FILE:-examples-results.csv}
+BASELINE_FILE=${EXAMPLES_BASELINE_FILE:-}
+SLOWDOWN_THRESHOLD=${EXAMPLES_SLOWDOWN_THRESHOLD:-1.5}
+
+function checkEnvironment {
+  local missing=false
+  for v in ARM_ENDPOINT ARM_SUBSCRIPTION_ID ARM_TENANT_ID ARM_CLIENT_ID ARM_TEST_LOCATION; do
+    if [ -z "${!v}" ]; then
+      echo "$v must be set to run the examples"
+      missing=true
+    fi
+  done
+
+  if $missing; then
+    exit 1
+  fi
+}
+
+# exampleName returns a name for the example which is suitable for use in results/statistics keys
+function exampleName {
+  local name=${1#"$EXAMPLES"/}
+  echo "${name//\//.}"
+}
+
+# reportStatistic surfaces the duration of an example in TeamCity, so that it can be graphed over time
+function reportStatistic {
+  if [ -n "$TEAMCITY_VERSION" ]; then
+    echo "##teamcity[buildStatisticValue key='examples.$1.$2' value='$3']"
+  fi
+}
+
+function runExample {
+  local dir=$1
+  local name=$2
+  local prefix="acctex$RANDOM"
+  local status="passed"
+  local start applyEnd end
+
+  start=$(date +%s)
+  (
+    cd "$dir" || exit 1
+    "$TERRAFORM" init -input=false > /dev/null &&
+      "$TERRAFORM" apply -input=false -auto-approve -var "prefix=$prefix" -var "location=$ARM_TEST_LOCATION"
+  ) || status="failed"
+  applyEnd=$(date +%s)
+
+  # always attempt to clean up, regardless of whether the apply succeeded
+  (
+    cd "$dir" || exit 1
+    "$TERRAFORM" destroy -input=false -auto-approve -var "prefix=$prefix" -var "location=$ARM_TEST_LOCATION"
+  ) || status="failed"
+  end=$(date +%s)
+
+  rm -rf "$dir/.terraform" "$dir/.terraform.lock.hcl" "$dir/terraform.tfstate" "$dir/terraform.tfstate.backup"
+
+  reportStatistic "$name" "apply" "$((applyEnd - start))"
+  reportStatistic "$name" "destroy" "$((end - applyEnd))"
+  echo "$name,$status,$((applyEnd - start)),$((end - applyEnd))" >> "$RESULTS_FILE"
+}
+
+# compareWithBaseline prints any examples which failed, or which were notably slower than in the baseline
+function compareWithBaseline {
+  local regressed=false
+
+  while IFS=, read -r name status apply destroy; do
+    if [ "$name" == "name" ]; then
+      continue
+    fi
+
+    if [ "$status" != "passed" ]; then
+      echo "REGRESSION: $name $status"
+      regressed=true
+      continue
+    fi
+
+    if [ -z "$BASELINE_FILE" ] || [ ! -f "$BASELINE_FILE" ]; then
+      continue
+    fi
+
+    local previous
+    previous=$(grep "^$name,passed," "$BASELINE_FILE" | cut -d, -f3)
+    if [ -z "$previous" ] || [ "$previous" -eq 0 ]; then
+      continue
+    fi
+
+    if awk -v current="$apply" -v previous="$previous" -v threshold="$SLOWDOWN_THRESHOLD" 'BEGIN { exit !(current > previous * threshold) }'; then
+      echo "REGRESSION: $name took ${apply}s to apply, previously ${previous}s"
+      regressed=true
+    fi
+  done < "$RESULTS_FILE"
+
+  if $regressed; then
+    exit 1
+  fi
+}
+
+function main {
+  checkEnvironment
+  configureDevOverrides
+
+  echo "name,status,apply_seconds,destroy_seconds" > "$RESULTS_FILE"
+  for d in $(findExamples); do
+    local name
+    name=$(exampleName "$d")
+    echo "==> Running example $name.."
+    runExample "$d" "$name"
+  done
+
+  echo "==> Results:"
+  cat "$RESULTS_FILE"
+  compareWithBaseline
+}
+
+main
@@ -0,0 +1,39 @@
+#!/usr/bin/env bash
+
+source "$(dirname "$0")/examples-common.sh"
+
+function validateExamples {
+  local error=false
+
+  echo "==> Checking examples are formatted.."
+  "$TERRAFORM" fmt -check -recursive "$EXAMPLES" || error=true
+
+  echo "==> Validating examples.."
+  for d in $(findExamples); do
+    echo "    $d"
+    (
+      cd "$d" || exit 1
+      "$TERRAFORM" init -backend=false -input=false > /dev/null && "$TERRAFORM" validate
+    ) || error=true
+    rm -rf "$d/.terraform" "$d/.terraform.lock.hcl"
+  done
+
+  if $error; then
+    echo ""
+    echo "------------------------------------------------"
+    echo ""
+    echo "The examples listed above are either not formatted or contain errors."
+    echo "You can format the examples by running:"
+    echo ""
+    echo "$ terraform fmt -recursive ./examples"
+    echo ""
+    exit 1
+  fi
+}
+
+function main {
+  configureDevOverrides
+  validateExamples
+}
+
+main